@@ -3,6 +3,7 @@ package main
 import (
 	"flag"
 	"fmt"
+	"os"
 	"runtime"
 
 	"github.com/cludden/protoc-gen-go-temporal/internal/plugin"
@@ -15,6 +16,14 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "compat" {
+		if err := compat(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	showVersion := flag.Bool("version", false, "print the version and exit")
 	flag.Parse()
 	if *showVersion {
@@ -34,3 +43,27 @@ func main() {
 
 	opts.Run(p.Run)
 }
+
+// compat reports changes between two descriptor sets that are incompatible with in-flight
+// workflow executions
+func compat(args []string) error {
+	fs := flag.NewFlagSet("compat", flag.ExitOnError)
+	oldPath := fs.String("old", "", "path to the previous FileDescriptorSet (e.g. buf build -o old.binpb)")
+	newPath := fs.String("new", "", "path to the updated FileDescriptorSet")
+	fs.Parse(args)
+	if *oldPath == "" || *newPath == "" {
+		return fmt.Errorf("usage: protoc-gen-go_temporal compat --old <old.binpb> --new <new.binpb>")
+	}
+
+	changes, err := plugin.CheckCompatibility(*oldPath, *newPath)
+	if err != nil {
+		return err
+	}
+	for _, change := range changes {
+		fmt.Println(change)
+	}
+	if len(changes) > 0 {
+		return fmt.Errorf("found %d breaking change(s)", len(changes))
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	simplepb "github.com/cludden/protoc-gen-go-temporal/gen/simple"
+	temporalv1 "github.com/cludden/protoc-gen-go-temporal/gen/temporal/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// writeDescriptorSet writes a serialized FileDescriptorSet containing the simple.proto
+// test file and its dependencies, after applying the given change to simple.proto
+func writeDescriptorSet(t *testing.T, change func(*descriptorpb.FileDescriptorProto)) string {
+	t.Helper()
+	var set descriptorpb.FileDescriptorSet
+	seen := make(map[string]bool)
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		for i := 0; i < fd.Imports().Len(); i++ {
+			add(fd.Imports().Get(i).FileDescriptor)
+		}
+		file := protodesc.ToFileDescriptorProto(fd)
+		if fd == simplepb.File_simple_simple_proto {
+			change(file)
+		}
+		set.File = append(set.File, file)
+	}
+	add(simplepb.File_simple_simple_proto)
+
+	b, err := proto.Marshal(&set)
+	require.NoError(t, err)
+	filename := filepath.Join(t.TempDir(), "set.binpb")
+	require.NoError(t, os.WriteFile(filename, b, 0644))
+	return filename
+}
+
+func TestCompat(t *testing.T) {
+	require := require.New(t)
+
+	oldPath := writeDescriptorSet(t, func(*descriptorpb.FileDescriptorProto) {})
+	require.ErrorContains(compat([]string{"--old", oldPath}), "usage: protoc-gen-go_temporal compat")
+	require.NoError(compat([]string{"--old", oldPath, "--new", oldPath}))
+
+	newPath := writeDescriptorSet(t, func(file *descriptorpb.FileDescriptorProto) {
+		for _, svc := range file.GetService() {
+			for _, method := range svc.GetMethod() {
+				if method.GetName() == "SomeWorkflow3" {
+					opts := proto.GetExtension(method.GetOptions(), temporalv1.E_Workflow).(*temporalv1.WorkflowOptions)
+					opts.Id = "some-workflow-3/${! id }"
+					proto.SetExtension(method.GetOptions(), temporalv1.E_Workflow, opts)
+				}
+			}
+		}
+	})
+	require.EqualError(compat([]string{"--old", oldPath, "--new", newPath}), "found 1 breaking change(s)")
+}
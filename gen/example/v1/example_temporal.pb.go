@@ -16,9 +16,10 @@ import (
 	"fmt"
 	expression "github.com/cludden/protoc-gen-go-temporal/pkg/expression"
 	testutil "github.com/cludden/protoc-gen-go-temporal/pkg/testutil"
+	mock "github.com/stretchr/testify/mock"
 	v2 "github.com/urfave/cli/v2"
-	v1 "go.temporal.io/api/enums/v1"
-	v11 "go.temporal.io/api/update/v1"
+	v11 "go.temporal.io/api/enums/v1"
+	v1 "go.temporal.io/api/update/v1"
 	activity "go.temporal.io/sdk/activity"
 	client "go.temporal.io/sdk/client"
 	temporal "go.temporal.io/sdk/temporal"
@@ -27,6 +28,7 @@ import (
 	workflow "go.temporal.io/sdk/workflow"
 	protojson "google.golang.org/protobuf/encoding/protojson"
 	"sort"
+	"time"
 )
 
 // ExampleTaskQueue= is the default task-queue for a example.v1.Example worker
@@ -75,6 +77,18 @@ type ExampleClient interface {
 	CreateFooAsync(ctx context.Context, req *CreateFooRequest, opts ...*CreateFooOptions) (CreateFooRun, error)
 	// GetCreateFoo retrieves a handle to an existing example.v1.Example.CreateFoo workflow execution
 	GetCreateFoo(ctx context.Context, workflowID string, runID string) CreateFooRun
+	// CreateCreateFooSchedule creates a Temporal Schedule that periodically starts a(n) example.v1.Example.CreateFoo workflow
+	CreateCreateFooSchedule(ctx context.Context, id string, spec client.ScheduleSpec, req *CreateFooRequest, opts ...*CreateFooScheduleOptions) (client.ScheduleHandle, error)
+	// GetCreateFooSchedule describes an existing example.v1.Example.CreateFoo workflow schedule
+	GetCreateFooSchedule(ctx context.Context, id string) (*client.ScheduleDescription, error)
+	// PauseCreateFooSchedule pauses an existing example.v1.Example.CreateFoo workflow schedule with an optional note, failing if the schedule starts a different workflow
+	PauseCreateFooSchedule(ctx context.Context, id string, note string) error
+	// UnpauseCreateFooSchedule unpauses an existing example.v1.Example.CreateFoo workflow schedule with an optional note, failing if the schedule starts a different workflow
+	UnpauseCreateFooSchedule(ctx context.Context, id string, note string) error
+	// TriggerCreateFooSchedule immediately starts a(n) example.v1.Example.CreateFoo workflow using an existing schedule, failing if the schedule starts a different workflow
+	TriggerCreateFooSchedule(ctx context.Context, id string) error
+	// DeleteCreateFooSchedule deletes an existing example.v1.Example.CreateFoo workflow schedule, failing if the schedule starts a different workflow
+	DeleteCreateFooSchedule(ctx context.Context, id string) error
 	/*
 	   SetFooProgress sets the current status of a CreateFoo operation
 	*/
@@ -103,22 +117,123 @@ type ExampleClient interface {
 
 // exampleClient implements a temporal client for a example.v1.Example service
 type exampleClient struct {
-	client client.Client
+	client       client.Client
+	interceptors exampleClientInterceptors
 }
 
-// NewExampleClient initializes a new example.v1.Example client
-func NewExampleClient(c client.Client) ExampleClient {
-	return &exampleClient{client: c}
+// NewExampleClient initializes a new example.v1.Example client that invokes the hooks of the given interceptors
+func NewExampleClient(c client.Client, interceptors ...ExampleClientInterceptor) ExampleClient {
+	return &exampleClient{client: c, interceptors: interceptors}
 }
 
-// NewExampleClientWithOptions initializes a new Example client with the given options
-func NewExampleClientWithOptions(c client.Client, opts client.Options) (ExampleClient, error) {
+// NewExampleClientWithOptions initializes a new Example client with the given options that invokes the hooks of the given interceptors
+func NewExampleClientWithOptions(c client.Client, opts client.Options, interceptors ...ExampleClientInterceptor) (ExampleClient, error) {
 	var err error
 	c, err = client.NewClientFromExisting(c, opts)
 	if err != nil {
 		return nil, fmt.Errorf("error initializing client with options: %w", err)
 	}
-	return &exampleClient{client: c}, nil
+	return &exampleClient{client: c, interceptors: interceptors}, nil
+}
+
+// ExampleClientInterceptor describes typed hooks invoked around example.v1.Example workflows, queries, signals,
+// and updates sent by a client, which can be installed via the NewExampleClient functions
+type ExampleClientInterceptor interface {
+	// BeforeCreateFoo is invoked with the input of a(n) example.v1.Example.CreateFoo workflow before it is started
+	BeforeCreateFoo(ctx context.Context, req *CreateFooRequest)
+	// AfterCreateFoo is invoked with the result of a(n) example.v1.Example.CreateFoo workflow when it is retrieved
+	AfterCreateFoo(ctx context.Context, resp *CreateFooResponse, err error)
+	// BeforeGetFooProgress is invoked with the input of a(n) example.v1.Example.GetFooProgress query before it is sent
+	BeforeGetFooProgress(ctx context.Context)
+	// AfterGetFooProgress is invoked with the result of a(n) example.v1.Example.GetFooProgress query after it is received
+	AfterGetFooProgress(ctx context.Context, resp *GetFooProgressResponse, err error)
+	// OnSetFooProgress is invoked with the input of a(n) example.v1.Example.SetFooProgress signal before it is sent
+	OnSetFooProgress(ctx context.Context, req *SetFooProgressRequest)
+	// BeforeUpdateFooProgress is invoked with the input of a(n) example.v1.Example.UpdateFooProgress update before it is sent
+	BeforeUpdateFooProgress(ctx context.Context, req *SetFooProgressRequest)
+	// AfterUpdateFooProgress is invoked with the result of a(n) example.v1.Example.UpdateFooProgress update when it is retrieved
+	AfterUpdateFooProgress(ctx context.Context, resp *GetFooProgressResponse, err error)
+}
+
+// NoopExampleClientInterceptor provides a no-op ExampleClientInterceptor implementation that can be embedded
+// to implement a subset of hooks
+type NoopExampleClientInterceptor struct{}
+
+// BeforeCreateFoo implements ExampleClientInterceptor
+func (NoopExampleClientInterceptor) BeforeCreateFoo(context.Context, *CreateFooRequest) {}
+
+// AfterCreateFoo implements ExampleClientInterceptor
+func (NoopExampleClientInterceptor) AfterCreateFoo(context.Context, *CreateFooResponse, error) {}
+
+// BeforeGetFooProgress implements ExampleClientInterceptor
+func (NoopExampleClientInterceptor) BeforeGetFooProgress(context.Context) {}
+
+// AfterGetFooProgress implements ExampleClientInterceptor
+func (NoopExampleClientInterceptor) AfterGetFooProgress(context.Context, *GetFooProgressResponse, error) {
+}
+
+// OnSetFooProgress implements ExampleClientInterceptor
+func (NoopExampleClientInterceptor) OnSetFooProgress(context.Context, *SetFooProgressRequest) {}
+
+// BeforeUpdateFooProgress implements ExampleClientInterceptor
+func (NoopExampleClientInterceptor) BeforeUpdateFooProgress(context.Context, *SetFooProgressRequest) {
+}
+
+// AfterUpdateFooProgress implements ExampleClientInterceptor
+func (NoopExampleClientInterceptor) AfterUpdateFooProgress(context.Context, *GetFooProgressResponse, error) {
+}
+
+// exampleClientInterceptors invokes the hooks of multiple ExampleClientInterceptor values, in order for
+// Before<Method> and On<Method> hooks and in reverse order for After<Method> hooks
+type exampleClientInterceptors []ExampleClientInterceptor
+
+// BeforeCreateFoo implements ExampleClientInterceptor
+func (c exampleClientInterceptors) BeforeCreateFoo(ctx context.Context, req *CreateFooRequest) {
+	for _, interceptor := range c {
+		interceptor.BeforeCreateFoo(ctx, req)
+	}
+}
+
+// AfterCreateFoo implements ExampleClientInterceptor
+func (c exampleClientInterceptors) AfterCreateFoo(ctx context.Context, resp *CreateFooResponse, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterCreateFoo(ctx, resp, err)
+	}
+}
+
+// BeforeGetFooProgress implements ExampleClientInterceptor
+func (c exampleClientInterceptors) BeforeGetFooProgress(ctx context.Context) {
+	for _, interceptor := range c {
+		interceptor.BeforeGetFooProgress(ctx)
+	}
+}
+
+// AfterGetFooProgress implements ExampleClientInterceptor
+func (c exampleClientInterceptors) AfterGetFooProgress(ctx context.Context, resp *GetFooProgressResponse, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterGetFooProgress(ctx, resp, err)
+	}
+}
+
+// OnSetFooProgress implements ExampleClientInterceptor
+func (c exampleClientInterceptors) OnSetFooProgress(ctx context.Context, req *SetFooProgressRequest) {
+	for _, interceptor := range c {
+		interceptor.OnSetFooProgress(ctx, req)
+	}
+}
+
+// BeforeUpdateFooProgress implements ExampleClientInterceptor
+func (c exampleClientInterceptors) BeforeUpdateFooProgress(ctx context.Context, req *SetFooProgressRequest) {
+	for _, interceptor := range c {
+		interceptor.BeforeUpdateFooProgress(ctx, req)
+	}
+}
+
+// AfterUpdateFooProgress implements ExampleClientInterceptor
+func (c exampleClientInterceptors) AfterUpdateFooProgress(ctx context.Context, resp *GetFooProgressResponse, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterUpdateFooProgress(ctx, resp, err)
+	}
 }
 
 // CreateFoo executes a example.v1.Example.CreateFoo workflow and blocks until error or response received
@@ -132,28 +247,18 @@ func (c *exampleClient) CreateFoo(ctx context.Context, req *CreateFooRequest, op
 
 // CreateFooAsync starts a(n) example.v1.Example.CreateFoo workflow
 func (c *exampleClient) CreateFooAsync(ctx context.Context, req *CreateFooRequest, options ...*CreateFooOptions) (CreateFooRun, error) {
-	opts := &client.StartWorkflowOptions{}
-	if len(options) > 0 && options[0].opts != nil {
-		opts = options[0].opts
-	}
-	if opts.TaskQueue == "" {
-		opts.TaskQueue = ExampleTaskQueue
-	}
-	if opts.ID == "" {
-		id, err := expression.EvalExpression(CreateFooIDExpression, req.ProtoReflect())
-		if err != nil {
-			return nil, err
-		}
-		opts.ID = id
-	}
-	if opts.WorkflowIDReusePolicy == v1.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
-		opts.WorkflowIDReusePolicy = v1.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
+	var o *CreateFooOptions
+	if len(options) > 0 {
+		o = options[0]
 	}
-	if opts.WorkflowExecutionTimeout == 0 {
-		opts.WorkflowRunTimeout = 3600000000000 // 1h0m0s
+	opts, err := o.Build(req)
+	if err != nil {
+		return nil, err
 	}
+	c.interceptors.BeforeCreateFoo(ctx, req)
 	run, err := c.client.ExecuteWorkflow(ctx, *opts, CreateFooWorkflowName, req)
 	if err != nil {
+		c.interceptors.AfterCreateFoo(ctx, nil, err)
 		return nil, err
 	}
 	if run == nil {
@@ -173,6 +278,63 @@ func (c *exampleClient) GetCreateFoo(ctx context.Context, workflowID string, run
 	}
 }
 
+// CreateCreateFooSchedule creates a Temporal Schedule that periodically starts a(n) example.v1.Example.CreateFoo workflow
+func (c *exampleClient) CreateCreateFooSchedule(ctx context.Context, id string, spec client.ScheduleSpec, req *CreateFooRequest, options ...*CreateFooScheduleOptions) (client.ScheduleHandle, error) {
+	var o *CreateFooScheduleOptions
+	if len(options) > 0 {
+		o = options[0]
+	}
+	opts, err := o.Build(id, spec, req)
+	if err != nil {
+		return nil, err
+	}
+	return c.client.ScheduleClient().Create(ctx, *opts)
+}
+
+// GetCreateFooSchedule describes an existing example.v1.Example.CreateFoo workflow schedule
+func (c *exampleClient) GetCreateFooSchedule(ctx context.Context, id string) (*client.ScheduleDescription, error) {
+	desc, err := c.client.ScheduleClient().GetHandle(ctx, id).Describe(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if action, ok := desc.Schedule.Action.(*client.ScheduleWorkflowAction); !ok || action.Workflow != CreateFooWorkflowName {
+		return nil, fmt.Errorf("schedule %q does not start a(n) %s workflow", id, CreateFooWorkflowName)
+	}
+	return desc, nil
+}
+
+// PauseCreateFooSchedule pauses an existing example.v1.Example.CreateFoo workflow schedule with an optional note, failing if the schedule starts a different workflow
+func (c *exampleClient) PauseCreateFooSchedule(ctx context.Context, id string, note string) error {
+	if _, err := c.GetCreateFooSchedule(ctx, id); err != nil {
+		return err
+	}
+	return c.client.ScheduleClient().GetHandle(ctx, id).Pause(ctx, client.SchedulePauseOptions{Note: note})
+}
+
+// UnpauseCreateFooSchedule unpauses an existing example.v1.Example.CreateFoo workflow schedule with an optional note, failing if the schedule starts a different workflow
+func (c *exampleClient) UnpauseCreateFooSchedule(ctx context.Context, id string, note string) error {
+	if _, err := c.GetCreateFooSchedule(ctx, id); err != nil {
+		return err
+	}
+	return c.client.ScheduleClient().GetHandle(ctx, id).Unpause(ctx, client.ScheduleUnpauseOptions{Note: note})
+}
+
+// TriggerCreateFooSchedule immediately starts a(n) example.v1.Example.CreateFoo workflow using an existing schedule, failing if the schedule starts a different workflow
+func (c *exampleClient) TriggerCreateFooSchedule(ctx context.Context, id string) error {
+	if _, err := c.GetCreateFooSchedule(ctx, id); err != nil {
+		return err
+	}
+	return c.client.ScheduleClient().GetHandle(ctx, id).Trigger(ctx, client.ScheduleTriggerOptions{})
+}
+
+// DeleteCreateFooSchedule deletes an existing example.v1.Example.CreateFoo workflow schedule, failing if the schedule starts a different workflow
+func (c *exampleClient) DeleteCreateFooSchedule(ctx context.Context, id string) error {
+	if _, err := c.GetCreateFooSchedule(ctx, id); err != nil {
+		return err
+	}
+	return c.client.ScheduleClient().GetHandle(ctx, id).Delete(ctx)
+}
+
 // CreateFooWithSetFooProgress starts a(n) example.v1.Example.CreateFoo workflow and sends a(n) example.v1.Example.SetFooProgress signal in a transaction
 func (c *exampleClient) CreateFooWithSetFooProgress(ctx context.Context, req *CreateFooRequest, signal *SetFooProgressRequest, options ...*CreateFooOptions) (*CreateFooResponse, error) {
 	run, err := c.CreateFooWithSetFooProgressAsync(ctx, req, signal, options...)
@@ -184,28 +346,19 @@ func (c *exampleClient) CreateFooWithSetFooProgress(ctx context.Context, req *Cr
 
 // CreateFooWithSetFooProgressAsync starts a(n) example.v1.Example.CreateFoo workflow and sends a(n) example.v1.Example.SetFooProgress signal in a transaction
 func (c *exampleClient) CreateFooWithSetFooProgressAsync(ctx context.Context, req *CreateFooRequest, signal *SetFooProgressRequest, options ...*CreateFooOptions) (CreateFooRun, error) {
-	opts := &client.StartWorkflowOptions{}
-	if len(options) > 0 && options[0].opts != nil {
-		opts = options[0].opts
-	}
-	if opts.TaskQueue == "" {
-		opts.TaskQueue = ExampleTaskQueue
-	}
-	if opts.ID == "" {
-		id, err := expression.EvalExpression(CreateFooIDExpression, req.ProtoReflect())
-		if err != nil {
-			return nil, err
-		}
-		opts.ID = id
-	}
-	if opts.WorkflowIDReusePolicy == v1.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
-		opts.WorkflowIDReusePolicy = v1.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
+	var o *CreateFooOptions
+	if len(options) > 0 {
+		o = options[0]
 	}
-	if opts.WorkflowExecutionTimeout == 0 {
-		opts.WorkflowRunTimeout = 3600000000000 // 1h0m0s
+	opts, err := o.Build(req)
+	if err != nil {
+		return nil, err
 	}
+	c.interceptors.BeforeCreateFoo(ctx, req)
+	c.interceptors.OnSetFooProgress(ctx, signal)
 	run, err := c.client.SignalWithStartWorkflow(ctx, opts.ID, SetFooProgressSignalName, signal, *opts, CreateFooWorkflowName, req)
 	if run == nil || err != nil {
+		c.interceptors.AfterCreateFoo(ctx, nil, err)
 		return nil, err
 	}
 	return &createFooRun{
@@ -216,17 +369,22 @@ func (c *exampleClient) CreateFooWithSetFooProgressAsync(ctx context.Context, re
 
 // GetFooProgress sends a(n) example.v1.Example.GetFooProgress query to an existing workflow
 func (c *exampleClient) GetFooProgress(ctx context.Context, workflowID string, runID string) (*GetFooProgressResponse, error) {
+	c.interceptors.BeforeGetFooProgress(ctx)
 	var resp GetFooProgressResponse
 	if val, err := c.client.QueryWorkflow(ctx, workflowID, runID, GetFooProgressQueryName); err != nil {
+		c.interceptors.AfterGetFooProgress(ctx, nil, err)
 		return nil, err
 	} else if err = val.Get(&resp); err != nil {
+		c.interceptors.AfterGetFooProgress(ctx, nil, err)
 		return nil, err
 	}
+	c.interceptors.AfterGetFooProgress(ctx, &resp, nil)
 	return &resp, nil
 }
 
 // SetFooProgress sends a(n) example.v1.Example.SetFooProgress signal to an existing workflow
 func (c *exampleClient) SetFooProgress(ctx context.Context, workflowID string, runID string, signal *SetFooProgressRequest) error {
+	c.interceptors.OnSetFooProgress(ctx, signal)
 	return c.client.SignalWorkflow(ctx, workflowID, runID, SetFooProgressSignalName, signal)
 }
 
@@ -236,7 +394,7 @@ func (c *exampleClient) UpdateFooProgress(ctx context.Context, workflowID string
 	if len(opts) > 0 && opts[0].opts != nil {
 		options = opts[0]
 	}
-	options.opts.WaitPolicy = &v11.WaitPolicy{LifecycleStage: v1.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED}
+	options.opts.WaitPolicy = &v1.WaitPolicy{LifecycleStage: v11.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED}
 	handle, err := c.UpdateFooProgressAsync(ctx, workflowID, runID, req, options)
 	if err != nil {
 		return nil, err
@@ -246,23 +404,18 @@ func (c *exampleClient) UpdateFooProgress(ctx context.Context, workflowID string
 
 // UpdateFooProgressAsync sends a(n) example.v1.Example.UpdateFooProgress update to an existing workflow
 func (c *exampleClient) UpdateFooProgressAsync(ctx context.Context, workflowID string, runID string, req *SetFooProgressRequest, opts ...*UpdateFooProgressOptions) (UpdateFooProgressHandle, error) {
-	options := &client.UpdateWorkflowWithOptionsRequest{}
-	if len(opts) > 0 && opts[0].opts != nil {
-		options = opts[0].opts
+	var o *UpdateFooProgressOptions
+	if len(opts) > 0 {
+		o = opts[0]
 	}
-	options.Args = []any{req}
-	options.RunID = runID
-	options.UpdateName = UpdateFooProgressUpdateName
-	options.WorkflowID = workflowID
-	if options.UpdateID == "" {
-		id, err := expression.EvalExpression(UpdateFooProgressIDExpression, req.ProtoReflect())
-		if err != nil {
-			return nil, fmt.Errorf("error evaluating %s id expression: %w", UpdateFooProgressUpdateName, err)
-		}
-		options.UpdateID = id
+	options, err := o.Build(workflowID, runID, req)
+	if err != nil {
+		return nil, err
 	}
+	c.interceptors.BeforeUpdateFooProgress(ctx, req)
 	handle, err := c.client.UpdateWorkflowWithOptions(ctx, options)
 	if err != nil {
+		c.interceptors.AfterUpdateFooProgress(ctx, nil, err)
 		return nil, err
 	}
 	return &updateFooProgressHandle{client: c, handle: handle}, nil
@@ -270,7 +423,9 @@ func (c *exampleClient) UpdateFooProgressAsync(ctx context.Context, workflowID s
 
 // CreateFooOptions provides configuration for a example.v1.Example.CreateFoo workflow operation
 type CreateFooOptions struct {
-	opts *client.StartWorkflowOptions
+	opts         *client.StartWorkflowOptions
+	cronSchedule *string
+	memo         map[string]any
 }
 
 // NewCreateFooOptions initializes a new CreateFooOptions value
@@ -284,6 +439,104 @@ func (opts *CreateFooOptions) WithStartWorkflowOptions(options client.StartWorkf
 	return opts
 }
 
+// WithCronSchedule overrides the default cron schedule, an empty schedule starts a non-cron workflow
+func (opts *CreateFooOptions) WithCronSchedule(schedule string) *CreateFooOptions {
+	opts.cronSchedule = &schedule
+	return opts
+}
+
+// WithMemo overrides the default workflow memo
+func (opts *CreateFooOptions) WithMemo(memo map[string]any) *CreateFooOptions {
+	opts.memo = memo
+	return opts
+}
+
+// Build initializes a client.StartWorkflowOptions with defaults for a(n) example.v1.Example.CreateFoo workflow operation
+func (o *CreateFooOptions) Build(req *CreateFooRequest) (*client.StartWorkflowOptions, error) {
+	opts := &client.StartWorkflowOptions{}
+	if o != nil && o.opts != nil {
+		opts = o.opts
+	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = ExampleTaskQueue
+	}
+	if o != nil && o.cronSchedule != nil {
+		opts.CronSchedule = *o.cronSchedule
+	}
+	if opts.ID == "" {
+		id, err := expression.EvalExpression(CreateFooIDExpression, req.ProtoReflect())
+		if err != nil {
+			return nil, err
+		}
+		opts.ID = id
+	}
+	if opts.WorkflowIDReusePolicy == v11.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
+		opts.WorkflowIDReusePolicy = v11.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
+	}
+	if opts.WorkflowExecutionTimeout == 0 {
+		opts.WorkflowRunTimeout = 3600000000000 // 1h0m0s
+	}
+	if o != nil && o.memo != nil {
+		opts.Memo = o.memo
+	}
+	return opts, nil
+}
+
+// CreateFooScheduleOptions provides configuration for a example.v1.Example.CreateFoo workflow schedule
+type CreateFooScheduleOptions struct {
+	opts         *client.ScheduleOptions
+	workflowOpts *CreateFooOptions
+}
+
+// NewCreateFooScheduleOptions initializes a new CreateFooScheduleOptions value
+func NewCreateFooScheduleOptions() *CreateFooScheduleOptions {
+	return &CreateFooScheduleOptions{}
+}
+
+// WithScheduleOptions sets the initial client.ScheduleOptions
+func (opts *CreateFooScheduleOptions) WithScheduleOptions(options client.ScheduleOptions) *CreateFooScheduleOptions {
+	opts.opts = &options
+	return opts
+}
+
+// WithWorkflowOptions sets the options used to initialize the scheduled workflow action
+func (opts *CreateFooScheduleOptions) WithWorkflowOptions(options *CreateFooOptions) *CreateFooScheduleOptions {
+	opts.workflowOpts = options
+	return opts
+}
+
+// Build initializes a client.ScheduleOptions with defaults for a(n) example.v1.Example.CreateFoo workflow schedule
+func (o *CreateFooScheduleOptions) Build(id string, spec client.ScheduleSpec, req *CreateFooRequest) (*client.ScheduleOptions, error) {
+	opts := &client.ScheduleOptions{}
+	if o != nil && o.opts != nil {
+		v := *o.opts
+		opts = &v
+	}
+	opts.ID = id
+	opts.Spec = spec
+	var workflowOpts *CreateFooOptions
+	if o != nil {
+		workflowOpts = o.workflowOpts
+	}
+	wopts, err := workflowOpts.Build(req)
+	if err != nil {
+		return nil, err
+	}
+	opts.Action = &client.ScheduleWorkflowAction{
+		ID:                       wopts.ID,
+		Workflow:                 CreateFooWorkflowName,
+		Args:                     []any{req},
+		TaskQueue:                wopts.TaskQueue,
+		WorkflowExecutionTimeout: wopts.WorkflowExecutionTimeout,
+		WorkflowRunTimeout:       wopts.WorkflowRunTimeout,
+		WorkflowTaskTimeout:      wopts.WorkflowTaskTimeout,
+		RetryPolicy:              wopts.RetryPolicy,
+		Memo:                     wopts.Memo,
+		SearchAttributes:         wopts.SearchAttributes,
+	}
+	return opts, nil
+}
+
 // CreateFooRun describes a(n) example.v1.Example.CreateFoo workflow run
 type CreateFooRun interface {
 	// ID returns the workflow ID
@@ -328,8 +581,10 @@ func (r *createFooRun) RunID() string {
 func (r *createFooRun) Get(ctx context.Context) (*CreateFooResponse, error) {
 	var resp CreateFooResponse
 	if err := r.run.Get(ctx, &resp); err != nil {
+		r.client.interceptors.AfterCreateFoo(ctx, nil, err)
 		return nil, err
 	}
+	r.client.interceptors.AfterCreateFoo(ctx, &resp, nil)
 	return &resp, nil
 }
 
@@ -390,8 +645,10 @@ func (h *updateFooProgressHandle) UpdateID() string {
 func (h *updateFooProgressHandle) Get(ctx context.Context) (*GetFooProgressResponse, error) {
 	var resp GetFooProgressResponse
 	if err := h.handle.Get(ctx, &resp); err != nil {
+		h.client.interceptors.AfterUpdateFooProgress(ctx, nil, err)
 		return nil, err
 	}
+	h.client.interceptors.AfterUpdateFooProgress(ctx, &resp, nil)
 	return &resp, nil
 }
 
@@ -411,6 +668,26 @@ func (opts *UpdateFooProgressOptions) WithUpdateWorkflowOptions(options client.U
 	return opts
 }
 
+// Build initializes a client.UpdateWorkflowWithOptionsRequest with defaults for a(n) example.v1.Example.UpdateFooProgress update operation
+func (o *UpdateFooProgressOptions) Build(workflowID string, runID string, req *SetFooProgressRequest) (*client.UpdateWorkflowWithOptionsRequest, error) {
+	options := &client.UpdateWorkflowWithOptionsRequest{}
+	if o != nil && o.opts != nil {
+		options = o.opts
+	}
+	options.Args = []any{req}
+	options.RunID = runID
+	options.UpdateName = UpdateFooProgressUpdateName
+	options.WorkflowID = workflowID
+	if options.UpdateID == "" {
+		id, err := expression.EvalExpression(UpdateFooProgressIDExpression, req.ProtoReflect())
+		if err != nil {
+			return nil, fmt.Errorf("error evaluating %s id expression: %w", UpdateFooProgressUpdateName, err)
+		}
+		options.UpdateID = id
+	}
+	return options, nil
+}
+
 // Reference to generated workflow functions
 var (
 	// CreateFoo creates a new foo operation
@@ -423,36 +700,192 @@ type ExampleWorkflows interface {
 }
 
 // CreateFoo creates a new foo operation
-// RegisterExampleWorkflows registers example.v1.Example workflows with the given worker
-func RegisterExampleWorkflows(r worker.Registry, workflows ExampleWorkflows) {
-	RegisterCreateFooWorkflow(r, workflows.CreateFoo)
+// RegisterExampleWorkflows registers example.v1.Example workflows with the given worker, invoking the hooks
+// of the given interceptors
+func RegisterExampleWorkflows(r worker.WorkflowRegistry, workflows ExampleWorkflows, interceptors ...ExampleInterceptor) {
+	RegisterCreateFooWorkflow(r, workflows.CreateFoo, interceptors...)
+}
+
+// ExampleInterceptor describes typed hooks invoked around example.v1.Example workflows, queries, signals, updates,
+// and activities executed by a worker, which can be installed via the RegisterExampleWorkflows,
+// Register<Workflow>Workflow, RegisterExampleActivities, and Register<Activity>Activity functions.
+// Queries, signals, and updates defined by other services are not intercepted, and workflow,
+// signal, and update hooks are not invoked while a workflow is replaying history.
+type ExampleInterceptor interface {
+	// BeforeCreateFoo is invoked with the input of a(n) example.v1.Example.CreateFoo workflow before it executes
+	BeforeCreateFoo(ctx workflow.Context, req *CreateFooRequest)
+	// AfterCreateFoo is invoked with the result of a(n) example.v1.Example.CreateFoo workflow after it executes
+	AfterCreateFoo(ctx workflow.Context, resp *CreateFooResponse, err error)
+	// BeforeGetFooProgress is invoked with the input of a(n) example.v1.Example.GetFooProgress query before it is handled
+	BeforeGetFooProgress(ctx workflow.Context)
+	// AfterGetFooProgress is invoked with the result of a(n) example.v1.Example.GetFooProgress query after it is handled
+	AfterGetFooProgress(ctx workflow.Context, resp *GetFooProgressResponse, err error)
+	// OnSetFooProgress is invoked with each example.v1.Example.SetFooProgress signal received by a workflow
+	OnSetFooProgress(ctx workflow.Context, req *SetFooProgressRequest)
+	// BeforeUpdateFooProgress is invoked with the input of a(n) example.v1.Example.UpdateFooProgress update before it is handled
+	BeforeUpdateFooProgress(ctx workflow.Context, req *SetFooProgressRequest)
+	// AfterUpdateFooProgress is invoked with the result of a(n) example.v1.Example.UpdateFooProgress update after it is handled
+	AfterUpdateFooProgress(ctx workflow.Context, resp *GetFooProgressResponse, err error)
+	// BeforeNotify is invoked with the input of a(n) example.v1.Example.Notify activity before it executes
+	BeforeNotify(ctx context.Context, req *NotifyRequest)
+	// AfterNotify is invoked with the result of a(n) example.v1.Example.Notify activity after it executes
+	AfterNotify(ctx context.Context, err error)
+}
+
+// NoopExampleInterceptor provides a no-op ExampleInterceptor implementation that can be embedded
+// to implement a subset of hooks
+type NoopExampleInterceptor struct{}
+
+// BeforeCreateFoo implements ExampleInterceptor
+func (NoopExampleInterceptor) BeforeCreateFoo(workflow.Context, *CreateFooRequest) {}
+
+// AfterCreateFoo implements ExampleInterceptor
+func (NoopExampleInterceptor) AfterCreateFoo(workflow.Context, *CreateFooResponse, error) {}
+
+// BeforeGetFooProgress implements ExampleInterceptor
+func (NoopExampleInterceptor) BeforeGetFooProgress(workflow.Context) {}
+
+// AfterGetFooProgress implements ExampleInterceptor
+func (NoopExampleInterceptor) AfterGetFooProgress(workflow.Context, *GetFooProgressResponse, error) {}
+
+// OnSetFooProgress implements ExampleInterceptor
+func (NoopExampleInterceptor) OnSetFooProgress(workflow.Context, *SetFooProgressRequest) {}
+
+// BeforeUpdateFooProgress implements ExampleInterceptor
+func (NoopExampleInterceptor) BeforeUpdateFooProgress(workflow.Context, *SetFooProgressRequest) {}
+
+// AfterUpdateFooProgress implements ExampleInterceptor
+func (NoopExampleInterceptor) AfterUpdateFooProgress(workflow.Context, *GetFooProgressResponse, error) {
+}
+
+// BeforeNotify implements ExampleInterceptor
+func (NoopExampleInterceptor) BeforeNotify(context.Context, *NotifyRequest) {}
+
+// AfterNotify implements ExampleInterceptor
+func (NoopExampleInterceptor) AfterNotify(context.Context, error) {}
+
+// exampleInterceptors invokes the hooks of multiple ExampleInterceptor values, in order for
+// Before<Method> and On<Method> hooks and in reverse order for After<Method> hooks
+type exampleInterceptors []ExampleInterceptor
+
+// BeforeCreateFoo implements ExampleInterceptor
+func (c exampleInterceptors) BeforeCreateFoo(ctx workflow.Context, req *CreateFooRequest) {
+	for _, interceptor := range c {
+		interceptor.BeforeCreateFoo(ctx, req)
+	}
+}
+
+// AfterCreateFoo implements ExampleInterceptor
+func (c exampleInterceptors) AfterCreateFoo(ctx workflow.Context, resp *CreateFooResponse, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterCreateFoo(ctx, resp, err)
+	}
 }
 
-// RegisterCreateFooWorkflow registers a example.v1.Example.CreateFoo workflow with the given worker
-func RegisterCreateFooWorkflow(r worker.Registry, wf func(workflow.Context, *CreateFooInput) (CreateFooWorkflow, error)) {
-	CreateFooFunction = buildCreateFoo(wf)
+// BeforeGetFooProgress implements ExampleInterceptor
+func (c exampleInterceptors) BeforeGetFooProgress(ctx workflow.Context) {
+	for _, interceptor := range c {
+		interceptor.BeforeGetFooProgress(ctx)
+	}
+}
+
+// AfterGetFooProgress implements ExampleInterceptor
+func (c exampleInterceptors) AfterGetFooProgress(ctx workflow.Context, resp *GetFooProgressResponse, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterGetFooProgress(ctx, resp, err)
+	}
+}
+
+// OnSetFooProgress implements ExampleInterceptor
+func (c exampleInterceptors) OnSetFooProgress(ctx workflow.Context, req *SetFooProgressRequest) {
+	for _, interceptor := range c {
+		interceptor.OnSetFooProgress(ctx, req)
+	}
+}
+
+// BeforeUpdateFooProgress implements ExampleInterceptor
+func (c exampleInterceptors) BeforeUpdateFooProgress(ctx workflow.Context, req *SetFooProgressRequest) {
+	for _, interceptor := range c {
+		interceptor.BeforeUpdateFooProgress(ctx, req)
+	}
+}
+
+// AfterUpdateFooProgress implements ExampleInterceptor
+func (c exampleInterceptors) AfterUpdateFooProgress(ctx workflow.Context, resp *GetFooProgressResponse, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterUpdateFooProgress(ctx, resp, err)
+	}
+}
+
+// BeforeNotify implements ExampleInterceptor
+func (c exampleInterceptors) BeforeNotify(ctx context.Context, req *NotifyRequest) {
+	for _, interceptor := range c {
+		interceptor.BeforeNotify(ctx, req)
+	}
+}
+
+// AfterNotify implements ExampleInterceptor
+func (c exampleInterceptors) AfterNotify(ctx context.Context, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterNotify(ctx, err)
+	}
+}
+
+// RegisterCreateFooWorkflow registers a example.v1.Example.CreateFoo workflow with the given worker, invoking the hooks
+// of the given interceptors
+func RegisterCreateFooWorkflow(r worker.WorkflowRegistry, wf func(workflow.Context, *CreateFooInput) (CreateFooWorkflow, error), interceptors ...ExampleInterceptor) {
+	CreateFooFunction = buildCreateFoo(wf, interceptors...)
 	r.RegisterWorkflowWithOptions(CreateFooFunction, workflow.RegisterOptions{Name: CreateFooWorkflowName})
 }
 
-// buildCreateFoo converts a CreateFoo workflow struct into a valid workflow function
-func buildCreateFoo(ctor func(workflow.Context, *CreateFooInput) (CreateFooWorkflow, error)) func(workflow.Context, *CreateFooRequest) (*CreateFooResponse, error) {
-	return func(ctx workflow.Context, req *CreateFooRequest) (*CreateFooResponse, error) {
+// buildCreateFoo converts a CreateFoo workflow struct into a valid workflow function that invokes
+// the hooks of the given interceptors
+func buildCreateFoo(ctor func(workflow.Context, *CreateFooInput) (CreateFooWorkflow, error), interceptors ...ExampleInterceptor) func(workflow.Context, *CreateFooRequest) (*CreateFooResponse, error) {
+	interceptor := exampleInterceptors(interceptors)
+	return func(ctx workflow.Context, req *CreateFooRequest) (resp *CreateFooResponse, err error) {
+		if !workflow.IsReplaying(ctx) {
+			interceptor.BeforeCreateFoo(ctx, req)
+		}
+		defer func() {
+			if !workflow.IsReplaying(ctx) {
+				interceptor.AfterCreateFoo(ctx, resp, err)
+			}
+		}()
 		input := &CreateFooInput{
 			Req: req,
 			SetFooProgress: &SetFooProgressSignal{
 				Channel: workflow.GetSignalChannel(ctx, SetFooProgressSignalName),
+				onReceive: func(req *SetFooProgressRequest) {
+					if !workflow.IsReplaying(ctx) {
+						interceptor.OnSetFooProgress(ctx, req)
+					}
+				},
 			},
 		}
 		wf, err := ctor(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if err := workflow.SetQueryHandler(ctx, GetFooProgressQueryName, wf.GetFooProgress); err != nil {
+		if err := workflow.SetQueryHandler(ctx, GetFooProgressQueryName, func() (*GetFooProgressResponse, error) {
+			interceptor.BeforeGetFooProgress(ctx)
+			resp, err := wf.GetFooProgress()
+			interceptor.AfterGetFooProgress(ctx, resp, err)
+			return resp, err
+		}); err != nil {
 			return nil, err
 		}
 		{
 			opts := workflow.UpdateHandlerOptions{}
-			if err := workflow.SetUpdateHandlerWithOptions(ctx, UpdateFooProgressUpdateName, wf.UpdateFooProgress, opts); err != nil {
+			if err := workflow.SetUpdateHandlerWithOptions(ctx, UpdateFooProgressUpdateName, func(ctx workflow.Context, req *SetFooProgressRequest) (*GetFooProgressResponse, error) {
+				if !workflow.IsReplaying(ctx) {
+					interceptor.BeforeUpdateFooProgress(ctx, req)
+				}
+				resp, err := wf.UpdateFooProgress(ctx, req)
+				if !workflow.IsReplaying(ctx) {
+					interceptor.AfterUpdateFooProgress(ctx, resp, err)
+				}
+				return resp, err
+			}, opts); err != nil {
 				return nil, err
 			}
 		}
@@ -504,8 +937,8 @@ func CreateFooChildAsync(ctx workflow.Context, req *CreateFooRequest, options ..
 		}
 		opts.WorkflowID = id
 	}
-	if opts.WorkflowIDReusePolicy == v1.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
-		opts.WorkflowIDReusePolicy = v1.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
+	if opts.WorkflowIDReusePolicy == v11.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
+		opts.WorkflowIDReusePolicy = v11.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
 	}
 	if opts.WorkflowExecutionTimeout == 0 {
 		opts.WorkflowRunTimeout = 3600000000000 // 1h0m0s
@@ -583,13 +1016,17 @@ func (r *CreateFooChildRun) SetFooProgressAsync(ctx workflow.Context, input *Set
 
 // SetFooProgressSignal describes a(n) example.v1.Example.SetFooProgress signal
 type SetFooProgressSignal struct {
-	Channel workflow.ReceiveChannel
+	Channel   workflow.ReceiveChannel
+	onReceive func(*SetFooProgressRequest)
 }
 
 // Receive blocks until a(n) example.v1.Example.SetFooProgress signal is received
 func (s *SetFooProgressSignal) Receive(ctx workflow.Context) (*SetFooProgressRequest, bool) {
 	var resp SetFooProgressRequest
 	more := s.Channel.Receive(ctx, &resp)
+	if more && s.onReceive != nil {
+		s.onReceive(&resp)
+	}
 	return &resp, more
 }
 
@@ -599,6 +1036,9 @@ func (s *SetFooProgressSignal) ReceiveAsync() *SetFooProgressRequest {
 	if ok := s.Channel.ReceiveAsync(&resp); !ok {
 		return nil
 	}
+	if s.onReceive != nil {
+		s.onReceive(&resp)
+	}
 	return &resp
 }
 
@@ -623,14 +1063,21 @@ type ExampleActivities interface {
 	Notify(ctx context.Context, req *NotifyRequest) error
 }
 
-// RegisterExampleActivities registers activities with a worker
-func RegisterExampleActivities(r worker.Registry, activities ExampleActivities) {
-	RegisterNotifyActivity(r, activities.Notify)
+// RegisterExampleActivities registers activities with a worker, invoking the hooks of the given interceptors
+func RegisterExampleActivities(r worker.ActivityRegistry, activities ExampleActivities, interceptors ...ExampleInterceptor) {
+	RegisterNotifyActivity(r, activities.Notify, interceptors...)
 }
 
-// RegisterNotifyActivity registers a example.v1.Example.Notify activity
-func RegisterNotifyActivity(r worker.Registry, fn func(context.Context, *NotifyRequest) error) {
-	r.RegisterActivityWithOptions(fn, activity.RegisterOptions{
+// RegisterNotifyActivity registers a example.v1.Example.Notify activity, invoking the hooks of the given interceptors
+func RegisterNotifyActivity(r worker.ActivityRegistry, fn func(context.Context, *NotifyRequest) error, interceptors ...ExampleInterceptor) {
+	interceptor := exampleInterceptors(interceptors)
+	impl := func(ctx context.Context, req *NotifyRequest) error {
+		interceptor.BeforeNotify(ctx, req)
+		err := fn(ctx, req)
+		interceptor.AfterNotify(ctx, err)
+		return err
+	}
+	r.RegisterActivityWithOptions(impl, activity.RegisterOptions{
 		Name: NotifyActivityName,
 	})
 }
@@ -672,7 +1119,13 @@ func Notify(ctx workflow.Context, req *NotifyRequest, options ...*NotifyActivity
 	if opts.opts.StartToCloseTimeout == 0 {
 		opts.opts.StartToCloseTimeout = 30000000000 // 30s
 	}
-	ctx = workflow.WithActivityOptions(ctx, *opts.opts)
+	activityID := opts.opts.ActivityID
+	if opts.activityID != nil {
+		activityID = *opts.activityID
+	}
+	activityOpts := *opts.opts
+	activityOpts.ActivityID = activityID
+	ctx = workflow.WithActivityOptions(ctx, activityOpts)
 	var activity any
 	activity = NotifyActivityName
 	future := &NotifyFuture{Future: workflow.ExecuteActivity(ctx, activity, req)}
@@ -697,7 +1150,13 @@ func NotifyAsync(ctx workflow.Context, req *NotifyRequest, options ...*NotifyAct
 	if opts.opts.StartToCloseTimeout == 0 {
 		opts.opts.StartToCloseTimeout = 30000000000 // 30s
 	}
-	ctx = workflow.WithActivityOptions(ctx, *opts.opts)
+	activityID := opts.opts.ActivityID
+	if opts.activityID != nil {
+		activityID = *opts.activityID
+	}
+	activityOpts := *opts.opts
+	activityOpts.ActivityID = activityID
+	ctx = workflow.WithActivityOptions(ctx, activityOpts)
 	var activity any
 	activity = NotifyActivityName
 	future := &NotifyFuture{Future: workflow.ExecuteActivity(ctx, activity, req)}
@@ -787,7 +1246,8 @@ func (opts *NotifyLocalActivityOptions) WithLocalActivityOptions(options workflo
 
 // NotifyActivityOptions provides configuration for a(n) example.v1.Example.Notify activity
 type NotifyActivityOptions struct {
-	opts *workflow.ActivityOptions
+	opts       *workflow.ActivityOptions
+	activityID *string
 }
 
 // NewNotifyActivityOptions sets default ActivityOptions
@@ -801,6 +1261,12 @@ func (opts *NotifyActivityOptions) WithActivityOptions(options workflow.Activity
 	return opts
 }
 
+// WithActivityID overrides the default activity ID
+func (opts *NotifyActivityOptions) WithActivityID(id string) *NotifyActivityOptions {
+	opts.activityID = &id
+	return opts
+}
+
 // TestClient provides a testsuite-compatible Client
 type TestExampleClient struct {
 	env       *testsuite.TestWorkflowEnvironment
@@ -810,10 +1276,18 @@ type TestExampleClient struct {
 var _ ExampleClient = &TestExampleClient{}
 
 // NewTestExampleClient initializes a new TestExampleClient value
+// If workflows are provided without activities, placeholder activities are registered that
+// fail unless mocked using the client's On<Activity> methods
 func NewTestExampleClient(env *testsuite.TestWorkflowEnvironment, workflows ExampleWorkflows, activities ExampleActivities) *TestExampleClient {
-	RegisterExampleWorkflows(env, workflows)
+	if workflows != nil {
+		RegisterExampleWorkflows(env, workflows)
+	}
 	if activities != nil {
 		RegisterExampleActivities(env, activities)
+	} else if workflows != nil {
+		RegisterNotifyActivity(env, func(context.Context, *NotifyRequest) error {
+			return testutil.NewNotMockedError(testutil.KindActivity, NotifyActivityName)
+		})
 	}
 	return &TestExampleClient{env, workflows}
 }
@@ -829,25 +1303,13 @@ func (c *TestExampleClient) CreateFoo(ctx context.Context, req *CreateFooRequest
 
 // CreateFooAsync executes a(n) CreateFoo workflow in the test environment
 func (c *TestExampleClient) CreateFooAsync(ctx context.Context, req *CreateFooRequest, options ...*CreateFooOptions) (CreateFooRun, error) {
-	opts := &client.StartWorkflowOptions{}
-	if len(options) > 0 && options[0].opts != nil {
-		opts = options[0].opts
-	}
-	if opts.TaskQueue == "" {
-		opts.TaskQueue = ExampleTaskQueue
-	}
-	if opts.ID == "" {
-		id, err := expression.EvalExpression(CreateFooIDExpression, req.ProtoReflect())
-		if err != nil {
-			return nil, err
-		}
-		opts.ID = id
-	}
-	if opts.WorkflowIDReusePolicy == v1.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
-		opts.WorkflowIDReusePolicy = v1.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
+	var o *CreateFooOptions
+	if len(options) > 0 {
+		o = options[0]
 	}
-	if opts.WorkflowExecutionTimeout == 0 {
-		opts.WorkflowRunTimeout = 3600000000000 // 1h0m0s
+	opts, err := o.Build(req)
+	if err != nil {
+		return nil, err
 	}
 	return &testCreateFooRun{client: c, env: c.env, opts: opts, req: req, workflows: c.workflows}, nil
 }
@@ -857,17 +1319,47 @@ func (c *TestExampleClient) GetCreateFoo(ctx context.Context, workflowID string,
 	return &testCreateFooRun{env: c.env, workflows: c.workflows}
 }
 
-// CreateFooWithSetFooProgress sends a(n) SetFooProgress signal to a(n) CreateFoo workflow, starting it if necessary
-func (c *TestExampleClient) CreateFooWithSetFooProgress(ctx context.Context, req *CreateFooRequest, signal *SetFooProgressRequest, opts ...*CreateFooOptions) (*CreateFooResponse, error) {
-	c.env.RegisterDelayedCallback(func() {
-		c.env.SignalWorkflow(SetFooProgressSignalName, signal)
-	}, 0)
-	return c.CreateFoo(ctx, req, opts...)
+// CreateCreateFooSchedule is not supported by the test client
+func (c *TestExampleClient) CreateCreateFooSchedule(ctx context.Context, id string, spec client.ScheduleSpec, req *CreateFooRequest, options ...*CreateFooScheduleOptions) (client.ScheduleHandle, error) {
+	return nil, errors.New("schedules are not supported by the test client")
 }
 
-// CreateFooWithSetFooProgressAsync sends a(n) SetFooProgress signal to a(n) CreateFoo workflow, starting it if necessary
-func (c *TestExampleClient) CreateFooWithSetFooProgressAsync(ctx context.Context, req *CreateFooRequest, signal *SetFooProgressRequest, opts ...*CreateFooOptions) (CreateFooRun, error) {
-	c.env.RegisterDelayedCallback(func() {
+// GetCreateFooSchedule is not supported by the test client
+func (c *TestExampleClient) GetCreateFooSchedule(ctx context.Context, id string) (*client.ScheduleDescription, error) {
+	return nil, errors.New("schedules are not supported by the test client")
+}
+
+// PauseCreateFooSchedule is not supported by the test client
+func (c *TestExampleClient) PauseCreateFooSchedule(ctx context.Context, id string, note string) error {
+	return errors.New("schedules are not supported by the test client")
+}
+
+// UnpauseCreateFooSchedule is not supported by the test client
+func (c *TestExampleClient) UnpauseCreateFooSchedule(ctx context.Context, id string, note string) error {
+	return errors.New("schedules are not supported by the test client")
+}
+
+// TriggerCreateFooSchedule is not supported by the test client
+func (c *TestExampleClient) TriggerCreateFooSchedule(ctx context.Context, id string) error {
+	return errors.New("schedules are not supported by the test client")
+}
+
+// DeleteCreateFooSchedule is not supported by the test client
+func (c *TestExampleClient) DeleteCreateFooSchedule(ctx context.Context, id string) error {
+	return errors.New("schedules are not supported by the test client")
+}
+
+// CreateFooWithSetFooProgress sends a(n) SetFooProgress signal to a(n) CreateFoo workflow, starting it if necessary
+func (c *TestExampleClient) CreateFooWithSetFooProgress(ctx context.Context, req *CreateFooRequest, signal *SetFooProgressRequest, opts ...*CreateFooOptions) (*CreateFooResponse, error) {
+	c.env.RegisterDelayedCallback(func() {
+		c.env.SignalWorkflow(SetFooProgressSignalName, signal)
+	}, 0)
+	return c.CreateFoo(ctx, req, opts...)
+}
+
+// CreateFooWithSetFooProgressAsync sends a(n) SetFooProgress signal to a(n) CreateFoo workflow, starting it if necessary
+func (c *TestExampleClient) CreateFooWithSetFooProgressAsync(ctx context.Context, req *CreateFooRequest, signal *SetFooProgressRequest, opts ...*CreateFooOptions) (CreateFooRun, error) {
+	c.env.RegisterDelayedCallback(func() {
 		_ = c.SetFooProgress(ctx, "", "", signal)
 	}, 0)
 	return c.CreateFooAsync(ctx, req, opts...)
@@ -897,12 +1389,7 @@ func (c *TestExampleClient) SetFooProgress(ctx context.Context, workflowID strin
 
 // UpdateFooProgress executes a(n) example.v1.Example.UpdateFooProgress update in the test environment
 func (c *TestExampleClient) UpdateFooProgress(ctx context.Context, workflowID string, runID string, req *SetFooProgressRequest, opts ...*UpdateFooProgressOptions) (*GetFooProgressResponse, error) {
-	options := NewUpdateFooProgressOptions()
-	if len(opts) > 0 && opts[0].opts != nil {
-		options = opts[0]
-	}
-	options.opts.WaitPolicy = &v11.WaitPolicy{LifecycleStage: v1.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED}
-	handle, err := c.UpdateFooProgressAsync(ctx, workflowID, runID, req, options)
+	handle, err := c.UpdateFooProgressAsync(ctx, workflowID, runID, req, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -911,20 +1398,13 @@ func (c *TestExampleClient) UpdateFooProgress(ctx context.Context, workflowID st
 
 // UpdateFooProgressAsync executes a(n) example.v1.Example.UpdateFooProgress update in the test environment
 func (c *TestExampleClient) UpdateFooProgressAsync(ctx context.Context, workflowID string, runID string, req *SetFooProgressRequest, opts ...*UpdateFooProgressOptions) (UpdateFooProgressHandle, error) {
-	options := &client.UpdateWorkflowWithOptionsRequest{}
-	if len(opts) > 0 && opts[0].opts != nil {
-		options = opts[0].opts
+	var o *UpdateFooProgressOptions
+	if len(opts) > 0 {
+		o = opts[0]
 	}
-	options.Args = []any{req}
-	options.RunID = runID
-	options.UpdateName = UpdateFooProgressUpdateName
-	options.WorkflowID = workflowID
-	if options.UpdateID == "" {
-		id, err := expression.EvalExpression(UpdateFooProgressIDExpression, req.ProtoReflect())
-		if err != nil {
-			return nil, fmt.Errorf("error evaluating %s id expression: %w", UpdateFooProgressUpdateName, err)
-		}
-		options.UpdateID = id
+	options, err := o.Build(workflowID, runID, req)
+	if err != nil {
+		return nil, err
 	}
 	uc := testutil.NewUpdateCallbacks()
 	c.env.UpdateWorkflow(UpdateFooProgressUpdateName, uc, req)
@@ -977,6 +1457,150 @@ func (h *testUpdateFooProgressHandle) WorkflowID() string {
 	return h.workflowID
 }
 
+// OnNotify mocks executions of the example.v1.Example.Notify activity whose input satisfies the given
+// matcher, which may be nil to match any input
+func (c *TestExampleClient) OnNotify(matcher func(*NotifyRequest) bool) *TestNotifyActivityCall {
+	var req any = mock.Anything
+	if matcher != nil {
+		req = mock.MatchedBy(matcher)
+	}
+	return &TestNotifyActivityCall{c.env.OnActivity(NotifyActivityName, mock.Anything, req)}
+}
+
+// TestNotifyActivityCall describes a mocked example.v1.Example.Notify activity execution
+type TestNotifyActivityCall struct {
+	call *testsuite.MockCallWrapper
+}
+
+// Return specifies the result of matching activity executions
+func (c *TestNotifyActivityCall) Return(err error) *TestNotifyActivityCall {
+	c.call.Return(err)
+	return c
+}
+
+// Times specifies the number of activity executions the mock should match
+func (c *TestNotifyActivityCall) Times(n int) *TestNotifyActivityCall {
+	c.call.Times(n)
+	return c
+}
+
+// After returns a TestExampleDelayed value that schedules queries, signals, and updates
+// to be sent to the test environment's workflow after the given duration of virtual time
+func (c *TestExampleClient) After(d time.Duration) *TestExampleDelayed {
+	return &TestExampleDelayed{c, d}
+}
+
+// TestExampleDelayed schedules example.v1.Example queries, signals, and updates using the test
+// environment's RegisterDelayedCallback method
+type TestExampleDelayed struct {
+	client *TestExampleClient
+	delay  time.Duration
+}
+
+// GetFooProgress schedules a(n) example.v1.Example.GetFooProgress query, invoking fn with the result
+func (d *TestExampleDelayed) GetFooProgress(fn func(*GetFooProgressResponse, error)) {
+	d.client.env.RegisterDelayedCallback(func() {
+		fn(d.client.GetFooProgress(context.Background(), "", ""))
+	}, d.delay)
+}
+
+// SetFooProgress schedules a(n) example.v1.Example.SetFooProgress signal
+func (d *TestExampleDelayed) SetFooProgress(req *SetFooProgressRequest) {
+	d.client.env.RegisterDelayedCallback(func() {
+		d.client.env.SignalWorkflow(SetFooProgressSignalName, req)
+	}, d.delay)
+}
+
+// UpdateFooProgress schedules a(n) example.v1.Example.UpdateFooProgress update, invoking the given callbacks, which may be nil
+func (d *TestExampleDelayed) UpdateFooProgress(req *SetFooProgressRequest, callbacks *TestUpdateFooProgressCallbacks) {
+	if callbacks == nil {
+		callbacks = &TestUpdateFooProgressCallbacks{}
+	}
+	d.client.env.RegisterDelayedCallback(func() {
+		d.client.env.UpdateWorkflow(UpdateFooProgressUpdateName, callbacks, req)
+	}, d.delay)
+}
+
+// TestUpdateFooProgressCallbacks describes optional callbacks invoked in response to a(n) example.v1.Example.UpdateFooProgress update
+// scheduled in a test environment
+type TestUpdateFooProgressCallbacks struct {
+	OnAccept   func()
+	OnReject   func(error)
+	OnComplete func(*GetFooProgressResponse, error)
+}
+
+// Accept invokes the OnAccept callback, if set
+func (c *TestUpdateFooProgressCallbacks) Accept() {
+	if c.OnAccept != nil {
+		c.OnAccept()
+	}
+}
+
+// Reject invokes the OnReject callback, if set
+func (c *TestUpdateFooProgressCallbacks) Reject(err error) {
+	if c.OnReject != nil {
+		c.OnReject(err)
+	}
+}
+
+// Complete invokes the OnComplete callback, if set
+func (c *TestUpdateFooProgressCallbacks) Complete(success any, err error) {
+	if c.OnComplete != nil {
+		resp, _ := success.(*GetFooProgressResponse)
+		c.OnComplete(resp, err)
+	}
+}
+
+// TestExampleChildWorkflows provides typed mocks for example.v1.Example child workflow executions in a
+// test environment, including child workflows started by workflows defined in other services
+type TestExampleChildWorkflows struct {
+	env *testsuite.TestWorkflowEnvironment
+}
+
+// NewTestExampleChildWorkflows initializes a new TestExampleChildWorkflows value, registering placeholder workflows
+// that fail unless mocked. It must be called before any mocks are registered with the test
+// environment, and must not be used when the service's workflows are registered with the test
+// environment, in which case the test client's On<Workflow>Child methods can be used instead.
+func NewTestExampleChildWorkflows(env *testsuite.TestWorkflowEnvironment) *TestExampleChildWorkflows {
+	env.RegisterWorkflowWithOptions(func(workflow.Context, *CreateFooRequest) (*CreateFooResponse, error) {
+		return nil, testutil.NewNotMockedError(testutil.KindWorkflow, CreateFooWorkflowName)
+	}, workflow.RegisterOptions{Name: CreateFooWorkflowName})
+	return &TestExampleChildWorkflows{env}
+}
+
+// OnCreateFooChild mocks child example.v1.Example.CreateFoo workflow executions whose input satisfies the given
+// matcher, which may be nil to match any input. The workflow must be registered with the test environment.
+func (c *TestExampleClient) OnCreateFooChild(matcher func(*CreateFooRequest) bool) *TestCreateFooChildCall {
+	return (&TestExampleChildWorkflows{c.env}).OnCreateFooChild(matcher)
+}
+
+// OnCreateFooChild mocks child example.v1.Example.CreateFoo workflow executions whose input satisfies the given
+// matcher, which may be nil to match any input
+func (c *TestExampleChildWorkflows) OnCreateFooChild(matcher func(*CreateFooRequest) bool) *TestCreateFooChildCall {
+	var req any = mock.Anything
+	if matcher != nil {
+		req = mock.MatchedBy(matcher)
+	}
+	return &TestCreateFooChildCall{c.env.OnWorkflow(CreateFooWorkflowName, mock.Anything, req)}
+}
+
+// TestCreateFooChildCall describes a mocked child example.v1.Example.CreateFoo workflow execution
+type TestCreateFooChildCall struct {
+	call *testsuite.MockCallWrapper
+}
+
+// Return specifies the result of matching child workflow executions
+func (c *TestCreateFooChildCall) Return(resp *CreateFooResponse, err error) *TestCreateFooChildCall {
+	c.call.Return(resp, err)
+	return c
+}
+
+// Times specifies the number of child workflow executions the mock should match
+func (c *TestCreateFooChildCall) Times(n int) *TestCreateFooChildCall {
+	c.call.Times(n)
+	return c
+}
+
 var _ CreateFooRun = &testCreateFooRun{}
 
 // testCreateFooRun provides convenience methods for interacting with a(n) CreateFoo workflow in the test environment
@@ -1037,6 +1661,861 @@ func (r *testCreateFooRun) UpdateFooProgressAsync(ctx context.Context, req *SetF
 	return r.client.UpdateFooProgressAsync(ctx, r.ID(), r.RunID(), req, opts...)
 }
 
+// TestExampleActivities executes example.v1.Example activities in a test activity environment
+type TestExampleActivities struct {
+	env *testsuite.TestActivityEnvironment
+}
+
+var _ ExampleActivities = &TestExampleActivities{}
+
+// NewTestExampleActivities registers the given activities with the test activity environment
+// and initializes a new TestExampleActivities value
+func NewTestExampleActivities(env *testsuite.TestActivityEnvironment, activities ExampleActivities) *TestExampleActivities {
+	RegisterExampleActivities(env, activities)
+	return &TestExampleActivities{env}
+}
+
+// Notify executes a(n) example.v1.Example.Notify activity in the test activity environment.
+// The context is ignored, use the environment's SetWorkerOptions (BackgroundActivityContext)
+// and SetTestTimeout methods to configure the activity context and timeout.
+func (a *TestExampleActivities) Notify(_ context.Context, req *NotifyRequest) error {
+	_, err := a.env.ExecuteActivity(NotifyActivityName, req)
+	return err
+}
+
+// ReplayExampleWorkflows replays the given JSON workflow history files, exported from Temporal, against
+// the given example.v1.Example workflow implementations, registered via RegisterExampleWorkflows.
+// Each history file that cannot be replayed, such as due to a nondeterminism error, is
+// reported as a test error.
+func ReplayExampleWorkflows(t testutil.TestingT, workflows ExampleWorkflows, historyFiles ...string) {
+	t.Helper()
+	replayer := worker.NewWorkflowReplayer()
+	RegisterExampleWorkflows(replayer, workflows)
+	for _, historyFile := range historyFiles {
+		if err := replayer.ReplayWorkflowHistoryFromJSONFile(nil, historyFile); err != nil {
+			t.Errorf("error replaying workflow history %s: %v", historyFile, err)
+		}
+	}
+}
+
+// MockExampleClient is a testify mock implementation of ExampleClient
+type MockExampleClient struct {
+	mock.Mock
+}
+
+var _ ExampleClient = (*MockExampleClient)(nil)
+
+// NewMockExampleClient initializes a new MockExampleClient that asserts its expectations when the test completes
+func NewMockExampleClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExampleClient {
+	m := &MockExampleClient{}
+	m.Mock.Test(t)
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+	return m
+}
+
+// CreateFoo implements ExampleClient
+func (m *MockExampleClient) CreateFoo(ctx context.Context, req *CreateFooRequest, opts ...*CreateFooOptions) (*CreateFooResponse, error) {
+	args := m.Called(ctx, req, opts)
+	var r0 *CreateFooResponse
+	if v := args.Get(0); v != nil {
+		r0 = v.(*CreateFooResponse)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnCreateFoo registers an expectation for a call to CreateFoo with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockExampleClient) OnCreateFoo(ctx any, req any, opts any) *MockExampleClientCreateFooCall {
+	return &MockExampleClientCreateFooCall{Call: m.On("CreateFoo", ctx, req, opts)}
+}
+
+// MockExampleClientCreateFooCall describes an expected call to MockExampleClient.CreateFoo
+type MockExampleClientCreateFooCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockExampleClientCreateFooCall) Return(resp *CreateFooResponse, err error) *MockExampleClientCreateFooCall {
+	c.Call.Return(resp, err)
+	return c
+}
+
+// CreateFooAsync implements ExampleClient
+func (m *MockExampleClient) CreateFooAsync(ctx context.Context, req *CreateFooRequest, opts ...*CreateFooOptions) (CreateFooRun, error) {
+	args := m.Called(ctx, req, opts)
+	var r0 CreateFooRun
+	if v := args.Get(0); v != nil {
+		r0 = v.(CreateFooRun)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnCreateFooAsync registers an expectation for a call to CreateFooAsync with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockExampleClient) OnCreateFooAsync(ctx any, req any, opts any) *MockExampleClientCreateFooAsyncCall {
+	return &MockExampleClientCreateFooAsyncCall{Call: m.On("CreateFooAsync", ctx, req, opts)}
+}
+
+// MockExampleClientCreateFooAsyncCall describes an expected call to MockExampleClient.CreateFooAsync
+type MockExampleClientCreateFooAsyncCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockExampleClientCreateFooAsyncCall) Return(run CreateFooRun, err error) *MockExampleClientCreateFooAsyncCall {
+	c.Call.Return(run, err)
+	return c
+}
+
+// GetCreateFoo implements ExampleClient
+func (m *MockExampleClient) GetCreateFoo(ctx context.Context, workflowID string, runID string) CreateFooRun {
+	args := m.Called(ctx, workflowID, runID)
+	var r0 CreateFooRun
+	if v := args.Get(0); v != nil {
+		r0 = v.(CreateFooRun)
+	}
+	return r0
+}
+
+// OnGetCreateFoo registers an expectation for a call to GetCreateFoo with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockExampleClient) OnGetCreateFoo(ctx any, workflowID any, runID any) *MockExampleClientGetCreateFooCall {
+	return &MockExampleClientGetCreateFooCall{Call: m.On("GetCreateFoo", ctx, workflowID, runID)}
+}
+
+// MockExampleClientGetCreateFooCall describes an expected call to MockExampleClient.GetCreateFoo
+type MockExampleClientGetCreateFooCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockExampleClientGetCreateFooCall) Return(run CreateFooRun) *MockExampleClientGetCreateFooCall {
+	c.Call.Return(run)
+	return c
+}
+
+// CreateCreateFooSchedule implements ExampleClient
+func (m *MockExampleClient) CreateCreateFooSchedule(ctx context.Context, id string, spec client.ScheduleSpec, req *CreateFooRequest, opts ...*CreateFooScheduleOptions) (client.ScheduleHandle, error) {
+	args := m.Called(ctx, id, spec, req, opts)
+	var r0 client.ScheduleHandle
+	if v := args.Get(0); v != nil {
+		r0 = v.(client.ScheduleHandle)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnCreateCreateFooSchedule registers an expectation for a call to CreateCreateFooSchedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockExampleClient) OnCreateCreateFooSchedule(ctx any, id any, spec any, req any, opts any) *MockExampleClientCreateCreateFooScheduleCall {
+	return &MockExampleClientCreateCreateFooScheduleCall{Call: m.On("CreateCreateFooSchedule", ctx, id, spec, req, opts)}
+}
+
+// MockExampleClientCreateCreateFooScheduleCall describes an expected call to MockExampleClient.CreateCreateFooSchedule
+type MockExampleClientCreateCreateFooScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockExampleClientCreateCreateFooScheduleCall) Return(handle client.ScheduleHandle, err error) *MockExampleClientCreateCreateFooScheduleCall {
+	c.Call.Return(handle, err)
+	return c
+}
+
+// GetCreateFooSchedule implements ExampleClient
+func (m *MockExampleClient) GetCreateFooSchedule(ctx context.Context, id string) (*client.ScheduleDescription, error) {
+	args := m.Called(ctx, id)
+	var r0 *client.ScheduleDescription
+	if v := args.Get(0); v != nil {
+		r0 = v.(*client.ScheduleDescription)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnGetCreateFooSchedule registers an expectation for a call to GetCreateFooSchedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockExampleClient) OnGetCreateFooSchedule(ctx any, id any) *MockExampleClientGetCreateFooScheduleCall {
+	return &MockExampleClientGetCreateFooScheduleCall{Call: m.On("GetCreateFooSchedule", ctx, id)}
+}
+
+// MockExampleClientGetCreateFooScheduleCall describes an expected call to MockExampleClient.GetCreateFooSchedule
+type MockExampleClientGetCreateFooScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockExampleClientGetCreateFooScheduleCall) Return(desc *client.ScheduleDescription, err error) *MockExampleClientGetCreateFooScheduleCall {
+	c.Call.Return(desc, err)
+	return c
+}
+
+// PauseCreateFooSchedule implements ExampleClient
+func (m *MockExampleClient) PauseCreateFooSchedule(ctx context.Context, id string, note string) error {
+	args := m.Called(ctx, id, note)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnPauseCreateFooSchedule registers an expectation for a call to PauseCreateFooSchedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockExampleClient) OnPauseCreateFooSchedule(ctx any, id any, note any) *MockExampleClientPauseCreateFooScheduleCall {
+	return &MockExampleClientPauseCreateFooScheduleCall{Call: m.On("PauseCreateFooSchedule", ctx, id, note)}
+}
+
+// MockExampleClientPauseCreateFooScheduleCall describes an expected call to MockExampleClient.PauseCreateFooSchedule
+type MockExampleClientPauseCreateFooScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockExampleClientPauseCreateFooScheduleCall) Return(err error) *MockExampleClientPauseCreateFooScheduleCall {
+	c.Call.Return(err)
+	return c
+}
+
+// UnpauseCreateFooSchedule implements ExampleClient
+func (m *MockExampleClient) UnpauseCreateFooSchedule(ctx context.Context, id string, note string) error {
+	args := m.Called(ctx, id, note)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnUnpauseCreateFooSchedule registers an expectation for a call to UnpauseCreateFooSchedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockExampleClient) OnUnpauseCreateFooSchedule(ctx any, id any, note any) *MockExampleClientUnpauseCreateFooScheduleCall {
+	return &MockExampleClientUnpauseCreateFooScheduleCall{Call: m.On("UnpauseCreateFooSchedule", ctx, id, note)}
+}
+
+// MockExampleClientUnpauseCreateFooScheduleCall describes an expected call to MockExampleClient.UnpauseCreateFooSchedule
+type MockExampleClientUnpauseCreateFooScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockExampleClientUnpauseCreateFooScheduleCall) Return(err error) *MockExampleClientUnpauseCreateFooScheduleCall {
+	c.Call.Return(err)
+	return c
+}
+
+// TriggerCreateFooSchedule implements ExampleClient
+func (m *MockExampleClient) TriggerCreateFooSchedule(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnTriggerCreateFooSchedule registers an expectation for a call to TriggerCreateFooSchedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockExampleClient) OnTriggerCreateFooSchedule(ctx any, id any) *MockExampleClientTriggerCreateFooScheduleCall {
+	return &MockExampleClientTriggerCreateFooScheduleCall{Call: m.On("TriggerCreateFooSchedule", ctx, id)}
+}
+
+// MockExampleClientTriggerCreateFooScheduleCall describes an expected call to MockExampleClient.TriggerCreateFooSchedule
+type MockExampleClientTriggerCreateFooScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockExampleClientTriggerCreateFooScheduleCall) Return(err error) *MockExampleClientTriggerCreateFooScheduleCall {
+	c.Call.Return(err)
+	return c
+}
+
+// DeleteCreateFooSchedule implements ExampleClient
+func (m *MockExampleClient) DeleteCreateFooSchedule(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnDeleteCreateFooSchedule registers an expectation for a call to DeleteCreateFooSchedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockExampleClient) OnDeleteCreateFooSchedule(ctx any, id any) *MockExampleClientDeleteCreateFooScheduleCall {
+	return &MockExampleClientDeleteCreateFooScheduleCall{Call: m.On("DeleteCreateFooSchedule", ctx, id)}
+}
+
+// MockExampleClientDeleteCreateFooScheduleCall describes an expected call to MockExampleClient.DeleteCreateFooSchedule
+type MockExampleClientDeleteCreateFooScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockExampleClientDeleteCreateFooScheduleCall) Return(err error) *MockExampleClientDeleteCreateFooScheduleCall {
+	c.Call.Return(err)
+	return c
+}
+
+// CreateFooWithSetFooProgress implements ExampleClient
+func (m *MockExampleClient) CreateFooWithSetFooProgress(ctx context.Context, req *CreateFooRequest, signal *SetFooProgressRequest, opts ...*CreateFooOptions) (*CreateFooResponse, error) {
+	args := m.Called(ctx, req, signal, opts)
+	var r0 *CreateFooResponse
+	if v := args.Get(0); v != nil {
+		r0 = v.(*CreateFooResponse)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnCreateFooWithSetFooProgress registers an expectation for a call to CreateFooWithSetFooProgress with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockExampleClient) OnCreateFooWithSetFooProgress(ctx any, req any, signal any, opts any) *MockExampleClientCreateFooWithSetFooProgressCall {
+	return &MockExampleClientCreateFooWithSetFooProgressCall{Call: m.On("CreateFooWithSetFooProgress", ctx, req, signal, opts)}
+}
+
+// MockExampleClientCreateFooWithSetFooProgressCall describes an expected call to MockExampleClient.CreateFooWithSetFooProgress
+type MockExampleClientCreateFooWithSetFooProgressCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockExampleClientCreateFooWithSetFooProgressCall) Return(resp *CreateFooResponse, err error) *MockExampleClientCreateFooWithSetFooProgressCall {
+	c.Call.Return(resp, err)
+	return c
+}
+
+// CreateFooWithSetFooProgressAsync implements ExampleClient
+func (m *MockExampleClient) CreateFooWithSetFooProgressAsync(ctx context.Context, req *CreateFooRequest, signal *SetFooProgressRequest, opts ...*CreateFooOptions) (CreateFooRun, error) {
+	args := m.Called(ctx, req, signal, opts)
+	var r0 CreateFooRun
+	if v := args.Get(0); v != nil {
+		r0 = v.(CreateFooRun)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnCreateFooWithSetFooProgressAsync registers an expectation for a call to CreateFooWithSetFooProgressAsync with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockExampleClient) OnCreateFooWithSetFooProgressAsync(ctx any, req any, signal any, opts any) *MockExampleClientCreateFooWithSetFooProgressAsyncCall {
+	return &MockExampleClientCreateFooWithSetFooProgressAsyncCall{Call: m.On("CreateFooWithSetFooProgressAsync", ctx, req, signal, opts)}
+}
+
+// MockExampleClientCreateFooWithSetFooProgressAsyncCall describes an expected call to MockExampleClient.CreateFooWithSetFooProgressAsync
+type MockExampleClientCreateFooWithSetFooProgressAsyncCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockExampleClientCreateFooWithSetFooProgressAsyncCall) Return(run CreateFooRun, err error) *MockExampleClientCreateFooWithSetFooProgressAsyncCall {
+	c.Call.Return(run, err)
+	return c
+}
+
+// GetFooProgress implements ExampleClient
+func (m *MockExampleClient) GetFooProgress(ctx context.Context, workflowID string, runID string) (*GetFooProgressResponse, error) {
+	args := m.Called(ctx, workflowID, runID)
+	var r0 *GetFooProgressResponse
+	if v := args.Get(0); v != nil {
+		r0 = v.(*GetFooProgressResponse)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnGetFooProgress registers an expectation for a call to GetFooProgress with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockExampleClient) OnGetFooProgress(ctx any, workflowID any, runID any) *MockExampleClientGetFooProgressCall {
+	return &MockExampleClientGetFooProgressCall{Call: m.On("GetFooProgress", ctx, workflowID, runID)}
+}
+
+// MockExampleClientGetFooProgressCall describes an expected call to MockExampleClient.GetFooProgress
+type MockExampleClientGetFooProgressCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockExampleClientGetFooProgressCall) Return(resp *GetFooProgressResponse, err error) *MockExampleClientGetFooProgressCall {
+	c.Call.Return(resp, err)
+	return c
+}
+
+// SetFooProgress implements ExampleClient
+func (m *MockExampleClient) SetFooProgress(ctx context.Context, workflowID string, runID string, signal *SetFooProgressRequest) error {
+	args := m.Called(ctx, workflowID, runID, signal)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnSetFooProgress registers an expectation for a call to SetFooProgress with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockExampleClient) OnSetFooProgress(ctx any, workflowID any, runID any, signal any) *MockExampleClientSetFooProgressCall {
+	return &MockExampleClientSetFooProgressCall{Call: m.On("SetFooProgress", ctx, workflowID, runID, signal)}
+}
+
+// MockExampleClientSetFooProgressCall describes an expected call to MockExampleClient.SetFooProgress
+type MockExampleClientSetFooProgressCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockExampleClientSetFooProgressCall) Return(err error) *MockExampleClientSetFooProgressCall {
+	c.Call.Return(err)
+	return c
+}
+
+// UpdateFooProgress implements ExampleClient
+func (m *MockExampleClient) UpdateFooProgress(ctx context.Context, workflowID string, runID string, req *SetFooProgressRequest, opts ...*UpdateFooProgressOptions) (*GetFooProgressResponse, error) {
+	args := m.Called(ctx, workflowID, runID, req, opts)
+	var r0 *GetFooProgressResponse
+	if v := args.Get(0); v != nil {
+		r0 = v.(*GetFooProgressResponse)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnUpdateFooProgress registers an expectation for a call to UpdateFooProgress with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockExampleClient) OnUpdateFooProgress(ctx any, workflowID any, runID any, req any, opts any) *MockExampleClientUpdateFooProgressCall {
+	return &MockExampleClientUpdateFooProgressCall{Call: m.On("UpdateFooProgress", ctx, workflowID, runID, req, opts)}
+}
+
+// MockExampleClientUpdateFooProgressCall describes an expected call to MockExampleClient.UpdateFooProgress
+type MockExampleClientUpdateFooProgressCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockExampleClientUpdateFooProgressCall) Return(resp *GetFooProgressResponse, err error) *MockExampleClientUpdateFooProgressCall {
+	c.Call.Return(resp, err)
+	return c
+}
+
+// UpdateFooProgressAsync implements ExampleClient
+func (m *MockExampleClient) UpdateFooProgressAsync(ctx context.Context, workflowID string, runID string, req *SetFooProgressRequest, opts ...*UpdateFooProgressOptions) (UpdateFooProgressHandle, error) {
+	args := m.Called(ctx, workflowID, runID, req, opts)
+	var r0 UpdateFooProgressHandle
+	if v := args.Get(0); v != nil {
+		r0 = v.(UpdateFooProgressHandle)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnUpdateFooProgressAsync registers an expectation for a call to UpdateFooProgressAsync with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockExampleClient) OnUpdateFooProgressAsync(ctx any, workflowID any, runID any, req any, opts any) *MockExampleClientUpdateFooProgressAsyncCall {
+	return &MockExampleClientUpdateFooProgressAsyncCall{Call: m.On("UpdateFooProgressAsync", ctx, workflowID, runID, req, opts)}
+}
+
+// MockExampleClientUpdateFooProgressAsyncCall describes an expected call to MockExampleClient.UpdateFooProgressAsync
+type MockExampleClientUpdateFooProgressAsyncCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockExampleClientUpdateFooProgressAsyncCall) Return(handle UpdateFooProgressHandle, err error) *MockExampleClientUpdateFooProgressAsyncCall {
+	c.Call.Return(handle, err)
+	return c
+}
+
+// MockCreateFooRun is a testify mock implementation of CreateFooRun
+type MockCreateFooRun struct {
+	mock.Mock
+}
+
+var _ CreateFooRun = (*MockCreateFooRun)(nil)
+
+// NewMockCreateFooRun initializes a new MockCreateFooRun that asserts its expectations when the test completes
+func NewMockCreateFooRun(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateFooRun {
+	m := &MockCreateFooRun{}
+	m.Mock.Test(t)
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+	return m
+}
+
+// ID implements CreateFooRun
+func (m *MockCreateFooRun) ID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnID registers an expectation for a call to ID with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockCreateFooRun) OnID() *MockCreateFooRunIDCall {
+	return &MockCreateFooRunIDCall{Call: m.On("ID")}
+}
+
+// MockCreateFooRunIDCall describes an expected call to MockCreateFooRun.ID
+type MockCreateFooRunIDCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockCreateFooRunIDCall) Return(id string) *MockCreateFooRunIDCall {
+	c.Call.Return(id)
+	return c
+}
+
+// RunID implements CreateFooRun
+func (m *MockCreateFooRun) RunID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnRunID registers an expectation for a call to RunID with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockCreateFooRun) OnRunID() *MockCreateFooRunRunIDCall {
+	return &MockCreateFooRunRunIDCall{Call: m.On("RunID")}
+}
+
+// MockCreateFooRunRunIDCall describes an expected call to MockCreateFooRun.RunID
+type MockCreateFooRunRunIDCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockCreateFooRunRunIDCall) Return(runID string) *MockCreateFooRunRunIDCall {
+	c.Call.Return(runID)
+	return c
+}
+
+// Get implements CreateFooRun
+func (m *MockCreateFooRun) Get(ctx context.Context) (*CreateFooResponse, error) {
+	args := m.Called(ctx)
+	var r0 *CreateFooResponse
+	if v := args.Get(0); v != nil {
+		r0 = v.(*CreateFooResponse)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnGet registers an expectation for a call to Get with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockCreateFooRun) OnGet(ctx any) *MockCreateFooRunGetCall {
+	return &MockCreateFooRunGetCall{Call: m.On("Get", ctx)}
+}
+
+// MockCreateFooRunGetCall describes an expected call to MockCreateFooRun.Get
+type MockCreateFooRunGetCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockCreateFooRunGetCall) Return(resp *CreateFooResponse, err error) *MockCreateFooRunGetCall {
+	c.Call.Return(resp, err)
+	return c
+}
+
+// GetFooProgress implements CreateFooRun
+func (m *MockCreateFooRun) GetFooProgress(ctx context.Context) (*GetFooProgressResponse, error) {
+	args := m.Called(ctx)
+	var r0 *GetFooProgressResponse
+	if v := args.Get(0); v != nil {
+		r0 = v.(*GetFooProgressResponse)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnGetFooProgress registers an expectation for a call to GetFooProgress with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockCreateFooRun) OnGetFooProgress(ctx any) *MockCreateFooRunGetFooProgressCall {
+	return &MockCreateFooRunGetFooProgressCall{Call: m.On("GetFooProgress", ctx)}
+}
+
+// MockCreateFooRunGetFooProgressCall describes an expected call to MockCreateFooRun.GetFooProgress
+type MockCreateFooRunGetFooProgressCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockCreateFooRunGetFooProgressCall) Return(resp *GetFooProgressResponse, err error) *MockCreateFooRunGetFooProgressCall {
+	c.Call.Return(resp, err)
+	return c
+}
+
+// SetFooProgress implements CreateFooRun
+func (m *MockCreateFooRun) SetFooProgress(ctx context.Context, req *SetFooProgressRequest) error {
+	args := m.Called(ctx, req)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnSetFooProgress registers an expectation for a call to SetFooProgress with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockCreateFooRun) OnSetFooProgress(ctx any, req any) *MockCreateFooRunSetFooProgressCall {
+	return &MockCreateFooRunSetFooProgressCall{Call: m.On("SetFooProgress", ctx, req)}
+}
+
+// MockCreateFooRunSetFooProgressCall describes an expected call to MockCreateFooRun.SetFooProgress
+type MockCreateFooRunSetFooProgressCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockCreateFooRunSetFooProgressCall) Return(err error) *MockCreateFooRunSetFooProgressCall {
+	c.Call.Return(err)
+	return c
+}
+
+// UpdateFooProgress implements CreateFooRun
+func (m *MockCreateFooRun) UpdateFooProgress(ctx context.Context, req *SetFooProgressRequest, opts ...*UpdateFooProgressOptions) (*GetFooProgressResponse, error) {
+	args := m.Called(ctx, req, opts)
+	var r0 *GetFooProgressResponse
+	if v := args.Get(0); v != nil {
+		r0 = v.(*GetFooProgressResponse)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnUpdateFooProgress registers an expectation for a call to UpdateFooProgress with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockCreateFooRun) OnUpdateFooProgress(ctx any, req any, opts any) *MockCreateFooRunUpdateFooProgressCall {
+	return &MockCreateFooRunUpdateFooProgressCall{Call: m.On("UpdateFooProgress", ctx, req, opts)}
+}
+
+// MockCreateFooRunUpdateFooProgressCall describes an expected call to MockCreateFooRun.UpdateFooProgress
+type MockCreateFooRunUpdateFooProgressCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockCreateFooRunUpdateFooProgressCall) Return(resp *GetFooProgressResponse, err error) *MockCreateFooRunUpdateFooProgressCall {
+	c.Call.Return(resp, err)
+	return c
+}
+
+// UpdateFooProgressAsync implements CreateFooRun
+func (m *MockCreateFooRun) UpdateFooProgressAsync(ctx context.Context, req *SetFooProgressRequest, opts ...*UpdateFooProgressOptions) (UpdateFooProgressHandle, error) {
+	args := m.Called(ctx, req, opts)
+	var r0 UpdateFooProgressHandle
+	if v := args.Get(0); v != nil {
+		r0 = v.(UpdateFooProgressHandle)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnUpdateFooProgressAsync registers an expectation for a call to UpdateFooProgressAsync with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockCreateFooRun) OnUpdateFooProgressAsync(ctx any, req any, opts any) *MockCreateFooRunUpdateFooProgressAsyncCall {
+	return &MockCreateFooRunUpdateFooProgressAsyncCall{Call: m.On("UpdateFooProgressAsync", ctx, req, opts)}
+}
+
+// MockCreateFooRunUpdateFooProgressAsyncCall describes an expected call to MockCreateFooRun.UpdateFooProgressAsync
+type MockCreateFooRunUpdateFooProgressAsyncCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockCreateFooRunUpdateFooProgressAsyncCall) Return(handle UpdateFooProgressHandle, err error) *MockCreateFooRunUpdateFooProgressAsyncCall {
+	c.Call.Return(handle, err)
+	return c
+}
+
+// MockUpdateFooProgressHandle is a testify mock implementation of UpdateFooProgressHandle
+type MockUpdateFooProgressHandle struct {
+	mock.Mock
+}
+
+var _ UpdateFooProgressHandle = (*MockUpdateFooProgressHandle)(nil)
+
+// NewMockUpdateFooProgressHandle initializes a new MockUpdateFooProgressHandle that asserts its expectations when the test completes
+func NewMockUpdateFooProgressHandle(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUpdateFooProgressHandle {
+	m := &MockUpdateFooProgressHandle{}
+	m.Mock.Test(t)
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+	return m
+}
+
+// WorkflowID implements UpdateFooProgressHandle
+func (m *MockUpdateFooProgressHandle) WorkflowID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnWorkflowID registers an expectation for a call to WorkflowID with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockUpdateFooProgressHandle) OnWorkflowID() *MockUpdateFooProgressHandleWorkflowIDCall {
+	return &MockUpdateFooProgressHandleWorkflowIDCall{Call: m.On("WorkflowID")}
+}
+
+// MockUpdateFooProgressHandleWorkflowIDCall describes an expected call to MockUpdateFooProgressHandle.WorkflowID
+type MockUpdateFooProgressHandleWorkflowIDCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockUpdateFooProgressHandleWorkflowIDCall) Return(workflowID string) *MockUpdateFooProgressHandleWorkflowIDCall {
+	c.Call.Return(workflowID)
+	return c
+}
+
+// RunID implements UpdateFooProgressHandle
+func (m *MockUpdateFooProgressHandle) RunID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnRunID registers an expectation for a call to RunID with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockUpdateFooProgressHandle) OnRunID() *MockUpdateFooProgressHandleRunIDCall {
+	return &MockUpdateFooProgressHandleRunIDCall{Call: m.On("RunID")}
+}
+
+// MockUpdateFooProgressHandleRunIDCall describes an expected call to MockUpdateFooProgressHandle.RunID
+type MockUpdateFooProgressHandleRunIDCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockUpdateFooProgressHandleRunIDCall) Return(runID string) *MockUpdateFooProgressHandleRunIDCall {
+	c.Call.Return(runID)
+	return c
+}
+
+// UpdateID implements UpdateFooProgressHandle
+func (m *MockUpdateFooProgressHandle) UpdateID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnUpdateID registers an expectation for a call to UpdateID with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockUpdateFooProgressHandle) OnUpdateID() *MockUpdateFooProgressHandleUpdateIDCall {
+	return &MockUpdateFooProgressHandleUpdateIDCall{Call: m.On("UpdateID")}
+}
+
+// MockUpdateFooProgressHandleUpdateIDCall describes an expected call to MockUpdateFooProgressHandle.UpdateID
+type MockUpdateFooProgressHandleUpdateIDCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockUpdateFooProgressHandleUpdateIDCall) Return(updateID string) *MockUpdateFooProgressHandleUpdateIDCall {
+	c.Call.Return(updateID)
+	return c
+}
+
+// Get implements UpdateFooProgressHandle
+func (m *MockUpdateFooProgressHandle) Get(ctx context.Context) (*GetFooProgressResponse, error) {
+	args := m.Called(ctx)
+	var r0 *GetFooProgressResponse
+	if v := args.Get(0); v != nil {
+		r0 = v.(*GetFooProgressResponse)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnGet registers an expectation for a call to Get with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockUpdateFooProgressHandle) OnGet(ctx any) *MockUpdateFooProgressHandleGetCall {
+	return &MockUpdateFooProgressHandleGetCall{Call: m.On("Get", ctx)}
+}
+
+// MockUpdateFooProgressHandleGetCall describes an expected call to MockUpdateFooProgressHandle.Get
+type MockUpdateFooProgressHandleGetCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockUpdateFooProgressHandleGetCall) Return(resp *GetFooProgressResponse, err error) *MockUpdateFooProgressHandleGetCall {
+	c.Call.Return(resp, err)
+	return c
+}
+
 // ExampleCliOptions describes runtime configuration for example.v1.Example cli
 type ExampleCliOptions struct {
 	after            func(*v2.Context) error
@@ -1281,6 +2760,10 @@ func newExampleCommands(options ...*ExampleCliOptions) ([]*v2.Command, error) {
 					Usage:   "run workflow in the background and print workflow and execution id",
 					Aliases: []string{"d"},
 				},
+				&v2.StringFlag{
+					Name:  "cron",
+					Usage: "cron schedule for the workflow, overriding the default schedule if any",
+				},
 				&v2.StringFlag{
 					Name:     "name",
 					Usage:    "unique foo name",
@@ -1298,7 +2781,11 @@ func newExampleCommands(options ...*ExampleCliOptions) ([]*v2.Command, error) {
 				if err != nil {
 					return fmt.Errorf("error unmarshalling request: %w", err)
 				}
-				run, err := client.CreateFooAsync(cmd.Context, req)
+				workflowOpts := NewCreateFooOptions()
+				if cmd.IsSet("cron") {
+					workflowOpts.WithCronSchedule(cmd.String("cron"))
+				}
+				run, err := client.CreateFooAsync(cmd.Context, req, workflowOpts)
 				if err != nil {
 					return fmt.Errorf("error starting %s workflow: %w", CreateFooWorkflowName, err)
 				}
@@ -1324,6 +2811,212 @@ func newExampleCommands(options ...*ExampleCliOptions) ([]*v2.Command, error) {
 				}
 			},
 		},
+		// manages example.v1.Example.CreateFoo workflow schedules,
+		{
+			Name:                   "create-foo-schedule",
+			Usage:                  "manages example.v1.Example.CreateFoo workflow schedules",
+			Category:               "SCHEDULES",
+			UseShortOptionHandling: true,
+			Before:                 opts.before,
+			After:                  opts.after,
+			Subcommands: []*v2.Command{
+				{
+					Name:  "create",
+					Usage: "creates a schedule that periodically starts a(n) example.v1.Example.CreateFoo workflow",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+						&v2.StringSliceFlag{
+							Name:  "cron",
+							Usage: "cron expression describing when to start the workflow, overriding the default spec",
+						},
+						&v2.StringSliceFlag{
+							Name:  "interval",
+							Usage: "interval (e.g. 1h) describing how often to start the workflow, overriding the default spec",
+						},
+						&v2.StringFlag{
+							Name:     "name",
+							Usage:    "unique foo name",
+							Category: "INPUT",
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						req, err := unmarshalCliFlagsToCreateFooRequest(cmd)
+						if err != nil {
+							return fmt.Errorf("error unmarshalling request: %w", err)
+						}
+						spec := client.ScheduleSpec{CronExpressions: cmd.StringSlice("cron")}
+						for _, interval := range cmd.StringSlice("interval") {
+							every, err := time.ParseDuration(interval)
+							if err != nil {
+								return fmt.Errorf("error parsing interval %q: %w", interval, err)
+							}
+							spec.Intervals = append(spec.Intervals, client.ScheduleIntervalSpec{Every: every})
+						}
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewExampleClient(c)
+						handle, err := client.CreateCreateFooSchedule(cmd.Context, cmd.String("schedule-id"), spec, req)
+						if err != nil {
+							return fmt.Errorf("error creating schedule: %w", err)
+						}
+						fmt.Println("success")
+						fmt.Printf("schedule id: %s\n", handle.GetID())
+						return nil
+					},
+				},
+				{
+					Name:  "get",
+					Usage: "describes an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewExampleClient(c)
+						desc, err := client.GetCreateFooSchedule(cmd.Context, cmd.String("schedule-id"))
+						if err != nil {
+							return fmt.Errorf("error describing schedule: %w", err)
+						}
+						if state := desc.Schedule.State; state != nil {
+							fmt.Printf("paused: %t\n", state.Paused)
+							fmt.Printf("note: %s\n", state.Note)
+						}
+						for _, t := range desc.Info.NextActionTimes {
+							fmt.Printf("next action: %s\n", t.Format(time.RFC3339))
+						}
+						for _, a := range desc.Info.RecentActions {
+							fmt.Printf("recent action: %s\n", a.ActualTime.Format(time.RFC3339))
+						}
+						return nil
+					},
+				},
+				{
+					Name:  "pause",
+					Usage: "pauses an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+						&v2.StringFlag{
+							Name:  "note",
+							Usage: "informative note describing the change",
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewExampleClient(c)
+						if err := client.PauseCreateFooSchedule(cmd.Context, cmd.String("schedule-id"), cmd.String("note")); err != nil {
+							return fmt.Errorf("error executing pause schedule command: %w", err)
+						}
+						fmt.Println("success")
+						return nil
+					},
+				},
+				{
+					Name:  "unpause",
+					Usage: "unpauses an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+						&v2.StringFlag{
+							Name:  "note",
+							Usage: "informative note describing the change",
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewExampleClient(c)
+						if err := client.UnpauseCreateFooSchedule(cmd.Context, cmd.String("schedule-id"), cmd.String("note")); err != nil {
+							return fmt.Errorf("error executing unpause schedule command: %w", err)
+						}
+						fmt.Println("success")
+						return nil
+					},
+				},
+				{
+					Name:  "trigger",
+					Usage: "immediately starts a workflow using an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewExampleClient(c)
+						if err := client.TriggerCreateFooSchedule(cmd.Context, cmd.String("schedule-id")); err != nil {
+							return fmt.Errorf("error executing trigger schedule command: %w", err)
+						}
+						fmt.Println("success")
+						return nil
+					},
+				},
+				{
+					Name:  "delete",
+					Usage: "deletes an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewExampleClient(c)
+						if err := client.DeleteCreateFooSchedule(cmd.Context, cmd.String("schedule-id")); err != nil {
+							return fmt.Errorf("error executing delete schedule command: %w", err)
+						}
+						fmt.Println("success")
+						return nil
+					},
+				},
+			},
+		},
 		// sends a SetFooProgress signal to a CreateFoo worklow, starting it if necessary,
 		{
 			Name:                   "create-foo-with-set-foo-progress",
@@ -0,0 +1,794 @@
+// Code generated by protoc-gen-go_temporal. DO NOT EDIT.
+// versions:
+//
+//	protoc-gen-go_temporal 1.0.0-next (49865815c1faa174b6ea94e7059ab4ca6e046f8a)
+//	go go1.20.4
+//	protoc (unknown)
+//
+// source: simple/common/common.proto
+package common
+
+import (
+	"context"
+	"fmt"
+	testutil "github.com/cludden/protoc-gen-go-temporal/pkg/testutil"
+	mock "github.com/stretchr/testify/mock"
+	v11 "go.temporal.io/api/enums/v1"
+	v1 "go.temporal.io/api/update/v1"
+	client "go.temporal.io/sdk/client"
+	testsuite "go.temporal.io/sdk/testsuite"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	"time"
+)
+
+// mycompany.simple.common.Common query names
+const (
+	GetValueQueryName = "mycompany.simple.common.Common.GetValue"
+)
+
+// mycompany.simple.common.Common signal names
+const (
+	SetValueSignalName = "mycompany.simple.common.Common.SetValue"
+)
+
+// mycompany.simple.common.Common update names
+const (
+	UpdateValueUpdateName = "mycompany.simple.common.Common.UpdateValue"
+)
+
+// CommonClient describes a client for a(n) mycompany.simple.common.Common worker
+type CommonClient interface {
+	/*
+	   GetValue returns the current value.
+	*/
+	GetValue(ctx context.Context, workflowID string, runID string) (*GetValueResponse, error)
+	/*
+	   SetValue sets the current value.
+	*/
+	SetValue(ctx context.Context, workflowID string, runID string, signal *SetValueRequest) error
+	/*
+	   UpdateValue updates the current value and returns the previous value.
+	*/
+	UpdateValue(ctx context.Context, workflowID string, runID string, req *UpdateValueRequest, opts ...*UpdateValueOptions) (*UpdateValueResponse, error)
+	/*
+	   UpdateValue updates the current value and returns the previous value.
+	*/
+	UpdateValueAsync(ctx context.Context, workflowID string, runID string, req *UpdateValueRequest, opts ...*UpdateValueOptions) (UpdateValueHandle, error)
+}
+
+// commonClient implements a temporal client for a mycompany.simple.common.Common service
+type commonClient struct {
+	client       client.Client
+	interceptors commonClientInterceptors
+}
+
+// NewCommonClient initializes a new mycompany.simple.common.Common client that invokes the hooks of the given interceptors
+func NewCommonClient(c client.Client, interceptors ...CommonClientInterceptor) CommonClient {
+	return &commonClient{client: c, interceptors: interceptors}
+}
+
+// NewCommonClientWithOptions initializes a new Common client with the given options that invokes the hooks of the given interceptors
+func NewCommonClientWithOptions(c client.Client, opts client.Options, interceptors ...CommonClientInterceptor) (CommonClient, error) {
+	var err error
+	c, err = client.NewClientFromExisting(c, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing client with options: %w", err)
+	}
+	return &commonClient{client: c, interceptors: interceptors}, nil
+}
+
+// CommonClientInterceptor describes typed hooks invoked around mycompany.simple.common.Common workflows, queries, signals,
+// and updates sent by a client, which can be installed via the NewCommonClient functions
+type CommonClientInterceptor interface {
+	// BeforeGetValue is invoked with the input of a(n) mycompany.simple.common.Common.GetValue query before it is sent
+	BeforeGetValue(ctx context.Context)
+	// AfterGetValue is invoked with the result of a(n) mycompany.simple.common.Common.GetValue query after it is received
+	AfterGetValue(ctx context.Context, resp *GetValueResponse, err error)
+	// OnSetValue is invoked with the input of a(n) mycompany.simple.common.Common.SetValue signal before it is sent
+	OnSetValue(ctx context.Context, req *SetValueRequest)
+	// BeforeUpdateValue is invoked with the input of a(n) mycompany.simple.common.Common.UpdateValue update before it is sent
+	BeforeUpdateValue(ctx context.Context, req *UpdateValueRequest)
+	// AfterUpdateValue is invoked with the result of a(n) mycompany.simple.common.Common.UpdateValue update when it is retrieved
+	AfterUpdateValue(ctx context.Context, resp *UpdateValueResponse, err error)
+}
+
+// NoopCommonClientInterceptor provides a no-op CommonClientInterceptor implementation that can be embedded
+// to implement a subset of hooks
+type NoopCommonClientInterceptor struct{}
+
+// BeforeGetValue implements CommonClientInterceptor
+func (NoopCommonClientInterceptor) BeforeGetValue(context.Context) {}
+
+// AfterGetValue implements CommonClientInterceptor
+func (NoopCommonClientInterceptor) AfterGetValue(context.Context, *GetValueResponse, error) {}
+
+// OnSetValue implements CommonClientInterceptor
+func (NoopCommonClientInterceptor) OnSetValue(context.Context, *SetValueRequest) {}
+
+// BeforeUpdateValue implements CommonClientInterceptor
+func (NoopCommonClientInterceptor) BeforeUpdateValue(context.Context, *UpdateValueRequest) {}
+
+// AfterUpdateValue implements CommonClientInterceptor
+func (NoopCommonClientInterceptor) AfterUpdateValue(context.Context, *UpdateValueResponse, error) {}
+
+// commonClientInterceptors invokes the hooks of multiple CommonClientInterceptor values, in order for
+// Before<Method> and On<Method> hooks and in reverse order for After<Method> hooks
+type commonClientInterceptors []CommonClientInterceptor
+
+// BeforeGetValue implements CommonClientInterceptor
+func (c commonClientInterceptors) BeforeGetValue(ctx context.Context) {
+	for _, interceptor := range c {
+		interceptor.BeforeGetValue(ctx)
+	}
+}
+
+// AfterGetValue implements CommonClientInterceptor
+func (c commonClientInterceptors) AfterGetValue(ctx context.Context, resp *GetValueResponse, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterGetValue(ctx, resp, err)
+	}
+}
+
+// OnSetValue implements CommonClientInterceptor
+func (c commonClientInterceptors) OnSetValue(ctx context.Context, req *SetValueRequest) {
+	for _, interceptor := range c {
+		interceptor.OnSetValue(ctx, req)
+	}
+}
+
+// BeforeUpdateValue implements CommonClientInterceptor
+func (c commonClientInterceptors) BeforeUpdateValue(ctx context.Context, req *UpdateValueRequest) {
+	for _, interceptor := range c {
+		interceptor.BeforeUpdateValue(ctx, req)
+	}
+}
+
+// AfterUpdateValue implements CommonClientInterceptor
+func (c commonClientInterceptors) AfterUpdateValue(ctx context.Context, resp *UpdateValueResponse, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterUpdateValue(ctx, resp, err)
+	}
+}
+
+// GetValue sends a(n) mycompany.simple.common.Common.GetValue query to an existing workflow
+func (c *commonClient) GetValue(ctx context.Context, workflowID string, runID string) (*GetValueResponse, error) {
+	c.interceptors.BeforeGetValue(ctx)
+	var resp GetValueResponse
+	if val, err := c.client.QueryWorkflow(ctx, workflowID, runID, GetValueQueryName); err != nil {
+		c.interceptors.AfterGetValue(ctx, nil, err)
+		return nil, err
+	} else if err = val.Get(&resp); err != nil {
+		c.interceptors.AfterGetValue(ctx, nil, err)
+		return nil, err
+	}
+	c.interceptors.AfterGetValue(ctx, &resp, nil)
+	return &resp, nil
+}
+
+// SetValue sends a(n) mycompany.simple.common.Common.SetValue signal to an existing workflow
+func (c *commonClient) SetValue(ctx context.Context, workflowID string, runID string, signal *SetValueRequest) error {
+	c.interceptors.OnSetValue(ctx, signal)
+	return c.client.SignalWorkflow(ctx, workflowID, runID, SetValueSignalName, signal)
+}
+
+// UpdateValue sends a(n) mycompany.simple.common.Common.UpdateValue update to an existing workflow
+func (c *commonClient) UpdateValue(ctx context.Context, workflowID string, runID string, req *UpdateValueRequest, opts ...*UpdateValueOptions) (*UpdateValueResponse, error) {
+	options := NewUpdateValueOptions()
+	if len(opts) > 0 && opts[0].opts != nil {
+		options = opts[0]
+	}
+	options.opts.WaitPolicy = &v1.WaitPolicy{LifecycleStage: v11.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED}
+	handle, err := c.UpdateValueAsync(ctx, workflowID, runID, req, options)
+	if err != nil {
+		return nil, err
+	}
+	return handle.Get(ctx)
+}
+
+// UpdateValueAsync sends a(n) mycompany.simple.common.Common.UpdateValue update to an existing workflow
+func (c *commonClient) UpdateValueAsync(ctx context.Context, workflowID string, runID string, req *UpdateValueRequest, opts ...*UpdateValueOptions) (UpdateValueHandle, error) {
+	var o *UpdateValueOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	options, err := o.Build(workflowID, runID, req)
+	if err != nil {
+		return nil, err
+	}
+	c.interceptors.BeforeUpdateValue(ctx, req)
+	handle, err := c.client.UpdateWorkflowWithOptions(ctx, options)
+	if err != nil {
+		c.interceptors.AfterUpdateValue(ctx, nil, err)
+		return nil, err
+	}
+	return &updateValueHandle{client: c, handle: handle}, nil
+}
+
+// UpdateValueHandle describes a(n) mycompany.simple.common.Common.UpdateValue update handle
+type UpdateValueHandle interface {
+	// WorkflowID returns the workflow ID
+	WorkflowID() string
+	// RunID returns the workflow instance ID
+	RunID() string
+	// UpdateID returns the update ID
+	UpdateID() string
+	// Get blocks until the workflow is complete and returns the result
+	Get(ctx context.Context) (*UpdateValueResponse, error)
+}
+
+// updateValueHandle provides an internal implementation of a(n) UpdateValueHandle
+type updateValueHandle struct {
+	client *commonClient
+	handle client.WorkflowUpdateHandle
+}
+
+// WorkflowID returns the workflow ID
+func (h *updateValueHandle) WorkflowID() string {
+	return h.handle.WorkflowID()
+}
+
+// RunID returns the execution ID
+func (h *updateValueHandle) RunID() string {
+	return h.handle.RunID()
+}
+
+// UpdateID returns the update ID
+func (h *updateValueHandle) UpdateID() string {
+	return h.handle.UpdateID()
+}
+
+// Get blocks until the update wait policy is met, returning the result if applicable
+func (h *updateValueHandle) Get(ctx context.Context) (*UpdateValueResponse, error) {
+	var resp UpdateValueResponse
+	if err := h.handle.Get(ctx, &resp); err != nil {
+		h.client.interceptors.AfterUpdateValue(ctx, nil, err)
+		return nil, err
+	}
+	h.client.interceptors.AfterUpdateValue(ctx, &resp, nil)
+	return &resp, nil
+}
+
+// UpdateValueOptions provides configuration for a mycompany.simple.common.Common.UpdateValue update operation
+type UpdateValueOptions struct {
+	opts *client.UpdateWorkflowWithOptionsRequest
+}
+
+// NewUpdateValueOptions initializes a new UpdateValueOptions value
+func NewUpdateValueOptions() *UpdateValueOptions {
+	return &UpdateValueOptions{opts: &client.UpdateWorkflowWithOptionsRequest{}}
+}
+
+// WithUpdateWorkflowOptions sets the initial client.UpdateWorkflowWithOptionsRequest
+func (opts *UpdateValueOptions) WithUpdateWorkflowOptions(options client.UpdateWorkflowWithOptionsRequest) *UpdateValueOptions {
+	opts.opts = &options
+	return opts
+}
+
+// Build initializes a client.UpdateWorkflowWithOptionsRequest with defaults for a(n) mycompany.simple.common.Common.UpdateValue update operation
+func (o *UpdateValueOptions) Build(workflowID string, runID string, req *UpdateValueRequest) (*client.UpdateWorkflowWithOptionsRequest, error) {
+	options := &client.UpdateWorkflowWithOptionsRequest{}
+	if o != nil && o.opts != nil {
+		options = o.opts
+	}
+	options.Args = []any{req}
+	options.RunID = runID
+	options.UpdateName = UpdateValueUpdateName
+	options.WorkflowID = workflowID
+	return options, nil
+}
+
+// Reference to generated workflow functions
+var ()
+
+// CommonWorkflows provides methods for initializing new mycompany.simple.common.Common workflow values
+type CommonWorkflows interface{}
+
+// RegisterCommonWorkflows registers mycompany.simple.common.Common workflows with the given worker
+func RegisterCommonWorkflows(r worker.WorkflowRegistry, workflows CommonWorkflows) {}
+
+// SetValueSignal describes a(n) mycompany.simple.common.Common.SetValue signal
+type SetValueSignal struct {
+	Channel workflow.ReceiveChannel
+}
+
+// Receive blocks until a(n) mycompany.simple.common.Common.SetValue signal is received
+func (s *SetValueSignal) Receive(ctx workflow.Context) (*SetValueRequest, bool) {
+	var resp SetValueRequest
+	more := s.Channel.Receive(ctx, &resp)
+	return &resp, more
+}
+
+// ReceiveAsync checks for a mycompany.simple.common.Common.SetValue signal without blocking
+func (s *SetValueSignal) ReceiveAsync() *SetValueRequest {
+	var resp SetValueRequest
+	if ok := s.Channel.ReceiveAsync(&resp); !ok {
+		return nil
+	}
+	return &resp
+}
+
+// Select checks for a(n) mycompany.simple.common.Common.SetValue signal without blocking
+func (s *SetValueSignal) Select(sel workflow.Selector, fn func(*SetValueRequest)) workflow.Selector {
+	return sel.AddReceive(s.Channel, func(workflow.ReceiveChannel, bool) {
+		req := s.ReceiveAsync()
+		if fn != nil {
+			fn(req)
+		}
+	})
+}
+
+// SetValueExternal sends a(n) mycompany.simple.common.Common.SetValue signal to an existing workflow
+func SetValueExternal(ctx workflow.Context, workflowID string, runID string, req *SetValueRequest) error {
+	return workflow.SignalExternalWorkflow(ctx, workflowID, runID, SetValueSignalName, req).Get(ctx, nil)
+}
+
+// CommonActivities describes available worker activites
+type CommonActivities interface{}
+
+// RegisterCommonActivities registers activities with a worker
+func RegisterCommonActivities(r worker.ActivityRegistry, activities CommonActivities) {}
+
+// TestClient provides a testsuite-compatible Client
+type TestCommonClient struct {
+	env       *testsuite.TestWorkflowEnvironment
+	workflows CommonWorkflows
+}
+
+var _ CommonClient = &TestCommonClient{}
+
+// NewTestCommonClient initializes a new TestCommonClient value
+func NewTestCommonClient(env *testsuite.TestWorkflowEnvironment, workflows CommonWorkflows, activities CommonActivities) *TestCommonClient {
+	if workflows != nil {
+		RegisterCommonWorkflows(env, workflows)
+	}
+	if activities != nil {
+		RegisterCommonActivities(env, activities)
+	}
+	return &TestCommonClient{env, workflows}
+}
+
+// GetValue executes a GetValue query
+func (c *TestCommonClient) GetValue(ctx context.Context, workflowID string, runID string) (*GetValueResponse, error) {
+	val, err := c.env.QueryWorkflow(GetValueQueryName)
+	if err != nil {
+		return nil, err
+	} else if !val.HasValue() {
+		return nil, nil
+	} else {
+		var result GetValueResponse
+		if err := val.Get(&result); err != nil {
+			return nil, err
+		}
+		return &result, nil
+	}
+}
+
+// SetValue executes a SetValue signal
+func (c *TestCommonClient) SetValue(ctx context.Context, workflowID string, runID string, req *SetValueRequest) error {
+	c.env.SignalWorkflow(SetValueSignalName, req)
+	return nil
+}
+
+// UpdateValue executes a(n) mycompany.simple.common.Common.UpdateValue update in the test environment
+func (c *TestCommonClient) UpdateValue(ctx context.Context, workflowID string, runID string, req *UpdateValueRequest, opts ...*UpdateValueOptions) (*UpdateValueResponse, error) {
+	handle, err := c.UpdateValueAsync(ctx, workflowID, runID, req, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return handle.Get(ctx)
+}
+
+// UpdateValueAsync executes a(n) mycompany.simple.common.Common.UpdateValue update in the test environment
+func (c *TestCommonClient) UpdateValueAsync(ctx context.Context, workflowID string, runID string, req *UpdateValueRequest, opts ...*UpdateValueOptions) (UpdateValueHandle, error) {
+	var o *UpdateValueOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	options, err := o.Build(workflowID, runID, req)
+	if err != nil {
+		return nil, err
+	}
+	uc := testutil.NewUpdateCallbacks()
+	c.env.UpdateWorkflow(UpdateValueUpdateName, uc, req)
+	return &testUpdateValueHandle{
+		callbacks:  uc,
+		env:        c.env,
+		opts:       options,
+		runID:      runID,
+		workflowID: workflowID,
+		req:        req,
+	}, nil
+}
+
+var _ UpdateValueHandle = &testUpdateValueHandle{}
+
+// testUpdateValueHandle provides an internal implementation of a(n) UpdateValueHandle
+type testUpdateValueHandle struct {
+	callbacks  *testutil.UpdateCallbacks
+	env        *testsuite.TestWorkflowEnvironment
+	opts       *client.UpdateWorkflowWithOptionsRequest
+	req        *UpdateValueRequest
+	runID      string
+	workflowID string
+}
+
+// Get retrieves a test mycompany.simple.common.Common.UpdateValue update result
+func (h *testUpdateValueHandle) Get(ctx context.Context) (*UpdateValueResponse, error) {
+	if resp, err := h.callbacks.Get(ctx); err != nil {
+		return nil, err
+	} else {
+		return resp.(*UpdateValueResponse), nil
+	}
+}
+
+// RunID implementation
+func (h *testUpdateValueHandle) RunID() string {
+	return h.runID
+}
+
+// UpdateID implementation
+func (h *testUpdateValueHandle) UpdateID() string {
+	if h.opts != nil {
+		return h.opts.UpdateID
+	}
+	return ""
+}
+
+// WorkflowID implementation
+func (h *testUpdateValueHandle) WorkflowID() string {
+	return h.workflowID
+}
+
+// After returns a TestCommonDelayed value that schedules queries, signals, and updates
+// to be sent to the test environment's workflow after the given duration of virtual time
+func (c *TestCommonClient) After(d time.Duration) *TestCommonDelayed {
+	return &TestCommonDelayed{c, d}
+}
+
+// TestCommonDelayed schedules mycompany.simple.common.Common queries, signals, and updates using the test
+// environment's RegisterDelayedCallback method
+type TestCommonDelayed struct {
+	client *TestCommonClient
+	delay  time.Duration
+}
+
+// GetValue schedules a(n) mycompany.simple.common.Common.GetValue query, invoking fn with the result
+func (d *TestCommonDelayed) GetValue(fn func(*GetValueResponse, error)) {
+	d.client.env.RegisterDelayedCallback(func() {
+		fn(d.client.GetValue(context.Background(), "", ""))
+	}, d.delay)
+}
+
+// SetValue schedules a(n) mycompany.simple.common.Common.SetValue signal
+func (d *TestCommonDelayed) SetValue(req *SetValueRequest) {
+	d.client.env.RegisterDelayedCallback(func() {
+		d.client.env.SignalWorkflow(SetValueSignalName, req)
+	}, d.delay)
+}
+
+// UpdateValue schedules a(n) mycompany.simple.common.Common.UpdateValue update, invoking the given callbacks, which may be nil
+func (d *TestCommonDelayed) UpdateValue(req *UpdateValueRequest, callbacks *TestUpdateValueCallbacks) {
+	if callbacks == nil {
+		callbacks = &TestUpdateValueCallbacks{}
+	}
+	d.client.env.RegisterDelayedCallback(func() {
+		d.client.env.UpdateWorkflow(UpdateValueUpdateName, callbacks, req)
+	}, d.delay)
+}
+
+// TestUpdateValueCallbacks describes optional callbacks invoked in response to a(n) mycompany.simple.common.Common.UpdateValue update
+// scheduled in a test environment
+type TestUpdateValueCallbacks struct {
+	OnAccept   func()
+	OnReject   func(error)
+	OnComplete func(*UpdateValueResponse, error)
+}
+
+// Accept invokes the OnAccept callback, if set
+func (c *TestUpdateValueCallbacks) Accept() {
+	if c.OnAccept != nil {
+		c.OnAccept()
+	}
+}
+
+// Reject invokes the OnReject callback, if set
+func (c *TestUpdateValueCallbacks) Reject(err error) {
+	if c.OnReject != nil {
+		c.OnReject(err)
+	}
+}
+
+// Complete invokes the OnComplete callback, if set
+func (c *TestUpdateValueCallbacks) Complete(success any, err error) {
+	if c.OnComplete != nil {
+		resp, _ := success.(*UpdateValueResponse)
+		c.OnComplete(resp, err)
+	}
+}
+
+// TestCommonChildWorkflows provides typed mocks for mycompany.simple.common.Common child workflow executions in a
+// test environment, including child workflows started by workflows defined in other services
+type TestCommonChildWorkflows struct {
+	env *testsuite.TestWorkflowEnvironment
+}
+
+// NewTestCommonChildWorkflows initializes a new TestCommonChildWorkflows value, registering placeholder workflows
+// that fail unless mocked. It must be called before any mocks are registered with the test
+// environment, and must not be used when the service's workflows are registered with the test
+// environment, in which case the test client's On<Workflow>Child methods can be used instead.
+func NewTestCommonChildWorkflows(env *testsuite.TestWorkflowEnvironment) *TestCommonChildWorkflows {
+	return &TestCommonChildWorkflows{env}
+}
+
+// MockCommonClient is a testify mock implementation of CommonClient
+type MockCommonClient struct {
+	mock.Mock
+}
+
+var _ CommonClient = (*MockCommonClient)(nil)
+
+// NewMockCommonClient initializes a new MockCommonClient that asserts its expectations when the test completes
+func NewMockCommonClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCommonClient {
+	m := &MockCommonClient{}
+	m.Mock.Test(t)
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+	return m
+}
+
+// GetValue implements CommonClient
+func (m *MockCommonClient) GetValue(ctx context.Context, workflowID string, runID string) (*GetValueResponse, error) {
+	args := m.Called(ctx, workflowID, runID)
+	var r0 *GetValueResponse
+	if v := args.Get(0); v != nil {
+		r0 = v.(*GetValueResponse)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnGetValue registers an expectation for a call to GetValue with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockCommonClient) OnGetValue(ctx any, workflowID any, runID any) *MockCommonClientGetValueCall {
+	return &MockCommonClientGetValueCall{Call: m.On("GetValue", ctx, workflowID, runID)}
+}
+
+// MockCommonClientGetValueCall describes an expected call to MockCommonClient.GetValue
+type MockCommonClientGetValueCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockCommonClientGetValueCall) Return(resp *GetValueResponse, err error) *MockCommonClientGetValueCall {
+	c.Call.Return(resp, err)
+	return c
+}
+
+// SetValue implements CommonClient
+func (m *MockCommonClient) SetValue(ctx context.Context, workflowID string, runID string, signal *SetValueRequest) error {
+	args := m.Called(ctx, workflowID, runID, signal)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnSetValue registers an expectation for a call to SetValue with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockCommonClient) OnSetValue(ctx any, workflowID any, runID any, signal any) *MockCommonClientSetValueCall {
+	return &MockCommonClientSetValueCall{Call: m.On("SetValue", ctx, workflowID, runID, signal)}
+}
+
+// MockCommonClientSetValueCall describes an expected call to MockCommonClient.SetValue
+type MockCommonClientSetValueCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockCommonClientSetValueCall) Return(err error) *MockCommonClientSetValueCall {
+	c.Call.Return(err)
+	return c
+}
+
+// UpdateValue implements CommonClient
+func (m *MockCommonClient) UpdateValue(ctx context.Context, workflowID string, runID string, req *UpdateValueRequest, opts ...*UpdateValueOptions) (*UpdateValueResponse, error) {
+	args := m.Called(ctx, workflowID, runID, req, opts)
+	var r0 *UpdateValueResponse
+	if v := args.Get(0); v != nil {
+		r0 = v.(*UpdateValueResponse)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnUpdateValue registers an expectation for a call to UpdateValue with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockCommonClient) OnUpdateValue(ctx any, workflowID any, runID any, req any, opts any) *MockCommonClientUpdateValueCall {
+	return &MockCommonClientUpdateValueCall{Call: m.On("UpdateValue", ctx, workflowID, runID, req, opts)}
+}
+
+// MockCommonClientUpdateValueCall describes an expected call to MockCommonClient.UpdateValue
+type MockCommonClientUpdateValueCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockCommonClientUpdateValueCall) Return(resp *UpdateValueResponse, err error) *MockCommonClientUpdateValueCall {
+	c.Call.Return(resp, err)
+	return c
+}
+
+// UpdateValueAsync implements CommonClient
+func (m *MockCommonClient) UpdateValueAsync(ctx context.Context, workflowID string, runID string, req *UpdateValueRequest, opts ...*UpdateValueOptions) (UpdateValueHandle, error) {
+	args := m.Called(ctx, workflowID, runID, req, opts)
+	var r0 UpdateValueHandle
+	if v := args.Get(0); v != nil {
+		r0 = v.(UpdateValueHandle)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnUpdateValueAsync registers an expectation for a call to UpdateValueAsync with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockCommonClient) OnUpdateValueAsync(ctx any, workflowID any, runID any, req any, opts any) *MockCommonClientUpdateValueAsyncCall {
+	return &MockCommonClientUpdateValueAsyncCall{Call: m.On("UpdateValueAsync", ctx, workflowID, runID, req, opts)}
+}
+
+// MockCommonClientUpdateValueAsyncCall describes an expected call to MockCommonClient.UpdateValueAsync
+type MockCommonClientUpdateValueAsyncCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockCommonClientUpdateValueAsyncCall) Return(handle UpdateValueHandle, err error) *MockCommonClientUpdateValueAsyncCall {
+	c.Call.Return(handle, err)
+	return c
+}
+
+// MockUpdateValueHandle is a testify mock implementation of UpdateValueHandle
+type MockUpdateValueHandle struct {
+	mock.Mock
+}
+
+var _ UpdateValueHandle = (*MockUpdateValueHandle)(nil)
+
+// NewMockUpdateValueHandle initializes a new MockUpdateValueHandle that asserts its expectations when the test completes
+func NewMockUpdateValueHandle(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUpdateValueHandle {
+	m := &MockUpdateValueHandle{}
+	m.Mock.Test(t)
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+	return m
+}
+
+// WorkflowID implements UpdateValueHandle
+func (m *MockUpdateValueHandle) WorkflowID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnWorkflowID registers an expectation for a call to WorkflowID with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockUpdateValueHandle) OnWorkflowID() *MockUpdateValueHandleWorkflowIDCall {
+	return &MockUpdateValueHandleWorkflowIDCall{Call: m.On("WorkflowID")}
+}
+
+// MockUpdateValueHandleWorkflowIDCall describes an expected call to MockUpdateValueHandle.WorkflowID
+type MockUpdateValueHandleWorkflowIDCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockUpdateValueHandleWorkflowIDCall) Return(workflowID string) *MockUpdateValueHandleWorkflowIDCall {
+	c.Call.Return(workflowID)
+	return c
+}
+
+// RunID implements UpdateValueHandle
+func (m *MockUpdateValueHandle) RunID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnRunID registers an expectation for a call to RunID with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockUpdateValueHandle) OnRunID() *MockUpdateValueHandleRunIDCall {
+	return &MockUpdateValueHandleRunIDCall{Call: m.On("RunID")}
+}
+
+// MockUpdateValueHandleRunIDCall describes an expected call to MockUpdateValueHandle.RunID
+type MockUpdateValueHandleRunIDCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockUpdateValueHandleRunIDCall) Return(runID string) *MockUpdateValueHandleRunIDCall {
+	c.Call.Return(runID)
+	return c
+}
+
+// UpdateID implements UpdateValueHandle
+func (m *MockUpdateValueHandle) UpdateID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnUpdateID registers an expectation for a call to UpdateID with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockUpdateValueHandle) OnUpdateID() *MockUpdateValueHandleUpdateIDCall {
+	return &MockUpdateValueHandleUpdateIDCall{Call: m.On("UpdateID")}
+}
+
+// MockUpdateValueHandleUpdateIDCall describes an expected call to MockUpdateValueHandle.UpdateID
+type MockUpdateValueHandleUpdateIDCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockUpdateValueHandleUpdateIDCall) Return(updateID string) *MockUpdateValueHandleUpdateIDCall {
+	c.Call.Return(updateID)
+	return c
+}
+
+// Get implements UpdateValueHandle
+func (m *MockUpdateValueHandle) Get(ctx context.Context) (*UpdateValueResponse, error) {
+	args := m.Called(ctx)
+	var r0 *UpdateValueResponse
+	if v := args.Get(0); v != nil {
+		r0 = v.(*UpdateValueResponse)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnGet registers an expectation for a call to Get with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockUpdateValueHandle) OnGet(ctx any) *MockUpdateValueHandleGetCall {
+	return &MockUpdateValueHandleGetCall{Call: m.On("Get", ctx)}
+}
+
+// MockUpdateValueHandleGetCall describes an expected call to MockUpdateValueHandle.Get
+type MockUpdateValueHandleGetCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockUpdateValueHandleGetCall) Return(resp *UpdateValueResponse, err error) *MockUpdateValueHandleGetCall {
+	c.Call.Return(resp, err)
+	return c
+}
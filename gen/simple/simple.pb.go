@@ -9,6 +9,7 @@
 package simple
 
 import (
+	_ "github.com/cludden/protoc-gen-go-temporal/gen/simple/common"
 	_ "github.com/cludden/protoc-gen-go-temporal/gen/temporal/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -228,6 +229,53 @@ func (x *SomeActivity2Request) GetRequestVal() string {
 	return ""
 }
 
+type SomeActivity2Progress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset int32 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *SomeActivity2Progress) Reset() {
+	*x = SomeActivity2Progress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simple_simple_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SomeActivity2Progress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SomeActivity2Progress) ProtoMessage() {}
+
+func (x *SomeActivity2Progress) ProtoReflect() protoreflect.Message {
+	mi := &file_simple_simple_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SomeActivity2Progress.ProtoReflect.Descriptor instead.
+func (*SomeActivity2Progress) Descriptor() ([]byte, []int) {
+	return file_simple_simple_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SomeActivity2Progress) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
 type SomeActivity3Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -239,7 +287,7 @@ type SomeActivity3Request struct {
 func (x *SomeActivity3Request) Reset() {
 	*x = SomeActivity3Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_simple_simple_proto_msgTypes[4]
+		mi := &file_simple_simple_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -252,7 +300,7 @@ func (x *SomeActivity3Request) String() string {
 func (*SomeActivity3Request) ProtoMessage() {}
 
 func (x *SomeActivity3Request) ProtoReflect() protoreflect.Message {
-	mi := &file_simple_simple_proto_msgTypes[4]
+	mi := &file_simple_simple_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -265,7 +313,7 @@ func (x *SomeActivity3Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SomeActivity3Request.ProtoReflect.Descriptor instead.
 func (*SomeActivity3Request) Descriptor() ([]byte, []int) {
-	return file_simple_simple_proto_rawDescGZIP(), []int{4}
+	return file_simple_simple_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SomeActivity3Request) GetRequestVal() string {
@@ -286,7 +334,7 @@ type SomeActivity3Response struct {
 func (x *SomeActivity3Response) Reset() {
 	*x = SomeActivity3Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_simple_simple_proto_msgTypes[5]
+		mi := &file_simple_simple_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -299,7 +347,7 @@ func (x *SomeActivity3Response) String() string {
 func (*SomeActivity3Response) ProtoMessage() {}
 
 func (x *SomeActivity3Response) ProtoReflect() protoreflect.Message {
-	mi := &file_simple_simple_proto_msgTypes[5]
+	mi := &file_simple_simple_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -312,7 +360,7 @@ func (x *SomeActivity3Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SomeActivity3Response.ProtoReflect.Descriptor instead.
 func (*SomeActivity3Response) Descriptor() ([]byte, []int) {
-	return file_simple_simple_proto_rawDescGZIP(), []int{5}
+	return file_simple_simple_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SomeActivity3Response) GetResponseVal() string {
@@ -322,6 +370,53 @@ func (x *SomeActivity3Response) GetResponseVal() string {
 	return ""
 }
 
+type SomeErrorDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *SomeErrorDetail) Reset() {
+	*x = SomeErrorDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simple_simple_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SomeErrorDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SomeErrorDetail) ProtoMessage() {}
+
+func (x *SomeErrorDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_simple_simple_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SomeErrorDetail.ProtoReflect.Descriptor instead.
+func (*SomeErrorDetail) Descriptor() ([]byte, []int) {
+	return file_simple_simple_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SomeErrorDetail) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type SomeQuery1Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -333,7 +428,7 @@ type SomeQuery1Response struct {
 func (x *SomeQuery1Response) Reset() {
 	*x = SomeQuery1Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_simple_simple_proto_msgTypes[6]
+		mi := &file_simple_simple_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -346,7 +441,7 @@ func (x *SomeQuery1Response) String() string {
 func (*SomeQuery1Response) ProtoMessage() {}
 
 func (x *SomeQuery1Response) ProtoReflect() protoreflect.Message {
-	mi := &file_simple_simple_proto_msgTypes[6]
+	mi := &file_simple_simple_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -359,7 +454,7 @@ func (x *SomeQuery1Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SomeQuery1Response.ProtoReflect.Descriptor instead.
 func (*SomeQuery1Response) Descriptor() ([]byte, []int) {
-	return file_simple_simple_proto_rawDescGZIP(), []int{6}
+	return file_simple_simple_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SomeQuery1Response) GetResponseVal() string {
@@ -380,7 +475,7 @@ type SomeQuery2Request struct {
 func (x *SomeQuery2Request) Reset() {
 	*x = SomeQuery2Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_simple_simple_proto_msgTypes[7]
+		mi := &file_simple_simple_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -393,7 +488,7 @@ func (x *SomeQuery2Request) String() string {
 func (*SomeQuery2Request) ProtoMessage() {}
 
 func (x *SomeQuery2Request) ProtoReflect() protoreflect.Message {
-	mi := &file_simple_simple_proto_msgTypes[7]
+	mi := &file_simple_simple_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -406,7 +501,7 @@ func (x *SomeQuery2Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SomeQuery2Request.ProtoReflect.Descriptor instead.
 func (*SomeQuery2Request) Descriptor() ([]byte, []int) {
-	return file_simple_simple_proto_rawDescGZIP(), []int{7}
+	return file_simple_simple_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SomeQuery2Request) GetRequestVal() string {
@@ -427,7 +522,7 @@ type SomeQuery2Response struct {
 func (x *SomeQuery2Response) Reset() {
 	*x = SomeQuery2Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_simple_simple_proto_msgTypes[8]
+		mi := &file_simple_simple_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -440,7 +535,7 @@ func (x *SomeQuery2Response) String() string {
 func (*SomeQuery2Response) ProtoMessage() {}
 
 func (x *SomeQuery2Response) ProtoReflect() protoreflect.Message {
-	mi := &file_simple_simple_proto_msgTypes[8]
+	mi := &file_simple_simple_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -453,7 +548,7 @@ func (x *SomeQuery2Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SomeQuery2Response.ProtoReflect.Descriptor instead.
 func (*SomeQuery2Response) Descriptor() ([]byte, []int) {
-	return file_simple_simple_proto_rawDescGZIP(), []int{8}
+	return file_simple_simple_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SomeQuery2Response) GetResponseVal() string {
@@ -474,7 +569,7 @@ type SomeSignal2Request struct {
 func (x *SomeSignal2Request) Reset() {
 	*x = SomeSignal2Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_simple_simple_proto_msgTypes[9]
+		mi := &file_simple_simple_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -487,7 +582,7 @@ func (x *SomeSignal2Request) String() string {
 func (*SomeSignal2Request) ProtoMessage() {}
 
 func (x *SomeSignal2Request) ProtoReflect() protoreflect.Message {
-	mi := &file_simple_simple_proto_msgTypes[9]
+	mi := &file_simple_simple_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -500,7 +595,7 @@ func (x *SomeSignal2Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SomeSignal2Request.ProtoReflect.Descriptor instead.
 func (*SomeSignal2Request) Descriptor() ([]byte, []int) {
-	return file_simple_simple_proto_rawDescGZIP(), []int{9}
+	return file_simple_simple_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SomeSignal2Request) GetRequestVal() string {
@@ -521,7 +616,7 @@ type SomeUpdate1Request struct {
 func (x *SomeUpdate1Request) Reset() {
 	*x = SomeUpdate1Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_simple_simple_proto_msgTypes[10]
+		mi := &file_simple_simple_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -534,7 +629,7 @@ func (x *SomeUpdate1Request) String() string {
 func (*SomeUpdate1Request) ProtoMessage() {}
 
 func (x *SomeUpdate1Request) ProtoReflect() protoreflect.Message {
-	mi := &file_simple_simple_proto_msgTypes[10]
+	mi := &file_simple_simple_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -547,7 +642,7 @@ func (x *SomeUpdate1Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SomeUpdate1Request.ProtoReflect.Descriptor instead.
 func (*SomeUpdate1Request) Descriptor() ([]byte, []int) {
-	return file_simple_simple_proto_rawDescGZIP(), []int{10}
+	return file_simple_simple_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SomeUpdate1Request) GetRequestVal() string {
@@ -568,7 +663,7 @@ type SomeUpdate1Response struct {
 func (x *SomeUpdate1Response) Reset() {
 	*x = SomeUpdate1Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_simple_simple_proto_msgTypes[11]
+		mi := &file_simple_simple_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -581,7 +676,7 @@ func (x *SomeUpdate1Response) String() string {
 func (*SomeUpdate1Response) ProtoMessage() {}
 
 func (x *SomeUpdate1Response) ProtoReflect() protoreflect.Message {
-	mi := &file_simple_simple_proto_msgTypes[11]
+	mi := &file_simple_simple_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,7 +689,7 @@ func (x *SomeUpdate1Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SomeUpdate1Response.ProtoReflect.Descriptor instead.
 func (*SomeUpdate1Response) Descriptor() ([]byte, []int) {
-	return file_simple_simple_proto_rawDescGZIP(), []int{11}
+	return file_simple_simple_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SomeUpdate1Response) GetResponseVal() string {
@@ -615,7 +710,7 @@ type OtherWorkflowRequest struct {
 func (x *OtherWorkflowRequest) Reset() {
 	*x = OtherWorkflowRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_simple_simple_proto_msgTypes[12]
+		mi := &file_simple_simple_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -628,7 +723,7 @@ func (x *OtherWorkflowRequest) String() string {
 func (*OtherWorkflowRequest) ProtoMessage() {}
 
 func (x *OtherWorkflowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_simple_simple_proto_msgTypes[12]
+	mi := &file_simple_simple_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -641,7 +736,7 @@ func (x *OtherWorkflowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OtherWorkflowRequest.ProtoReflect.Descriptor instead.
 func (*OtherWorkflowRequest) Descriptor() ([]byte, []int) {
-	return file_simple_simple_proto_rawDescGZIP(), []int{12}
+	return file_simple_simple_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *OtherWorkflowRequest) GetSomeVal() string {
@@ -660,7 +755,7 @@ type OtherWorkflowResponse struct {
 func (x *OtherWorkflowResponse) Reset() {
 	*x = OtherWorkflowResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_simple_simple_proto_msgTypes[13]
+		mi := &file_simple_simple_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -673,7 +768,7 @@ func (x *OtherWorkflowResponse) String() string {
 func (*OtherWorkflowResponse) ProtoMessage() {}
 
 func (x *OtherWorkflowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_simple_simple_proto_msgTypes[13]
+	mi := &file_simple_simple_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -686,7 +781,7 @@ func (x *OtherWorkflowResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OtherWorkflowResponse.ProtoReflect.Descriptor instead.
 func (*OtherWorkflowResponse) Descriptor() ([]byte, []int) {
-	return file_simple_simple_proto_rawDescGZIP(), []int{13}
+	return file_simple_simple_proto_rawDescGZIP(), []int{15}
 }
 
 type OtherQueryResponse struct {
@@ -700,7 +795,7 @@ type OtherQueryResponse struct {
 func (x *OtherQueryResponse) Reset() {
 	*x = OtherQueryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_simple_simple_proto_msgTypes[14]
+		mi := &file_simple_simple_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -713,7 +808,7 @@ func (x *OtherQueryResponse) String() string {
 func (*OtherQueryResponse) ProtoMessage() {}
 
 func (x *OtherQueryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_simple_simple_proto_msgTypes[14]
+	mi := &file_simple_simple_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -726,7 +821,7 @@ func (x *OtherQueryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OtherQueryResponse.ProtoReflect.Descriptor instead.
 func (*OtherQueryResponse) Descriptor() ([]byte, []int) {
-	return file_simple_simple_proto_rawDescGZIP(), []int{14}
+	return file_simple_simple_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *OtherQueryResponse) GetFilter() string {
@@ -747,7 +842,7 @@ type OtherSignalRequest struct {
 func (x *OtherSignalRequest) Reset() {
 	*x = OtherSignalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_simple_simple_proto_msgTypes[15]
+		mi := &file_simple_simple_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -760,7 +855,7 @@ func (x *OtherSignalRequest) String() string {
 func (*OtherSignalRequest) ProtoMessage() {}
 
 func (x *OtherSignalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_simple_simple_proto_msgTypes[15]
+	mi := &file_simple_simple_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -773,7 +868,7 @@ func (x *OtherSignalRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OtherSignalRequest.ProtoReflect.Descriptor instead.
 func (*OtherSignalRequest) Descriptor() ([]byte, []int) {
-	return file_simple_simple_proto_rawDescGZIP(), []int{15}
+	return file_simple_simple_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *OtherSignalRequest) GetType() string {
@@ -794,7 +889,7 @@ type OtherUpdateRequest struct {
 func (x *OtherUpdateRequest) Reset() {
 	*x = OtherUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_simple_simple_proto_msgTypes[16]
+		mi := &file_simple_simple_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -807,7 +902,7 @@ func (x *OtherUpdateRequest) String() string {
 func (*OtherUpdateRequest) ProtoMessage() {}
 
 func (x *OtherUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_simple_simple_proto_msgTypes[16]
+	mi := &file_simple_simple_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -820,7 +915,7 @@ func (x *OtherUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OtherUpdateRequest.ProtoReflect.Descriptor instead.
 func (*OtherUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_simple_simple_proto_rawDescGZIP(), []int{16}
+	return file_simple_simple_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *OtherUpdateRequest) GetMode() string {
@@ -839,7 +934,7 @@ type OtherUpdateResponse struct {
 func (x *OtherUpdateResponse) Reset() {
 	*x = OtherUpdateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_simple_simple_proto_msgTypes[17]
+		mi := &file_simple_simple_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -852,7 +947,7 @@ func (x *OtherUpdateResponse) String() string {
 func (*OtherUpdateResponse) ProtoMessage() {}
 
 func (x *OtherUpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_simple_simple_proto_msgTypes[17]
+	mi := &file_simple_simple_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -865,7 +960,7 @@ func (x *OtherUpdateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OtherUpdateResponse.ProtoReflect.Descriptor instead.
 func (*OtherUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_simple_simple_proto_rawDescGZIP(), []int{17}
+	return file_simple_simple_proto_rawDescGZIP(), []int{19}
 }
 
 var File_simple_simple_proto protoreflect.FileDescriptor
@@ -875,197 +970,252 @@ var file_simple_simple_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
 	0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2f, 0x76,
-	0x31, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x47, 0x0a, 0x14, 0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
-	0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3a, 0x0a, 0x15, 0x53, 0x6f, 0x6d,
-	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x76,
-	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x56, 0x61, 0x6c, 0x22, 0x47, 0x0a, 0x14, 0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f, 0x72,
-	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x33, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a,
-	0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x22, 0x37,
-	0x0a, 0x14, 0x53, 0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x32, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x22, 0x37, 0x0a, 0x14, 0x53, 0x6f, 0x6d, 0x65, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x33, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c,
-	0x22, 0x3a, 0x0a, 0x15, 0x53, 0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
-	0x33, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1a, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x65,
+	0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x47, 0x0a, 0x14,
+	0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x31, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x56, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3a, 0x0a, 0x15, 0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x61,
+	0x6c, 0x22, 0x47, 0x0a, 0x14, 0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x33, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x22, 0x37, 0x0a, 0x14, 0x53, 0x6f,
+	0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x56, 0x61, 0x6c, 0x22, 0x2f, 0x0a, 0x15, 0x53, 0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x32, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x22, 0x37, 0x0a, 0x14, 0x53, 0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x33, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x22, 0x3a, 0x0a,
+	0x15, 0x53, 0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x33, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x22, 0x29, 0x0a, 0x0f, 0x53, 0x6f, 0x6d,
+	0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x12, 0x53, 0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x22, 0x34, 0x0a,
+	0x11, 0x53, 0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x56, 0x61, 0x6c, 0x22, 0x37, 0x0a, 0x12, 0x53, 0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x22, 0x37, 0x0a, 0x12,
-	0x53, 0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x76,
-	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x56, 0x61, 0x6c, 0x22, 0x34, 0x0a, 0x11, 0x53, 0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x22, 0x37, 0x0a, 0x12, 0x53,
-	0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x0b, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x22, 0x35, 0x0a, 0x12,
+	0x53, 0x6f, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x56, 0x61, 0x6c, 0x22, 0x35, 0x0a, 0x12, 0x53, 0x6f, 0x6d, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x22, 0x38, 0x0a, 0x13, 0x53, 0x6f,
+	0x6d, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x76, 0x61,
 	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x56, 0x61, 0x6c, 0x22, 0x35, 0x0a, 0x12, 0x53, 0x6f, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e,
-	0x61, 0x6c, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x22, 0x35, 0x0a, 0x12, 0x53,
-	0x6f, 0x6d, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
-	0x61, 0x6c, 0x22, 0x38, 0x0a, 0x13, 0x53, 0x6f, 0x6d, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x22, 0x31, 0x0a, 0x14,
-	0x4f, 0x74, 0x68, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x6f, 0x6d, 0x65, 0x5f, 0x76, 0x61, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6f, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x22,
-	0x17, 0x0a, 0x15, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x12, 0x4f, 0x74, 0x68, 0x65,
-	0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x28, 0x0a, 0x12, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x22, 0x28, 0x0a, 0x12, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x4f, 0x74,
-	0x68, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x32, 0xf8, 0x0a, 0x0a, 0x06, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0xec, 0x01, 0x0a,
-	0x0d, 0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x31, 0x12, 0x26,
+	0x65, 0x56, 0x61, 0x6c, 0x22, 0x31, 0x0a, 0x14, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x57, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x73, 0x6f, 0x6d, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x6f, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x22, 0x17, 0x0a, 0x15, 0x4f, 0x74, 0x68, 0x65, 0x72,
+	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2c, 0x0a, 0x12, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x28,
+	0x0a, 0x12, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x4f, 0x74, 0x68, 0x65,
+	0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe4, 0x10, 0x0a, 0x06, 0x53, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x12, 0xd8, 0x02, 0x0a, 0x0d, 0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x31, 0x12, 0x26, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
 	0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c,
 	0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x31, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61,
-	0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f,
-	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x89, 0x01, 0x8a, 0xc4, 0x03, 0x84, 0x01, 0x0a, 0x0c, 0x0a, 0x0a, 0x53, 0x6f, 0x6d, 0x65, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x31, 0x0a, 0x0c, 0x0a, 0x0a, 0x53, 0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x32, 0x12, 0x0d, 0x0a, 0x0b, 0x53, 0x6f, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x6c, 0x31, 0x12, 0x0d, 0x0a, 0x0b, 0x53, 0x6f, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
-	0x32, 0x2a, 0x28, 0x73, 0x6f, 0x6d, 0x65, 0x2d, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
-	0x2d, 0x31, 0x2f, 0x24, 0x7b, 0x21, 0x20, 0x69, 0x64, 0x20, 0x7d, 0x2f, 0x24, 0x7b, 0x21, 0x20,
-	0x75, 0x75, 0x69, 0x64, 0x5f, 0x76, 0x34, 0x28, 0x29, 0x20, 0x7d, 0x72, 0x1e, 0x6d, 0x79, 0x63,
-	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f,
-	0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x31, 0x12, 0x85, 0x01, 0x0a, 0x0d,
-	0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x32, 0x12, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x44, 0x8a,
-	0xc4, 0x03, 0x40, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x6f, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x6c, 0x31, 0x10, 0x01, 0x1a, 0x0d, 0x0a, 0x0b, 0x53, 0x6f, 0x6d, 0x65, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x31, 0x72, 0x1e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf5, 0x01, 0x8a, 0xc4, 0x03, 0xf0, 0x01, 0x0a,
+	0x0c, 0x0a, 0x0a, 0x53, 0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x31, 0x0a, 0x0c, 0x0a,
+	0x0a, 0x53, 0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x32, 0x12, 0x0d, 0x0a, 0x0b, 0x53,
+	0x6f, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x31, 0x12, 0x0d, 0x0a, 0x0b, 0x53, 0x6f,
+	0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x32, 0x2a, 0x28, 0x73, 0x6f, 0x6d, 0x65, 0x2d,
+	0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2d, 0x31, 0x2f, 0x24, 0x7b, 0x21, 0x20, 0x69,
+	0x64, 0x20, 0x7d, 0x2f, 0x24, 0x7b, 0x21, 0x20, 0x75, 0x75, 0x69, 0x64, 0x5f, 0x76, 0x34, 0x28,
+	0x29, 0x20, 0x7d, 0x72, 0x1e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73,
 	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
-	0x6f, 0x77, 0x32, 0x12, 0xad, 0x01, 0x0a, 0x0d, 0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b,
-	0x66, 0x6c, 0x6f, 0x77, 0x33, 0x12, 0x26, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
-	0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f, 0x72,
-	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x33, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x5c, 0x8a, 0xc4, 0x03, 0x58, 0x12, 0x0f, 0x0a, 0x0b, 0x53,
-	0x6f, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x32, 0x10, 0x01, 0x22, 0x03, 0x08, 0x90,
-	0x1c, 0x2a, 0x29, 0x73, 0x6f, 0x6d, 0x65, 0x2d, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
-	0x2d, 0x33, 0x2f, 0x24, 0x7b, 0x21, 0x20, 0x69, 0x64, 0x20, 0x7d, 0x2f, 0x24, 0x7b, 0x21, 0x20,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x20, 0x7d, 0x30, 0x01, 0x4a, 0x02,
-	0x20, 0x02, 0x5a, 0x0f, 0x6d, 0x79, 0x2d, 0x74, 0x61, 0x73, 0x6b, 0x2d, 0x71, 0x75, 0x65, 0x75,
-	0x65, 0x2d, 0x32, 0x12, 0x65, 0x0a, 0x0d, 0x53, 0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x69, 0x74, 0x79, 0x31, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x77, 0x31, 0x92, 0x01, 0x23, 0x72, 0x6f, 0x6f, 0x74, 0x20, 0x3d, 0x20, 0x7b, 0x20, 0x22,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x22, 0x3a, 0x20, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x20, 0x7d, 0x9a, 0x01, 0x26, 0x0a, 0x13, 0x53, 0x6f,
+	0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x31, 0x46, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x12, 0x0f, 0x53, 0x6f, 0x6d, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0xa2, 0x01, 0x1a, 0x72, 0x6f, 0x6f, 0x74, 0x20, 0x3d, 0x20, 0x7b, 0x20, 0x22, 0x73,
+	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x69, 0x64, 0x22, 0x3a, 0x20, 0x69, 0x64, 0x20, 0x7d, 0x12,
+	0x85, 0x01, 0x0a, 0x0d, 0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x32, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x44, 0x8a, 0xc4, 0x03, 0x40, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x6f, 0x6d, 0x65, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x31, 0x10, 0x01, 0x1a, 0x0d, 0x0a, 0x0b, 0x53, 0x6f, 0x6d, 0x65,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x31, 0x72, 0x1e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x32, 0x12, 0xc8, 0x01, 0x0a, 0x0d, 0x53, 0x6f, 0x6d, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x33, 0x12, 0x26, 0x2e, 0x6d, 0x79, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d,
+	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x33, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x77, 0x8a, 0xc4, 0x03, 0x73, 0x12,
+	0x0f, 0x0a, 0x0b, 0x53, 0x6f, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x32, 0x10, 0x01,
+	0x22, 0x03, 0x08, 0x90, 0x1c, 0x2a, 0x29, 0x73, 0x6f, 0x6d, 0x65, 0x2d, 0x77, 0x6f, 0x72, 0x6b,
+	0x66, 0x6c, 0x6f, 0x77, 0x2d, 0x33, 0x2f, 0x24, 0x7b, 0x21, 0x20, 0x69, 0x64, 0x20, 0x7d, 0x2f,
+	0x24, 0x7b, 0x21, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x20, 0x7d,
+	0x30, 0x01, 0x4a, 0x02, 0x20, 0x02, 0x5a, 0x0f, 0x6d, 0x79, 0x2d, 0x74, 0x61, 0x73, 0x6b, 0x2d,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x2d, 0x32, 0x82, 0x01, 0x09, 0x30, 0x20, 0x33, 0x20, 0x2a, 0x20,
+	0x2a, 0x20, 0x2a, 0x8a, 0x01, 0x0c, 0x12, 0x03, 0x08, 0x90, 0x1c, 0x28, 0x02, 0x32, 0x03, 0x08,
+	0xac, 0x02, 0x12, 0xed, 0x01, 0x0a, 0x0d, 0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x34, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x24, 0x92, 0xc4, 0x03, 0x20, 0x3a, 0x1e, 0x6d, 0x79, 0x63, 0x6f,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0xab, 0x01, 0x8a, 0xc4, 0x03, 0xa6, 0x01, 0x0a, 0x29, 0x0a, 0x27,
+	0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x47,
+	0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2b, 0x0a, 0x27, 0x6d, 0x79, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x10, 0x01, 0x1a, 0x2c, 0x0a, 0x2a, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x72, 0x1e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x34, 0x12, 0x67, 0x0a, 0x0d, 0x53, 0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x31, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x26, 0x92, 0xc4, 0x03, 0x22, 0x3a, 0x1e, 0x6d, 0x79, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x31, 0x60, 0x01, 0x12, 0xad, 0x01, 0x0a, 0x0d,
+	0x53, 0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x32, 0x12, 0x26, 0x2e,
+	0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x32, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x5c, 0x92,
+	0xc4, 0x03, 0x58, 0x22, 0x02, 0x08, 0x0a, 0x32, 0x04, 0x1a, 0x02, 0x08, 0x1e, 0x42, 0x15, 0x53,
+	0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x32, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x48, 0x01, 0x5a, 0x20, 0x73, 0x6f, 0x6d, 0x65, 0x2d, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x2d, 0x32, 0x2f, 0x24, 0x7b, 0x21, 0x20, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x20, 0x7d, 0x60, 0x01, 0x6a, 0x0f, 0x0a, 0x0d, 0x53, 0x6f,
+	0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x31, 0x12, 0x85, 0x02, 0x0a, 0x0d,
+	0x53, 0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x33, 0x12, 0x26, 0x2e,
+	0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x33, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x33, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa2,
+	0x01, 0x92, 0xc4, 0x03, 0x9d, 0x01, 0x22, 0x02, 0x08, 0x0a, 0x32, 0x02, 0x20, 0x05, 0x50, 0x01,
+	0x6a, 0x3a, 0x0a, 0x0d, 0x53, 0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x32, 0x12, 0x29, 0x72, 0x6f, 0x6f, 0x74, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
+	0x61, 0x6c, 0x20, 0x3d, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x2e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x72, 0x28, 0x0a, 0x13,
+	0x53, 0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x33, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x12, 0x0f, 0x53, 0x6f, 0x6d, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x7a, 0x2b, 0x72, 0x6f, 0x6f, 0x74, 0x20, 0x3d, 0x20, 0x7b,
+	0x20, 0x22, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x76, 0x61, 0x6c, 0x22, 0x3a, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x61,
+	0x6c, 0x20, 0x7d, 0x12, 0x50, 0x0a, 0x0a, 0x53, 0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x31, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x24, 0x2e, 0x6d, 0x79, 0x63, 0x6f,
 	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d,
-	0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x31, 0x12, 0x5f, 0x0a, 0x0d, 0x53, 0x6f,
-	0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x32, 0x12, 0x26, 0x2e, 0x6d, 0x79,
-	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53,
-	0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x32, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x0e, 0x92, 0xc4, 0x03,
-	0x0a, 0x22, 0x02, 0x08, 0x0a, 0x32, 0x04, 0x1a, 0x02, 0x08, 0x1e, 0x12, 0x6e, 0x0a, 0x0d, 0x53,
-	0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x33, 0x12, 0x26, 0x2e, 0x6d,
-	0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
-	0x53, 0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x33, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
-	0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x69, 0x74, 0x79, 0x33, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x92,
-	0xc4, 0x03, 0x08, 0x22, 0x02, 0x08, 0x0a, 0x32, 0x02, 0x20, 0x05, 0x12, 0x50, 0x0a, 0x0a, 0x53,
-	0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x31, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x04, 0x9a, 0xc4, 0x03, 0x00, 0x12, 0x8a, 0x01, 0x0a, 0x0a, 0x53, 0x6f, 0x6d, 0x65, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x32, 0x12, 0x23, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x79, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d,
+	0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x31, 0x9a, 0xc4, 0x03, 0x2d, 0x12, 0x2b, 0x72, 0x6f, 0x6f, 0x74, 0x20, 0x3d, 0x20, 0x7b, 0x20,
+	0x22, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x76, 0x61, 0x6c, 0x22, 0x3a, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c,
+	0x20, 0x7d, 0x12, 0x43, 0x0a, 0x0b, 0x53, 0x6f, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x31, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x24, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69,
-	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x31, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04, 0x9a, 0xc4, 0x03, 0x00, 0x12, 0x5d, 0x0a,
-	0x0a, 0x53, 0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x32, 0x12, 0x23, 0x2e, 0x6d, 0x79,
-	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53,
-	0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x24, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d,
-	0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x32, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04, 0x9a, 0xc4, 0x03, 0x00, 0x12, 0x43, 0x0a, 0x0b,
-	0x53, 0x6f, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x31, 0x12, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x04, 0xa2, 0xc4, 0x03,
-	0x00, 0x12, 0x51, 0x0a, 0x0b, 0x53, 0x6f, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x32,
-	0x12, 0x24, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d,
-	0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x32, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x04,
-	0xa2, 0xc4, 0x03, 0x00, 0x12, 0xa6, 0x01, 0x0a, 0x0b, 0x53, 0x6f, 0x6d, 0x65, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x31, 0x12, 0x24, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
-	0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x79, 0x63,
-	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f,
-	0x6d, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x4a, 0xaa, 0xc4, 0x03, 0x46, 0x0a, 0x40, 0x73, 0x6f, 0x6d, 0x65, 0x2d, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x2f, 0x24, 0x7b, 0x21, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x56, 0x61, 0x6c, 0x2e, 0x6e, 0x6f, 0x74, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x29, 0x2e,
-	0x63, 0x61, 0x74, 0x63, 0x68, 0x28, 0x22, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x29,
-	0x2e, 0x73, 0x6c, 0x75, 0x67, 0x28, 0x29, 0x20, 0x7d, 0x10, 0x01, 0x18, 0x03, 0x1a, 0x1d, 0x8a,
-	0xc4, 0x03, 0x19, 0x0a, 0x0d, 0x6d, 0x79, 0x2d, 0x74, 0x61, 0x73, 0x6b, 0x2d, 0x71, 0x75, 0x65,
-	0x75, 0x65, 0x1a, 0x08, 0x0a, 0x02, 0x08, 0x01, 0x12, 0x02, 0x08, 0x01, 0x32, 0xdb, 0x03, 0x0a,
-	0x05, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x12, 0x8c, 0x01, 0x0a, 0x0d, 0x4f, 0x74, 0x68, 0x65, 0x72,
-	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x26, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d,
-	0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4f, 0x74, 0x68, 0x65,
-	0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x27, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d,
-	0x70, 0x6c, 0x65, 0x2e, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
-	0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x8a, 0xc4, 0x03, 0x1e, 0x2a,
-	0x1c, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x2d, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2f,
-	0x24, 0x7b, 0x21, 0x75, 0x75, 0x69, 0x64, 0x5f, 0x76, 0x34, 0x28, 0x29, 0x7d, 0x92, 0xc4, 0x03,
-	0x04, 0x22, 0x02, 0x08, 0x1e, 0x12, 0x50, 0x0a, 0x0a, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x24, 0x2e, 0x6d, 0x79,
-	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4f,
-	0x74, 0x68, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x04, 0x9a, 0xc4, 0x03, 0x00, 0x12, 0x51, 0x0a, 0x0b, 0x4f, 0x74, 0x68, 0x65, 0x72,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x24, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61,
-	0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x79, 0x22, 0x04, 0xa2, 0xc4, 0x03, 0x00, 0x12, 0x7e, 0x0a, 0x0b, 0x53, 0x6f, 0x6d, 0x65, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x32, 0x12, 0x24, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x04, 0xa2, 0xc4, 0x03, 0x00, 0x12, 0x7c, 0x0a, 0x0b, 0x4f, 0x74,
-	0x68, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x6d, 0x79, 0x63, 0x6f,
-	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4f, 0x74, 0x68,
-	0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x25, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70,
-	0x6c, 0x65, 0x2e, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0xaa, 0xc4, 0x03, 0x1c, 0x0a, 0x1a, 0x6f, 0x74,
-	0x68, 0x65, 0x72, 0x2d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x24, 0x7b, 0x21, 0x75, 0x75,
-	0x69, 0x64, 0x5f, 0x76, 0x34, 0x28, 0x29, 0x7d, 0x1a, 0x20, 0x8a, 0xc4, 0x03, 0x1c, 0x0a, 0x10,
-	0x6f, 0x74, 0x68, 0x65, 0x72, 0x2d, 0x74, 0x61, 0x73, 0x6b, 0x2d, 0x71, 0x75, 0x65, 0x75, 0x65,
-	0x1a, 0x08, 0x0a, 0x02, 0x08, 0x01, 0x12, 0x02, 0x08, 0x01, 0x42, 0xba, 0x01, 0x0a, 0x14, 0x63,
-	0x6f, 0x6d, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d,
-	0x70, 0x6c, 0x65, 0x42, 0x0b, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
-	0x6c, 0x75, 0x64, 0x64, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65,
-	0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2f, 0x67, 0x65,
-	0x6e, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0xa2, 0x02, 0x03, 0x4d, 0x53, 0x58, 0xaa, 0x02,
-	0x10, 0x4d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c,
-	0x65, 0xca, 0x02, 0x10, 0x4d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5c, 0x53, 0x69,
-	0x6d, 0x70, 0x6c, 0x65, 0xe2, 0x02, 0x1c, 0x4d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
-	0x5c, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x4d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x3a,
-	0x3a, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x31, 0xa2, 0xc4, 0x03, 0x2d, 0x12, 0x2b, 0x72, 0x6f, 0x6f, 0x74,
+	0x20, 0x3d, 0x20, 0x7b, 0x20, 0x22, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x22, 0x3a, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x56, 0x61, 0x6c, 0x20, 0x7d, 0x12, 0xd3, 0x01, 0x0a, 0x0b, 0x53, 0x6f, 0x6d, 0x65,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x31, 0x12, 0x24, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x31, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x77, 0xaa, 0xc4, 0x03, 0x73, 0x0a, 0x40, 0x73, 0x6f, 0x6d, 0x65,
+	0x2d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x24, 0x7b, 0x21, 0x20, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x2e, 0x6e, 0x6f, 0x74, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x28, 0x29, 0x2e, 0x63, 0x61, 0x74, 0x63, 0x68, 0x28, 0x22, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x22, 0x29, 0x2e, 0x73, 0x6c, 0x75, 0x67, 0x28, 0x29, 0x20, 0x7d, 0x10, 0x01, 0x18, 0x03,
+	0x2a, 0x2b, 0x72, 0x6f, 0x6f, 0x74, 0x20, 0x3d, 0x20, 0x7b, 0x20, 0x22, 0x73, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x22, 0x3a,
+	0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x20, 0x7d, 0x1a, 0x21, 0x8a,
+	0xc4, 0x03, 0x1d, 0x0a, 0x0d, 0x6d, 0x79, 0x2d, 0x74, 0x61, 0x73, 0x6b, 0x2d, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x1a, 0x0c, 0x0a, 0x02, 0x08, 0x01, 0x12, 0x02, 0x08, 0x01, 0x1a, 0x02, 0x08, 0x01,
+	0x32, 0xdb, 0x03, 0x0a, 0x05, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x12, 0x8c, 0x01, 0x0a, 0x0d, 0x4f,
+	0x74, 0x68, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x26, 0x2e, 0x6d,
+	0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x4f, 0x74, 0x68, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x57, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x8a,
+	0xc4, 0x03, 0x1e, 0x2a, 0x1c, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x2d, 0x77, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x2f, 0x24, 0x7b, 0x21, 0x75, 0x75, 0x69, 0x64, 0x5f, 0x76, 0x34, 0x28, 0x29,
+	0x7d, 0x92, 0xc4, 0x03, 0x04, 0x22, 0x02, 0x08, 0x1e, 0x12, 0x50, 0x0a, 0x0a, 0x4f, 0x74, 0x68,
+	0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x24, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04, 0x9a, 0xc4, 0x03, 0x00, 0x12, 0x51, 0x0a, 0x0b, 0x4f,
+	0x74, 0x68, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x24, 0x2e, 0x6d, 0x79, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4f, 0x74,
+	0x68, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x04, 0xa2, 0xc4, 0x03, 0x00, 0x12, 0x7c,
+	0x0a, 0x0b, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e,
+	0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e,
+	0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0xaa, 0xc4, 0x03, 0x1c,
+	0x0a, 0x1a, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x2d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x24,
+	0x7b, 0x21, 0x75, 0x75, 0x69, 0x64, 0x5f, 0x76, 0x34, 0x28, 0x29, 0x7d, 0x1a, 0x20, 0x8a, 0xc4,
+	0x03, 0x1c, 0x0a, 0x10, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x2d, 0x74, 0x61, 0x73, 0x6b, 0x2d, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x1a, 0x08, 0x0a, 0x02, 0x08, 0x01, 0x12, 0x02, 0x08, 0x01, 0x42, 0xba,
+	0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x42, 0x0b, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x75, 0x64, 0x64, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0xa2, 0x02, 0x03, 0x4d,
+	0x53, 0x58, 0xaa, 0x02, 0x10, 0x4d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x53,
+	0x69, 0x6d, 0x70, 0x6c, 0x65, 0xca, 0x02, 0x10, 0x4d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x5c, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0xe2, 0x02, 0x1c, 0x4d, 0x79, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x5c, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x4d, 0x79, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x3a, 0x3a, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1080,61 +1230,65 @@ func file_simple_simple_proto_rawDescGZIP() []byte {
 	return file_simple_simple_proto_rawDescData
 }
 
-var file_simple_simple_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_simple_simple_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_simple_simple_proto_goTypes = []interface{}{
 	(*SomeWorkflow1Request)(nil),  // 0: mycompany.simple.SomeWorkflow1Request
 	(*SomeWorkflow1Response)(nil), // 1: mycompany.simple.SomeWorkflow1Response
 	(*SomeWorkflow3Request)(nil),  // 2: mycompany.simple.SomeWorkflow3Request
 	(*SomeActivity2Request)(nil),  // 3: mycompany.simple.SomeActivity2Request
-	(*SomeActivity3Request)(nil),  // 4: mycompany.simple.SomeActivity3Request
-	(*SomeActivity3Response)(nil), // 5: mycompany.simple.SomeActivity3Response
-	(*SomeQuery1Response)(nil),    // 6: mycompany.simple.SomeQuery1Response
-	(*SomeQuery2Request)(nil),     // 7: mycompany.simple.SomeQuery2Request
-	(*SomeQuery2Response)(nil),    // 8: mycompany.simple.SomeQuery2Response
-	(*SomeSignal2Request)(nil),    // 9: mycompany.simple.SomeSignal2Request
-	(*SomeUpdate1Request)(nil),    // 10: mycompany.simple.SomeUpdate1Request
-	(*SomeUpdate1Response)(nil),   // 11: mycompany.simple.SomeUpdate1Response
-	(*OtherWorkflowRequest)(nil),  // 12: mycompany.simple.OtherWorkflowRequest
-	(*OtherWorkflowResponse)(nil), // 13: mycompany.simple.OtherWorkflowResponse
-	(*OtherQueryResponse)(nil),    // 14: mycompany.simple.OtherQueryResponse
-	(*OtherSignalRequest)(nil),    // 15: mycompany.simple.OtherSignalRequest
-	(*OtherUpdateRequest)(nil),    // 16: mycompany.simple.OtherUpdateRequest
-	(*OtherUpdateResponse)(nil),   // 17: mycompany.simple.OtherUpdateResponse
-	(*emptypb.Empty)(nil),         // 18: google.protobuf.Empty
+	(*SomeActivity2Progress)(nil), // 4: mycompany.simple.SomeActivity2Progress
+	(*SomeActivity3Request)(nil),  // 5: mycompany.simple.SomeActivity3Request
+	(*SomeActivity3Response)(nil), // 6: mycompany.simple.SomeActivity3Response
+	(*SomeErrorDetail)(nil),       // 7: mycompany.simple.SomeErrorDetail
+	(*SomeQuery1Response)(nil),    // 8: mycompany.simple.SomeQuery1Response
+	(*SomeQuery2Request)(nil),     // 9: mycompany.simple.SomeQuery2Request
+	(*SomeQuery2Response)(nil),    // 10: mycompany.simple.SomeQuery2Response
+	(*SomeSignal2Request)(nil),    // 11: mycompany.simple.SomeSignal2Request
+	(*SomeUpdate1Request)(nil),    // 12: mycompany.simple.SomeUpdate1Request
+	(*SomeUpdate1Response)(nil),   // 13: mycompany.simple.SomeUpdate1Response
+	(*OtherWorkflowRequest)(nil),  // 14: mycompany.simple.OtherWorkflowRequest
+	(*OtherWorkflowResponse)(nil), // 15: mycompany.simple.OtherWorkflowResponse
+	(*OtherQueryResponse)(nil),    // 16: mycompany.simple.OtherQueryResponse
+	(*OtherSignalRequest)(nil),    // 17: mycompany.simple.OtherSignalRequest
+	(*OtherUpdateRequest)(nil),    // 18: mycompany.simple.OtherUpdateRequest
+	(*OtherUpdateResponse)(nil),   // 19: mycompany.simple.OtherUpdateResponse
+	(*emptypb.Empty)(nil),         // 20: google.protobuf.Empty
 }
 var file_simple_simple_proto_depIdxs = []int32{
 	0,  // 0: mycompany.simple.Simple.SomeWorkflow1:input_type -> mycompany.simple.SomeWorkflow1Request
-	18, // 1: mycompany.simple.Simple.SomeWorkflow2:input_type -> google.protobuf.Empty
+	20, // 1: mycompany.simple.Simple.SomeWorkflow2:input_type -> google.protobuf.Empty
 	2,  // 2: mycompany.simple.Simple.SomeWorkflow3:input_type -> mycompany.simple.SomeWorkflow3Request
-	18, // 3: mycompany.simple.Simple.SomeActivity1:input_type -> google.protobuf.Empty
-	3,  // 4: mycompany.simple.Simple.SomeActivity2:input_type -> mycompany.simple.SomeActivity2Request
-	4,  // 5: mycompany.simple.Simple.SomeActivity3:input_type -> mycompany.simple.SomeActivity3Request
-	18, // 6: mycompany.simple.Simple.SomeQuery1:input_type -> google.protobuf.Empty
-	7,  // 7: mycompany.simple.Simple.SomeQuery2:input_type -> mycompany.simple.SomeQuery2Request
-	18, // 8: mycompany.simple.Simple.SomeSignal1:input_type -> google.protobuf.Empty
-	9,  // 9: mycompany.simple.Simple.SomeSignal2:input_type -> mycompany.simple.SomeSignal2Request
-	10, // 10: mycompany.simple.Simple.SomeUpdate1:input_type -> mycompany.simple.SomeUpdate1Request
-	12, // 11: mycompany.simple.Other.OtherWorkflow:input_type -> mycompany.simple.OtherWorkflowRequest
-	18, // 12: mycompany.simple.Other.OtherQuery:input_type -> google.protobuf.Empty
-	15, // 13: mycompany.simple.Other.OtherSignal:input_type -> mycompany.simple.OtherSignalRequest
-	16, // 14: mycompany.simple.Other.OtherUpdate:input_type -> mycompany.simple.OtherUpdateRequest
-	1,  // 15: mycompany.simple.Simple.SomeWorkflow1:output_type -> mycompany.simple.SomeWorkflow1Response
-	18, // 16: mycompany.simple.Simple.SomeWorkflow2:output_type -> google.protobuf.Empty
-	18, // 17: mycompany.simple.Simple.SomeWorkflow3:output_type -> google.protobuf.Empty
-	18, // 18: mycompany.simple.Simple.SomeActivity1:output_type -> google.protobuf.Empty
-	18, // 19: mycompany.simple.Simple.SomeActivity2:output_type -> google.protobuf.Empty
-	5,  // 20: mycompany.simple.Simple.SomeActivity3:output_type -> mycompany.simple.SomeActivity3Response
-	6,  // 21: mycompany.simple.Simple.SomeQuery1:output_type -> mycompany.simple.SomeQuery1Response
-	8,  // 22: mycompany.simple.Simple.SomeQuery2:output_type -> mycompany.simple.SomeQuery2Response
-	18, // 23: mycompany.simple.Simple.SomeSignal1:output_type -> google.protobuf.Empty
-	18, // 24: mycompany.simple.Simple.SomeSignal2:output_type -> google.protobuf.Empty
-	11, // 25: mycompany.simple.Simple.SomeUpdate1:output_type -> mycompany.simple.SomeUpdate1Response
-	13, // 26: mycompany.simple.Other.OtherWorkflow:output_type -> mycompany.simple.OtherWorkflowResponse
-	14, // 27: mycompany.simple.Other.OtherQuery:output_type -> mycompany.simple.OtherQueryResponse
-	18, // 28: mycompany.simple.Other.OtherSignal:output_type -> google.protobuf.Empty
-	17, // 29: mycompany.simple.Other.OtherUpdate:output_type -> mycompany.simple.OtherUpdateResponse
-	15, // [15:30] is the sub-list for method output_type
-	0,  // [0:15] is the sub-list for method input_type
+	20, // 3: mycompany.simple.Simple.SomeWorkflow4:input_type -> google.protobuf.Empty
+	20, // 4: mycompany.simple.Simple.SomeActivity1:input_type -> google.protobuf.Empty
+	3,  // 5: mycompany.simple.Simple.SomeActivity2:input_type -> mycompany.simple.SomeActivity2Request
+	5,  // 6: mycompany.simple.Simple.SomeActivity3:input_type -> mycompany.simple.SomeActivity3Request
+	20, // 7: mycompany.simple.Simple.SomeQuery1:input_type -> google.protobuf.Empty
+	9,  // 8: mycompany.simple.Simple.SomeQuery2:input_type -> mycompany.simple.SomeQuery2Request
+	20, // 9: mycompany.simple.Simple.SomeSignal1:input_type -> google.protobuf.Empty
+	11, // 10: mycompany.simple.Simple.SomeSignal2:input_type -> mycompany.simple.SomeSignal2Request
+	12, // 11: mycompany.simple.Simple.SomeUpdate1:input_type -> mycompany.simple.SomeUpdate1Request
+	14, // 12: mycompany.simple.Other.OtherWorkflow:input_type -> mycompany.simple.OtherWorkflowRequest
+	20, // 13: mycompany.simple.Other.OtherQuery:input_type -> google.protobuf.Empty
+	17, // 14: mycompany.simple.Other.OtherSignal:input_type -> mycompany.simple.OtherSignalRequest
+	18, // 15: mycompany.simple.Other.OtherUpdate:input_type -> mycompany.simple.OtherUpdateRequest
+	1,  // 16: mycompany.simple.Simple.SomeWorkflow1:output_type -> mycompany.simple.SomeWorkflow1Response
+	20, // 17: mycompany.simple.Simple.SomeWorkflow2:output_type -> google.protobuf.Empty
+	20, // 18: mycompany.simple.Simple.SomeWorkflow3:output_type -> google.protobuf.Empty
+	20, // 19: mycompany.simple.Simple.SomeWorkflow4:output_type -> google.protobuf.Empty
+	20, // 20: mycompany.simple.Simple.SomeActivity1:output_type -> google.protobuf.Empty
+	20, // 21: mycompany.simple.Simple.SomeActivity2:output_type -> google.protobuf.Empty
+	6,  // 22: mycompany.simple.Simple.SomeActivity3:output_type -> mycompany.simple.SomeActivity3Response
+	8,  // 23: mycompany.simple.Simple.SomeQuery1:output_type -> mycompany.simple.SomeQuery1Response
+	10, // 24: mycompany.simple.Simple.SomeQuery2:output_type -> mycompany.simple.SomeQuery2Response
+	20, // 25: mycompany.simple.Simple.SomeSignal1:output_type -> google.protobuf.Empty
+	20, // 26: mycompany.simple.Simple.SomeSignal2:output_type -> google.protobuf.Empty
+	13, // 27: mycompany.simple.Simple.SomeUpdate1:output_type -> mycompany.simple.SomeUpdate1Response
+	15, // 28: mycompany.simple.Other.OtherWorkflow:output_type -> mycompany.simple.OtherWorkflowResponse
+	16, // 29: mycompany.simple.Other.OtherQuery:output_type -> mycompany.simple.OtherQueryResponse
+	20, // 30: mycompany.simple.Other.OtherSignal:output_type -> google.protobuf.Empty
+	19, // 31: mycompany.simple.Other.OtherUpdate:output_type -> mycompany.simple.OtherUpdateResponse
+	16, // [16:32] is the sub-list for method output_type
+	0,  // [0:16] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -1195,7 +1349,7 @@ func file_simple_simple_proto_init() {
 			}
 		}
 		file_simple_simple_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SomeActivity3Request); i {
+			switch v := v.(*SomeActivity2Progress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1207,7 +1361,7 @@ func file_simple_simple_proto_init() {
 			}
 		}
 		file_simple_simple_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SomeActivity3Response); i {
+			switch v := v.(*SomeActivity3Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1219,7 +1373,7 @@ func file_simple_simple_proto_init() {
 			}
 		}
 		file_simple_simple_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SomeQuery1Response); i {
+			switch v := v.(*SomeActivity3Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1231,7 +1385,7 @@ func file_simple_simple_proto_init() {
 			}
 		}
 		file_simple_simple_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SomeQuery2Request); i {
+			switch v := v.(*SomeErrorDetail); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1243,7 +1397,7 @@ func file_simple_simple_proto_init() {
 			}
 		}
 		file_simple_simple_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SomeQuery2Response); i {
+			switch v := v.(*SomeQuery1Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1255,7 +1409,7 @@ func file_simple_simple_proto_init() {
 			}
 		}
 		file_simple_simple_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SomeSignal2Request); i {
+			switch v := v.(*SomeQuery2Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1267,7 +1421,7 @@ func file_simple_simple_proto_init() {
 			}
 		}
 		file_simple_simple_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SomeUpdate1Request); i {
+			switch v := v.(*SomeQuery2Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1279,7 +1433,7 @@ func file_simple_simple_proto_init() {
 			}
 		}
 		file_simple_simple_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SomeUpdate1Response); i {
+			switch v := v.(*SomeSignal2Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1291,7 +1445,7 @@ func file_simple_simple_proto_init() {
 			}
 		}
 		file_simple_simple_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OtherWorkflowRequest); i {
+			switch v := v.(*SomeUpdate1Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1303,7 +1457,7 @@ func file_simple_simple_proto_init() {
 			}
 		}
 		file_simple_simple_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OtherWorkflowResponse); i {
+			switch v := v.(*SomeUpdate1Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1315,7 +1469,7 @@ func file_simple_simple_proto_init() {
 			}
 		}
 		file_simple_simple_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OtherQueryResponse); i {
+			switch v := v.(*OtherWorkflowRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1327,7 +1481,7 @@ func file_simple_simple_proto_init() {
 			}
 		}
 		file_simple_simple_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OtherSignalRequest); i {
+			switch v := v.(*OtherWorkflowResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1339,7 +1493,7 @@ func file_simple_simple_proto_init() {
 			}
 		}
 		file_simple_simple_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OtherUpdateRequest); i {
+			switch v := v.(*OtherQueryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1351,6 +1505,30 @@ func file_simple_simple_proto_init() {
 			}
 		}
 		file_simple_simple_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OtherSignalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simple_simple_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OtherUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simple_simple_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OtherUpdateResponse); i {
 			case 0:
 				return &v.state
@@ -1369,7 +1547,7 @@ func file_simple_simple_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_simple_simple_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -14,19 +14,25 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/cludden/protoc-gen-go-temporal/gen/simple/common"
 	expression "github.com/cludden/protoc-gen-go-temporal/pkg/expression"
+	heartbeat "github.com/cludden/protoc-gen-go-temporal/pkg/heartbeat"
 	testutil "github.com/cludden/protoc-gen-go-temporal/pkg/testutil"
+	tracing "github.com/cludden/protoc-gen-go-temporal/pkg/tracing"
+	mock "github.com/stretchr/testify/mock"
 	v2 "github.com/urfave/cli/v2"
-	v1 "go.temporal.io/api/enums/v1"
-	v11 "go.temporal.io/api/update/v1"
+	v11 "go.temporal.io/api/enums/v1"
+	v1 "go.temporal.io/api/update/v1"
 	activity "go.temporal.io/sdk/activity"
 	client "go.temporal.io/sdk/client"
+	converter "go.temporal.io/sdk/converter"
 	temporal "go.temporal.io/sdk/temporal"
 	testsuite "go.temporal.io/sdk/testsuite"
 	worker "go.temporal.io/sdk/worker"
 	workflow "go.temporal.io/sdk/workflow"
 	protojson "google.golang.org/protobuf/encoding/protojson"
 	"sort"
+	"time"
 )
 
 // SimpleTaskQueue= is the default task-queue for a mycompany.simple.Simple worker
@@ -37,6 +43,7 @@ const (
 	SomeWorkflow1WorkflowName = "mycompany.simple.SomeWorkflow1"
 	SomeWorkflow2WorkflowName = "mycompany.simple.SomeWorkflow2"
 	SomeWorkflow3WorkflowName = "mycompany.simple.Simple.SomeWorkflow3"
+	SomeWorkflow4WorkflowName = "mycompany.simple.SomeWorkflow4"
 )
 
 // mycompany.simple.Simple workflow id expressions
@@ -45,6 +52,11 @@ var (
 	SomeWorkflow3IDExpression = expression.MustParseExpression("some-workflow-3/${! id }/${! requestVal }")
 )
 
+// mycompany.simple.Simple workflow memo mappings
+var (
+	SomeWorkflow1MemoMapping = expression.MustParseMapping("root = { \"requestVal\": requestVal }")
+)
+
 // mycompany.simple.Simple activity names
 const (
 	SomeActivity1ActivityName = "mycompany.simple.SomeActivity1"
@@ -52,6 +64,16 @@ const (
 	SomeActivity3ActivityName = "mycompany.simple.Simple.SomeActivity3"
 )
 
+// mycompany.simple.Simple activity id expressions
+var (
+	SomeActivity2ActivityIDExpression = expression.MustParseExpression("some-activity-2/${! requestVal }")
+)
+
+// mycompany.simple.Simple activity compensation input mappings
+var (
+	SomeActivity3CompensationMapping = expression.MustParseMapping("root.requestVal = this.output.responseVal")
+)
+
 // mycompany.simple.Simple query names
 const (
 	SomeQuery1QueryName = "mycompany.simple.Simple.SomeQuery1"
@@ -74,20 +96,113 @@ var (
 	SomeUpdate1IDExpression = expression.MustParseExpression("some-update/${! requestVal.not_empty().catch(\"default\").slug() }")
 )
 
+// mycompany.simple.Simple span attribute mappings
+var (
+	SomeWorkflow1SpanAttributesMapping         = expression.MustParseMapping("root = { \"simple.id\": id }")
+	SomeActivity3ActivitySpanAttributesMapping = expression.MustParseMapping("root = { \"simple.request_val\": requestVal }")
+	SomeQuery2SpanAttributesMapping            = expression.MustParseMapping("root = { \"simple.request_val\": requestVal }")
+	SomeSignal2SpanAttributesMapping           = expression.MustParseMapping("root = { \"simple.request_val\": requestVal }")
+	SomeUpdate1SpanAttributesMapping           = expression.MustParseMapping("root = { \"simple.request_val\": requestVal }")
+)
+
+// mycompany.simple.Simple application error types
+const (
+	SomeActivity3FailedErrorType = "SomeActivity3Failed"
+	SomeWorkflow1FailedErrorType = "SomeWorkflow1Failed"
+)
+
+// NewSomeActivity3FailedError initializes a new non-retryable SomeActivity3Failed application error with the given details
+func NewSomeActivity3FailedError(detail *SomeErrorDetail) error {
+	return temporal.NewNonRetryableApplicationError(SomeActivity3FailedErrorType, SomeActivity3FailedErrorType, nil, detail)
+}
+
+// AsSomeActivity3FailedError returns the details of the first SomeActivity3Failed application error in the chain of err, which
+// includes the causes of activity, child workflow, and workflow execution errors
+func AsSomeActivity3FailedError(err error) (*SomeErrorDetail, bool) {
+	var appErr *temporal.ApplicationError
+	for errors.As(err, &appErr) {
+		if appErr.Type() == SomeActivity3FailedErrorType {
+			var detail *SomeErrorDetail
+			if appErr.HasDetails() {
+				if err := appErr.Details(&detail); err != nil {
+					return nil, false
+				}
+			}
+			if detail == nil {
+				detail = &SomeErrorDetail{}
+			}
+			return detail, true
+		}
+		err = appErr.Unwrap()
+	}
+	return nil, false
+}
+
+// NewSomeWorkflow1FailedError initializes a new SomeWorkflow1Failed application error with the given details
+func NewSomeWorkflow1FailedError(detail *SomeErrorDetail) error {
+	return temporal.NewApplicationError(SomeWorkflow1FailedErrorType, SomeWorkflow1FailedErrorType, detail)
+}
+
+// AsSomeWorkflow1FailedError returns the details of the first SomeWorkflow1Failed application error in the chain of err, which
+// includes the causes of activity, child workflow, and workflow execution errors
+func AsSomeWorkflow1FailedError(err error) (*SomeErrorDetail, bool) {
+	var appErr *temporal.ApplicationError
+	for errors.As(err, &appErr) {
+		if appErr.Type() == SomeWorkflow1FailedErrorType {
+			var detail *SomeErrorDetail
+			if appErr.HasDetails() {
+				if err := appErr.Details(&detail); err != nil {
+					return nil, false
+				}
+			}
+			if detail == nil {
+				detail = &SomeErrorDetail{}
+			}
+			return detail, true
+		}
+		err = appErr.Unwrap()
+	}
+	return nil, false
+}
+
 // SimpleClient describes a client for a(n) mycompany.simple.Simple worker
 type SimpleClient interface {
 	// SomeWorkflow1 does some workflow thing.
 	SomeWorkflow1(ctx context.Context, req *SomeWorkflow1Request, opts ...*SomeWorkflow1Options) (*SomeWorkflow1Response, error)
-	// SomeWorkflow1Async executes a(n) mycompany.simple.Simple.SomeWorkflow1 workflow asynchronously
+	// SomeWorkflow1Async executes a(n) mycompany.simple.SomeWorkflow1 workflow asynchronously
 	SomeWorkflow1Async(ctx context.Context, req *SomeWorkflow1Request, opts ...*SomeWorkflow1Options) (SomeWorkflow1Run, error)
-	// GetSomeWorkflow1 retrieves a handle to an existing mycompany.simple.Simple.SomeWorkflow1 workflow execution
+	// GetSomeWorkflow1 retrieves a handle to an existing mycompany.simple.SomeWorkflow1 workflow execution
 	GetSomeWorkflow1(ctx context.Context, workflowID string, runID string) SomeWorkflow1Run
+	// CreateSomeWorkflow1Schedule creates a Temporal Schedule that periodically starts a(n) mycompany.simple.SomeWorkflow1 workflow
+	CreateSomeWorkflow1Schedule(ctx context.Context, id string, spec client.ScheduleSpec, req *SomeWorkflow1Request, opts ...*SomeWorkflow1ScheduleOptions) (client.ScheduleHandle, error)
+	// GetSomeWorkflow1Schedule describes an existing mycompany.simple.SomeWorkflow1 workflow schedule
+	GetSomeWorkflow1Schedule(ctx context.Context, id string) (*client.ScheduleDescription, error)
+	// PauseSomeWorkflow1Schedule pauses an existing mycompany.simple.SomeWorkflow1 workflow schedule with an optional note, failing if the schedule starts a different workflow
+	PauseSomeWorkflow1Schedule(ctx context.Context, id string, note string) error
+	// UnpauseSomeWorkflow1Schedule unpauses an existing mycompany.simple.SomeWorkflow1 workflow schedule with an optional note, failing if the schedule starts a different workflow
+	UnpauseSomeWorkflow1Schedule(ctx context.Context, id string, note string) error
+	// TriggerSomeWorkflow1Schedule immediately starts a(n) mycompany.simple.SomeWorkflow1 workflow using an existing schedule, failing if the schedule starts a different workflow
+	TriggerSomeWorkflow1Schedule(ctx context.Context, id string) error
+	// DeleteSomeWorkflow1Schedule deletes an existing mycompany.simple.SomeWorkflow1 workflow schedule, failing if the schedule starts a different workflow
+	DeleteSomeWorkflow1Schedule(ctx context.Context, id string) error
 	// SomeWorkflow2 does some workflow thing.
 	SomeWorkflow2(ctx context.Context, opts ...*SomeWorkflow2Options) error
-	// SomeWorkflow2Async executes a(n) mycompany.simple.Simple.SomeWorkflow2 workflow asynchronously
+	// SomeWorkflow2Async executes a(n) mycompany.simple.SomeWorkflow2 workflow asynchronously
 	SomeWorkflow2Async(ctx context.Context, opts ...*SomeWorkflow2Options) (SomeWorkflow2Run, error)
-	// GetSomeWorkflow2 retrieves a handle to an existing mycompany.simple.Simple.SomeWorkflow2 workflow execution
+	// GetSomeWorkflow2 retrieves a handle to an existing mycompany.simple.SomeWorkflow2 workflow execution
 	GetSomeWorkflow2(ctx context.Context, workflowID string, runID string) SomeWorkflow2Run
+	// CreateSomeWorkflow2Schedule creates a Temporal Schedule that periodically starts a(n) mycompany.simple.SomeWorkflow2 workflow
+	CreateSomeWorkflow2Schedule(ctx context.Context, id string, spec client.ScheduleSpec, opts ...*SomeWorkflow2ScheduleOptions) (client.ScheduleHandle, error)
+	// GetSomeWorkflow2Schedule describes an existing mycompany.simple.SomeWorkflow2 workflow schedule
+	GetSomeWorkflow2Schedule(ctx context.Context, id string) (*client.ScheduleDescription, error)
+	// PauseSomeWorkflow2Schedule pauses an existing mycompany.simple.SomeWorkflow2 workflow schedule with an optional note, failing if the schedule starts a different workflow
+	PauseSomeWorkflow2Schedule(ctx context.Context, id string, note string) error
+	// UnpauseSomeWorkflow2Schedule unpauses an existing mycompany.simple.SomeWorkflow2 workflow schedule with an optional note, failing if the schedule starts a different workflow
+	UnpauseSomeWorkflow2Schedule(ctx context.Context, id string, note string) error
+	// TriggerSomeWorkflow2Schedule immediately starts a(n) mycompany.simple.SomeWorkflow2 workflow using an existing schedule, failing if the schedule starts a different workflow
+	TriggerSomeWorkflow2Schedule(ctx context.Context, id string) error
+	// DeleteSomeWorkflow2Schedule deletes an existing mycompany.simple.SomeWorkflow2 workflow schedule, failing if the schedule starts a different workflow
+	DeleteSomeWorkflow2Schedule(ctx context.Context, id string) error
 	/*
 	   SomeSignal1 is a signal.
 	*/
@@ -102,6 +217,18 @@ type SimpleClient interface {
 	SomeWorkflow3Async(ctx context.Context, req *SomeWorkflow3Request, opts ...*SomeWorkflow3Options) (SomeWorkflow3Run, error)
 	// GetSomeWorkflow3 retrieves a handle to an existing mycompany.simple.Simple.SomeWorkflow3 workflow execution
 	GetSomeWorkflow3(ctx context.Context, workflowID string, runID string) SomeWorkflow3Run
+	// CreateSomeWorkflow3Schedule creates a Temporal Schedule that periodically starts a(n) mycompany.simple.Simple.SomeWorkflow3 workflow
+	CreateSomeWorkflow3Schedule(ctx context.Context, id string, spec client.ScheduleSpec, req *SomeWorkflow3Request, opts ...*SomeWorkflow3ScheduleOptions) (client.ScheduleHandle, error)
+	// GetSomeWorkflow3Schedule describes an existing mycompany.simple.Simple.SomeWorkflow3 workflow schedule
+	GetSomeWorkflow3Schedule(ctx context.Context, id string) (*client.ScheduleDescription, error)
+	// PauseSomeWorkflow3Schedule pauses an existing mycompany.simple.Simple.SomeWorkflow3 workflow schedule with an optional note, failing if the schedule starts a different workflow
+	PauseSomeWorkflow3Schedule(ctx context.Context, id string, note string) error
+	// UnpauseSomeWorkflow3Schedule unpauses an existing mycompany.simple.Simple.SomeWorkflow3 workflow schedule with an optional note, failing if the schedule starts a different workflow
+	UnpauseSomeWorkflow3Schedule(ctx context.Context, id string, note string) error
+	// TriggerSomeWorkflow3Schedule immediately starts a(n) mycompany.simple.Simple.SomeWorkflow3 workflow using an existing schedule, failing if the schedule starts a different workflow
+	TriggerSomeWorkflow3Schedule(ctx context.Context, id string) error
+	// DeleteSomeWorkflow3Schedule deletes an existing mycompany.simple.Simple.SomeWorkflow3 workflow schedule, failing if the schedule starts a different workflow
+	DeleteSomeWorkflow3Schedule(ctx context.Context, id string) error
 	/*
 	   SomeSignal2 is a signal.
 	*/
@@ -110,6 +237,32 @@ type SimpleClient interface {
 	   SomeSignal2 is a signal.
 	*/
 	SomeWorkflow3WithSomeSignal2Async(ctx context.Context, req *SomeWorkflow3Request, signal *SomeSignal2Request, opts ...*SomeWorkflow3Options) (SomeWorkflow3Run, error)
+	// SomeWorkflow4 does some workflow thing using queries, signals, and updates defined by another package.
+	SomeWorkflow4(ctx context.Context, opts ...*SomeWorkflow4Options) error
+	// SomeWorkflow4Async executes a(n) mycompany.simple.SomeWorkflow4 workflow asynchronously
+	SomeWorkflow4Async(ctx context.Context, opts ...*SomeWorkflow4Options) (SomeWorkflow4Run, error)
+	// GetSomeWorkflow4 retrieves a handle to an existing mycompany.simple.SomeWorkflow4 workflow execution
+	GetSomeWorkflow4(ctx context.Context, workflowID string, runID string) SomeWorkflow4Run
+	// CreateSomeWorkflow4Schedule creates a Temporal Schedule that periodically starts a(n) mycompany.simple.SomeWorkflow4 workflow
+	CreateSomeWorkflow4Schedule(ctx context.Context, id string, spec client.ScheduleSpec, opts ...*SomeWorkflow4ScheduleOptions) (client.ScheduleHandle, error)
+	// GetSomeWorkflow4Schedule describes an existing mycompany.simple.SomeWorkflow4 workflow schedule
+	GetSomeWorkflow4Schedule(ctx context.Context, id string) (*client.ScheduleDescription, error)
+	// PauseSomeWorkflow4Schedule pauses an existing mycompany.simple.SomeWorkflow4 workflow schedule with an optional note, failing if the schedule starts a different workflow
+	PauseSomeWorkflow4Schedule(ctx context.Context, id string, note string) error
+	// UnpauseSomeWorkflow4Schedule unpauses an existing mycompany.simple.SomeWorkflow4 workflow schedule with an optional note, failing if the schedule starts a different workflow
+	UnpauseSomeWorkflow4Schedule(ctx context.Context, id string, note string) error
+	// TriggerSomeWorkflow4Schedule immediately starts a(n) mycompany.simple.SomeWorkflow4 workflow using an existing schedule, failing if the schedule starts a different workflow
+	TriggerSomeWorkflow4Schedule(ctx context.Context, id string) error
+	// DeleteSomeWorkflow4Schedule deletes an existing mycompany.simple.SomeWorkflow4 workflow schedule, failing if the schedule starts a different workflow
+	DeleteSomeWorkflow4Schedule(ctx context.Context, id string) error
+	/*
+	   SetValue sets the current value.
+	*/
+	SomeWorkflow4WithSetValue(ctx context.Context, signal *common.SetValueRequest, opts ...*SomeWorkflow4Options) error
+	/*
+	   SetValue sets the current value.
+	*/
+	SomeWorkflow4WithSetValueAsync(ctx context.Context, signal *common.SetValueRequest, opts ...*SomeWorkflow4Options) (SomeWorkflow4Run, error)
 	/*
 	   SomeQuery1 queries some thing.
 	*/
@@ -134,29 +287,245 @@ type SimpleClient interface {
 	   SomeUpdate1 updates a SomeWorkflow2
 	*/
 	SomeUpdate1Async(ctx context.Context, workflowID string, runID string, req *SomeUpdate1Request, opts ...*SomeUpdate1Options) (SomeUpdate1Handle, error)
+	// CompleteSomeActivity3 completes a(n) mycompany.simple.Simple.SomeActivity3 activity that returned activity.ErrResultPending, identified by its task token
+	CompleteSomeActivity3(ctx context.Context, taskToken []byte, resp *SomeActivity3Response, err error) error
+	// CompleteSomeActivity3ByID completes a(n) mycompany.simple.Simple.SomeActivity3 activity that returned activity.ErrResultPending, identified by its activity ID
+	CompleteSomeActivity3ByID(ctx context.Context, namespace string, workflowID string, runID string, activityID string, resp *SomeActivity3Response, err error) error
 }
 
 // simpleClient implements a temporal client for a mycompany.simple.Simple service
 type simpleClient struct {
-	client client.Client
+	client        client.Client
+	interceptors  simpleClientInterceptors
+	dataConverter converter.DataConverter
 }
 
-// NewSimpleClient initializes a new mycompany.simple.Simple client
-func NewSimpleClient(c client.Client) SimpleClient {
-	return &simpleClient{client: c}
+// NewSimpleClient initializes a new mycompany.simple.Simple client that invokes the hooks of the given interceptors
+func NewSimpleClient(c client.Client, interceptors ...SimpleClientInterceptor) SimpleClient {
+	return &simpleClient{client: c, interceptors: interceptors, dataConverter: converter.GetDefaultDataConverter()}
 }
 
-// NewSimpleClientWithOptions initializes a new Simple client with the given options
-func NewSimpleClientWithOptions(c client.Client, opts client.Options) (SimpleClient, error) {
+// NewSimpleClientWithOptions initializes a new Simple client with the given options that invokes the hooks of the given interceptors
+func NewSimpleClientWithOptions(c client.Client, opts client.Options, interceptors ...SimpleClientInterceptor) (SimpleClient, error) {
 	var err error
 	c, err = client.NewClientFromExisting(c, opts)
 	if err != nil {
 		return nil, fmt.Errorf("error initializing client with options: %w", err)
 	}
-	return &simpleClient{client: c}, nil
+	dataConverter := opts.DataConverter
+	if dataConverter == nil {
+		dataConverter = converter.GetDefaultDataConverter()
+	}
+	return &simpleClient{client: c, interceptors: interceptors, dataConverter: dataConverter}, nil
+}
+
+// SimpleClientInterceptor describes typed hooks invoked around mycompany.simple.Simple workflows, queries, signals,
+// and updates sent by a client, which can be installed via the NewSimpleClient functions
+type SimpleClientInterceptor interface {
+	// BeforeSomeWorkflow1 is invoked with the input of a(n) mycompany.simple.SomeWorkflow1 workflow before it is started
+	BeforeSomeWorkflow1(ctx context.Context, req *SomeWorkflow1Request)
+	// AfterSomeWorkflow1 is invoked with the result of a(n) mycompany.simple.SomeWorkflow1 workflow when it is retrieved
+	AfterSomeWorkflow1(ctx context.Context, resp *SomeWorkflow1Response, err error)
+	// BeforeSomeWorkflow2 is invoked with the input of a(n) mycompany.simple.SomeWorkflow2 workflow before it is started
+	BeforeSomeWorkflow2(ctx context.Context)
+	// AfterSomeWorkflow2 is invoked with the result of a(n) mycompany.simple.SomeWorkflow2 workflow when it is retrieved
+	AfterSomeWorkflow2(ctx context.Context, err error)
+	// BeforeSomeWorkflow3 is invoked with the input of a(n) mycompany.simple.Simple.SomeWorkflow3 workflow before it is started
+	BeforeSomeWorkflow3(ctx context.Context, req *SomeWorkflow3Request)
+	// AfterSomeWorkflow3 is invoked with the result of a(n) mycompany.simple.Simple.SomeWorkflow3 workflow when it is retrieved
+	AfterSomeWorkflow3(ctx context.Context, err error)
+	// BeforeSomeWorkflow4 is invoked with the input of a(n) mycompany.simple.SomeWorkflow4 workflow before it is started
+	BeforeSomeWorkflow4(ctx context.Context)
+	// AfterSomeWorkflow4 is invoked with the result of a(n) mycompany.simple.SomeWorkflow4 workflow when it is retrieved
+	AfterSomeWorkflow4(ctx context.Context, err error)
+	// BeforeSomeQuery1 is invoked with the input of a(n) mycompany.simple.Simple.SomeQuery1 query before it is sent
+	BeforeSomeQuery1(ctx context.Context)
+	// AfterSomeQuery1 is invoked with the result of a(n) mycompany.simple.Simple.SomeQuery1 query after it is received
+	AfterSomeQuery1(ctx context.Context, resp *SomeQuery1Response, err error)
+	// BeforeSomeQuery2 is invoked with the input of a(n) mycompany.simple.Simple.SomeQuery2 query before it is sent
+	BeforeSomeQuery2(ctx context.Context, req *SomeQuery2Request)
+	// AfterSomeQuery2 is invoked with the result of a(n) mycompany.simple.Simple.SomeQuery2 query after it is received
+	AfterSomeQuery2(ctx context.Context, resp *SomeQuery2Response, err error)
+	// OnSomeSignal1 is invoked with the input of a(n) mycompany.simple.Simple.SomeSignal1 signal before it is sent
+	OnSomeSignal1(ctx context.Context)
+	// OnSomeSignal2 is invoked with the input of a(n) mycompany.simple.Simple.SomeSignal2 signal before it is sent
+	OnSomeSignal2(ctx context.Context, req *SomeSignal2Request)
+	// BeforeSomeUpdate1 is invoked with the input of a(n) mycompany.simple.Simple.SomeUpdate1 update before it is sent
+	BeforeSomeUpdate1(ctx context.Context, req *SomeUpdate1Request)
+	// AfterSomeUpdate1 is invoked with the result of a(n) mycompany.simple.Simple.SomeUpdate1 update when it is retrieved
+	AfterSomeUpdate1(ctx context.Context, resp *SomeUpdate1Response, err error)
+}
+
+// NoopSimpleClientInterceptor provides a no-op SimpleClientInterceptor implementation that can be embedded
+// to implement a subset of hooks
+type NoopSimpleClientInterceptor struct{}
+
+// BeforeSomeWorkflow1 implements SimpleClientInterceptor
+func (NoopSimpleClientInterceptor) BeforeSomeWorkflow1(context.Context, *SomeWorkflow1Request) {}
+
+// AfterSomeWorkflow1 implements SimpleClientInterceptor
+func (NoopSimpleClientInterceptor) AfterSomeWorkflow1(context.Context, *SomeWorkflow1Response, error) {
+}
+
+// BeforeSomeWorkflow2 implements SimpleClientInterceptor
+func (NoopSimpleClientInterceptor) BeforeSomeWorkflow2(context.Context) {}
+
+// AfterSomeWorkflow2 implements SimpleClientInterceptor
+func (NoopSimpleClientInterceptor) AfterSomeWorkflow2(context.Context, error) {}
+
+// BeforeSomeWorkflow3 implements SimpleClientInterceptor
+func (NoopSimpleClientInterceptor) BeforeSomeWorkflow3(context.Context, *SomeWorkflow3Request) {}
+
+// AfterSomeWorkflow3 implements SimpleClientInterceptor
+func (NoopSimpleClientInterceptor) AfterSomeWorkflow3(context.Context, error) {}
+
+// BeforeSomeWorkflow4 implements SimpleClientInterceptor
+func (NoopSimpleClientInterceptor) BeforeSomeWorkflow4(context.Context) {}
+
+// AfterSomeWorkflow4 implements SimpleClientInterceptor
+func (NoopSimpleClientInterceptor) AfterSomeWorkflow4(context.Context, error) {}
+
+// BeforeSomeQuery1 implements SimpleClientInterceptor
+func (NoopSimpleClientInterceptor) BeforeSomeQuery1(context.Context) {}
+
+// AfterSomeQuery1 implements SimpleClientInterceptor
+func (NoopSimpleClientInterceptor) AfterSomeQuery1(context.Context, *SomeQuery1Response, error) {}
+
+// BeforeSomeQuery2 implements SimpleClientInterceptor
+func (NoopSimpleClientInterceptor) BeforeSomeQuery2(context.Context, *SomeQuery2Request) {}
+
+// AfterSomeQuery2 implements SimpleClientInterceptor
+func (NoopSimpleClientInterceptor) AfterSomeQuery2(context.Context, *SomeQuery2Response, error) {}
+
+// OnSomeSignal1 implements SimpleClientInterceptor
+func (NoopSimpleClientInterceptor) OnSomeSignal1(context.Context) {}
+
+// OnSomeSignal2 implements SimpleClientInterceptor
+func (NoopSimpleClientInterceptor) OnSomeSignal2(context.Context, *SomeSignal2Request) {}
+
+// BeforeSomeUpdate1 implements SimpleClientInterceptor
+func (NoopSimpleClientInterceptor) BeforeSomeUpdate1(context.Context, *SomeUpdate1Request) {}
+
+// AfterSomeUpdate1 implements SimpleClientInterceptor
+func (NoopSimpleClientInterceptor) AfterSomeUpdate1(context.Context, *SomeUpdate1Response, error) {}
+
+// simpleClientInterceptors invokes the hooks of multiple SimpleClientInterceptor values, in order for
+// Before<Method> and On<Method> hooks and in reverse order for After<Method> hooks
+type simpleClientInterceptors []SimpleClientInterceptor
+
+// BeforeSomeWorkflow1 implements SimpleClientInterceptor
+func (c simpleClientInterceptors) BeforeSomeWorkflow1(ctx context.Context, req *SomeWorkflow1Request) {
+	for _, interceptor := range c {
+		interceptor.BeforeSomeWorkflow1(ctx, req)
+	}
+}
+
+// AfterSomeWorkflow1 implements SimpleClientInterceptor
+func (c simpleClientInterceptors) AfterSomeWorkflow1(ctx context.Context, resp *SomeWorkflow1Response, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterSomeWorkflow1(ctx, resp, err)
+	}
+}
+
+// BeforeSomeWorkflow2 implements SimpleClientInterceptor
+func (c simpleClientInterceptors) BeforeSomeWorkflow2(ctx context.Context) {
+	for _, interceptor := range c {
+		interceptor.BeforeSomeWorkflow2(ctx)
+	}
+}
+
+// AfterSomeWorkflow2 implements SimpleClientInterceptor
+func (c simpleClientInterceptors) AfterSomeWorkflow2(ctx context.Context, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterSomeWorkflow2(ctx, err)
+	}
+}
+
+// BeforeSomeWorkflow3 implements SimpleClientInterceptor
+func (c simpleClientInterceptors) BeforeSomeWorkflow3(ctx context.Context, req *SomeWorkflow3Request) {
+	for _, interceptor := range c {
+		interceptor.BeforeSomeWorkflow3(ctx, req)
+	}
+}
+
+// AfterSomeWorkflow3 implements SimpleClientInterceptor
+func (c simpleClientInterceptors) AfterSomeWorkflow3(ctx context.Context, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterSomeWorkflow3(ctx, err)
+	}
+}
+
+// BeforeSomeWorkflow4 implements SimpleClientInterceptor
+func (c simpleClientInterceptors) BeforeSomeWorkflow4(ctx context.Context) {
+	for _, interceptor := range c {
+		interceptor.BeforeSomeWorkflow4(ctx)
+	}
+}
+
+// AfterSomeWorkflow4 implements SimpleClientInterceptor
+func (c simpleClientInterceptors) AfterSomeWorkflow4(ctx context.Context, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterSomeWorkflow4(ctx, err)
+	}
+}
+
+// BeforeSomeQuery1 implements SimpleClientInterceptor
+func (c simpleClientInterceptors) BeforeSomeQuery1(ctx context.Context) {
+	for _, interceptor := range c {
+		interceptor.BeforeSomeQuery1(ctx)
+	}
+}
+
+// AfterSomeQuery1 implements SimpleClientInterceptor
+func (c simpleClientInterceptors) AfterSomeQuery1(ctx context.Context, resp *SomeQuery1Response, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterSomeQuery1(ctx, resp, err)
+	}
+}
+
+// BeforeSomeQuery2 implements SimpleClientInterceptor
+func (c simpleClientInterceptors) BeforeSomeQuery2(ctx context.Context, req *SomeQuery2Request) {
+	for _, interceptor := range c {
+		interceptor.BeforeSomeQuery2(ctx, req)
+	}
+}
+
+// AfterSomeQuery2 implements SimpleClientInterceptor
+func (c simpleClientInterceptors) AfterSomeQuery2(ctx context.Context, resp *SomeQuery2Response, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterSomeQuery2(ctx, resp, err)
+	}
+}
+
+// OnSomeSignal1 implements SimpleClientInterceptor
+func (c simpleClientInterceptors) OnSomeSignal1(ctx context.Context) {
+	for _, interceptor := range c {
+		interceptor.OnSomeSignal1(ctx)
+	}
+}
+
+// OnSomeSignal2 implements SimpleClientInterceptor
+func (c simpleClientInterceptors) OnSomeSignal2(ctx context.Context, req *SomeSignal2Request) {
+	for _, interceptor := range c {
+		interceptor.OnSomeSignal2(ctx, req)
+	}
+}
+
+// BeforeSomeUpdate1 implements SimpleClientInterceptor
+func (c simpleClientInterceptors) BeforeSomeUpdate1(ctx context.Context, req *SomeUpdate1Request) {
+	for _, interceptor := range c {
+		interceptor.BeforeSomeUpdate1(ctx, req)
+	}
+}
+
+// AfterSomeUpdate1 implements SimpleClientInterceptor
+func (c simpleClientInterceptors) AfterSomeUpdate1(ctx context.Context, resp *SomeUpdate1Response, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterSomeUpdate1(ctx, resp, err)
+	}
 }
 
-// SomeWorkflow1 executes a mycompany.simple.Simple.SomeWorkflow1 workflow and blocks until error or response received
+// SomeWorkflow1 executes a mycompany.simple.SomeWorkflow1 workflow and blocks until error or response received
 func (c *simpleClient) SomeWorkflow1(ctx context.Context, req *SomeWorkflow1Request, options ...*SomeWorkflow1Options) (*SomeWorkflow1Response, error) {
 	run, err := c.SomeWorkflow1Async(ctx, req, options...)
 	if err != nil {
@@ -165,24 +534,22 @@ func (c *simpleClient) SomeWorkflow1(ctx context.Context, req *SomeWorkflow1Requ
 	return run.Get(ctx)
 }
 
-// SomeWorkflow1Async starts a(n) mycompany.simple.Simple.SomeWorkflow1 workflow
+// SomeWorkflow1Async starts a(n) mycompany.simple.SomeWorkflow1 workflow
 func (c *simpleClient) SomeWorkflow1Async(ctx context.Context, req *SomeWorkflow1Request, options ...*SomeWorkflow1Options) (SomeWorkflow1Run, error) {
-	opts := &client.StartWorkflowOptions{}
-	if len(options) > 0 && options[0].opts != nil {
-		opts = options[0].opts
-	}
-	if opts.TaskQueue == "" {
-		opts.TaskQueue = SimpleTaskQueue
+	var o *SomeWorkflow1Options
+	if len(options) > 0 {
+		o = options[0]
 	}
-	if opts.ID == "" {
-		id, err := expression.EvalExpression(SomeWorkflow1IDExpression, req.ProtoReflect())
-		if err != nil {
-			return nil, err
-		}
-		opts.ID = id
+	opts, err := o.Build(req)
+	if err != nil {
+		return nil, err
 	}
+	c.interceptors.BeforeSomeWorkflow1(ctx, req)
+	ctx, span := tracing.Start(ctx, "mycompany.simple.Simple", "StartWorkflow:"+SomeWorkflow1WorkflowName, tracing.Attributes(SomeWorkflow1SpanAttributesMapping, req, tracing.WorkflowNameKey.String(SomeWorkflow1WorkflowName), tracing.WorkflowIDKey.String(opts.ID))...)
 	run, err := c.client.ExecuteWorkflow(ctx, *opts, SomeWorkflow1WorkflowName, req)
+	tracing.End(span, err)
 	if err != nil {
+		c.interceptors.AfterSomeWorkflow1(ctx, nil, err)
 		return nil, err
 	}
 	if run == nil {
@@ -194,7 +561,7 @@ func (c *simpleClient) SomeWorkflow1Async(ctx context.Context, req *SomeWorkflow
 	}, nil
 }
 
-// GetSomeWorkflow1 fetches an existing mycompany.simple.Simple.SomeWorkflow1 execution
+// GetSomeWorkflow1 fetches an existing mycompany.simple.SomeWorkflow1 execution
 func (c *simpleClient) GetSomeWorkflow1(ctx context.Context, workflowID string, runID string) SomeWorkflow1Run {
 	return &someWorkflow1Run{
 		client: c,
@@ -202,7 +569,64 @@ func (c *simpleClient) GetSomeWorkflow1(ctx context.Context, workflowID string,
 	}
 }
 
-// SomeWorkflow2 executes a mycompany.simple.Simple.SomeWorkflow2 workflow and blocks until error or response received
+// CreateSomeWorkflow1Schedule creates a Temporal Schedule that periodically starts a(n) mycompany.simple.SomeWorkflow1 workflow
+func (c *simpleClient) CreateSomeWorkflow1Schedule(ctx context.Context, id string, spec client.ScheduleSpec, req *SomeWorkflow1Request, options ...*SomeWorkflow1ScheduleOptions) (client.ScheduleHandle, error) {
+	var o *SomeWorkflow1ScheduleOptions
+	if len(options) > 0 {
+		o = options[0]
+	}
+	opts, err := o.Build(id, spec, req)
+	if err != nil {
+		return nil, err
+	}
+	return c.client.ScheduleClient().Create(ctx, *opts)
+}
+
+// GetSomeWorkflow1Schedule describes an existing mycompany.simple.SomeWorkflow1 workflow schedule
+func (c *simpleClient) GetSomeWorkflow1Schedule(ctx context.Context, id string) (*client.ScheduleDescription, error) {
+	desc, err := c.client.ScheduleClient().GetHandle(ctx, id).Describe(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if action, ok := desc.Schedule.Action.(*client.ScheduleWorkflowAction); !ok || action.Workflow != SomeWorkflow1WorkflowName {
+		return nil, fmt.Errorf("schedule %q does not start a(n) %s workflow", id, SomeWorkflow1WorkflowName)
+	}
+	return desc, nil
+}
+
+// PauseSomeWorkflow1Schedule pauses an existing mycompany.simple.SomeWorkflow1 workflow schedule with an optional note, failing if the schedule starts a different workflow
+func (c *simpleClient) PauseSomeWorkflow1Schedule(ctx context.Context, id string, note string) error {
+	if _, err := c.GetSomeWorkflow1Schedule(ctx, id); err != nil {
+		return err
+	}
+	return c.client.ScheduleClient().GetHandle(ctx, id).Pause(ctx, client.SchedulePauseOptions{Note: note})
+}
+
+// UnpauseSomeWorkflow1Schedule unpauses an existing mycompany.simple.SomeWorkflow1 workflow schedule with an optional note, failing if the schedule starts a different workflow
+func (c *simpleClient) UnpauseSomeWorkflow1Schedule(ctx context.Context, id string, note string) error {
+	if _, err := c.GetSomeWorkflow1Schedule(ctx, id); err != nil {
+		return err
+	}
+	return c.client.ScheduleClient().GetHandle(ctx, id).Unpause(ctx, client.ScheduleUnpauseOptions{Note: note})
+}
+
+// TriggerSomeWorkflow1Schedule immediately starts a(n) mycompany.simple.SomeWorkflow1 workflow using an existing schedule, failing if the schedule starts a different workflow
+func (c *simpleClient) TriggerSomeWorkflow1Schedule(ctx context.Context, id string) error {
+	if _, err := c.GetSomeWorkflow1Schedule(ctx, id); err != nil {
+		return err
+	}
+	return c.client.ScheduleClient().GetHandle(ctx, id).Trigger(ctx, client.ScheduleTriggerOptions{})
+}
+
+// DeleteSomeWorkflow1Schedule deletes an existing mycompany.simple.SomeWorkflow1 workflow schedule, failing if the schedule starts a different workflow
+func (c *simpleClient) DeleteSomeWorkflow1Schedule(ctx context.Context, id string) error {
+	if _, err := c.GetSomeWorkflow1Schedule(ctx, id); err != nil {
+		return err
+	}
+	return c.client.ScheduleClient().GetHandle(ctx, id).Delete(ctx)
+}
+
+// SomeWorkflow2 executes a mycompany.simple.SomeWorkflow2 workflow and blocks until error or response received
 func (c *simpleClient) SomeWorkflow2(ctx context.Context, options ...*SomeWorkflow2Options) error {
 	run, err := c.SomeWorkflow2Async(ctx, options...)
 	if err != nil {
@@ -211,17 +635,22 @@ func (c *simpleClient) SomeWorkflow2(ctx context.Context, options ...*SomeWorkfl
 	return run.Get(ctx)
 }
 
-// SomeWorkflow2Async starts a(n) mycompany.simple.Simple.SomeWorkflow2 workflow
+// SomeWorkflow2Async starts a(n) mycompany.simple.SomeWorkflow2 workflow
 func (c *simpleClient) SomeWorkflow2Async(ctx context.Context, options ...*SomeWorkflow2Options) (SomeWorkflow2Run, error) {
-	opts := &client.StartWorkflowOptions{}
-	if len(options) > 0 && options[0].opts != nil {
-		opts = options[0].opts
+	var o *SomeWorkflow2Options
+	if len(options) > 0 {
+		o = options[0]
 	}
-	if opts.TaskQueue == "" {
-		opts.TaskQueue = SimpleTaskQueue
+	opts, err := o.Build()
+	if err != nil {
+		return nil, err
 	}
+	c.interceptors.BeforeSomeWorkflow2(ctx)
+	ctx, span := tracing.Start(ctx, "mycompany.simple.Simple", "StartWorkflow:"+SomeWorkflow2WorkflowName, tracing.WorkflowNameKey.String(SomeWorkflow2WorkflowName), tracing.WorkflowIDKey.String(opts.ID))
 	run, err := c.client.ExecuteWorkflow(ctx, *opts, SomeWorkflow2WorkflowName)
+	tracing.End(span, err)
 	if err != nil {
+		c.interceptors.AfterSomeWorkflow2(ctx, err)
 		return nil, err
 	}
 	if run == nil {
@@ -233,7 +662,7 @@ func (c *simpleClient) SomeWorkflow2Async(ctx context.Context, options ...*SomeW
 	}, nil
 }
 
-// GetSomeWorkflow2 fetches an existing mycompany.simple.Simple.SomeWorkflow2 execution
+// GetSomeWorkflow2 fetches an existing mycompany.simple.SomeWorkflow2 execution
 func (c *simpleClient) GetSomeWorkflow2(ctx context.Context, workflowID string, runID string) SomeWorkflow2Run {
 	return &someWorkflow2Run{
 		client: c,
@@ -241,7 +670,64 @@ func (c *simpleClient) GetSomeWorkflow2(ctx context.Context, workflowID string,
 	}
 }
 
-// SomeWorkflow2WithSomeSignal1 starts a(n) mycompany.simple.Simple.SomeWorkflow2 workflow and sends a(n) mycompany.simple.Simple.SomeSignal1 signal in a transaction
+// CreateSomeWorkflow2Schedule creates a Temporal Schedule that periodically starts a(n) mycompany.simple.SomeWorkflow2 workflow
+func (c *simpleClient) CreateSomeWorkflow2Schedule(ctx context.Context, id string, spec client.ScheduleSpec, options ...*SomeWorkflow2ScheduleOptions) (client.ScheduleHandle, error) {
+	var o *SomeWorkflow2ScheduleOptions
+	if len(options) > 0 {
+		o = options[0]
+	}
+	opts, err := o.Build(id, spec)
+	if err != nil {
+		return nil, err
+	}
+	return c.client.ScheduleClient().Create(ctx, *opts)
+}
+
+// GetSomeWorkflow2Schedule describes an existing mycompany.simple.SomeWorkflow2 workflow schedule
+func (c *simpleClient) GetSomeWorkflow2Schedule(ctx context.Context, id string) (*client.ScheduleDescription, error) {
+	desc, err := c.client.ScheduleClient().GetHandle(ctx, id).Describe(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if action, ok := desc.Schedule.Action.(*client.ScheduleWorkflowAction); !ok || action.Workflow != SomeWorkflow2WorkflowName {
+		return nil, fmt.Errorf("schedule %q does not start a(n) %s workflow", id, SomeWorkflow2WorkflowName)
+	}
+	return desc, nil
+}
+
+// PauseSomeWorkflow2Schedule pauses an existing mycompany.simple.SomeWorkflow2 workflow schedule with an optional note, failing if the schedule starts a different workflow
+func (c *simpleClient) PauseSomeWorkflow2Schedule(ctx context.Context, id string, note string) error {
+	if _, err := c.GetSomeWorkflow2Schedule(ctx, id); err != nil {
+		return err
+	}
+	return c.client.ScheduleClient().GetHandle(ctx, id).Pause(ctx, client.SchedulePauseOptions{Note: note})
+}
+
+// UnpauseSomeWorkflow2Schedule unpauses an existing mycompany.simple.SomeWorkflow2 workflow schedule with an optional note, failing if the schedule starts a different workflow
+func (c *simpleClient) UnpauseSomeWorkflow2Schedule(ctx context.Context, id string, note string) error {
+	if _, err := c.GetSomeWorkflow2Schedule(ctx, id); err != nil {
+		return err
+	}
+	return c.client.ScheduleClient().GetHandle(ctx, id).Unpause(ctx, client.ScheduleUnpauseOptions{Note: note})
+}
+
+// TriggerSomeWorkflow2Schedule immediately starts a(n) mycompany.simple.SomeWorkflow2 workflow using an existing schedule, failing if the schedule starts a different workflow
+func (c *simpleClient) TriggerSomeWorkflow2Schedule(ctx context.Context, id string) error {
+	if _, err := c.GetSomeWorkflow2Schedule(ctx, id); err != nil {
+		return err
+	}
+	return c.client.ScheduleClient().GetHandle(ctx, id).Trigger(ctx, client.ScheduleTriggerOptions{})
+}
+
+// DeleteSomeWorkflow2Schedule deletes an existing mycompany.simple.SomeWorkflow2 workflow schedule, failing if the schedule starts a different workflow
+func (c *simpleClient) DeleteSomeWorkflow2Schedule(ctx context.Context, id string) error {
+	if _, err := c.GetSomeWorkflow2Schedule(ctx, id); err != nil {
+		return err
+	}
+	return c.client.ScheduleClient().GetHandle(ctx, id).Delete(ctx)
+}
+
+// SomeWorkflow2WithSomeSignal1 starts a(n) mycompany.simple.SomeWorkflow2 workflow and sends a(n) mycompany.simple.Simple.SomeSignal1 signal in a transaction
 func (c *simpleClient) SomeWorkflow2WithSomeSignal1(ctx context.Context, options ...*SomeWorkflow2Options) error {
 	run, err := c.SomeWorkflow2WithSomeSignal1Async(ctx, options...)
 	if err != nil {
@@ -250,17 +736,23 @@ func (c *simpleClient) SomeWorkflow2WithSomeSignal1(ctx context.Context, options
 	return run.Get(ctx)
 }
 
-// SomeWorkflow2WithSomeSignal1Async starts a(n) mycompany.simple.Simple.SomeWorkflow2 workflow and sends a(n) mycompany.simple.Simple.SomeSignal1 signal in a transaction
+// SomeWorkflow2WithSomeSignal1Async starts a(n) mycompany.simple.SomeWorkflow2 workflow and sends a(n) mycompany.simple.Simple.SomeSignal1 signal in a transaction
 func (c *simpleClient) SomeWorkflow2WithSomeSignal1Async(ctx context.Context, options ...*SomeWorkflow2Options) (SomeWorkflow2Run, error) {
-	opts := &client.StartWorkflowOptions{}
-	if len(options) > 0 && options[0].opts != nil {
-		opts = options[0].opts
+	var o *SomeWorkflow2Options
+	if len(options) > 0 {
+		o = options[0]
 	}
-	if opts.TaskQueue == "" {
-		opts.TaskQueue = SimpleTaskQueue
+	opts, err := o.Build()
+	if err != nil {
+		return nil, err
 	}
+	c.interceptors.BeforeSomeWorkflow2(ctx)
+	c.interceptors.OnSomeSignal1(ctx)
+	ctx, span := tracing.Start(ctx, "mycompany.simple.Simple", "SignalWithStartWorkflow:"+SomeWorkflow2WorkflowName, tracing.WorkflowNameKey.String(SomeWorkflow2WorkflowName), tracing.SignalNameKey.String(SomeSignal1SignalName), tracing.WorkflowIDKey.String(opts.ID))
 	run, err := c.client.SignalWithStartWorkflow(ctx, opts.ID, SomeSignal1SignalName, nil, *opts, SomeWorkflow2WorkflowName)
+	tracing.End(span, err)
 	if run == nil || err != nil {
+		c.interceptors.AfterSomeWorkflow2(ctx, err)
 		return nil, err
 	}
 	return &someWorkflow2Run{
@@ -280,31 +772,20 @@ func (c *simpleClient) SomeWorkflow3(ctx context.Context, req *SomeWorkflow3Requ
 
 // SomeWorkflow3Async starts a(n) mycompany.simple.Simple.SomeWorkflow3 workflow
 func (c *simpleClient) SomeWorkflow3Async(ctx context.Context, req *SomeWorkflow3Request, options ...*SomeWorkflow3Options) (SomeWorkflow3Run, error) {
-	opts := &client.StartWorkflowOptions{}
-	if len(options) > 0 && options[0].opts != nil {
-		opts = options[0].opts
-	}
-	if opts.TaskQueue == "" {
-		opts.TaskQueue = "my-task-queue-2"
-	}
-	if opts.ID == "" {
-		id, err := expression.EvalExpression(SomeWorkflow3IDExpression, req.ProtoReflect())
-		if err != nil {
-			return nil, err
-		}
-		opts.ID = id
-	}
-	if opts.WorkflowIDReusePolicy == v1.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
-		opts.WorkflowIDReusePolicy = v1.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
-	}
-	if opts.RetryPolicy == nil {
-		opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(2)}
+	var o *SomeWorkflow3Options
+	if len(options) > 0 {
+		o = options[0]
 	}
-	if opts.WorkflowExecutionTimeout == 0 {
-		opts.WorkflowRunTimeout = 3600000000000 // 1h0m0s
+	opts, err := o.Build(req)
+	if err != nil {
+		return nil, err
 	}
+	c.interceptors.BeforeSomeWorkflow3(ctx, req)
+	ctx, span := tracing.Start(ctx, "mycompany.simple.Simple", "StartWorkflow:"+SomeWorkflow3WorkflowName, tracing.WorkflowNameKey.String(SomeWorkflow3WorkflowName), tracing.WorkflowIDKey.String(opts.ID))
 	run, err := c.client.ExecuteWorkflow(ctx, *opts, SomeWorkflow3WorkflowName, req)
+	tracing.End(span, err)
 	if err != nil {
+		c.interceptors.AfterSomeWorkflow3(ctx, err)
 		return nil, err
 	}
 	if run == nil {
@@ -324,6 +805,63 @@ func (c *simpleClient) GetSomeWorkflow3(ctx context.Context, workflowID string,
 	}
 }
 
+// CreateSomeWorkflow3Schedule creates a Temporal Schedule that periodically starts a(n) mycompany.simple.Simple.SomeWorkflow3 workflow
+func (c *simpleClient) CreateSomeWorkflow3Schedule(ctx context.Context, id string, spec client.ScheduleSpec, req *SomeWorkflow3Request, options ...*SomeWorkflow3ScheduleOptions) (client.ScheduleHandle, error) {
+	var o *SomeWorkflow3ScheduleOptions
+	if len(options) > 0 {
+		o = options[0]
+	}
+	opts, err := o.Build(id, spec, req)
+	if err != nil {
+		return nil, err
+	}
+	return c.client.ScheduleClient().Create(ctx, *opts)
+}
+
+// GetSomeWorkflow3Schedule describes an existing mycompany.simple.Simple.SomeWorkflow3 workflow schedule
+func (c *simpleClient) GetSomeWorkflow3Schedule(ctx context.Context, id string) (*client.ScheduleDescription, error) {
+	desc, err := c.client.ScheduleClient().GetHandle(ctx, id).Describe(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if action, ok := desc.Schedule.Action.(*client.ScheduleWorkflowAction); !ok || action.Workflow != SomeWorkflow3WorkflowName {
+		return nil, fmt.Errorf("schedule %q does not start a(n) %s workflow", id, SomeWorkflow3WorkflowName)
+	}
+	return desc, nil
+}
+
+// PauseSomeWorkflow3Schedule pauses an existing mycompany.simple.Simple.SomeWorkflow3 workflow schedule with an optional note, failing if the schedule starts a different workflow
+func (c *simpleClient) PauseSomeWorkflow3Schedule(ctx context.Context, id string, note string) error {
+	if _, err := c.GetSomeWorkflow3Schedule(ctx, id); err != nil {
+		return err
+	}
+	return c.client.ScheduleClient().GetHandle(ctx, id).Pause(ctx, client.SchedulePauseOptions{Note: note})
+}
+
+// UnpauseSomeWorkflow3Schedule unpauses an existing mycompany.simple.Simple.SomeWorkflow3 workflow schedule with an optional note, failing if the schedule starts a different workflow
+func (c *simpleClient) UnpauseSomeWorkflow3Schedule(ctx context.Context, id string, note string) error {
+	if _, err := c.GetSomeWorkflow3Schedule(ctx, id); err != nil {
+		return err
+	}
+	return c.client.ScheduleClient().GetHandle(ctx, id).Unpause(ctx, client.ScheduleUnpauseOptions{Note: note})
+}
+
+// TriggerSomeWorkflow3Schedule immediately starts a(n) mycompany.simple.Simple.SomeWorkflow3 workflow using an existing schedule, failing if the schedule starts a different workflow
+func (c *simpleClient) TriggerSomeWorkflow3Schedule(ctx context.Context, id string) error {
+	if _, err := c.GetSomeWorkflow3Schedule(ctx, id); err != nil {
+		return err
+	}
+	return c.client.ScheduleClient().GetHandle(ctx, id).Trigger(ctx, client.ScheduleTriggerOptions{})
+}
+
+// DeleteSomeWorkflow3Schedule deletes an existing mycompany.simple.Simple.SomeWorkflow3 workflow schedule, failing if the schedule starts a different workflow
+func (c *simpleClient) DeleteSomeWorkflow3Schedule(ctx context.Context, id string) error {
+	if _, err := c.GetSomeWorkflow3Schedule(ctx, id); err != nil {
+		return err
+	}
+	return c.client.ScheduleClient().GetHandle(ctx, id).Delete(ctx)
+}
+
 // SomeWorkflow3WithSomeSignal2 starts a(n) mycompany.simple.Simple.SomeWorkflow3 workflow and sends a(n) mycompany.simple.Simple.SomeSignal2 signal in a transaction
 func (c *simpleClient) SomeWorkflow3WithSomeSignal2(ctx context.Context, req *SomeWorkflow3Request, signal *SomeSignal2Request, options ...*SomeWorkflow3Options) error {
 	run, err := c.SomeWorkflow3WithSomeSignal2Async(ctx, req, signal, options...)
@@ -335,34 +873,158 @@ func (c *simpleClient) SomeWorkflow3WithSomeSignal2(ctx context.Context, req *So
 
 // SomeWorkflow3WithSomeSignal2Async starts a(n) mycompany.simple.Simple.SomeWorkflow3 workflow and sends a(n) mycompany.simple.Simple.SomeSignal2 signal in a transaction
 func (c *simpleClient) SomeWorkflow3WithSomeSignal2Async(ctx context.Context, req *SomeWorkflow3Request, signal *SomeSignal2Request, options ...*SomeWorkflow3Options) (SomeWorkflow3Run, error) {
-	opts := &client.StartWorkflowOptions{}
-	if len(options) > 0 && options[0].opts != nil {
-		opts = options[0].opts
+	var o *SomeWorkflow3Options
+	if len(options) > 0 {
+		o = options[0]
 	}
-	if opts.TaskQueue == "" {
-		opts.TaskQueue = "my-task-queue-2"
+	opts, err := o.Build(req)
+	if err != nil {
+		return nil, err
 	}
-	if opts.ID == "" {
-		id, err := expression.EvalExpression(SomeWorkflow3IDExpression, req.ProtoReflect())
-		if err != nil {
-			return nil, err
-		}
-		opts.ID = id
+	c.interceptors.BeforeSomeWorkflow3(ctx, req)
+	c.interceptors.OnSomeSignal2(ctx, signal)
+	ctx, span := tracing.Start(ctx, "mycompany.simple.Simple", "SignalWithStartWorkflow:"+SomeWorkflow3WorkflowName, tracing.WorkflowNameKey.String(SomeWorkflow3WorkflowName), tracing.SignalNameKey.String(SomeSignal2SignalName), tracing.WorkflowIDKey.String(opts.ID))
+	run, err := c.client.SignalWithStartWorkflow(ctx, opts.ID, SomeSignal2SignalName, signal, *opts, SomeWorkflow3WorkflowName, req)
+	tracing.End(span, err)
+	if run == nil || err != nil {
+		c.interceptors.AfterSomeWorkflow3(ctx, err)
+		return nil, err
 	}
-	if opts.WorkflowIDReusePolicy == v1.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
-		opts.WorkflowIDReusePolicy = v1.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
+	return &someWorkflow3Run{
+		client: c,
+		run:    run,
+	}, nil
+}
+
+// SomeWorkflow4 executes a mycompany.simple.SomeWorkflow4 workflow and blocks until error or response received
+func (c *simpleClient) SomeWorkflow4(ctx context.Context, options ...*SomeWorkflow4Options) error {
+	run, err := c.SomeWorkflow4Async(ctx, options...)
+	if err != nil {
+		return err
 	}
-	if opts.RetryPolicy == nil {
-		opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(2)}
+	return run.Get(ctx)
+}
+
+// SomeWorkflow4Async starts a(n) mycompany.simple.SomeWorkflow4 workflow
+func (c *simpleClient) SomeWorkflow4Async(ctx context.Context, options ...*SomeWorkflow4Options) (SomeWorkflow4Run, error) {
+	var o *SomeWorkflow4Options
+	if len(options) > 0 {
+		o = options[0]
 	}
-	if opts.WorkflowExecutionTimeout == 0 {
-		opts.WorkflowRunTimeout = 3600000000000 // 1h0m0s
+	opts, err := o.Build()
+	if err != nil {
+		return nil, err
 	}
-	run, err := c.client.SignalWithStartWorkflow(ctx, opts.ID, SomeSignal2SignalName, signal, *opts, SomeWorkflow3WorkflowName, req)
+	c.interceptors.BeforeSomeWorkflow4(ctx)
+	ctx, span := tracing.Start(ctx, "mycompany.simple.Simple", "StartWorkflow:"+SomeWorkflow4WorkflowName, tracing.WorkflowNameKey.String(SomeWorkflow4WorkflowName), tracing.WorkflowIDKey.String(opts.ID))
+	run, err := c.client.ExecuteWorkflow(ctx, *opts, SomeWorkflow4WorkflowName)
+	tracing.End(span, err)
+	if err != nil {
+		c.interceptors.AfterSomeWorkflow4(ctx, err)
+		return nil, err
+	}
+	if run == nil {
+		return nil, errors.New("execute workflow returned nil run")
+	}
+	return &someWorkflow4Run{
+		client: c,
+		run:    run,
+	}, nil
+}
+
+// GetSomeWorkflow4 fetches an existing mycompany.simple.SomeWorkflow4 execution
+func (c *simpleClient) GetSomeWorkflow4(ctx context.Context, workflowID string, runID string) SomeWorkflow4Run {
+	return &someWorkflow4Run{
+		client: c,
+		run:    c.client.GetWorkflow(ctx, workflowID, runID),
+	}
+}
+
+// CreateSomeWorkflow4Schedule creates a Temporal Schedule that periodically starts a(n) mycompany.simple.SomeWorkflow4 workflow
+func (c *simpleClient) CreateSomeWorkflow4Schedule(ctx context.Context, id string, spec client.ScheduleSpec, options ...*SomeWorkflow4ScheduleOptions) (client.ScheduleHandle, error) {
+	var o *SomeWorkflow4ScheduleOptions
+	if len(options) > 0 {
+		o = options[0]
+	}
+	opts, err := o.Build(id, spec)
+	if err != nil {
+		return nil, err
+	}
+	return c.client.ScheduleClient().Create(ctx, *opts)
+}
+
+// GetSomeWorkflow4Schedule describes an existing mycompany.simple.SomeWorkflow4 workflow schedule
+func (c *simpleClient) GetSomeWorkflow4Schedule(ctx context.Context, id string) (*client.ScheduleDescription, error) {
+	desc, err := c.client.ScheduleClient().GetHandle(ctx, id).Describe(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if action, ok := desc.Schedule.Action.(*client.ScheduleWorkflowAction); !ok || action.Workflow != SomeWorkflow4WorkflowName {
+		return nil, fmt.Errorf("schedule %q does not start a(n) %s workflow", id, SomeWorkflow4WorkflowName)
+	}
+	return desc, nil
+}
+
+// PauseSomeWorkflow4Schedule pauses an existing mycompany.simple.SomeWorkflow4 workflow schedule with an optional note, failing if the schedule starts a different workflow
+func (c *simpleClient) PauseSomeWorkflow4Schedule(ctx context.Context, id string, note string) error {
+	if _, err := c.GetSomeWorkflow4Schedule(ctx, id); err != nil {
+		return err
+	}
+	return c.client.ScheduleClient().GetHandle(ctx, id).Pause(ctx, client.SchedulePauseOptions{Note: note})
+}
+
+// UnpauseSomeWorkflow4Schedule unpauses an existing mycompany.simple.SomeWorkflow4 workflow schedule with an optional note, failing if the schedule starts a different workflow
+func (c *simpleClient) UnpauseSomeWorkflow4Schedule(ctx context.Context, id string, note string) error {
+	if _, err := c.GetSomeWorkflow4Schedule(ctx, id); err != nil {
+		return err
+	}
+	return c.client.ScheduleClient().GetHandle(ctx, id).Unpause(ctx, client.ScheduleUnpauseOptions{Note: note})
+}
+
+// TriggerSomeWorkflow4Schedule immediately starts a(n) mycompany.simple.SomeWorkflow4 workflow using an existing schedule, failing if the schedule starts a different workflow
+func (c *simpleClient) TriggerSomeWorkflow4Schedule(ctx context.Context, id string) error {
+	if _, err := c.GetSomeWorkflow4Schedule(ctx, id); err != nil {
+		return err
+	}
+	return c.client.ScheduleClient().GetHandle(ctx, id).Trigger(ctx, client.ScheduleTriggerOptions{})
+}
+
+// DeleteSomeWorkflow4Schedule deletes an existing mycompany.simple.SomeWorkflow4 workflow schedule, failing if the schedule starts a different workflow
+func (c *simpleClient) DeleteSomeWorkflow4Schedule(ctx context.Context, id string) error {
+	if _, err := c.GetSomeWorkflow4Schedule(ctx, id); err != nil {
+		return err
+	}
+	return c.client.ScheduleClient().GetHandle(ctx, id).Delete(ctx)
+}
+
+// SomeWorkflow4WithSetValue starts a(n) mycompany.simple.SomeWorkflow4 workflow and sends a(n) mycompany.simple.common.Common.SetValue signal in a transaction
+func (c *simpleClient) SomeWorkflow4WithSetValue(ctx context.Context, signal *common.SetValueRequest, options ...*SomeWorkflow4Options) error {
+	run, err := c.SomeWorkflow4WithSetValueAsync(ctx, signal, options...)
+	if err != nil {
+		return err
+	}
+	return run.Get(ctx)
+}
+
+// SomeWorkflow4WithSetValueAsync starts a(n) mycompany.simple.SomeWorkflow4 workflow and sends a(n) mycompany.simple.common.Common.SetValue signal in a transaction
+func (c *simpleClient) SomeWorkflow4WithSetValueAsync(ctx context.Context, signal *common.SetValueRequest, options ...*SomeWorkflow4Options) (SomeWorkflow4Run, error) {
+	var o *SomeWorkflow4Options
+	if len(options) > 0 {
+		o = options[0]
+	}
+	opts, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+	c.interceptors.BeforeSomeWorkflow4(ctx)
+	ctx, span := tracing.Start(ctx, "mycompany.simple.Simple", "SignalWithStartWorkflow:"+SomeWorkflow4WorkflowName, tracing.WorkflowNameKey.String(SomeWorkflow4WorkflowName), tracing.SignalNameKey.String(common.SetValueSignalName), tracing.WorkflowIDKey.String(opts.ID))
+	run, err := c.client.SignalWithStartWorkflow(ctx, opts.ID, common.SetValueSignalName, signal, *opts, SomeWorkflow4WorkflowName)
+	tracing.End(span, err)
 	if run == nil || err != nil {
+		c.interceptors.AfterSomeWorkflow4(ctx, err)
 		return nil, err
 	}
-	return &someWorkflow3Run{
+	return &someWorkflow4Run{
 		client: c,
 		run:    run,
 	}, nil
@@ -370,34 +1032,58 @@ func (c *simpleClient) SomeWorkflow3WithSomeSignal2Async(ctx context.Context, re
 
 // SomeQuery1 sends a(n) mycompany.simple.Simple.SomeQuery1 query to an existing workflow
 func (c *simpleClient) SomeQuery1(ctx context.Context, workflowID string, runID string) (*SomeQuery1Response, error) {
+	c.interceptors.BeforeSomeQuery1(ctx)
+	ctx, span := tracing.Start(ctx, "mycompany.simple.Simple", "QueryWorkflow:"+SomeQuery1QueryName, tracing.QueryNameKey.String(SomeQuery1QueryName), tracing.WorkflowIDKey.String(workflowID))
 	var resp SomeQuery1Response
 	if val, err := c.client.QueryWorkflow(ctx, workflowID, runID, SomeQuery1QueryName); err != nil {
+		tracing.End(span, err)
+		c.interceptors.AfterSomeQuery1(ctx, nil, err)
 		return nil, err
 	} else if err = val.Get(&resp); err != nil {
+		tracing.End(span, err)
+		c.interceptors.AfterSomeQuery1(ctx, nil, err)
 		return nil, err
 	}
+	tracing.End(span, nil)
+	c.interceptors.AfterSomeQuery1(ctx, &resp, nil)
 	return &resp, nil
 }
 
 // SomeQuery2 sends a(n) mycompany.simple.Simple.SomeQuery2 query to an existing workflow
 func (c *simpleClient) SomeQuery2(ctx context.Context, workflowID string, runID string, query *SomeQuery2Request) (*SomeQuery2Response, error) {
+	c.interceptors.BeforeSomeQuery2(ctx, query)
+	ctx, span := tracing.Start(ctx, "mycompany.simple.Simple", "QueryWorkflow:"+SomeQuery2QueryName, tracing.Attributes(SomeQuery2SpanAttributesMapping, query, tracing.QueryNameKey.String(SomeQuery2QueryName), tracing.WorkflowIDKey.String(workflowID))...)
 	var resp SomeQuery2Response
 	if val, err := c.client.QueryWorkflow(ctx, workflowID, runID, SomeQuery2QueryName, query); err != nil {
+		tracing.End(span, err)
+		c.interceptors.AfterSomeQuery2(ctx, nil, err)
 		return nil, err
 	} else if err = val.Get(&resp); err != nil {
+		tracing.End(span, err)
+		c.interceptors.AfterSomeQuery2(ctx, nil, err)
 		return nil, err
 	}
+	tracing.End(span, nil)
+	c.interceptors.AfterSomeQuery2(ctx, &resp, nil)
 	return &resp, nil
 }
 
 // SomeSignal1 sends a(n) mycompany.simple.Simple.SomeSignal1 signal to an existing workflow
 func (c *simpleClient) SomeSignal1(ctx context.Context, workflowID string, runID string) error {
-	return c.client.SignalWorkflow(ctx, workflowID, runID, SomeSignal1SignalName, nil)
+	c.interceptors.OnSomeSignal1(ctx)
+	ctx, span := tracing.Start(ctx, "mycompany.simple.Simple", "SignalWorkflow:"+SomeSignal1SignalName, tracing.SignalNameKey.String(SomeSignal1SignalName), tracing.WorkflowIDKey.String(workflowID))
+	err := c.client.SignalWorkflow(ctx, workflowID, runID, SomeSignal1SignalName, nil)
+	tracing.End(span, err)
+	return err
 }
 
 // SomeSignal2 sends a(n) mycompany.simple.Simple.SomeSignal2 signal to an existing workflow
 func (c *simpleClient) SomeSignal2(ctx context.Context, workflowID string, runID string, signal *SomeSignal2Request) error {
-	return c.client.SignalWorkflow(ctx, workflowID, runID, SomeSignal2SignalName, signal)
+	c.interceptors.OnSomeSignal2(ctx, signal)
+	ctx, span := tracing.Start(ctx, "mycompany.simple.Simple", "SignalWorkflow:"+SomeSignal2SignalName, tracing.Attributes(SomeSignal2SpanAttributesMapping, signal, tracing.SignalNameKey.String(SomeSignal2SignalName), tracing.WorkflowIDKey.String(workflowID))...)
+	err := c.client.SignalWorkflow(ctx, workflowID, runID, SomeSignal2SignalName, signal)
+	tracing.End(span, err)
+	return err
 }
 
 // SomeUpdate1 sends a(n) mycompany.simple.Simple.SomeUpdate1 update to an existing workflow
@@ -406,7 +1092,7 @@ func (c *simpleClient) SomeUpdate1(ctx context.Context, workflowID string, runID
 	if len(opts) > 0 && opts[0].opts != nil {
 		options = opts[0]
 	}
-	options.opts.WaitPolicy = &v11.WaitPolicy{LifecycleStage: v1.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED}
+	options.opts.WaitPolicy = &v1.WaitPolicy{LifecycleStage: v11.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED}
 	handle, err := c.SomeUpdate1Async(ctx, workflowID, runID, req, options)
 	if err != nil {
 		return nil, err
@@ -416,34 +1102,40 @@ func (c *simpleClient) SomeUpdate1(ctx context.Context, workflowID string, runID
 
 // SomeUpdate1Async sends a(n) mycompany.simple.Simple.SomeUpdate1 update to an existing workflow
 func (c *simpleClient) SomeUpdate1Async(ctx context.Context, workflowID string, runID string, req *SomeUpdate1Request, opts ...*SomeUpdate1Options) (SomeUpdate1Handle, error) {
-	options := &client.UpdateWorkflowWithOptionsRequest{}
-	if len(opts) > 0 && opts[0].opts != nil {
-		options = opts[0].opts
-	}
-	options.Args = []any{req}
-	options.RunID = runID
-	options.UpdateName = SomeUpdate1UpdateName
-	options.WorkflowID = workflowID
-	if options.UpdateID == "" {
-		id, err := expression.EvalExpression(SomeUpdate1IDExpression, req.ProtoReflect())
-		if err != nil {
-			return nil, fmt.Errorf("error evaluating %s id expression: %w", SomeUpdate1UpdateName, err)
-		}
-		options.UpdateID = id
+	var o *SomeUpdate1Options
+	if len(opts) > 0 {
+		o = opts[0]
 	}
-	if options.WaitPolicy.GetLifecycleStage() == v1.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_UNSPECIFIED {
-		options.WaitPolicy = &v11.WaitPolicy{LifecycleStage: v1.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED}
+	options, err := o.Build(workflowID, runID, req)
+	if err != nil {
+		return nil, err
 	}
+	c.interceptors.BeforeSomeUpdate1(ctx, req)
+	ctx, span := tracing.Start(ctx, "mycompany.simple.Simple", "UpdateWorkflow:"+SomeUpdate1UpdateName, tracing.Attributes(SomeUpdate1SpanAttributesMapping, req, tracing.UpdateNameKey.String(SomeUpdate1UpdateName), tracing.WorkflowIDKey.String(workflowID))...)
 	handle, err := c.client.UpdateWorkflowWithOptions(ctx, options)
+	tracing.End(span, err)
 	if err != nil {
+		c.interceptors.AfterSomeUpdate1(ctx, nil, err)
 		return nil, err
 	}
 	return &someUpdate1Handle{client: c, handle: handle}, nil
 }
 
-// SomeWorkflow1Options provides configuration for a mycompany.simple.Simple.SomeWorkflow1 workflow operation
+// CompleteSomeActivity3 completes a(n) mycompany.simple.Simple.SomeActivity3 activity that returned activity.ErrResultPending, identified by its task token
+func (c *simpleClient) CompleteSomeActivity3(ctx context.Context, taskToken []byte, resp *SomeActivity3Response, err error) error {
+	return c.client.CompleteActivity(ctx, taskToken, resp, err)
+}
+
+// CompleteSomeActivity3ByID completes a(n) mycompany.simple.Simple.SomeActivity3 activity that returned activity.ErrResultPending, identified by its activity ID
+func (c *simpleClient) CompleteSomeActivity3ByID(ctx context.Context, namespace string, workflowID string, runID string, activityID string, resp *SomeActivity3Response, err error) error {
+	return c.client.CompleteActivityByID(ctx, namespace, workflowID, runID, activityID, resp, err)
+}
+
+// SomeWorkflow1Options provides configuration for a mycompany.simple.SomeWorkflow1 workflow operation
 type SomeWorkflow1Options struct {
-	opts *client.StartWorkflowOptions
+	opts         *client.StartWorkflowOptions
+	cronSchedule *string
+	memo         map[string]any
 }
 
 // NewSomeWorkflow1Options initializes a new SomeWorkflow1Options value
@@ -457,12 +1149,127 @@ func (opts *SomeWorkflow1Options) WithStartWorkflowOptions(options client.StartW
 	return opts
 }
 
-// SomeWorkflow1Run describes a(n) mycompany.simple.Simple.SomeWorkflow1 workflow run
+// WithCronSchedule overrides the default cron schedule, an empty schedule starts a non-cron workflow
+func (opts *SomeWorkflow1Options) WithCronSchedule(schedule string) *SomeWorkflow1Options {
+	opts.cronSchedule = &schedule
+	return opts
+}
+
+// WithMemo overrides the default workflow memo
+func (opts *SomeWorkflow1Options) WithMemo(memo map[string]any) *SomeWorkflow1Options {
+	opts.memo = memo
+	return opts
+}
+
+// Build initializes a client.StartWorkflowOptions with defaults for a(n) mycompany.simple.SomeWorkflow1 workflow operation
+func (o *SomeWorkflow1Options) Build(req *SomeWorkflow1Request) (*client.StartWorkflowOptions, error) {
+	opts := &client.StartWorkflowOptions{}
+	if o != nil && o.opts != nil {
+		opts = o.opts
+	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = SimpleTaskQueue
+	}
+	if o != nil && o.cronSchedule != nil {
+		opts.CronSchedule = *o.cronSchedule
+	}
+	if opts.ID == "" {
+		id, err := expression.EvalExpression(SomeWorkflow1IDExpression, req.ProtoReflect())
+		if err != nil {
+			return nil, err
+		}
+		opts.ID = id
+	}
+	if o != nil && o.memo != nil {
+		opts.Memo = o.memo
+	}
+	if opts.Memo == nil {
+		structured, err := expression.ToStructured(req.ProtoReflect())
+		if err != nil {
+			return nil, fmt.Errorf("error serializing input for \"SomeWorkflow1\" memo mapping: %v", err)
+		}
+		result, err := SomeWorkflow1MemoMapping.Query(structured)
+		if err != nil {
+			return nil, fmt.Errorf("error executing \"SomeWorkflow1\" memo mapping: %v", err)
+		}
+		values, ok := result.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected \"SomeWorkflow1\" memo mapping to return map[string]any, got: %T", result)
+		}
+		opts.Memo = values
+	}
+	return opts, nil
+}
+
+// SomeWorkflow1ScheduleOptions provides configuration for a mycompany.simple.SomeWorkflow1 workflow schedule
+type SomeWorkflow1ScheduleOptions struct {
+	opts         *client.ScheduleOptions
+	workflowOpts *SomeWorkflow1Options
+}
+
+// NewSomeWorkflow1ScheduleOptions initializes a new SomeWorkflow1ScheduleOptions value
+func NewSomeWorkflow1ScheduleOptions() *SomeWorkflow1ScheduleOptions {
+	return &SomeWorkflow1ScheduleOptions{}
+}
+
+// WithScheduleOptions sets the initial client.ScheduleOptions
+func (opts *SomeWorkflow1ScheduleOptions) WithScheduleOptions(options client.ScheduleOptions) *SomeWorkflow1ScheduleOptions {
+	opts.opts = &options
+	return opts
+}
+
+// WithWorkflowOptions sets the options used to initialize the scheduled workflow action
+func (opts *SomeWorkflow1ScheduleOptions) WithWorkflowOptions(options *SomeWorkflow1Options) *SomeWorkflow1ScheduleOptions {
+	opts.workflowOpts = options
+	return opts
+}
+
+// Build initializes a client.ScheduleOptions with defaults for a(n) mycompany.simple.SomeWorkflow1 workflow schedule
+func (o *SomeWorkflow1ScheduleOptions) Build(id string, spec client.ScheduleSpec, req *SomeWorkflow1Request) (*client.ScheduleOptions, error) {
+	opts := &client.ScheduleOptions{}
+	if o != nil && o.opts != nil {
+		v := *o.opts
+		opts = &v
+	}
+	opts.ID = id
+	opts.Spec = spec
+	var workflowOpts *SomeWorkflow1Options
+	if o != nil {
+		workflowOpts = o.workflowOpts
+	}
+	wopts, err := workflowOpts.Build(req)
+	if err != nil {
+		return nil, err
+	}
+	opts.Action = &client.ScheduleWorkflowAction{
+		ID:                       wopts.ID,
+		Workflow:                 SomeWorkflow1WorkflowName,
+		Args:                     []any{req},
+		TaskQueue:                wopts.TaskQueue,
+		WorkflowExecutionTimeout: wopts.WorkflowExecutionTimeout,
+		WorkflowRunTimeout:       wopts.WorkflowRunTimeout,
+		WorkflowTaskTimeout:      wopts.WorkflowTaskTimeout,
+		RetryPolicy:              wopts.RetryPolicy,
+		Memo:                     wopts.Memo,
+		SearchAttributes:         wopts.SearchAttributes,
+	}
+	return opts, nil
+}
+
+// SomeWorkflow1Memo describes the memo of a(n) mycompany.simple.SomeWorkflow1 workflow, with a field for each key produced by its memo mapping
+type SomeWorkflow1Memo struct {
+	// RequestVal is the decoded "requestVal" memo value
+	RequestVal any
+}
+
+// SomeWorkflow1Run describes a(n) mycompany.simple.SomeWorkflow1 workflow run
 type SomeWorkflow1Run interface {
 	// ID returns the workflow ID
 	ID() string
 	// RunID returns the workflow instance ID
 	RunID() string
+	// Memo describes the workflow execution and returns its decoded memo, which is the result of the workflow's memo mapping unless overridden via WithMemo
+	Memo(ctx context.Context) (*SomeWorkflow1Memo, error)
 	// Get blocks until the workflow is complete and returns the result
 	Get(ctx context.Context) (*SomeWorkflow1Response, error)
 	/*
@@ -499,12 +1306,30 @@ func (r *someWorkflow1Run) RunID() string {
 	return r.run.GetRunID()
 }
 
+// Memo describes the workflow execution and returns its decoded memo, which is the result of the workflow's memo mapping unless overridden via WithMemo
+func (r *someWorkflow1Run) Memo(ctx context.Context) (*SomeWorkflow1Memo, error) {
+	resp, err := r.client.client.DescribeWorkflowExecution(ctx, r.ID(), r.RunID())
+	if err != nil {
+		return nil, err
+	}
+	fields := resp.GetWorkflowExecutionInfo().GetMemo().GetFields()
+	memo := &SomeWorkflow1Memo{}
+	if payload, ok := fields["requestVal"]; ok {
+		if err := r.client.dataConverter.FromPayload(payload, &memo.RequestVal); err != nil {
+			return nil, fmt.Errorf("error decoding \"requestVal\" memo: %w", err)
+		}
+	}
+	return memo, nil
+}
+
 // Get blocks until the workflow is complete, returning the result if applicable
 func (r *someWorkflow1Run) Get(ctx context.Context) (*SomeWorkflow1Response, error) {
 	var resp SomeWorkflow1Response
 	if err := r.run.Get(ctx, &resp); err != nil {
+		r.client.interceptors.AfterSomeWorkflow1(ctx, nil, err)
 		return nil, err
 	}
+	r.client.interceptors.AfterSomeWorkflow1(ctx, &resp, nil)
 	return &resp, nil
 }
 
@@ -528,9 +1353,11 @@ func (r *someWorkflow1Run) SomeSignal2(ctx context.Context, req *SomeSignal2Requ
 	return r.client.SomeSignal2(ctx, r.ID(), "", req)
 }
 
-// SomeWorkflow2Options provides configuration for a mycompany.simple.Simple.SomeWorkflow2 workflow operation
+// SomeWorkflow2Options provides configuration for a mycompany.simple.SomeWorkflow2 workflow operation
 type SomeWorkflow2Options struct {
-	opts *client.StartWorkflowOptions
+	opts         *client.StartWorkflowOptions
+	cronSchedule *string
+	memo         map[string]any
 }
 
 // NewSomeWorkflow2Options initializes a new SomeWorkflow2Options value
@@ -544,7 +1371,91 @@ func (opts *SomeWorkflow2Options) WithStartWorkflowOptions(options client.StartW
 	return opts
 }
 
-// SomeWorkflow2Run describes a(n) mycompany.simple.Simple.SomeWorkflow2 workflow run
+// WithCronSchedule overrides the default cron schedule, an empty schedule starts a non-cron workflow
+func (opts *SomeWorkflow2Options) WithCronSchedule(schedule string) *SomeWorkflow2Options {
+	opts.cronSchedule = &schedule
+	return opts
+}
+
+// WithMemo overrides the default workflow memo
+func (opts *SomeWorkflow2Options) WithMemo(memo map[string]any) *SomeWorkflow2Options {
+	opts.memo = memo
+	return opts
+}
+
+// Build initializes a client.StartWorkflowOptions with defaults for a(n) mycompany.simple.SomeWorkflow2 workflow operation
+func (o *SomeWorkflow2Options) Build() (*client.StartWorkflowOptions, error) {
+	opts := &client.StartWorkflowOptions{}
+	if o != nil && o.opts != nil {
+		opts = o.opts
+	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = SimpleTaskQueue
+	}
+	if o != nil && o.cronSchedule != nil {
+		opts.CronSchedule = *o.cronSchedule
+	}
+	if o != nil && o.memo != nil {
+		opts.Memo = o.memo
+	}
+	return opts, nil
+}
+
+// SomeWorkflow2ScheduleOptions provides configuration for a mycompany.simple.SomeWorkflow2 workflow schedule
+type SomeWorkflow2ScheduleOptions struct {
+	opts         *client.ScheduleOptions
+	workflowOpts *SomeWorkflow2Options
+}
+
+// NewSomeWorkflow2ScheduleOptions initializes a new SomeWorkflow2ScheduleOptions value
+func NewSomeWorkflow2ScheduleOptions() *SomeWorkflow2ScheduleOptions {
+	return &SomeWorkflow2ScheduleOptions{}
+}
+
+// WithScheduleOptions sets the initial client.ScheduleOptions
+func (opts *SomeWorkflow2ScheduleOptions) WithScheduleOptions(options client.ScheduleOptions) *SomeWorkflow2ScheduleOptions {
+	opts.opts = &options
+	return opts
+}
+
+// WithWorkflowOptions sets the options used to initialize the scheduled workflow action
+func (opts *SomeWorkflow2ScheduleOptions) WithWorkflowOptions(options *SomeWorkflow2Options) *SomeWorkflow2ScheduleOptions {
+	opts.workflowOpts = options
+	return opts
+}
+
+// Build initializes a client.ScheduleOptions with defaults for a(n) mycompany.simple.SomeWorkflow2 workflow schedule
+func (o *SomeWorkflow2ScheduleOptions) Build(id string, spec client.ScheduleSpec) (*client.ScheduleOptions, error) {
+	opts := &client.ScheduleOptions{}
+	if o != nil && o.opts != nil {
+		v := *o.opts
+		opts = &v
+	}
+	opts.ID = id
+	opts.Spec = spec
+	var workflowOpts *SomeWorkflow2Options
+	if o != nil {
+		workflowOpts = o.workflowOpts
+	}
+	wopts, err := workflowOpts.Build()
+	if err != nil {
+		return nil, err
+	}
+	opts.Action = &client.ScheduleWorkflowAction{
+		ID:                       wopts.ID,
+		Workflow:                 SomeWorkflow2WorkflowName,
+		TaskQueue:                wopts.TaskQueue,
+		WorkflowExecutionTimeout: wopts.WorkflowExecutionTimeout,
+		WorkflowRunTimeout:       wopts.WorkflowRunTimeout,
+		WorkflowTaskTimeout:      wopts.WorkflowTaskTimeout,
+		RetryPolicy:              wopts.RetryPolicy,
+		Memo:                     wopts.Memo,
+		SearchAttributes:         wopts.SearchAttributes,
+	}
+	return opts, nil
+}
+
+// SomeWorkflow2Run describes a(n) mycompany.simple.SomeWorkflow2 workflow run
 type SomeWorkflow2Run interface {
 	// ID returns the workflow ID
 	ID() string
@@ -582,7 +1493,9 @@ func (r *someWorkflow2Run) RunID() string {
 
 // Get blocks until the workflow is complete, returning the result if applicable
 func (r *someWorkflow2Run) Get(ctx context.Context) error {
-	return r.run.Get(ctx, nil)
+	err := r.run.Get(ctx, nil)
+	r.client.interceptors.AfterSomeWorkflow2(ctx, err)
+	return err
 }
 
 // SomeSignal1 sends a(n) mycompany.simple.Simple.SomeSignal1 signal
@@ -602,7 +1515,9 @@ func (r *someWorkflow2Run) SomeUpdate1Async(ctx context.Context, req *SomeUpdate
 
 // SomeWorkflow3Options provides configuration for a mycompany.simple.Simple.SomeWorkflow3 workflow operation
 type SomeWorkflow3Options struct {
-	opts *client.StartWorkflowOptions
+	opts         *client.StartWorkflowOptions
+	cronSchedule *string
+	memo         map[string]any
 }
 
 // NewSomeWorkflow3Options initializes a new SomeWorkflow3Options value
@@ -616,6 +1531,120 @@ func (opts *SomeWorkflow3Options) WithStartWorkflowOptions(options client.StartW
 	return opts
 }
 
+// WithCronSchedule overrides the default cron schedule, an empty schedule starts a non-cron workflow
+func (opts *SomeWorkflow3Options) WithCronSchedule(schedule string) *SomeWorkflow3Options {
+	opts.cronSchedule = &schedule
+	return opts
+}
+
+// WithMemo overrides the default workflow memo
+func (opts *SomeWorkflow3Options) WithMemo(memo map[string]any) *SomeWorkflow3Options {
+	opts.memo = memo
+	return opts
+}
+
+// Build initializes a client.StartWorkflowOptions with defaults for a(n) mycompany.simple.Simple.SomeWorkflow3 workflow operation
+func (o *SomeWorkflow3Options) Build(req *SomeWorkflow3Request) (*client.StartWorkflowOptions, error) {
+	opts := &client.StartWorkflowOptions{}
+	if o != nil && o.opts != nil {
+		opts = o.opts
+	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = "my-task-queue-2"
+	}
+	if o != nil && o.cronSchedule != nil {
+		opts.CronSchedule = *o.cronSchedule
+	} else if opts.CronSchedule == "" {
+		opts.CronSchedule = "0 3 * * *"
+	}
+	if opts.ID == "" {
+		id, err := expression.EvalExpression(SomeWorkflow3IDExpression, req.ProtoReflect())
+		if err != nil {
+			return nil, err
+		}
+		opts.ID = id
+	}
+	if opts.WorkflowIDReusePolicy == v11.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
+		opts.WorkflowIDReusePolicy = v11.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
+	}
+	if opts.RetryPolicy == nil {
+		opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(2)}
+	}
+	if opts.WorkflowExecutionTimeout == 0 {
+		opts.WorkflowRunTimeout = 3600000000000 // 1h0m0s
+	}
+	if o != nil && o.memo != nil {
+		opts.Memo = o.memo
+	}
+	return opts, nil
+}
+
+// SomeWorkflow3ScheduleOptions provides configuration for a mycompany.simple.Simple.SomeWorkflow3 workflow schedule
+type SomeWorkflow3ScheduleOptions struct {
+	opts         *client.ScheduleOptions
+	workflowOpts *SomeWorkflow3Options
+}
+
+// NewSomeWorkflow3ScheduleOptions initializes a new SomeWorkflow3ScheduleOptions value
+func NewSomeWorkflow3ScheduleOptions() *SomeWorkflow3ScheduleOptions {
+	return &SomeWorkflow3ScheduleOptions{}
+}
+
+// WithScheduleOptions sets the initial client.ScheduleOptions
+func (opts *SomeWorkflow3ScheduleOptions) WithScheduleOptions(options client.ScheduleOptions) *SomeWorkflow3ScheduleOptions {
+	opts.opts = &options
+	return opts
+}
+
+// WithWorkflowOptions sets the options used to initialize the scheduled workflow action
+func (opts *SomeWorkflow3ScheduleOptions) WithWorkflowOptions(options *SomeWorkflow3Options) *SomeWorkflow3ScheduleOptions {
+	opts.workflowOpts = options
+	return opts
+}
+
+// Build initializes a client.ScheduleOptions with defaults for a(n) mycompany.simple.Simple.SomeWorkflow3 workflow schedule
+func (o *SomeWorkflow3ScheduleOptions) Build(id string, spec client.ScheduleSpec, req *SomeWorkflow3Request) (*client.ScheduleOptions, error) {
+	opts := &client.ScheduleOptions{}
+	if o != nil && o.opts != nil {
+		v := *o.opts
+		opts = &v
+	}
+	opts.ID = id
+	opts.Spec = spec
+	if len(opts.Spec.Calendars) == 0 && len(opts.Spec.CronExpressions) == 0 && len(opts.Spec.Intervals) == 0 {
+		opts.Spec.Intervals = []client.ScheduleIntervalSpec{
+			{Every: 3600000000000}, // 1h0m0s
+		}
+	}
+	if opts.Overlap == v11.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED {
+		opts.Overlap = v11.SCHEDULE_OVERLAP_POLICY_BUFFER_ONE
+	}
+	if opts.CatchupWindow == 0 {
+		opts.CatchupWindow = 300000000000 // 5m0s
+	}
+	var workflowOpts *SomeWorkflow3Options
+	if o != nil {
+		workflowOpts = o.workflowOpts
+	}
+	wopts, err := workflowOpts.Build(req)
+	if err != nil {
+		return nil, err
+	}
+	opts.Action = &client.ScheduleWorkflowAction{
+		ID:                       wopts.ID,
+		Workflow:                 SomeWorkflow3WorkflowName,
+		Args:                     []any{req},
+		TaskQueue:                wopts.TaskQueue,
+		WorkflowExecutionTimeout: wopts.WorkflowExecutionTimeout,
+		WorkflowRunTimeout:       wopts.WorkflowRunTimeout,
+		WorkflowTaskTimeout:      wopts.WorkflowTaskTimeout,
+		RetryPolicy:              wopts.RetryPolicy,
+		Memo:                     wopts.Memo,
+		SearchAttributes:         wopts.SearchAttributes,
+	}
+	return opts, nil
+}
+
 // SomeWorkflow3Run describes a(n) mycompany.simple.Simple.SomeWorkflow3 workflow run
 type SomeWorkflow3Run interface {
 	// ID returns the workflow ID
@@ -648,7 +1677,9 @@ func (r *someWorkflow3Run) RunID() string {
 
 // Get blocks until the workflow is complete, returning the result if applicable
 func (r *someWorkflow3Run) Get(ctx context.Context) error {
-	return r.run.Get(ctx, nil)
+	err := r.run.Get(ctx, nil)
+	r.client.interceptors.AfterSomeWorkflow3(ctx, err)
+	return err
 }
 
 // SomeSignal2 sends a(n) mycompany.simple.Simple.SomeSignal2 signal
@@ -656,22 +1687,191 @@ func (r *someWorkflow3Run) SomeSignal2(ctx context.Context, req *SomeSignal2Requ
 	return r.client.SomeSignal2(ctx, r.ID(), "", req)
 }
 
-// SomeUpdate1Handle describes a(n) mycompany.simple.Simple.SomeUpdate1 update handle
-type SomeUpdate1Handle interface {
-	// WorkflowID returns the workflow ID
-	WorkflowID() string
-	// RunID returns the workflow instance ID
-	RunID() string
-	// UpdateID returns the update ID
-	UpdateID() string
-	// Get blocks until the workflow is complete and returns the result
-	Get(ctx context.Context) (*SomeUpdate1Response, error)
+// SomeWorkflow4Options provides configuration for a mycompany.simple.SomeWorkflow4 workflow operation
+type SomeWorkflow4Options struct {
+	opts         *client.StartWorkflowOptions
+	cronSchedule *string
+	memo         map[string]any
 }
 
-// someUpdate1Handle provides an internal implementation of a(n) SomeUpdate1Handle
-type someUpdate1Handle struct {
-	client *simpleClient
-	handle client.WorkflowUpdateHandle
+// NewSomeWorkflow4Options initializes a new SomeWorkflow4Options value
+func NewSomeWorkflow4Options() *SomeWorkflow4Options {
+	return &SomeWorkflow4Options{}
+}
+
+// WithStartWorkflowOptions sets the initial client.StartWorkflowOptions
+func (opts *SomeWorkflow4Options) WithStartWorkflowOptions(options client.StartWorkflowOptions) *SomeWorkflow4Options {
+	opts.opts = &options
+	return opts
+}
+
+// WithCronSchedule overrides the default cron schedule, an empty schedule starts a non-cron workflow
+func (opts *SomeWorkflow4Options) WithCronSchedule(schedule string) *SomeWorkflow4Options {
+	opts.cronSchedule = &schedule
+	return opts
+}
+
+// WithMemo overrides the default workflow memo
+func (opts *SomeWorkflow4Options) WithMemo(memo map[string]any) *SomeWorkflow4Options {
+	opts.memo = memo
+	return opts
+}
+
+// Build initializes a client.StartWorkflowOptions with defaults for a(n) mycompany.simple.SomeWorkflow4 workflow operation
+func (o *SomeWorkflow4Options) Build() (*client.StartWorkflowOptions, error) {
+	opts := &client.StartWorkflowOptions{}
+	if o != nil && o.opts != nil {
+		opts = o.opts
+	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = SimpleTaskQueue
+	}
+	if o != nil && o.cronSchedule != nil {
+		opts.CronSchedule = *o.cronSchedule
+	}
+	if o != nil && o.memo != nil {
+		opts.Memo = o.memo
+	}
+	return opts, nil
+}
+
+// SomeWorkflow4ScheduleOptions provides configuration for a mycompany.simple.SomeWorkflow4 workflow schedule
+type SomeWorkflow4ScheduleOptions struct {
+	opts         *client.ScheduleOptions
+	workflowOpts *SomeWorkflow4Options
+}
+
+// NewSomeWorkflow4ScheduleOptions initializes a new SomeWorkflow4ScheduleOptions value
+func NewSomeWorkflow4ScheduleOptions() *SomeWorkflow4ScheduleOptions {
+	return &SomeWorkflow4ScheduleOptions{}
+}
+
+// WithScheduleOptions sets the initial client.ScheduleOptions
+func (opts *SomeWorkflow4ScheduleOptions) WithScheduleOptions(options client.ScheduleOptions) *SomeWorkflow4ScheduleOptions {
+	opts.opts = &options
+	return opts
+}
+
+// WithWorkflowOptions sets the options used to initialize the scheduled workflow action
+func (opts *SomeWorkflow4ScheduleOptions) WithWorkflowOptions(options *SomeWorkflow4Options) *SomeWorkflow4ScheduleOptions {
+	opts.workflowOpts = options
+	return opts
+}
+
+// Build initializes a client.ScheduleOptions with defaults for a(n) mycompany.simple.SomeWorkflow4 workflow schedule
+func (o *SomeWorkflow4ScheduleOptions) Build(id string, spec client.ScheduleSpec) (*client.ScheduleOptions, error) {
+	opts := &client.ScheduleOptions{}
+	if o != nil && o.opts != nil {
+		v := *o.opts
+		opts = &v
+	}
+	opts.ID = id
+	opts.Spec = spec
+	var workflowOpts *SomeWorkflow4Options
+	if o != nil {
+		workflowOpts = o.workflowOpts
+	}
+	wopts, err := workflowOpts.Build()
+	if err != nil {
+		return nil, err
+	}
+	opts.Action = &client.ScheduleWorkflowAction{
+		ID:                       wopts.ID,
+		Workflow:                 SomeWorkflow4WorkflowName,
+		TaskQueue:                wopts.TaskQueue,
+		WorkflowExecutionTimeout: wopts.WorkflowExecutionTimeout,
+		WorkflowRunTimeout:       wopts.WorkflowRunTimeout,
+		WorkflowTaskTimeout:      wopts.WorkflowTaskTimeout,
+		RetryPolicy:              wopts.RetryPolicy,
+		Memo:                     wopts.Memo,
+		SearchAttributes:         wopts.SearchAttributes,
+	}
+	return opts, nil
+}
+
+// SomeWorkflow4Run describes a(n) mycompany.simple.SomeWorkflow4 workflow run
+type SomeWorkflow4Run interface {
+	// ID returns the workflow ID
+	ID() string
+	// RunID returns the workflow instance ID
+	RunID() string
+	// Get blocks until the workflow is complete and returns the result
+	Get(ctx context.Context) error
+	/*
+	   GetValue returns the current value.
+	*/
+	GetValue(ctx context.Context) (*common.GetValueResponse, error)
+	/*
+	   SetValue sets the current value.
+	*/
+	SetValue(ctx context.Context, req *common.SetValueRequest) error
+	/*
+	   UpdateValue updates the current value and returns the previous value.
+	*/
+	UpdateValue(ctx context.Context, req *common.UpdateValueRequest, opts ...*common.UpdateValueOptions) (*common.UpdateValueResponse, error)
+	// UpdateValueAsync sends a(n) mycompany.simple.common.Common.UpdateValue update to the workflow
+	UpdateValueAsync(ctx context.Context, req *common.UpdateValueRequest, opts ...*common.UpdateValueOptions) (common.UpdateValueHandle, error)
+}
+
+// someWorkflow4Run provides an internal implementation of a(n) SomeWorkflow4RunRun
+type someWorkflow4Run struct {
+	client *simpleClient
+	run    client.WorkflowRun
+}
+
+// ID returns the workflow ID
+func (r *someWorkflow4Run) ID() string {
+	return r.run.GetID()
+}
+
+// RunID returns the execution ID
+func (r *someWorkflow4Run) RunID() string {
+	return r.run.GetRunID()
+}
+
+// Get blocks until the workflow is complete, returning the result if applicable
+func (r *someWorkflow4Run) Get(ctx context.Context) error {
+	err := r.run.Get(ctx, nil)
+	r.client.interceptors.AfterSomeWorkflow4(ctx, err)
+	return err
+}
+
+// GetValue executes a(n) mycompany.simple.common.Common.GetValue query
+func (r *someWorkflow4Run) GetValue(ctx context.Context) (*common.GetValueResponse, error) {
+	return common.NewCommonClient(r.client.client).GetValue(ctx, r.ID(), "")
+}
+
+// SetValue sends a(n) mycompany.simple.common.Common.SetValue signal
+func (r *someWorkflow4Run) SetValue(ctx context.Context, req *common.SetValueRequest) error {
+	return common.NewCommonClient(r.client.client).SetValue(ctx, r.ID(), "", req)
+}
+
+// UpdateValue executes a(n) mycompany.simple.common.Common.UpdateValue update
+func (r *someWorkflow4Run) UpdateValue(ctx context.Context, req *common.UpdateValueRequest, opts ...*common.UpdateValueOptions) (*common.UpdateValueResponse, error) {
+	return common.NewCommonClient(r.client.client).UpdateValue(ctx, r.ID(), r.RunID(), req, opts...)
+}
+
+// UpdateValueAsync sends a(n) mycompany.simple.common.Common.UpdateValue update to the workflow
+func (r *someWorkflow4Run) UpdateValueAsync(ctx context.Context, req *common.UpdateValueRequest, opts ...*common.UpdateValueOptions) (common.UpdateValueHandle, error) {
+	return common.NewCommonClient(r.client.client).UpdateValueAsync(ctx, r.ID(), r.RunID(), req, opts...)
+}
+
+// SomeUpdate1Handle describes a(n) mycompany.simple.Simple.SomeUpdate1 update handle
+type SomeUpdate1Handle interface {
+	// WorkflowID returns the workflow ID
+	WorkflowID() string
+	// RunID returns the workflow instance ID
+	RunID() string
+	// UpdateID returns the update ID
+	UpdateID() string
+	// Get blocks until the workflow is complete and returns the result
+	Get(ctx context.Context) (*SomeUpdate1Response, error)
+}
+
+// someUpdate1Handle provides an internal implementation of a(n) SomeUpdate1Handle
+type someUpdate1Handle struct {
+	client *simpleClient
+	handle client.WorkflowUpdateHandle
 }
 
 // WorkflowID returns the workflow ID
@@ -693,8 +1893,10 @@ func (h *someUpdate1Handle) UpdateID() string {
 func (h *someUpdate1Handle) Get(ctx context.Context) (*SomeUpdate1Response, error) {
 	var resp SomeUpdate1Response
 	if err := h.handle.Get(ctx, &resp); err != nil {
+		h.client.interceptors.AfterSomeUpdate1(ctx, nil, err)
 		return nil, err
 	}
+	h.client.interceptors.AfterSomeUpdate1(ctx, &resp, nil)
 	return &resp, nil
 }
 
@@ -714,6 +1916,29 @@ func (opts *SomeUpdate1Options) WithUpdateWorkflowOptions(options client.UpdateW
 	return opts
 }
 
+// Build initializes a client.UpdateWorkflowWithOptionsRequest with defaults for a(n) mycompany.simple.Simple.SomeUpdate1 update operation
+func (o *SomeUpdate1Options) Build(workflowID string, runID string, req *SomeUpdate1Request) (*client.UpdateWorkflowWithOptionsRequest, error) {
+	options := &client.UpdateWorkflowWithOptionsRequest{}
+	if o != nil && o.opts != nil {
+		options = o.opts
+	}
+	options.Args = []any{req}
+	options.RunID = runID
+	options.UpdateName = SomeUpdate1UpdateName
+	options.WorkflowID = workflowID
+	if options.UpdateID == "" {
+		id, err := expression.EvalExpression(SomeUpdate1IDExpression, req.ProtoReflect())
+		if err != nil {
+			return nil, fmt.Errorf("error evaluating %s id expression: %w", SomeUpdate1UpdateName, err)
+		}
+		options.UpdateID = id
+	}
+	if options.WaitPolicy.GetLifecycleStage() == v11.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_UNSPECIFIED {
+		options.WaitPolicy = &v1.WaitPolicy{LifecycleStage: v11.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED}
+	}
+	return options, nil
+}
+
 // Reference to generated workflow functions
 var (
 	// SomeWorkflow1 does some workflow thing.
@@ -722,6 +1947,8 @@ var (
 	SomeWorkflow2Function func(workflow.Context) error
 	// SomeWorkflow3 does some workflow thing.
 	SomeWorkflow3Function func(workflow.Context, *SomeWorkflow3Request) error
+	// SomeWorkflow4 does some workflow thing using queries, signals, and updates defined by another package.
+	SomeWorkflow4Function func(workflow.Context) error
 )
 
 // SimpleWorkflows provides methods for initializing new mycompany.simple.Simple workflow values
@@ -729,44 +1956,371 @@ type SimpleWorkflows interface {
 	SomeWorkflow1(ctx workflow.Context, input *SomeWorkflow1Input) (SomeWorkflow1Workflow, error)
 	SomeWorkflow2(ctx workflow.Context, input *SomeWorkflow2Input) (SomeWorkflow2Workflow, error)
 	SomeWorkflow3(ctx workflow.Context, input *SomeWorkflow3Input) (SomeWorkflow3Workflow, error)
+	SomeWorkflow4(ctx workflow.Context, input *SomeWorkflow4Input) (SomeWorkflow4Workflow, error)
 }
 
 // SomeWorkflow1 does some workflow thing.
 // SomeWorkflow2 does some workflow thing.
 // SomeWorkflow3 does some workflow thing.
-// RegisterSimpleWorkflows registers mycompany.simple.Simple workflows with the given worker
-func RegisterSimpleWorkflows(r worker.Registry, workflows SimpleWorkflows) {
-	RegisterSomeWorkflow1Workflow(r, workflows.SomeWorkflow1)
-	RegisterSomeWorkflow2Workflow(r, workflows.SomeWorkflow2)
-	RegisterSomeWorkflow3Workflow(r, workflows.SomeWorkflow3)
+// SomeWorkflow4 does some workflow thing using queries, signals, and updates defined by another package.
+// RegisterSimpleWorkflows registers mycompany.simple.Simple workflows with the given worker, invoking the hooks
+// of the given interceptors
+func RegisterSimpleWorkflows(r worker.WorkflowRegistry, workflows SimpleWorkflows, interceptors ...SimpleInterceptor) {
+	RegisterSomeWorkflow1Workflow(r, workflows.SomeWorkflow1, interceptors...)
+	RegisterSomeWorkflow2Workflow(r, workflows.SomeWorkflow2, interceptors...)
+	RegisterSomeWorkflow3Workflow(r, workflows.SomeWorkflow3, interceptors...)
+	RegisterSomeWorkflow4Workflow(r, workflows.SomeWorkflow4, interceptors...)
+}
+
+// SimpleInterceptor describes typed hooks invoked around mycompany.simple.Simple workflows, queries, signals, updates,
+// and activities executed by a worker, which can be installed via the RegisterSimpleWorkflows,
+// Register<Workflow>Workflow, RegisterSimpleActivities, and Register<Activity>Activity functions.
+// Queries, signals, and updates defined by other services are not intercepted, and workflow,
+// signal, and update hooks are not invoked while a workflow is replaying history.
+type SimpleInterceptor interface {
+	// BeforeSomeWorkflow1 is invoked with the input of a(n) mycompany.simple.SomeWorkflow1 workflow before it executes
+	BeforeSomeWorkflow1(ctx workflow.Context, req *SomeWorkflow1Request)
+	// AfterSomeWorkflow1 is invoked with the result of a(n) mycompany.simple.SomeWorkflow1 workflow after it executes
+	AfterSomeWorkflow1(ctx workflow.Context, resp *SomeWorkflow1Response, err error)
+	// BeforeSomeWorkflow2 is invoked with the input of a(n) mycompany.simple.SomeWorkflow2 workflow before it executes
+	BeforeSomeWorkflow2(ctx workflow.Context)
+	// AfterSomeWorkflow2 is invoked with the result of a(n) mycompany.simple.SomeWorkflow2 workflow after it executes
+	AfterSomeWorkflow2(ctx workflow.Context, err error)
+	// BeforeSomeWorkflow3 is invoked with the input of a(n) mycompany.simple.Simple.SomeWorkflow3 workflow before it executes
+	BeforeSomeWorkflow3(ctx workflow.Context, req *SomeWorkflow3Request)
+	// AfterSomeWorkflow3 is invoked with the result of a(n) mycompany.simple.Simple.SomeWorkflow3 workflow after it executes
+	AfterSomeWorkflow3(ctx workflow.Context, err error)
+	// BeforeSomeWorkflow4 is invoked with the input of a(n) mycompany.simple.SomeWorkflow4 workflow before it executes
+	BeforeSomeWorkflow4(ctx workflow.Context)
+	// AfterSomeWorkflow4 is invoked with the result of a(n) mycompany.simple.SomeWorkflow4 workflow after it executes
+	AfterSomeWorkflow4(ctx workflow.Context, err error)
+	// BeforeSomeQuery1 is invoked with the input of a(n) mycompany.simple.Simple.SomeQuery1 query before it is handled
+	BeforeSomeQuery1(ctx workflow.Context)
+	// AfterSomeQuery1 is invoked with the result of a(n) mycompany.simple.Simple.SomeQuery1 query after it is handled
+	AfterSomeQuery1(ctx workflow.Context, resp *SomeQuery1Response, err error)
+	// BeforeSomeQuery2 is invoked with the input of a(n) mycompany.simple.Simple.SomeQuery2 query before it is handled
+	BeforeSomeQuery2(ctx workflow.Context, req *SomeQuery2Request)
+	// AfterSomeQuery2 is invoked with the result of a(n) mycompany.simple.Simple.SomeQuery2 query after it is handled
+	AfterSomeQuery2(ctx workflow.Context, resp *SomeQuery2Response, err error)
+	// OnSomeSignal1 is invoked with each mycompany.simple.Simple.SomeSignal1 signal received by a workflow
+	OnSomeSignal1(ctx workflow.Context)
+	// OnSomeSignal2 is invoked with each mycompany.simple.Simple.SomeSignal2 signal received by a workflow
+	OnSomeSignal2(ctx workflow.Context, req *SomeSignal2Request)
+	// BeforeSomeUpdate1 is invoked with the input of a(n) mycompany.simple.Simple.SomeUpdate1 update before it is handled
+	BeforeSomeUpdate1(ctx workflow.Context, req *SomeUpdate1Request)
+	// AfterSomeUpdate1 is invoked with the result of a(n) mycompany.simple.Simple.SomeUpdate1 update after it is handled
+	AfterSomeUpdate1(ctx workflow.Context, resp *SomeUpdate1Response, err error)
+	// BeforeSomeActivity1 is invoked with the input of a(n) mycompany.simple.SomeActivity1 activity before it executes
+	BeforeSomeActivity1(ctx context.Context)
+	// AfterSomeActivity1 is invoked with the result of a(n) mycompany.simple.SomeActivity1 activity after it executes
+	AfterSomeActivity1(ctx context.Context, err error)
+	// BeforeSomeActivity2 is invoked with the input of a(n) mycompany.simple.Simple.SomeActivity2 activity before it executes
+	BeforeSomeActivity2(ctx context.Context, req *SomeActivity2Request)
+	// AfterSomeActivity2 is invoked with the result of a(n) mycompany.simple.Simple.SomeActivity2 activity after it executes
+	AfterSomeActivity2(ctx context.Context, err error)
+	// BeforeSomeActivity3 is invoked with the input of a(n) mycompany.simple.Simple.SomeActivity3 activity before it executes
+	BeforeSomeActivity3(ctx context.Context, req *SomeActivity3Request)
+	// AfterSomeActivity3 is invoked with the result of a(n) mycompany.simple.Simple.SomeActivity3 activity after it executes
+	AfterSomeActivity3(ctx context.Context, resp *SomeActivity3Response, err error)
+}
+
+// NoopSimpleInterceptor provides a no-op SimpleInterceptor implementation that can be embedded
+// to implement a subset of hooks
+type NoopSimpleInterceptor struct{}
+
+// BeforeSomeWorkflow1 implements SimpleInterceptor
+func (NoopSimpleInterceptor) BeforeSomeWorkflow1(workflow.Context, *SomeWorkflow1Request) {}
+
+// AfterSomeWorkflow1 implements SimpleInterceptor
+func (NoopSimpleInterceptor) AfterSomeWorkflow1(workflow.Context, *SomeWorkflow1Response, error) {}
+
+// BeforeSomeWorkflow2 implements SimpleInterceptor
+func (NoopSimpleInterceptor) BeforeSomeWorkflow2(workflow.Context) {}
+
+// AfterSomeWorkflow2 implements SimpleInterceptor
+func (NoopSimpleInterceptor) AfterSomeWorkflow2(workflow.Context, error) {}
+
+// BeforeSomeWorkflow3 implements SimpleInterceptor
+func (NoopSimpleInterceptor) BeforeSomeWorkflow3(workflow.Context, *SomeWorkflow3Request) {}
+
+// AfterSomeWorkflow3 implements SimpleInterceptor
+func (NoopSimpleInterceptor) AfterSomeWorkflow3(workflow.Context, error) {}
+
+// BeforeSomeWorkflow4 implements SimpleInterceptor
+func (NoopSimpleInterceptor) BeforeSomeWorkflow4(workflow.Context) {}
+
+// AfterSomeWorkflow4 implements SimpleInterceptor
+func (NoopSimpleInterceptor) AfterSomeWorkflow4(workflow.Context, error) {}
+
+// BeforeSomeQuery1 implements SimpleInterceptor
+func (NoopSimpleInterceptor) BeforeSomeQuery1(workflow.Context) {}
+
+// AfterSomeQuery1 implements SimpleInterceptor
+func (NoopSimpleInterceptor) AfterSomeQuery1(workflow.Context, *SomeQuery1Response, error) {}
+
+// BeforeSomeQuery2 implements SimpleInterceptor
+func (NoopSimpleInterceptor) BeforeSomeQuery2(workflow.Context, *SomeQuery2Request) {}
+
+// AfterSomeQuery2 implements SimpleInterceptor
+func (NoopSimpleInterceptor) AfterSomeQuery2(workflow.Context, *SomeQuery2Response, error) {}
+
+// OnSomeSignal1 implements SimpleInterceptor
+func (NoopSimpleInterceptor) OnSomeSignal1(workflow.Context) {}
+
+// OnSomeSignal2 implements SimpleInterceptor
+func (NoopSimpleInterceptor) OnSomeSignal2(workflow.Context, *SomeSignal2Request) {}
+
+// BeforeSomeUpdate1 implements SimpleInterceptor
+func (NoopSimpleInterceptor) BeforeSomeUpdate1(workflow.Context, *SomeUpdate1Request) {}
+
+// AfterSomeUpdate1 implements SimpleInterceptor
+func (NoopSimpleInterceptor) AfterSomeUpdate1(workflow.Context, *SomeUpdate1Response, error) {}
+
+// BeforeSomeActivity1 implements SimpleInterceptor
+func (NoopSimpleInterceptor) BeforeSomeActivity1(context.Context) {}
+
+// AfterSomeActivity1 implements SimpleInterceptor
+func (NoopSimpleInterceptor) AfterSomeActivity1(context.Context, error) {}
+
+// BeforeSomeActivity2 implements SimpleInterceptor
+func (NoopSimpleInterceptor) BeforeSomeActivity2(context.Context, *SomeActivity2Request) {}
+
+// AfterSomeActivity2 implements SimpleInterceptor
+func (NoopSimpleInterceptor) AfterSomeActivity2(context.Context, error) {}
+
+// BeforeSomeActivity3 implements SimpleInterceptor
+func (NoopSimpleInterceptor) BeforeSomeActivity3(context.Context, *SomeActivity3Request) {}
+
+// AfterSomeActivity3 implements SimpleInterceptor
+func (NoopSimpleInterceptor) AfterSomeActivity3(context.Context, *SomeActivity3Response, error) {}
+
+// simpleInterceptors invokes the hooks of multiple SimpleInterceptor values, in order for
+// Before<Method> and On<Method> hooks and in reverse order for After<Method> hooks
+type simpleInterceptors []SimpleInterceptor
+
+// BeforeSomeWorkflow1 implements SimpleInterceptor
+func (c simpleInterceptors) BeforeSomeWorkflow1(ctx workflow.Context, req *SomeWorkflow1Request) {
+	for _, interceptor := range c {
+		interceptor.BeforeSomeWorkflow1(ctx, req)
+	}
+}
+
+// AfterSomeWorkflow1 implements SimpleInterceptor
+func (c simpleInterceptors) AfterSomeWorkflow1(ctx workflow.Context, resp *SomeWorkflow1Response, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterSomeWorkflow1(ctx, resp, err)
+	}
+}
+
+// BeforeSomeWorkflow2 implements SimpleInterceptor
+func (c simpleInterceptors) BeforeSomeWorkflow2(ctx workflow.Context) {
+	for _, interceptor := range c {
+		interceptor.BeforeSomeWorkflow2(ctx)
+	}
+}
+
+// AfterSomeWorkflow2 implements SimpleInterceptor
+func (c simpleInterceptors) AfterSomeWorkflow2(ctx workflow.Context, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterSomeWorkflow2(ctx, err)
+	}
+}
+
+// BeforeSomeWorkflow3 implements SimpleInterceptor
+func (c simpleInterceptors) BeforeSomeWorkflow3(ctx workflow.Context, req *SomeWorkflow3Request) {
+	for _, interceptor := range c {
+		interceptor.BeforeSomeWorkflow3(ctx, req)
+	}
+}
+
+// AfterSomeWorkflow3 implements SimpleInterceptor
+func (c simpleInterceptors) AfterSomeWorkflow3(ctx workflow.Context, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterSomeWorkflow3(ctx, err)
+	}
+}
+
+// BeforeSomeWorkflow4 implements SimpleInterceptor
+func (c simpleInterceptors) BeforeSomeWorkflow4(ctx workflow.Context) {
+	for _, interceptor := range c {
+		interceptor.BeforeSomeWorkflow4(ctx)
+	}
+}
+
+// AfterSomeWorkflow4 implements SimpleInterceptor
+func (c simpleInterceptors) AfterSomeWorkflow4(ctx workflow.Context, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterSomeWorkflow4(ctx, err)
+	}
+}
+
+// BeforeSomeQuery1 implements SimpleInterceptor
+func (c simpleInterceptors) BeforeSomeQuery1(ctx workflow.Context) {
+	for _, interceptor := range c {
+		interceptor.BeforeSomeQuery1(ctx)
+	}
+}
+
+// AfterSomeQuery1 implements SimpleInterceptor
+func (c simpleInterceptors) AfterSomeQuery1(ctx workflow.Context, resp *SomeQuery1Response, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterSomeQuery1(ctx, resp, err)
+	}
+}
+
+// BeforeSomeQuery2 implements SimpleInterceptor
+func (c simpleInterceptors) BeforeSomeQuery2(ctx workflow.Context, req *SomeQuery2Request) {
+	for _, interceptor := range c {
+		interceptor.BeforeSomeQuery2(ctx, req)
+	}
+}
+
+// AfterSomeQuery2 implements SimpleInterceptor
+func (c simpleInterceptors) AfterSomeQuery2(ctx workflow.Context, resp *SomeQuery2Response, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterSomeQuery2(ctx, resp, err)
+	}
+}
+
+// OnSomeSignal1 implements SimpleInterceptor
+func (c simpleInterceptors) OnSomeSignal1(ctx workflow.Context) {
+	for _, interceptor := range c {
+		interceptor.OnSomeSignal1(ctx)
+	}
+}
+
+// OnSomeSignal2 implements SimpleInterceptor
+func (c simpleInterceptors) OnSomeSignal2(ctx workflow.Context, req *SomeSignal2Request) {
+	for _, interceptor := range c {
+		interceptor.OnSomeSignal2(ctx, req)
+	}
+}
+
+// BeforeSomeUpdate1 implements SimpleInterceptor
+func (c simpleInterceptors) BeforeSomeUpdate1(ctx workflow.Context, req *SomeUpdate1Request) {
+	for _, interceptor := range c {
+		interceptor.BeforeSomeUpdate1(ctx, req)
+	}
+}
+
+// AfterSomeUpdate1 implements SimpleInterceptor
+func (c simpleInterceptors) AfterSomeUpdate1(ctx workflow.Context, resp *SomeUpdate1Response, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterSomeUpdate1(ctx, resp, err)
+	}
+}
+
+// BeforeSomeActivity1 implements SimpleInterceptor
+func (c simpleInterceptors) BeforeSomeActivity1(ctx context.Context) {
+	for _, interceptor := range c {
+		interceptor.BeforeSomeActivity1(ctx)
+	}
+}
+
+// AfterSomeActivity1 implements SimpleInterceptor
+func (c simpleInterceptors) AfterSomeActivity1(ctx context.Context, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterSomeActivity1(ctx, err)
+	}
+}
+
+// BeforeSomeActivity2 implements SimpleInterceptor
+func (c simpleInterceptors) BeforeSomeActivity2(ctx context.Context, req *SomeActivity2Request) {
+	for _, interceptor := range c {
+		interceptor.BeforeSomeActivity2(ctx, req)
+	}
+}
+
+// AfterSomeActivity2 implements SimpleInterceptor
+func (c simpleInterceptors) AfterSomeActivity2(ctx context.Context, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterSomeActivity2(ctx, err)
+	}
+}
+
+// BeforeSomeActivity3 implements SimpleInterceptor
+func (c simpleInterceptors) BeforeSomeActivity3(ctx context.Context, req *SomeActivity3Request) {
+	for _, interceptor := range c {
+		interceptor.BeforeSomeActivity3(ctx, req)
+	}
+}
+
+// AfterSomeActivity3 implements SimpleInterceptor
+func (c simpleInterceptors) AfterSomeActivity3(ctx context.Context, resp *SomeActivity3Response, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterSomeActivity3(ctx, resp, err)
+	}
 }
 
-// RegisterSomeWorkflow1Workflow registers a mycompany.simple.Simple.SomeWorkflow1 workflow with the given worker
-func RegisterSomeWorkflow1Workflow(r worker.Registry, wf func(workflow.Context, *SomeWorkflow1Input) (SomeWorkflow1Workflow, error)) {
-	SomeWorkflow1Function = buildSomeWorkflow1(wf)
+// RegisterSomeWorkflow1Workflow registers a mycompany.simple.Simple.SomeWorkflow1 workflow with the given worker, invoking the hooks
+// of the given interceptors
+func RegisterSomeWorkflow1Workflow(r worker.WorkflowRegistry, wf func(workflow.Context, *SomeWorkflow1Input) (SomeWorkflow1Workflow, error), interceptors ...SimpleInterceptor) {
+	SomeWorkflow1Function = buildSomeWorkflow1(wf, interceptors...)
 	r.RegisterWorkflowWithOptions(SomeWorkflow1Function, workflow.RegisterOptions{Name: SomeWorkflow1WorkflowName})
 }
 
-// buildSomeWorkflow1 converts a SomeWorkflow1 workflow struct into a valid workflow function
-func buildSomeWorkflow1(ctor func(workflow.Context, *SomeWorkflow1Input) (SomeWorkflow1Workflow, error)) func(workflow.Context, *SomeWorkflow1Request) (*SomeWorkflow1Response, error) {
-	return func(ctx workflow.Context, req *SomeWorkflow1Request) (*SomeWorkflow1Response, error) {
+// buildSomeWorkflow1 converts a SomeWorkflow1 workflow struct into a valid workflow function that invokes
+// the hooks of the given interceptors
+func buildSomeWorkflow1(ctor func(workflow.Context, *SomeWorkflow1Input) (SomeWorkflow1Workflow, error), interceptors ...SimpleInterceptor) func(workflow.Context, *SomeWorkflow1Request) (*SomeWorkflow1Response, error) {
+	interceptor := simpleInterceptors(interceptors)
+	return func(ctx workflow.Context, req *SomeWorkflow1Request) (resp *SomeWorkflow1Response, err error) {
+		ctx, span := tracing.StartWorkflow(ctx, "mycompany.simple.Simple", "RunWorkflow:"+SomeWorkflow1WorkflowName, tracing.Attributes(SomeWorkflow1SpanAttributesMapping, req, tracing.WorkflowNameKey.String(SomeWorkflow1WorkflowName))...)
+		defer func() {
+			span.End(err)
+		}()
+		if !workflow.IsReplaying(ctx) {
+			interceptor.BeforeSomeWorkflow1(ctx, req)
+		}
+		defer func() {
+			if !workflow.IsReplaying(ctx) {
+				interceptor.AfterSomeWorkflow1(ctx, resp, err)
+			}
+		}()
 		input := &SomeWorkflow1Input{
 			Req: req,
 			SomeSignal1: &SomeSignal1Signal{
 				Channel: workflow.GetSignalChannel(ctx, SomeSignal1SignalName),
+				onReceive: func() {
+					_, span := tracing.StartWorkflow(ctx, "mycompany.simple.Simple", "HandleSignal:"+SomeSignal1SignalName, tracing.SignalNameKey.String(SomeSignal1SignalName))
+					span.End(nil)
+					if !workflow.IsReplaying(ctx) {
+						interceptor.OnSomeSignal1(ctx)
+					}
+				},
 			},
 			SomeSignal2: &SomeSignal2Signal{
 				Channel: workflow.GetSignalChannel(ctx, SomeSignal2SignalName),
+				onReceive: func(req *SomeSignal2Request) {
+					_, span := tracing.StartWorkflow(ctx, "mycompany.simple.Simple", "HandleSignal:"+SomeSignal2SignalName, tracing.Attributes(SomeSignal2SpanAttributesMapping, req, tracing.SignalNameKey.String(SomeSignal2SignalName))...)
+					span.End(nil)
+					if !workflow.IsReplaying(ctx) {
+						interceptor.OnSomeSignal2(ctx, req)
+					}
+				},
 			},
 		}
 		wf, err := ctor(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if err := workflow.SetQueryHandler(ctx, SomeQuery1QueryName, wf.SomeQuery1); err != nil {
+		if err := workflow.SetQueryHandler(ctx, SomeQuery1QueryName, func() (*SomeQuery1Response, error) {
+			ctx, span := tracing.StartWorkflow(ctx, "mycompany.simple.Simple", "HandleQuery:"+SomeQuery1QueryName, tracing.QueryNameKey.String(SomeQuery1QueryName))
+			interceptor.BeforeSomeQuery1(ctx)
+			resp, err := wf.SomeQuery1()
+			interceptor.AfterSomeQuery1(ctx, resp, err)
+			span.End(err)
+			return resp, err
+		}); err != nil {
 			return nil, err
 		}
-		if err := workflow.SetQueryHandler(ctx, SomeQuery2QueryName, wf.SomeQuery2); err != nil {
+		if err := workflow.SetQueryHandler(ctx, SomeQuery2QueryName, func(req *SomeQuery2Request) (*SomeQuery2Response, error) {
+			ctx, span := tracing.StartWorkflow(ctx, "mycompany.simple.Simple", "HandleQuery:"+SomeQuery2QueryName, tracing.Attributes(SomeQuery2SpanAttributesMapping, req, tracing.QueryNameKey.String(SomeQuery2QueryName))...)
+			interceptor.BeforeSomeQuery2(ctx, req)
+			resp, err := wf.SomeQuery2(req)
+			interceptor.AfterSomeQuery2(ctx, resp, err)
+			span.End(err)
+			return resp, err
+		}); err != nil {
 			return nil, err
 		}
 		return wf.Execute(ctx)
@@ -790,7 +2344,7 @@ type SomeWorkflow1Workflow interface {
 	SomeQuery2(*SomeQuery2Request) (*SomeQuery2Response, error)
 }
 
-// SomeWorkflow1Child executes a child mycompany.simple.Simple.SomeWorkflow1 workflow
+// SomeWorkflow1Child executes a child mycompany.simple.SomeWorkflow1 workflow
 func SomeWorkflow1Child(ctx workflow.Context, req *SomeWorkflow1Request, options ...*SomeWorkflow1ChildOptions) (*SomeWorkflow1Response, error) {
 	childRun, err := SomeWorkflow1ChildAsync(ctx, req, options...)
 	if err != nil {
@@ -799,7 +2353,7 @@ func SomeWorkflow1Child(ctx workflow.Context, req *SomeWorkflow1Request, options
 	return childRun.Get(ctx)
 }
 
-// SomeWorkflow1ChildAsync executes a child mycompany.simple.Simple.SomeWorkflow1 workflow
+// SomeWorkflow1ChildAsync executes a child mycompany.simple.SomeWorkflow1 workflow
 func SomeWorkflow1ChildAsync(ctx workflow.Context, req *SomeWorkflow1Request, options ...*SomeWorkflow1ChildOptions) (*SomeWorkflow1ChildRun, error) {
 	var opts *workflow.ChildWorkflowOptions
 	if len(options) > 0 && options[0].opts != nil {
@@ -818,11 +2372,26 @@ func SomeWorkflow1ChildAsync(ctx workflow.Context, req *SomeWorkflow1Request, op
 		}
 		opts.WorkflowID = id
 	}
+	if opts.Memo == nil {
+		structured, err := expression.ToStructured(req.ProtoReflect())
+		if err != nil {
+			return nil, fmt.Errorf("error serializing input for \"SomeWorkflow1\" memo mapping: %v", err)
+		}
+		result, err := SomeWorkflow1MemoMapping.Query(structured)
+		if err != nil {
+			return nil, fmt.Errorf("error executing \"SomeWorkflow1\" memo mapping: %v", err)
+		}
+		values, ok := result.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected \"SomeWorkflow1\" memo mapping to return map[string]any, got: %T", result)
+		}
+		opts.Memo = values
+	}
 	ctx = workflow.WithChildOptions(ctx, *opts)
 	return &SomeWorkflow1ChildRun{Future: workflow.ExecuteChildWorkflow(ctx, SomeWorkflow1WorkflowName, req)}, nil
 }
 
-// SomeWorkflow1ChildOptions provides configuration for a mycompany.simple.Simple.SomeWorkflow1 workflow operation
+// SomeWorkflow1ChildOptions provides configuration for a mycompany.simple.SomeWorkflow1 workflow operation
 type SomeWorkflow1ChildOptions struct {
 	opts *workflow.ChildWorkflowOptions
 }
@@ -899,18 +2468,40 @@ func (r *SomeWorkflow1ChildRun) SomeSignal2Async(ctx workflow.Context, input *So
 	return r.Future.SignalChildWorkflow(ctx, SomeSignal2SignalName, input)
 }
 
-// RegisterSomeWorkflow2Workflow registers a mycompany.simple.Simple.SomeWorkflow2 workflow with the given worker
-func RegisterSomeWorkflow2Workflow(r worker.Registry, wf func(workflow.Context, *SomeWorkflow2Input) (SomeWorkflow2Workflow, error)) {
-	SomeWorkflow2Function = buildSomeWorkflow2(wf)
+// RegisterSomeWorkflow2Workflow registers a mycompany.simple.Simple.SomeWorkflow2 workflow with the given worker, invoking the hooks
+// of the given interceptors
+func RegisterSomeWorkflow2Workflow(r worker.WorkflowRegistry, wf func(workflow.Context, *SomeWorkflow2Input) (SomeWorkflow2Workflow, error), interceptors ...SimpleInterceptor) {
+	SomeWorkflow2Function = buildSomeWorkflow2(wf, interceptors...)
 	r.RegisterWorkflowWithOptions(SomeWorkflow2Function, workflow.RegisterOptions{Name: SomeWorkflow2WorkflowName})
 }
 
-// buildSomeWorkflow2 converts a SomeWorkflow2 workflow struct into a valid workflow function
-func buildSomeWorkflow2(ctor func(workflow.Context, *SomeWorkflow2Input) (SomeWorkflow2Workflow, error)) func(workflow.Context) error {
-	return func(ctx workflow.Context) error {
+// buildSomeWorkflow2 converts a SomeWorkflow2 workflow struct into a valid workflow function that invokes
+// the hooks of the given interceptors
+func buildSomeWorkflow2(ctor func(workflow.Context, *SomeWorkflow2Input) (SomeWorkflow2Workflow, error), interceptors ...SimpleInterceptor) func(workflow.Context) error {
+	interceptor := simpleInterceptors(interceptors)
+	return func(ctx workflow.Context) (err error) {
+		ctx, span := tracing.StartWorkflow(ctx, "mycompany.simple.Simple", "RunWorkflow:"+SomeWorkflow2WorkflowName, tracing.WorkflowNameKey.String(SomeWorkflow2WorkflowName))
+		defer func() {
+			span.End(err)
+		}()
+		if !workflow.IsReplaying(ctx) {
+			interceptor.BeforeSomeWorkflow2(ctx)
+		}
+		defer func() {
+			if !workflow.IsReplaying(ctx) {
+				interceptor.AfterSomeWorkflow2(ctx, err)
+			}
+		}()
 		input := &SomeWorkflow2Input{
 			SomeSignal1: &SomeSignal1Signal{
 				Channel: workflow.GetSignalChannel(ctx, SomeSignal1SignalName),
+				onReceive: func() {
+					_, span := tracing.StartWorkflow(ctx, "mycompany.simple.Simple", "HandleSignal:"+SomeSignal1SignalName, tracing.SignalNameKey.String(SomeSignal1SignalName))
+					span.End(nil)
+					if !workflow.IsReplaying(ctx) {
+						interceptor.OnSomeSignal1(ctx)
+					}
+				},
 			},
 		}
 		wf, err := ctor(ctx, input)
@@ -919,7 +2510,19 @@ func buildSomeWorkflow2(ctor func(workflow.Context, *SomeWorkflow2Input) (SomeWo
 		}
 		{
 			opts := workflow.UpdateHandlerOptions{Validator: wf.ValidateSomeUpdate1}
-			if err := workflow.SetUpdateHandlerWithOptions(ctx, SomeUpdate1UpdateName, wf.SomeUpdate1, opts); err != nil {
+			if err := workflow.SetUpdateHandlerWithOptions(ctx, SomeUpdate1UpdateName, func(ctx workflow.Context, req *SomeUpdate1Request) (*SomeUpdate1Response, error) {
+				ctx = tracing.ContextWithSpan(ctx, span)
+				ctx, span := tracing.StartWorkflow(ctx, "mycompany.simple.Simple", "HandleUpdate:"+SomeUpdate1UpdateName, tracing.Attributes(SomeUpdate1SpanAttributesMapping, req, tracing.UpdateNameKey.String(SomeUpdate1UpdateName))...)
+				if !workflow.IsReplaying(ctx) {
+					interceptor.BeforeSomeUpdate1(ctx, req)
+				}
+				resp, err := wf.SomeUpdate1(ctx, req)
+				if !workflow.IsReplaying(ctx) {
+					interceptor.AfterSomeUpdate1(ctx, resp, err)
+				}
+				span.End(err)
+				return resp, err
+			}, opts); err != nil {
 				return err
 			}
 		}
@@ -942,7 +2545,7 @@ type SomeWorkflow2Workflow interface {
 	SomeUpdate1(workflow.Context, *SomeUpdate1Request) (*SomeUpdate1Response, error)
 }
 
-// SomeWorkflow2Child executes a child mycompany.simple.Simple.SomeWorkflow2 workflow
+// SomeWorkflow2Child executes a child mycompany.simple.SomeWorkflow2 workflow
 func SomeWorkflow2Child(ctx workflow.Context, options ...*SomeWorkflow2ChildOptions) error {
 	childRun, err := SomeWorkflow2ChildAsync(ctx, options...)
 	if err != nil {
@@ -951,7 +2554,7 @@ func SomeWorkflow2Child(ctx workflow.Context, options ...*SomeWorkflow2ChildOpti
 	return childRun.Get(ctx)
 }
 
-// SomeWorkflow2ChildAsync executes a child mycompany.simple.Simple.SomeWorkflow2 workflow
+// SomeWorkflow2ChildAsync executes a child mycompany.simple.SomeWorkflow2 workflow
 func SomeWorkflow2ChildAsync(ctx workflow.Context, options ...*SomeWorkflow2ChildOptions) (*SomeWorkflow2ChildRun, error) {
 	var opts *workflow.ChildWorkflowOptions
 	if len(options) > 0 && options[0].opts != nil {
@@ -967,7 +2570,7 @@ func SomeWorkflow2ChildAsync(ctx workflow.Context, options ...*SomeWorkflow2Chil
 	return &SomeWorkflow2ChildRun{Future: workflow.ExecuteChildWorkflow(ctx, SomeWorkflow2WorkflowName, nil)}, nil
 }
 
-// SomeWorkflow2ChildOptions provides configuration for a mycompany.simple.Simple.SomeWorkflow2 workflow operation
+// SomeWorkflow2ChildOptions provides configuration for a mycompany.simple.SomeWorkflow2 workflow operation
 type SomeWorkflow2ChildOptions struct {
 	opts *workflow.ChildWorkflowOptions
 }
@@ -1033,19 +2636,41 @@ func (r *SomeWorkflow2ChildRun) SomeSignal1Async(ctx workflow.Context) workflow.
 	return r.Future.SignalChildWorkflow(ctx, SomeSignal1SignalName, nil)
 }
 
-// RegisterSomeWorkflow3Workflow registers a mycompany.simple.Simple.SomeWorkflow3 workflow with the given worker
-func RegisterSomeWorkflow3Workflow(r worker.Registry, wf func(workflow.Context, *SomeWorkflow3Input) (SomeWorkflow3Workflow, error)) {
-	SomeWorkflow3Function = buildSomeWorkflow3(wf)
+// RegisterSomeWorkflow3Workflow registers a mycompany.simple.Simple.SomeWorkflow3 workflow with the given worker, invoking the hooks
+// of the given interceptors
+func RegisterSomeWorkflow3Workflow(r worker.WorkflowRegistry, wf func(workflow.Context, *SomeWorkflow3Input) (SomeWorkflow3Workflow, error), interceptors ...SimpleInterceptor) {
+	SomeWorkflow3Function = buildSomeWorkflow3(wf, interceptors...)
 	r.RegisterWorkflowWithOptions(SomeWorkflow3Function, workflow.RegisterOptions{Name: SomeWorkflow3WorkflowName})
 }
 
-// buildSomeWorkflow3 converts a SomeWorkflow3 workflow struct into a valid workflow function
-func buildSomeWorkflow3(ctor func(workflow.Context, *SomeWorkflow3Input) (SomeWorkflow3Workflow, error)) func(workflow.Context, *SomeWorkflow3Request) error {
-	return func(ctx workflow.Context, req *SomeWorkflow3Request) error {
+// buildSomeWorkflow3 converts a SomeWorkflow3 workflow struct into a valid workflow function that invokes
+// the hooks of the given interceptors
+func buildSomeWorkflow3(ctor func(workflow.Context, *SomeWorkflow3Input) (SomeWorkflow3Workflow, error), interceptors ...SimpleInterceptor) func(workflow.Context, *SomeWorkflow3Request) error {
+	interceptor := simpleInterceptors(interceptors)
+	return func(ctx workflow.Context, req *SomeWorkflow3Request) (err error) {
+		ctx, span := tracing.StartWorkflow(ctx, "mycompany.simple.Simple", "RunWorkflow:"+SomeWorkflow3WorkflowName, tracing.WorkflowNameKey.String(SomeWorkflow3WorkflowName))
+		defer func() {
+			span.End(err)
+		}()
+		if !workflow.IsReplaying(ctx) {
+			interceptor.BeforeSomeWorkflow3(ctx, req)
+		}
+		defer func() {
+			if !workflow.IsReplaying(ctx) {
+				interceptor.AfterSomeWorkflow3(ctx, err)
+			}
+		}()
 		input := &SomeWorkflow3Input{
 			Req: req,
 			SomeSignal2: &SomeSignal2Signal{
 				Channel: workflow.GetSignalChannel(ctx, SomeSignal2SignalName),
+				onReceive: func(req *SomeSignal2Request) {
+					_, span := tracing.StartWorkflow(ctx, "mycompany.simple.Simple", "HandleSignal:"+SomeSignal2SignalName, tracing.Attributes(SomeSignal2SpanAttributesMapping, req, tracing.SignalNameKey.String(SomeSignal2SignalName))...)
+					span.End(nil)
+					if !workflow.IsReplaying(ctx) {
+						interceptor.OnSomeSignal2(ctx, req)
+					}
+				},
 			},
 		}
 		wf, err := ctor(ctx, input)
@@ -1096,8 +2721,8 @@ func SomeWorkflow3ChildAsync(ctx workflow.Context, req *SomeWorkflow3Request, op
 		}
 		opts.WorkflowID = id
 	}
-	if opts.WorkflowIDReusePolicy == v1.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
-		opts.WorkflowIDReusePolicy = v1.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
+	if opts.WorkflowIDReusePolicy == v11.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
+		opts.WorkflowIDReusePolicy = v11.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
 	}
 	if opts.RetryPolicy == nil {
 		opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(2)}
@@ -1175,30 +2800,190 @@ func (r *SomeWorkflow3ChildRun) SomeSignal2Async(ctx workflow.Context, input *So
 	return r.Future.SignalChildWorkflow(ctx, SomeSignal2SignalName, input)
 }
 
-// SomeSignal1Signal describes a(n) mycompany.simple.Simple.SomeSignal1 signal
-type SomeSignal1Signal struct {
-	Channel workflow.ReceiveChannel
+// RegisterSomeWorkflow4Workflow registers a mycompany.simple.Simple.SomeWorkflow4 workflow with the given worker, invoking the hooks
+// of the given interceptors
+func RegisterSomeWorkflow4Workflow(r worker.WorkflowRegistry, wf func(workflow.Context, *SomeWorkflow4Input) (SomeWorkflow4Workflow, error), interceptors ...SimpleInterceptor) {
+	SomeWorkflow4Function = buildSomeWorkflow4(wf, interceptors...)
+	r.RegisterWorkflowWithOptions(SomeWorkflow4Function, workflow.RegisterOptions{Name: SomeWorkflow4WorkflowName})
+}
+
+// buildSomeWorkflow4 converts a SomeWorkflow4 workflow struct into a valid workflow function that invokes
+// the hooks of the given interceptors
+func buildSomeWorkflow4(ctor func(workflow.Context, *SomeWorkflow4Input) (SomeWorkflow4Workflow, error), interceptors ...SimpleInterceptor) func(workflow.Context) error {
+	interceptor := simpleInterceptors(interceptors)
+	return func(ctx workflow.Context) (err error) {
+		ctx, span := tracing.StartWorkflow(ctx, "mycompany.simple.Simple", "RunWorkflow:"+SomeWorkflow4WorkflowName, tracing.WorkflowNameKey.String(SomeWorkflow4WorkflowName))
+		defer func() {
+			span.End(err)
+		}()
+		if !workflow.IsReplaying(ctx) {
+			interceptor.BeforeSomeWorkflow4(ctx)
+		}
+		defer func() {
+			if !workflow.IsReplaying(ctx) {
+				interceptor.AfterSomeWorkflow4(ctx, err)
+			}
+		}()
+		input := &SomeWorkflow4Input{
+			SetValue: &common.SetValueSignal{
+				Channel: workflow.GetSignalChannel(ctx, common.SetValueSignalName),
+			},
+		}
+		wf, err := ctor(ctx, input)
+		if err != nil {
+			return err
+		}
+		if err := workflow.SetQueryHandler(ctx, common.GetValueQueryName, wf.GetValue); err != nil {
+			return err
+		}
+		{
+			opts := workflow.UpdateHandlerOptions{}
+			if err := workflow.SetUpdateHandlerWithOptions(ctx, common.UpdateValueUpdateName, wf.UpdateValue, opts); err != nil {
+				return err
+			}
+		}
+		return wf.Execute(ctx)
+	}
 }
 
-// Receive blocks until a(n) mycompany.simple.Simple.SomeSignal1 signal is received
-func (s *SomeSignal1Signal) Receive(ctx workflow.Context) bool {
-	more := s.Channel.Receive(ctx, nil)
-	return more
+// SomeWorkflow4Input describes the input to a(n) mycompany.simple.Simple.SomeWorkflow4 workflow constructor
+type SomeWorkflow4Input struct {
+	SetValue *common.SetValueSignal
 }
 
-// ReceiveAsync checks for a mycompany.simple.Simple.SomeSignal1 signal without blocking
-func (s *SomeSignal1Signal) ReceiveAsync() bool {
-	return s.Channel.ReceiveAsync(nil)
+// SomeWorkflow4 does some workflow thing using queries, signals, and updates defined by another package.
+type SomeWorkflow4Workflow interface {
+	// SomeWorkflow4 does some workflow thing using queries, signals, and updates defined by another package.
+	Execute(ctx workflow.Context) error
+	// GetValue returns the current value.
+	GetValue() (*common.GetValueResponse, error)
+	// UpdateValue updates the current value and returns the previous value.
+	UpdateValue(workflow.Context, *common.UpdateValueRequest) (*common.UpdateValueResponse, error)
 }
 
-// Select checks for a(n) mycompany.simple.Simple.SomeSignal1 signal without blocking
-func (s *SomeSignal1Signal) Select(sel workflow.Selector, fn func()) workflow.Selector {
-	return sel.AddReceive(s.Channel, func(workflow.ReceiveChannel, bool) {
-		s.ReceiveAsync()
-		if fn != nil {
-			fn()
-		}
-	})
+// SomeWorkflow4Child executes a child mycompany.simple.SomeWorkflow4 workflow
+func SomeWorkflow4Child(ctx workflow.Context, options ...*SomeWorkflow4ChildOptions) error {
+	childRun, err := SomeWorkflow4ChildAsync(ctx, options...)
+	if err != nil {
+		return err
+	}
+	return childRun.Get(ctx)
+}
+
+// SomeWorkflow4ChildAsync executes a child mycompany.simple.SomeWorkflow4 workflow
+func SomeWorkflow4ChildAsync(ctx workflow.Context, options ...*SomeWorkflow4ChildOptions) (*SomeWorkflow4ChildRun, error) {
+	var opts *workflow.ChildWorkflowOptions
+	if len(options) > 0 && options[0].opts != nil {
+		opts = options[0].opts
+	} else {
+		childOpts := workflow.GetChildWorkflowOptions(ctx)
+		opts = &childOpts
+	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = SimpleTaskQueue
+	}
+	ctx = workflow.WithChildOptions(ctx, *opts)
+	return &SomeWorkflow4ChildRun{Future: workflow.ExecuteChildWorkflow(ctx, SomeWorkflow4WorkflowName, nil)}, nil
+}
+
+// SomeWorkflow4ChildOptions provides configuration for a mycompany.simple.SomeWorkflow4 workflow operation
+type SomeWorkflow4ChildOptions struct {
+	opts *workflow.ChildWorkflowOptions
+}
+
+// NewSomeWorkflow4ChildOptions initializes a new SomeWorkflow4ChildOptions value
+func NewSomeWorkflow4ChildOptions() *SomeWorkflow4ChildOptions {
+	return &SomeWorkflow4ChildOptions{}
+}
+
+// WithStartWorkflowOptions sets the initial client.StartWorkflowOptions
+func (opts *SomeWorkflow4ChildOptions) WithStartWorkflowOptions(options workflow.ChildWorkflowOptions) *SomeWorkflow4ChildOptions {
+	opts.opts = &options
+	return opts
+}
+
+// SomeWorkflow4ChildRun describes a child mycompany.simple.Simple.SomeWorkflow4 workflow run
+type SomeWorkflow4ChildRun struct {
+	Future workflow.ChildWorkflowFuture
+}
+
+// Get blocks until the workflow is completed, returning the response value
+func (r *SomeWorkflow4ChildRun) Get(ctx workflow.Context) error {
+	if err := r.Future.Get(ctx, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Select adds this completion to the selector. Callback can be nil.
+func (r *SomeWorkflow4ChildRun) Select(sel workflow.Selector, fn func(SomeWorkflow4ChildRun)) workflow.Selector {
+	return sel.AddFuture(r.Future, func(workflow.Future) {
+		if fn != nil {
+			fn(*r)
+		}
+	})
+}
+
+// SelectStart adds waiting for start to the selector. Callback can be nil.
+func (r *SomeWorkflow4ChildRun) SelectStart(sel workflow.Selector, fn func(SomeWorkflow4ChildRun)) workflow.Selector {
+	return sel.AddFuture(r.Future.GetChildWorkflowExecution(), func(workflow.Future) {
+		if fn != nil {
+			fn(*r)
+		}
+	})
+}
+
+// WaitStart waits for the child workflow to start
+func (r *SomeWorkflow4ChildRun) WaitStart(ctx workflow.Context) (*workflow.Execution, error) {
+	var exec workflow.Execution
+	if err := r.Future.GetChildWorkflowExecution().Get(ctx, &exec); err != nil {
+		return nil, err
+	}
+	return &exec, nil
+}
+
+// SetValue sends a(n) "mycompany.simple.common.Common.SetValue" signal request to the child workflow
+func (r *SomeWorkflow4ChildRun) SetValue(ctx workflow.Context, input *common.SetValueRequest) error {
+	return r.SetValueAsync(ctx, input).Get(ctx, nil)
+}
+
+// SetValueAsync sends a(n) "mycompany.simple.common.Common.SetValue" signal request to the child workflow
+func (r *SomeWorkflow4ChildRun) SetValueAsync(ctx workflow.Context, input *common.SetValueRequest) workflow.Future {
+	return r.Future.SignalChildWorkflow(ctx, common.SetValueSignalName, input)
+}
+
+// SomeSignal1Signal describes a(n) mycompany.simple.Simple.SomeSignal1 signal
+type SomeSignal1Signal struct {
+	Channel   workflow.ReceiveChannel
+	onReceive func()
+}
+
+// Receive blocks until a(n) mycompany.simple.Simple.SomeSignal1 signal is received
+func (s *SomeSignal1Signal) Receive(ctx workflow.Context) bool {
+	more := s.Channel.Receive(ctx, nil)
+	if more && s.onReceive != nil {
+		s.onReceive()
+	}
+	return more
+}
+
+// ReceiveAsync checks for a mycompany.simple.Simple.SomeSignal1 signal without blocking
+func (s *SomeSignal1Signal) ReceiveAsync() bool {
+	ok := s.Channel.ReceiveAsync(nil)
+	if ok && s.onReceive != nil {
+		s.onReceive()
+	}
+	return ok
+}
+
+// Select checks for a(n) mycompany.simple.Simple.SomeSignal1 signal without blocking
+func (s *SomeSignal1Signal) Select(sel workflow.Selector, fn func()) workflow.Selector {
+	return sel.AddReceive(s.Channel, func(workflow.ReceiveChannel, bool) {
+		s.ReceiveAsync()
+		if fn != nil {
+			fn()
+		}
+	})
 }
 
 // SomeSignal1External sends a(n) mycompany.simple.Simple.SomeSignal1 signal to an existing workflow
@@ -1208,13 +2993,17 @@ func SomeSignal1External(ctx workflow.Context, workflowID string, runID string)
 
 // SomeSignal2Signal describes a(n) mycompany.simple.Simple.SomeSignal2 signal
 type SomeSignal2Signal struct {
-	Channel workflow.ReceiveChannel
+	Channel   workflow.ReceiveChannel
+	onReceive func(*SomeSignal2Request)
 }
 
 // Receive blocks until a(n) mycompany.simple.Simple.SomeSignal2 signal is received
 func (s *SomeSignal2Signal) Receive(ctx workflow.Context) (*SomeSignal2Request, bool) {
 	var resp SomeSignal2Request
 	more := s.Channel.Receive(ctx, &resp)
+	if more && s.onReceive != nil {
+		s.onReceive(&resp)
+	}
 	return &resp, more
 }
 
@@ -1224,6 +3013,9 @@ func (s *SomeSignal2Signal) ReceiveAsync() *SomeSignal2Request {
 	if ok := s.Channel.ReceiveAsync(&resp); !ok {
 		return nil
 	}
+	if s.onReceive != nil {
+		s.onReceive(&resp)
+	}
 	return &resp
 }
 
@@ -1252,16 +3044,25 @@ type SimpleActivities interface {
 	SomeActivity3(ctx context.Context, req *SomeActivity3Request) (*SomeActivity3Response, error)
 }
 
-// RegisterSimpleActivities registers activities with a worker
-func RegisterSimpleActivities(r worker.Registry, activities SimpleActivities) {
-	RegisterSomeActivity1Activity(r, activities.SomeActivity1)
-	RegisterSomeActivity2Activity(r, activities.SomeActivity2)
-	RegisterSomeActivity3Activity(r, activities.SomeActivity3)
+// RegisterSimpleActivities registers activities with a worker, invoking the hooks of the given interceptors
+func RegisterSimpleActivities(r worker.ActivityRegistry, activities SimpleActivities, interceptors ...SimpleInterceptor) {
+	RegisterSomeActivity1Activity(r, activities.SomeActivity1, interceptors...)
+	RegisterSomeActivity2Activity(r, activities.SomeActivity2, interceptors...)
+	RegisterSomeActivity3Activity(r, activities.SomeActivity3, interceptors...)
 }
 
-// RegisterSomeActivity1Activity registers a mycompany.simple.SomeActivity1 activity
-func RegisterSomeActivity1Activity(r worker.Registry, fn func(context.Context) error) {
-	r.RegisterActivityWithOptions(fn, activity.RegisterOptions{
+// RegisterSomeActivity1Activity registers a mycompany.simple.SomeActivity1 activity, invoking the hooks of the given interceptors
+func RegisterSomeActivity1Activity(r worker.ActivityRegistry, fn func(context.Context) error, interceptors ...SimpleInterceptor) {
+	interceptor := simpleInterceptors(interceptors)
+	impl := func(ctx context.Context) error {
+		ctx, span := tracing.StartActivity(ctx, "mycompany.simple.Simple", "RunActivity:"+SomeActivity1ActivityName, tracing.ActivityNameKey.String(SomeActivity1ActivityName))
+		interceptor.BeforeSomeActivity1(ctx)
+		err := fn(ctx)
+		interceptor.AfterSomeActivity1(ctx, err)
+		tracing.End(span, err)
+		return err
+	}
+	r.RegisterActivityWithOptions(impl, activity.RegisterOptions{
 		Name: SomeActivity1ActivityName,
 	})
 }
@@ -1297,7 +3098,13 @@ func SomeActivity1(ctx workflow.Context, options ...*SomeActivity1ActivityOption
 		activityOpts := workflow.GetActivityOptions(ctx)
 		opts.opts = &activityOpts
 	}
-	ctx = workflow.WithActivityOptions(ctx, *opts.opts)
+	activityID := opts.opts.ActivityID
+	if opts.activityID != nil {
+		activityID = *opts.activityID
+	}
+	activityOpts := *opts.opts
+	activityOpts.ActivityID = activityID
+	ctx = workflow.WithActivityOptions(ctx, activityOpts)
 	var activity any
 	activity = SomeActivity1ActivityName
 	future := &SomeActivity1Future{Future: workflow.ExecuteActivity(ctx, activity)}
@@ -1316,7 +3123,13 @@ func SomeActivity1Async(ctx workflow.Context, options ...*SomeActivity1ActivityO
 		activityOpts := workflow.GetActivityOptions(ctx)
 		opts.opts = &activityOpts
 	}
-	ctx = workflow.WithActivityOptions(ctx, *opts.opts)
+	activityID := opts.opts.ActivityID
+	if opts.activityID != nil {
+		activityID = *opts.activityID
+	}
+	activityOpts := *opts.opts
+	activityOpts.ActivityID = activityID
+	ctx = workflow.WithActivityOptions(ctx, activityOpts)
 	var activity any
 	activity = SomeActivity1ActivityName
 	future := &SomeActivity1Future{Future: workflow.ExecuteActivity(ctx, activity)}
@@ -1394,7 +3207,8 @@ func (opts *SomeActivity1LocalActivityOptions) WithLocalActivityOptions(options
 
 // SomeActivity1ActivityOptions provides configuration for a(n) mycompany.simple.SomeActivity1 activity
 type SomeActivity1ActivityOptions struct {
-	opts *workflow.ActivityOptions
+	opts       *workflow.ActivityOptions
+	activityID *string
 }
 
 // NewSomeActivity1ActivityOptions sets default ActivityOptions
@@ -1408,13 +3222,68 @@ func (opts *SomeActivity1ActivityOptions) WithActivityOptions(options workflow.A
 	return opts
 }
 
-// RegisterSomeActivity2Activity registers a mycompany.simple.Simple.SomeActivity2 activity
-func RegisterSomeActivity2Activity(r worker.Registry, fn func(context.Context, *SomeActivity2Request) error) {
-	r.RegisterActivityWithOptions(fn, activity.RegisterOptions{
+// WithActivityID overrides the default activity ID
+func (opts *SomeActivity1ActivityOptions) WithActivityID(id string) *SomeActivity1ActivityOptions {
+	opts.activityID = &id
+	return opts
+}
+
+// RegisterSomeActivity2Activity registers a mycompany.simple.Simple.SomeActivity2 activity, invoking the hooks of the given interceptors
+func RegisterSomeActivity2Activity(r worker.ActivityRegistry, fn func(context.Context, *SomeActivity2Request) error, interceptors ...SimpleInterceptor) {
+	interceptor := simpleInterceptors(interceptors)
+	impl := func(ctx context.Context, req *SomeActivity2Request) error {
+		ctx, span := tracing.StartActivity(ctx, "mycompany.simple.Simple", "RunActivity:"+SomeActivity2ActivityName, tracing.ActivityNameKey.String(SomeActivity2ActivityName))
+		interceptor.BeforeSomeActivity2(ctx, req)
+		err := fn(ctx, req)
+		interceptor.AfterSomeActivity2(ctx, err)
+		tracing.End(span, err)
+		return err
+	}
+	r.RegisterActivityWithOptions(func(ctx context.Context, req *SomeActivity2Request) error {
+		var details []any
+		if d, ok := GetSomeActivity2HeartbeatDetails(ctx); ok {
+			details = append(details, d)
+		}
+		ctx, stop := heartbeat.Auto(ctx, details...)
+		defer stop()
+		return impl(ctx, req)
+	}, activity.RegisterOptions{
 		Name: SomeActivity2ActivityName,
 	})
 }
 
+// RecordSomeActivity2Heartbeat records a typed heartbeat from within a(n) mycompany.simple.Simple.SomeActivity2 activity
+func RecordSomeActivity2Heartbeat(ctx context.Context, details *SomeActivity2Progress) {
+	heartbeat.Record(ctx, details)
+}
+
+// GetSomeActivity2HeartbeatDetails returns the typed details of the last heartbeat recorded by a
+// previous attempt of a(n) mycompany.simple.Simple.SomeActivity2 activity, if any
+func GetSomeActivity2HeartbeatDetails(ctx context.Context) (*SomeActivity2Progress, bool) {
+	if !activity.HasHeartbeatDetails(ctx) {
+		return nil, false
+	}
+	var details *SomeActivity2Progress
+	if err := activity.GetHeartbeatDetails(ctx, &details); err != nil {
+		return nil, false
+	}
+	return details, true
+}
+
+// GetSomeActivity2LastHeartbeatDetails returns the typed details of the last heartbeat recorded by
+// a(n) mycompany.simple.Simple.SomeActivity2 activity that timed out, if any
+func GetSomeActivity2LastHeartbeatDetails(err error) (*SomeActivity2Progress, bool) {
+	var timeoutErr *temporal.TimeoutError
+	if !errors.As(err, &timeoutErr) || !timeoutErr.HasLastHeartbeatDetails() {
+		return nil, false
+	}
+	var details *SomeActivity2Progress
+	if err := timeoutErr.LastHeartbeatDetails(&details); err != nil {
+		return nil, false
+	}
+	return details, true
+}
+
 // SomeActivity2Future describes a(n) mycompany.simple.Simple.SomeActivity2 activity execution
 type SomeActivity2Future struct {
 	Future workflow.Future
@@ -1425,6 +3294,12 @@ func (f *SomeActivity2Future) Get(ctx workflow.Context) error {
 	return f.Future.Get(ctx, nil)
 }
 
+// LastHeartbeatDetails blocks on the activity's completion, returning the typed details of
+// the last heartbeat recorded by the activity if it timed out
+func (f *SomeActivity2Future) LastHeartbeatDetails(ctx workflow.Context) (*SomeActivity2Progress, bool) {
+	return GetSomeActivity2LastHeartbeatDetails(f.Future.Get(ctx, nil))
+}
+
 // Select adds the activity's completion to the selector, callback can be nil
 func (f *SomeActivity2Future) Select(sel workflow.Selector, fn func(*SomeActivity2Future)) workflow.Selector {
 	return sel.AddFuture(f.Future, func(workflow.Future) {
@@ -1452,7 +3327,26 @@ func SomeActivity2(ctx workflow.Context, req *SomeActivity2Request, options ...*
 	if opts.opts.StartToCloseTimeout == 0 {
 		opts.opts.StartToCloseTimeout = 10000000000 // 10s
 	}
-	ctx = workflow.WithActivityOptions(ctx, *opts.opts)
+	activityID := opts.opts.ActivityID
+	if opts.activityID != nil {
+		activityID = *opts.activityID
+	}
+	if activityID == "" {
+		id, idErr := expression.EvalExpression(SomeActivity2ActivityIDExpression, req.ProtoReflect())
+		if id == "" && idErr == nil {
+			idErr = errors.New("expression evaluated to an empty string")
+		}
+		if idErr != nil {
+			f, settable := workflow.NewFuture(ctx)
+			settable.SetError(fmt.Errorf("error evaluating \"SomeActivity2\" activity id expression: %w", idErr))
+			future := &SomeActivity2Future{Future: f}
+			return future.Get(ctx)
+		}
+		activityID = id
+	}
+	activityOpts := *opts.opts
+	activityOpts.ActivityID = activityID
+	ctx = workflow.WithActivityOptions(ctx, activityOpts)
 	var activity any
 	activity = SomeActivity2ActivityName
 	future := &SomeActivity2Future{Future: workflow.ExecuteActivity(ctx, activity, req)}
@@ -1477,97 +3371,36 @@ func SomeActivity2Async(ctx workflow.Context, req *SomeActivity2Request, options
 	if opts.opts.StartToCloseTimeout == 0 {
 		opts.opts.StartToCloseTimeout = 10000000000 // 10s
 	}
-	ctx = workflow.WithActivityOptions(ctx, *opts.opts)
-	var activity any
-	activity = SomeActivity2ActivityName
-	future := &SomeActivity2Future{Future: workflow.ExecuteActivity(ctx, activity, req)}
-	return future
-}
-
-// SomeActivity2 does some activity thing. (locally)
-func SomeActivity2Local(ctx workflow.Context, req *SomeActivity2Request, options ...*SomeActivity2LocalActivityOptions) error {
-	var opts *SomeActivity2LocalActivityOptions
-	if len(options) > 0 && options[0] != nil {
-		opts = options[0]
-	} else {
-		opts = NewSomeActivity2LocalActivityOptions()
-	}
-	if opts.opts == nil {
-		activityOpts := workflow.GetLocalActivityOptions(ctx)
-		opts.opts = &activityOpts
-	}
-	if opts.opts.RetryPolicy == nil {
-		opts.opts.RetryPolicy = &temporal.RetryPolicy{MaximumInterval: 30000000000}
-	}
-	if opts.opts.StartToCloseTimeout == 0 {
-		opts.opts.StartToCloseTimeout = 10000000000 // 10s
-	}
-	ctx = workflow.WithLocalActivityOptions(ctx, *opts.opts)
-	var activity any
-	if opts.fn != nil {
-		activity = opts.fn
-	} else {
-		activity = SomeActivity2ActivityName
-	}
-	future := &SomeActivity2Future{Future: workflow.ExecuteLocalActivity(ctx, activity, req)}
-	return future.Get(ctx)
-}
-
-// SomeActivity2 does some activity thing. (asynchronously, locally)
-func SomeActivity2LocalAsync(ctx workflow.Context, req *SomeActivity2Request, options ...*SomeActivity2LocalActivityOptions) *SomeActivity2Future {
-	var opts *SomeActivity2LocalActivityOptions
-	if len(options) > 0 && options[0] != nil {
-		opts = options[0]
-	} else {
-		opts = NewSomeActivity2LocalActivityOptions()
-	}
-	if opts.opts == nil {
-		activityOpts := workflow.GetLocalActivityOptions(ctx)
-		opts.opts = &activityOpts
-	}
-	if opts.opts.RetryPolicy == nil {
-		opts.opts.RetryPolicy = &temporal.RetryPolicy{MaximumInterval: 30000000000}
+	activityID := opts.opts.ActivityID
+	if opts.activityID != nil {
+		activityID = *opts.activityID
 	}
-	if opts.opts.StartToCloseTimeout == 0 {
-		opts.opts.StartToCloseTimeout = 10000000000 // 10s
+	if activityID == "" {
+		id, idErr := expression.EvalExpression(SomeActivity2ActivityIDExpression, req.ProtoReflect())
+		if id == "" && idErr == nil {
+			idErr = errors.New("expression evaluated to an empty string")
+		}
+		if idErr != nil {
+			f, settable := workflow.NewFuture(ctx)
+			settable.SetError(fmt.Errorf("error evaluating \"SomeActivity2\" activity id expression: %w", idErr))
+			future := &SomeActivity2Future{Future: f}
+			return future
+		}
+		activityID = id
 	}
-	ctx = workflow.WithLocalActivityOptions(ctx, *opts.opts)
+	activityOpts := *opts.opts
+	activityOpts.ActivityID = activityID
+	ctx = workflow.WithActivityOptions(ctx, activityOpts)
 	var activity any
-	if opts.fn != nil {
-		activity = opts.fn
-	} else {
-		activity = SomeActivity2ActivityName
-	}
-	future := &SomeActivity2Future{Future: workflow.ExecuteLocalActivity(ctx, activity, req)}
+	activity = SomeActivity2ActivityName
+	future := &SomeActivity2Future{Future: workflow.ExecuteActivity(ctx, activity, req)}
 	return future
 }
 
-// SomeActivity2LocalActivityOptions provides configuration for a local mycompany.simple.Simple.SomeActivity2 activity
-type SomeActivity2LocalActivityOptions struct {
-	fn   func(context.Context, *SomeActivity2Request) error
-	opts *workflow.LocalActivityOptions
-}
-
-// NewSomeActivity2LocalActivityOptions sets default LocalActivityOptions
-func NewSomeActivity2LocalActivityOptions() *SomeActivity2LocalActivityOptions {
-	return &SomeActivity2LocalActivityOptions{}
-}
-
-// Local provides a local mycompany.simple.Simple.SomeActivity2 activity implementation
-func (opts *SomeActivity2LocalActivityOptions) Local(fn func(context.Context, *SomeActivity2Request) error) *SomeActivity2LocalActivityOptions {
-	opts.fn = fn
-	return opts
-}
-
-// WithLocalActivityOptions sets default LocalActivityOptions
-func (opts *SomeActivity2LocalActivityOptions) WithLocalActivityOptions(options workflow.LocalActivityOptions) *SomeActivity2LocalActivityOptions {
-	opts.opts = &options
-	return opts
-}
-
 // SomeActivity2ActivityOptions provides configuration for a(n) mycompany.simple.Simple.SomeActivity2 activity
 type SomeActivity2ActivityOptions struct {
-	opts *workflow.ActivityOptions
+	opts       *workflow.ActivityOptions
+	activityID *string
 }
 
 // NewSomeActivity2ActivityOptions sets default ActivityOptions
@@ -1581,9 +3414,24 @@ func (opts *SomeActivity2ActivityOptions) WithActivityOptions(options workflow.A
 	return opts
 }
 
-// RegisterSomeActivity3Activity registers a mycompany.simple.Simple.SomeActivity3 activity
-func RegisterSomeActivity3Activity(r worker.Registry, fn func(context.Context, *SomeActivity3Request) (*SomeActivity3Response, error)) {
-	r.RegisterActivityWithOptions(fn, activity.RegisterOptions{
+// WithActivityID overrides the default activity ID
+func (opts *SomeActivity2ActivityOptions) WithActivityID(id string) *SomeActivity2ActivityOptions {
+	opts.activityID = &id
+	return opts
+}
+
+// RegisterSomeActivity3Activity registers a mycompany.simple.Simple.SomeActivity3 activity, invoking the hooks of the given interceptors
+func RegisterSomeActivity3Activity(r worker.ActivityRegistry, fn func(context.Context, *SomeActivity3Request) (*SomeActivity3Response, error), interceptors ...SimpleInterceptor) {
+	interceptor := simpleInterceptors(interceptors)
+	impl := func(ctx context.Context, req *SomeActivity3Request) (*SomeActivity3Response, error) {
+		ctx, span := tracing.StartActivity(ctx, "mycompany.simple.Simple", "RunActivity:"+SomeActivity3ActivityName, tracing.Attributes(SomeActivity3ActivitySpanAttributesMapping, req, tracing.ActivityNameKey.String(SomeActivity3ActivityName))...)
+		interceptor.BeforeSomeActivity3(ctx, req)
+		resp, err := fn(ctx, req)
+		interceptor.AfterSomeActivity3(ctx, resp, err)
+		tracing.End(span, err)
+		return resp, err
+	}
+	r.RegisterActivityWithOptions(impl, activity.RegisterOptions{
 		Name: SomeActivity3ActivityName,
 	})
 }
@@ -1624,12 +3472,18 @@ func SomeActivity3(ctx workflow.Context, req *SomeActivity3Request, options ...*
 		opts.opts = &activityOpts
 	}
 	if opts.opts.RetryPolicy == nil {
-		opts.opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(5)}
+		opts.opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(5), NonRetryableErrorTypes: []string{"SomeActivity3Failed"}}
 	}
 	if opts.opts.StartToCloseTimeout == 0 {
 		opts.opts.StartToCloseTimeout = 10000000000 // 10s
 	}
-	ctx = workflow.WithActivityOptions(ctx, *opts.opts)
+	activityID := opts.opts.ActivityID
+	if opts.activityID != nil {
+		activityID = *opts.activityID
+	}
+	activityOpts := *opts.opts
+	activityOpts.ActivityID = activityID
+	ctx = workflow.WithActivityOptions(ctx, activityOpts)
 	var activity any
 	activity = SomeActivity3ActivityName
 	future := &SomeActivity3Future{Future: workflow.ExecuteActivity(ctx, activity, req)}
@@ -1649,12 +3503,18 @@ func SomeActivity3Async(ctx workflow.Context, req *SomeActivity3Request, options
 		opts.opts = &activityOpts
 	}
 	if opts.opts.RetryPolicy == nil {
-		opts.opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(5)}
+		opts.opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(5), NonRetryableErrorTypes: []string{"SomeActivity3Failed"}}
 	}
 	if opts.opts.StartToCloseTimeout == 0 {
 		opts.opts.StartToCloseTimeout = 10000000000 // 10s
 	}
-	ctx = workflow.WithActivityOptions(ctx, *opts.opts)
+	activityID := opts.opts.ActivityID
+	if opts.activityID != nil {
+		activityID = *opts.activityID
+	}
+	activityOpts := *opts.opts
+	activityOpts.ActivityID = activityID
+	ctx = workflow.WithActivityOptions(ctx, activityOpts)
 	var activity any
 	activity = SomeActivity3ActivityName
 	future := &SomeActivity3Future{Future: workflow.ExecuteActivity(ctx, activity, req)}
@@ -1674,7 +3534,7 @@ func SomeActivity3Local(ctx workflow.Context, req *SomeActivity3Request, options
 		opts.opts = &activityOpts
 	}
 	if opts.opts.RetryPolicy == nil {
-		opts.opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(5)}
+		opts.opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(5), NonRetryableErrorTypes: []string{"SomeActivity3Failed"}}
 	}
 	if opts.opts.StartToCloseTimeout == 0 {
 		opts.opts.StartToCloseTimeout = 10000000000 // 10s
@@ -1703,7 +3563,7 @@ func SomeActivity3LocalAsync(ctx workflow.Context, req *SomeActivity3Request, op
 		opts.opts = &activityOpts
 	}
 	if opts.opts.RetryPolicy == nil {
-		opts.opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(5)}
+		opts.opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(5), NonRetryableErrorTypes: []string{"SomeActivity3Failed"}}
 	}
 	if opts.opts.StartToCloseTimeout == 0 {
 		opts.opts.StartToCloseTimeout = 10000000000 // 10s
@@ -1744,7 +3604,8 @@ func (opts *SomeActivity3LocalActivityOptions) WithLocalActivityOptions(options
 
 // SomeActivity3ActivityOptions provides configuration for a(n) mycompany.simple.Simple.SomeActivity3 activity
 type SomeActivity3ActivityOptions struct {
-	opts *workflow.ActivityOptions
+	opts       *workflow.ActivityOptions
+	activityID *string
 }
 
 // NewSomeActivity3ActivityOptions sets default ActivityOptions
@@ -1758,131 +3619,391 @@ func (opts *SomeActivity3ActivityOptions) WithActivityOptions(options workflow.A
 	return opts
 }
 
-// TestClient provides a testsuite-compatible Client
-type TestSimpleClient struct {
-	env       *testsuite.TestWorkflowEnvironment
-	workflows SimpleWorkflows
+// WithActivityID overrides the default activity ID
+func (opts *SomeActivity3ActivityOptions) WithActivityID(id string) *SomeActivity3ActivityOptions {
+	opts.activityID = &id
+	return opts
 }
 
-var _ SimpleClient = &TestSimpleClient{}
+// SimpleSession executes mycompany.simple.Simple activities within a Temporal worker session, which schedules them on the same worker host
+type SimpleSession struct {
+	ctx workflow.Context
+}
 
-// NewTestSimpleClient initializes a new TestSimpleClient value
-func NewTestSimpleClient(env *testsuite.TestWorkflowEnvironment, workflows SimpleWorkflows, activities SimpleActivities) *TestSimpleClient {
-	RegisterSimpleWorkflows(env, workflows)
-	if activities != nil {
-		RegisterSimpleActivities(env, activities)
+// NewSimpleSession creates a new worker session via workflow.CreateSession
+func NewSimpleSession(ctx workflow.Context, opts *workflow.SessionOptions) (*SimpleSession, error) {
+	sessionCtx, err := workflow.CreateSession(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error creating session: %w", err)
 	}
-	return &TestSimpleClient{env, workflows}
+	return &SimpleSession{ctx: sessionCtx}, nil
 }
 
-// SomeWorkflow1 executes a(n) SomeWorkflow1 workflow in the test environment
-func (c *TestSimpleClient) SomeWorkflow1(ctx context.Context, req *SomeWorkflow1Request, opts ...*SomeWorkflow1Options) (*SomeWorkflow1Response, error) {
-	run, err := c.SomeWorkflow1Async(ctx, req, opts...)
+// RecreateSimpleSession recreates a worker session on the worker host identified by the given recreate token via workflow.RecreateSession
+func RecreateSimpleSession(ctx workflow.Context, recreateToken []byte, opts *workflow.SessionOptions) (*SimpleSession, error) {
+	sessionCtx, err := workflow.RecreateSession(ctx, recreateToken, opts)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error recreating session: %w", err)
 	}
-	return run.Get(ctx)
+	return &SimpleSession{ctx: sessionCtx}, nil
 }
 
-// SomeWorkflow1Async executes a(n) SomeWorkflow1 workflow in the test environment
-func (c *TestSimpleClient) SomeWorkflow1Async(ctx context.Context, req *SomeWorkflow1Request, options ...*SomeWorkflow1Options) (SomeWorkflow1Run, error) {
-	opts := &client.StartWorkflowOptions{}
-	if len(options) > 0 && options[0].opts != nil {
-		opts = options[0].opts
-	}
-	if opts.TaskQueue == "" {
-		opts.TaskQueue = SimpleTaskQueue
-	}
-	if opts.ID == "" {
-		id, err := expression.EvalExpression(SomeWorkflow1IDExpression, req.ProtoReflect())
-		if err != nil {
-			return nil, err
-		}
-		opts.ID = id
-	}
-	return &testSomeWorkflow1Run{client: c, env: c.env, opts: opts, req: req, workflows: c.workflows}, nil
+// Complete completes the session via workflow.CompleteSession, releasing its resources on the worker host
+func (s *SimpleSession) Complete() {
+	workflow.CompleteSession(s.ctx)
 }
 
-// GetSomeWorkflow1 is a noop
-func (c *TestSimpleClient) GetSomeWorkflow1(ctx context.Context, workflowID string, runID string) SomeWorkflow1Run {
-	return &testSomeWorkflow1Run{env: c.env, workflows: c.workflows}
+// Context returns the session context, which can be used to execute other activities within the session
+func (s *SimpleSession) Context() workflow.Context {
+	return s.ctx
 }
 
-// SomeWorkflow2 executes a(n) SomeWorkflow2 workflow in the test environment
-func (c *TestSimpleClient) SomeWorkflow2(ctx context.Context, opts ...*SomeWorkflow2Options) error {
-	run, err := c.SomeWorkflow2Async(ctx, opts...)
-	if err != nil {
-		return err
-	}
-	return run.Get(ctx)
+// Info returns information about the session
+func (s *SimpleSession) Info() *workflow.SessionInfo {
+	return workflow.GetSessionInfo(s.ctx)
 }
 
-// SomeWorkflow2Async executes a(n) SomeWorkflow2 workflow in the test environment
-func (c *TestSimpleClient) SomeWorkflow2Async(ctx context.Context, options ...*SomeWorkflow2Options) (SomeWorkflow2Run, error) {
-	opts := &client.StartWorkflowOptions{}
-	if len(options) > 0 && options[0].opts != nil {
-		opts = options[0].opts
-	}
-	if opts.TaskQueue == "" {
-		opts.TaskQueue = SimpleTaskQueue
-	}
-	return &testSomeWorkflow2Run{client: c, env: c.env, opts: opts, workflows: c.workflows}, nil
+// RecreateToken returns a token that can be passed to RecreateSimpleSession to recreate the session on the same worker host
+func (s *SimpleSession) RecreateToken() []byte {
+	return s.Info().GetRecreateToken()
 }
 
-// GetSomeWorkflow2 is a noop
-func (c *TestSimpleClient) GetSomeWorkflow2(ctx context.Context, workflowID string, runID string) SomeWorkflow2Run {
-	return &testSomeWorkflow2Run{env: c.env, workflows: c.workflows}
+// SomeActivity1 executes a(n) mycompany.simple.SomeActivity1 activity within the session
+func (s *SimpleSession) SomeActivity1(options ...*SomeActivity1ActivityOptions) error {
+	return SomeActivity1(s.ctx, options...)
 }
 
-// SomeWorkflow2WithSomeSignal1 sends a(n) SomeSignal1 signal to a(n) SomeWorkflow2 workflow, starting it if necessary
-func (c *TestSimpleClient) SomeWorkflow2WithSomeSignal1(ctx context.Context, opts ...*SomeWorkflow2Options) error {
-	c.env.RegisterDelayedCallback(func() {
-		c.env.SignalWorkflow(SomeSignal1SignalName, nil)
-	}, 0)
-	return c.SomeWorkflow2(ctx, opts...)
+// SomeActivity1Async executes a(n) mycompany.simple.SomeActivity1 activity within the session (asynchronously)
+func (s *SimpleSession) SomeActivity1Async(options ...*SomeActivity1ActivityOptions) *SomeActivity1Future {
+	return SomeActivity1Async(s.ctx, options...)
 }
 
-// SomeWorkflow2WithSomeSignal1Async sends a(n) SomeSignal1 signal to a(n) SomeWorkflow2 workflow, starting it if necessary
-func (c *TestSimpleClient) SomeWorkflow2WithSomeSignal1Async(ctx context.Context, opts ...*SomeWorkflow2Options) (SomeWorkflow2Run, error) {
-	c.env.RegisterDelayedCallback(func() {
-		_ = c.SomeSignal1(ctx, "", "")
-	}, 0)
-	return c.SomeWorkflow2Async(ctx, opts...)
+// SomeActivity2 executes a(n) mycompany.simple.Simple.SomeActivity2 activity within the session
+func (s *SimpleSession) SomeActivity2(req *SomeActivity2Request, options ...*SomeActivity2ActivityOptions) error {
+	return SomeActivity2(s.ctx, req, options...)
 }
 
-// SomeWorkflow3 executes a(n) SomeWorkflow3 workflow in the test environment
-func (c *TestSimpleClient) SomeWorkflow3(ctx context.Context, req *SomeWorkflow3Request, opts ...*SomeWorkflow3Options) error {
-	run, err := c.SomeWorkflow3Async(ctx, req, opts...)
+// SomeActivity2Async executes a(n) mycompany.simple.Simple.SomeActivity2 activity within the session (asynchronously)
+func (s *SimpleSession) SomeActivity2Async(req *SomeActivity2Request, options ...*SomeActivity2ActivityOptions) *SomeActivity2Future {
+	return SomeActivity2Async(s.ctx, req, options...)
+}
+
+// SimpleSagaOptions describes how a SimpleSaga runs compensations
+type SimpleSagaOptions struct {
+	continueOnError bool
+	parallel        bool
+}
+
+// NewSimpleSagaOptions initializes a new SimpleSagaOptions value
+func NewSimpleSagaOptions() *SimpleSagaOptions {
+	return &SimpleSagaOptions{}
+}
+
+// WithContinueOnError configures whether sequential compensation continues running the remaining
+// compensations after a compensation fails, returning the combined errors
+func (opts *SimpleSagaOptions) WithContinueOnError(continueOnError bool) *SimpleSagaOptions {
+	opts.continueOnError = continueOnError
+	return opts
+}
+
+// WithParallel configures whether compensations run concurrently rather than sequentially in reverse
+// order, in which case every compensation runs regardless of failures
+func (opts *SimpleSagaOptions) WithParallel(parallel bool) *SimpleSagaOptions {
+	opts.parallel = parallel
+	return opts
+}
+
+// SimpleSaga executes mycompany.simple.Simple activities and records the compensation of each successful
+// activity execution, which can be run via Compensate to roll back the saga
+type SimpleSaga struct {
+	compensations []func(workflow.Context) error
+	opts          *SimpleSagaOptions
+}
+
+// NewSimpleSaga initializes a new SimpleSaga value
+func NewSimpleSaga(options ...*SimpleSagaOptions) *SimpleSaga {
+	var opts *SimpleSagaOptions
+	if len(options) > 0 && options[0] != nil {
+		opts = options[0]
+	} else {
+		opts = NewSimpleSagaOptions()
+	}
+	return &SimpleSaga{opts: opts}
+}
+
+// AddCompensation records a custom compensation to be run by Compensate
+func (s *SimpleSaga) AddCompensation(fn func(workflow.Context) error) {
+	s.compensations = append(s.compensations, fn)
+}
+
+// Compensate runs the recorded compensations in reverse order, or concurrently if configured. If a
+// sequential compensation fails and ContinueOnError is not configured, it returns immediately and
+// retains the failed and remaining compensations, allowing Compensate to be retried. Use a
+// disconnected context to compensate after the workflow is canceled.
+func (s *SimpleSaga) Compensate(ctx workflow.Context) error {
+	compensations := s.compensations
+	s.compensations = nil
+	var errs []error
+	if s.opts.parallel {
+		futures := make([]workflow.Future, 0, len(compensations))
+		for i := len(compensations) - 1; i >= 0; i-- {
+			compensate := compensations[i]
+			future, settable := workflow.NewFuture(ctx)
+			workflow.Go(ctx, func(ctx workflow.Context) {
+				settable.Set(nil, compensate(ctx))
+			})
+			futures = append(futures, future)
+		}
+		for _, future := range futures {
+			if err := future.Get(ctx, nil); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	}
+	for i := len(compensations) - 1; i >= 0; i-- {
+		if err := compensations[i](ctx); err != nil {
+			if !s.opts.continueOnError {
+				s.compensations = compensations[:i+1]
+				return err
+			}
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SomeActivity2 executes a(n) mycompany.simple.Simple.SomeActivity2 activity, recording a(n) mycompany.simple.SomeActivity1 compensation if successful
+func (s *SimpleSaga) SomeActivity2(ctx workflow.Context, req *SomeActivity2Request, options ...*SomeActivity2ActivityOptions) error {
+	err := SomeActivity2(ctx, req, options...)
 	if err != nil {
 		return err
 	}
-	return run.Get(ctx)
+	s.AddCompensation(func(ctx workflow.Context) error {
+		err := SomeActivity1(ctx)
+		if err != nil {
+			return fmt.Errorf("error compensating \"SomeActivity2\" activity: %w", err)
+		}
+		return nil
+	})
+	return nil
 }
 
-// SomeWorkflow3Async executes a(n) SomeWorkflow3 workflow in the test environment
-func (c *TestSimpleClient) SomeWorkflow3Async(ctx context.Context, req *SomeWorkflow3Request, options ...*SomeWorkflow3Options) (SomeWorkflow3Run, error) {
-	opts := &client.StartWorkflowOptions{}
-	if len(options) > 0 && options[0].opts != nil {
-		opts = options[0].opts
+// SomeActivity3 executes a(n) mycompany.simple.Simple.SomeActivity3 activity, recording a(n) mycompany.simple.Simple.SomeActivity2 compensation if successful
+func (s *SimpleSaga) SomeActivity3(ctx workflow.Context, req *SomeActivity3Request, options ...*SomeActivity3ActivityOptions) (*SomeActivity3Response, error) {
+	resp, err := SomeActivity3(ctx, req, options...)
+	if err != nil {
+		return resp, err
 	}
-	if opts.TaskQueue == "" {
-		opts.TaskQueue = "my-task-queue-2"
+	input, err := expression.ToStructured(req.ProtoReflect())
+	if err != nil {
+		return resp, fmt.Errorf("error serializing input for \"SomeActivity3\" compensation input mapping: %w", err)
 	}
-	if opts.ID == "" {
-		id, err := expression.EvalExpression(SomeWorkflow3IDExpression, req.ProtoReflect())
+	output, err := expression.ToStructured(resp.ProtoReflect())
+	if err != nil {
+		return resp, fmt.Errorf("error serializing output for \"SomeActivity3\" compensation input mapping: %w", err)
+	}
+	structured := map[string]any{
+		"input":  input,
+		"output": output,
+	}
+	result, err := SomeActivity3CompensationMapping.Query(structured)
+	if err != nil {
+		return resp, fmt.Errorf("error executing \"SomeActivity3\" compensation input mapping: %w", err)
+	}
+	compensation := &SomeActivity2Request{}
+	if err := expression.FromStructured(result, compensation); err != nil {
+		return resp, fmt.Errorf("error converting \"SomeActivity3\" compensation input: %w", err)
+	}
+	s.AddCompensation(func(ctx workflow.Context) error {
+		err := SomeActivity2(ctx, compensation)
 		if err != nil {
-			return nil, err
+			return fmt.Errorf("error compensating \"SomeActivity3\" activity: %w", err)
 		}
-		opts.ID = id
+		return nil
+	})
+	return resp, nil
+}
+
+// TestClient provides a testsuite-compatible Client
+type TestSimpleClient struct {
+	env       *testsuite.TestWorkflowEnvironment
+	workflows SimpleWorkflows
+}
+
+var _ SimpleClient = &TestSimpleClient{}
+
+// NewTestSimpleClient initializes a new TestSimpleClient value
+// If workflows are provided without activities, placeholder activities are registered that
+// fail unless mocked using the client's On<Activity> methods
+func NewTestSimpleClient(env *testsuite.TestWorkflowEnvironment, workflows SimpleWorkflows, activities SimpleActivities) *TestSimpleClient {
+	if workflows != nil {
+		RegisterSimpleWorkflows(env, workflows)
 	}
-	if opts.WorkflowIDReusePolicy == v1.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
-		opts.WorkflowIDReusePolicy = v1.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
+	if activities != nil {
+		RegisterSimpleActivities(env, activities)
+	} else if workflows != nil {
+		RegisterSomeActivity1Activity(env, func(context.Context) error {
+			return testutil.NewNotMockedError(testutil.KindActivity, SomeActivity1ActivityName)
+		})
+		RegisterSomeActivity2Activity(env, func(context.Context, *SomeActivity2Request) error {
+			return testutil.NewNotMockedError(testutil.KindActivity, SomeActivity2ActivityName)
+		})
+		RegisterSomeActivity3Activity(env, func(context.Context, *SomeActivity3Request) (*SomeActivity3Response, error) {
+			return nil, testutil.NewNotMockedError(testutil.KindActivity, SomeActivity3ActivityName)
+		})
 	}
-	if opts.RetryPolicy == nil {
-		opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(2)}
+	return &TestSimpleClient{env, workflows}
+}
+
+// SomeWorkflow1 executes a(n) SomeWorkflow1 workflow in the test environment
+func (c *TestSimpleClient) SomeWorkflow1(ctx context.Context, req *SomeWorkflow1Request, opts ...*SomeWorkflow1Options) (*SomeWorkflow1Response, error) {
+	run, err := c.SomeWorkflow1Async(ctx, req, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if opts.WorkflowExecutionTimeout == 0 {
-		opts.WorkflowRunTimeout = 3600000000000 // 1h0m0s
+	return run.Get(ctx)
+}
+
+// SomeWorkflow1Async executes a(n) SomeWorkflow1 workflow in the test environment
+func (c *TestSimpleClient) SomeWorkflow1Async(ctx context.Context, req *SomeWorkflow1Request, options ...*SomeWorkflow1Options) (SomeWorkflow1Run, error) {
+	var o *SomeWorkflow1Options
+	if len(options) > 0 {
+		o = options[0]
+	}
+	opts, err := o.Build(req)
+	if err != nil {
+		return nil, err
+	}
+	return &testSomeWorkflow1Run{client: c, env: c.env, opts: opts, req: req, workflows: c.workflows}, nil
+}
+
+// GetSomeWorkflow1 is a noop
+func (c *TestSimpleClient) GetSomeWorkflow1(ctx context.Context, workflowID string, runID string) SomeWorkflow1Run {
+	return &testSomeWorkflow1Run{env: c.env, workflows: c.workflows}
+}
+
+// CreateSomeWorkflow1Schedule is not supported by the test client
+func (c *TestSimpleClient) CreateSomeWorkflow1Schedule(ctx context.Context, id string, spec client.ScheduleSpec, req *SomeWorkflow1Request, options ...*SomeWorkflow1ScheduleOptions) (client.ScheduleHandle, error) {
+	return nil, errors.New("schedules are not supported by the test client")
+}
+
+// GetSomeWorkflow1Schedule is not supported by the test client
+func (c *TestSimpleClient) GetSomeWorkflow1Schedule(ctx context.Context, id string) (*client.ScheduleDescription, error) {
+	return nil, errors.New("schedules are not supported by the test client")
+}
+
+// PauseSomeWorkflow1Schedule is not supported by the test client
+func (c *TestSimpleClient) PauseSomeWorkflow1Schedule(ctx context.Context, id string, note string) error {
+	return errors.New("schedules are not supported by the test client")
+}
+
+// UnpauseSomeWorkflow1Schedule is not supported by the test client
+func (c *TestSimpleClient) UnpauseSomeWorkflow1Schedule(ctx context.Context, id string, note string) error {
+	return errors.New("schedules are not supported by the test client")
+}
+
+// TriggerSomeWorkflow1Schedule is not supported by the test client
+func (c *TestSimpleClient) TriggerSomeWorkflow1Schedule(ctx context.Context, id string) error {
+	return errors.New("schedules are not supported by the test client")
+}
+
+// DeleteSomeWorkflow1Schedule is not supported by the test client
+func (c *TestSimpleClient) DeleteSomeWorkflow1Schedule(ctx context.Context, id string) error {
+	return errors.New("schedules are not supported by the test client")
+}
+
+// SomeWorkflow2 executes a(n) SomeWorkflow2 workflow in the test environment
+func (c *TestSimpleClient) SomeWorkflow2(ctx context.Context, opts ...*SomeWorkflow2Options) error {
+	run, err := c.SomeWorkflow2Async(ctx, opts...)
+	if err != nil {
+		return err
+	}
+	return run.Get(ctx)
+}
+
+// SomeWorkflow2Async executes a(n) SomeWorkflow2 workflow in the test environment
+func (c *TestSimpleClient) SomeWorkflow2Async(ctx context.Context, options ...*SomeWorkflow2Options) (SomeWorkflow2Run, error) {
+	var o *SomeWorkflow2Options
+	if len(options) > 0 {
+		o = options[0]
+	}
+	opts, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+	return &testSomeWorkflow2Run{client: c, env: c.env, opts: opts, workflows: c.workflows}, nil
+}
+
+// GetSomeWorkflow2 is a noop
+func (c *TestSimpleClient) GetSomeWorkflow2(ctx context.Context, workflowID string, runID string) SomeWorkflow2Run {
+	return &testSomeWorkflow2Run{env: c.env, workflows: c.workflows}
+}
+
+// CreateSomeWorkflow2Schedule is not supported by the test client
+func (c *TestSimpleClient) CreateSomeWorkflow2Schedule(ctx context.Context, id string, spec client.ScheduleSpec, options ...*SomeWorkflow2ScheduleOptions) (client.ScheduleHandle, error) {
+	return nil, errors.New("schedules are not supported by the test client")
+}
+
+// GetSomeWorkflow2Schedule is not supported by the test client
+func (c *TestSimpleClient) GetSomeWorkflow2Schedule(ctx context.Context, id string) (*client.ScheduleDescription, error) {
+	return nil, errors.New("schedules are not supported by the test client")
+}
+
+// PauseSomeWorkflow2Schedule is not supported by the test client
+func (c *TestSimpleClient) PauseSomeWorkflow2Schedule(ctx context.Context, id string, note string) error {
+	return errors.New("schedules are not supported by the test client")
+}
+
+// UnpauseSomeWorkflow2Schedule is not supported by the test client
+func (c *TestSimpleClient) UnpauseSomeWorkflow2Schedule(ctx context.Context, id string, note string) error {
+	return errors.New("schedules are not supported by the test client")
+}
+
+// TriggerSomeWorkflow2Schedule is not supported by the test client
+func (c *TestSimpleClient) TriggerSomeWorkflow2Schedule(ctx context.Context, id string) error {
+	return errors.New("schedules are not supported by the test client")
+}
+
+// DeleteSomeWorkflow2Schedule is not supported by the test client
+func (c *TestSimpleClient) DeleteSomeWorkflow2Schedule(ctx context.Context, id string) error {
+	return errors.New("schedules are not supported by the test client")
+}
+
+// SomeWorkflow2WithSomeSignal1 sends a(n) SomeSignal1 signal to a(n) SomeWorkflow2 workflow, starting it if necessary
+func (c *TestSimpleClient) SomeWorkflow2WithSomeSignal1(ctx context.Context, opts ...*SomeWorkflow2Options) error {
+	c.env.RegisterDelayedCallback(func() {
+		c.env.SignalWorkflow(SomeSignal1SignalName, nil)
+	}, 0)
+	return c.SomeWorkflow2(ctx, opts...)
+}
+
+// SomeWorkflow2WithSomeSignal1Async sends a(n) SomeSignal1 signal to a(n) SomeWorkflow2 workflow, starting it if necessary
+func (c *TestSimpleClient) SomeWorkflow2WithSomeSignal1Async(ctx context.Context, opts ...*SomeWorkflow2Options) (SomeWorkflow2Run, error) {
+	c.env.RegisterDelayedCallback(func() {
+		_ = c.SomeSignal1(ctx, "", "")
+	}, 0)
+	return c.SomeWorkflow2Async(ctx, opts...)
+}
+
+// SomeWorkflow3 executes a(n) SomeWorkflow3 workflow in the test environment
+func (c *TestSimpleClient) SomeWorkflow3(ctx context.Context, req *SomeWorkflow3Request, opts ...*SomeWorkflow3Options) error {
+	run, err := c.SomeWorkflow3Async(ctx, req, opts...)
+	if err != nil {
+		return err
+	}
+	return run.Get(ctx)
+}
+
+// SomeWorkflow3Async executes a(n) SomeWorkflow3 workflow in the test environment
+func (c *TestSimpleClient) SomeWorkflow3Async(ctx context.Context, req *SomeWorkflow3Request, options ...*SomeWorkflow3Options) (SomeWorkflow3Run, error) {
+	var o *SomeWorkflow3Options
+	if len(options) > 0 {
+		o = options[0]
+	}
+	opts, err := o.Build(req)
+	if err != nil {
+		return nil, err
 	}
 	return &testSomeWorkflow3Run{client: c, env: c.env, opts: opts, req: req, workflows: c.workflows}, nil
 }
@@ -1892,6 +4013,36 @@ func (c *TestSimpleClient) GetSomeWorkflow3(ctx context.Context, workflowID stri
 	return &testSomeWorkflow3Run{env: c.env, workflows: c.workflows}
 }
 
+// CreateSomeWorkflow3Schedule is not supported by the test client
+func (c *TestSimpleClient) CreateSomeWorkflow3Schedule(ctx context.Context, id string, spec client.ScheduleSpec, req *SomeWorkflow3Request, options ...*SomeWorkflow3ScheduleOptions) (client.ScheduleHandle, error) {
+	return nil, errors.New("schedules are not supported by the test client")
+}
+
+// GetSomeWorkflow3Schedule is not supported by the test client
+func (c *TestSimpleClient) GetSomeWorkflow3Schedule(ctx context.Context, id string) (*client.ScheduleDescription, error) {
+	return nil, errors.New("schedules are not supported by the test client")
+}
+
+// PauseSomeWorkflow3Schedule is not supported by the test client
+func (c *TestSimpleClient) PauseSomeWorkflow3Schedule(ctx context.Context, id string, note string) error {
+	return errors.New("schedules are not supported by the test client")
+}
+
+// UnpauseSomeWorkflow3Schedule is not supported by the test client
+func (c *TestSimpleClient) UnpauseSomeWorkflow3Schedule(ctx context.Context, id string, note string) error {
+	return errors.New("schedules are not supported by the test client")
+}
+
+// TriggerSomeWorkflow3Schedule is not supported by the test client
+func (c *TestSimpleClient) TriggerSomeWorkflow3Schedule(ctx context.Context, id string) error {
+	return errors.New("schedules are not supported by the test client")
+}
+
+// DeleteSomeWorkflow3Schedule is not supported by the test client
+func (c *TestSimpleClient) DeleteSomeWorkflow3Schedule(ctx context.Context, id string) error {
+	return errors.New("schedules are not supported by the test client")
+}
+
 // SomeWorkflow3WithSomeSignal2 sends a(n) SomeSignal2 signal to a(n) SomeWorkflow3 workflow, starting it if necessary
 func (c *TestSimpleClient) SomeWorkflow3WithSomeSignal2(ctx context.Context, req *SomeWorkflow3Request, signal *SomeSignal2Request, opts ...*SomeWorkflow3Options) error {
 	c.env.RegisterDelayedCallback(func() {
@@ -1908,6 +4059,79 @@ func (c *TestSimpleClient) SomeWorkflow3WithSomeSignal2Async(ctx context.Context
 	return c.SomeWorkflow3Async(ctx, req, opts...)
 }
 
+// SomeWorkflow4 executes a(n) SomeWorkflow4 workflow in the test environment
+func (c *TestSimpleClient) SomeWorkflow4(ctx context.Context, opts ...*SomeWorkflow4Options) error {
+	run, err := c.SomeWorkflow4Async(ctx, opts...)
+	if err != nil {
+		return err
+	}
+	return run.Get(ctx)
+}
+
+// SomeWorkflow4Async executes a(n) SomeWorkflow4 workflow in the test environment
+func (c *TestSimpleClient) SomeWorkflow4Async(ctx context.Context, options ...*SomeWorkflow4Options) (SomeWorkflow4Run, error) {
+	var o *SomeWorkflow4Options
+	if len(options) > 0 {
+		o = options[0]
+	}
+	opts, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+	return &testSomeWorkflow4Run{client: c, env: c.env, opts: opts, workflows: c.workflows}, nil
+}
+
+// GetSomeWorkflow4 is a noop
+func (c *TestSimpleClient) GetSomeWorkflow4(ctx context.Context, workflowID string, runID string) SomeWorkflow4Run {
+	return &testSomeWorkflow4Run{env: c.env, workflows: c.workflows}
+}
+
+// CreateSomeWorkflow4Schedule is not supported by the test client
+func (c *TestSimpleClient) CreateSomeWorkflow4Schedule(ctx context.Context, id string, spec client.ScheduleSpec, options ...*SomeWorkflow4ScheduleOptions) (client.ScheduleHandle, error) {
+	return nil, errors.New("schedules are not supported by the test client")
+}
+
+// GetSomeWorkflow4Schedule is not supported by the test client
+func (c *TestSimpleClient) GetSomeWorkflow4Schedule(ctx context.Context, id string) (*client.ScheduleDescription, error) {
+	return nil, errors.New("schedules are not supported by the test client")
+}
+
+// PauseSomeWorkflow4Schedule is not supported by the test client
+func (c *TestSimpleClient) PauseSomeWorkflow4Schedule(ctx context.Context, id string, note string) error {
+	return errors.New("schedules are not supported by the test client")
+}
+
+// UnpauseSomeWorkflow4Schedule is not supported by the test client
+func (c *TestSimpleClient) UnpauseSomeWorkflow4Schedule(ctx context.Context, id string, note string) error {
+	return errors.New("schedules are not supported by the test client")
+}
+
+// TriggerSomeWorkflow4Schedule is not supported by the test client
+func (c *TestSimpleClient) TriggerSomeWorkflow4Schedule(ctx context.Context, id string) error {
+	return errors.New("schedules are not supported by the test client")
+}
+
+// DeleteSomeWorkflow4Schedule is not supported by the test client
+func (c *TestSimpleClient) DeleteSomeWorkflow4Schedule(ctx context.Context, id string) error {
+	return errors.New("schedules are not supported by the test client")
+}
+
+// SomeWorkflow4WithSetValue sends a(n) SetValue signal to a(n) SomeWorkflow4 workflow, starting it if necessary
+func (c *TestSimpleClient) SomeWorkflow4WithSetValue(ctx context.Context, signal *common.SetValueRequest, opts ...*SomeWorkflow4Options) error {
+	c.env.RegisterDelayedCallback(func() {
+		c.env.SignalWorkflow(common.SetValueSignalName, signal)
+	}, 0)
+	return c.SomeWorkflow4(ctx, opts...)
+}
+
+// SomeWorkflow4WithSetValueAsync sends a(n) SetValue signal to a(n) SomeWorkflow4 workflow, starting it if necessary
+func (c *TestSimpleClient) SomeWorkflow4WithSetValueAsync(ctx context.Context, signal *common.SetValueRequest, opts ...*SomeWorkflow4Options) (SomeWorkflow4Run, error) {
+	c.env.RegisterDelayedCallback(func() {
+		_ = common.NewTestCommonClient(c.env, nil, nil).SetValue(ctx, "", "", signal)
+	}, 0)
+	return c.SomeWorkflow4Async(ctx, opts...)
+}
+
 // SomeQuery1 executes a SomeQuery1 query
 func (c *TestSimpleClient) SomeQuery1(ctx context.Context, workflowID string, runID string) (*SomeQuery1Response, error) {
 	val, err := c.env.QueryWorkflow(SomeQuery1QueryName)
@@ -1954,12 +4178,7 @@ func (c *TestSimpleClient) SomeSignal2(ctx context.Context, workflowID string, r
 
 // SomeUpdate1 executes a(n) mycompany.simple.Simple.SomeUpdate1 update in the test environment
 func (c *TestSimpleClient) SomeUpdate1(ctx context.Context, workflowID string, runID string, req *SomeUpdate1Request, opts ...*SomeUpdate1Options) (*SomeUpdate1Response, error) {
-	options := NewSomeUpdate1Options()
-	if len(opts) > 0 && opts[0].opts != nil {
-		options = opts[0]
-	}
-	options.opts.WaitPolicy = &v11.WaitPolicy{LifecycleStage: v1.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED}
-	handle, err := c.SomeUpdate1Async(ctx, workflowID, runID, req, options)
+	handle, err := c.SomeUpdate1Async(ctx, workflowID, runID, req, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1968,23 +4187,13 @@ func (c *TestSimpleClient) SomeUpdate1(ctx context.Context, workflowID string, r
 
 // SomeUpdate1Async executes a(n) mycompany.simple.Simple.SomeUpdate1 update in the test environment
 func (c *TestSimpleClient) SomeUpdate1Async(ctx context.Context, workflowID string, runID string, req *SomeUpdate1Request, opts ...*SomeUpdate1Options) (SomeUpdate1Handle, error) {
-	options := &client.UpdateWorkflowWithOptionsRequest{}
-	if len(opts) > 0 && opts[0].opts != nil {
-		options = opts[0].opts
-	}
-	options.Args = []any{req}
-	options.RunID = runID
-	options.UpdateName = SomeUpdate1UpdateName
-	options.WorkflowID = workflowID
-	if options.UpdateID == "" {
-		id, err := expression.EvalExpression(SomeUpdate1IDExpression, req.ProtoReflect())
-		if err != nil {
-			return nil, fmt.Errorf("error evaluating %s id expression: %w", SomeUpdate1UpdateName, err)
-		}
-		options.UpdateID = id
+	var o *SomeUpdate1Options
+	if len(opts) > 0 {
+		o = opts[0]
 	}
-	if options.WaitPolicy.GetLifecycleStage() == v1.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_UNSPECIFIED {
-		options.WaitPolicy = &v11.WaitPolicy{LifecycleStage: v1.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED}
+	options, err := o.Build(workflowID, runID, req)
+	if err != nil {
+		return nil, err
 	}
 	uc := testutil.NewUpdateCallbacks()
 	c.env.UpdateWorkflow(SomeUpdate1UpdateName, uc, req)
@@ -2037,15 +4246,328 @@ func (h *testSomeUpdate1Handle) WorkflowID() string {
 	return h.workflowID
 }
 
-var _ SomeWorkflow1Run = &testSomeWorkflow1Run{}
+// OnSomeActivity1 mocks executions of the mycompany.simple.SomeActivity1 activity
+func (c *TestSimpleClient) OnSomeActivity1() *TestSomeActivity1ActivityCall {
+	return &TestSomeActivity1ActivityCall{c.env.OnActivity(SomeActivity1ActivityName, mock.Anything)}
+}
 
-// testSomeWorkflow1Run provides convenience methods for interacting with a(n) SomeWorkflow1 workflow in the test environment
-type testSomeWorkflow1Run struct {
-	client    *TestSimpleClient
-	env       *testsuite.TestWorkflowEnvironment
-	opts      *client.StartWorkflowOptions
-	req       *SomeWorkflow1Request
-	workflows SimpleWorkflows
+// TestSomeActivity1ActivityCall describes a mocked mycompany.simple.SomeActivity1 activity execution
+type TestSomeActivity1ActivityCall struct {
+	call *testsuite.MockCallWrapper
+}
+
+// Return specifies the result of matching activity executions
+func (c *TestSomeActivity1ActivityCall) Return(err error) *TestSomeActivity1ActivityCall {
+	c.call.Return(err)
+	return c
+}
+
+// Times specifies the number of activity executions the mock should match
+func (c *TestSomeActivity1ActivityCall) Times(n int) *TestSomeActivity1ActivityCall {
+	c.call.Times(n)
+	return c
+}
+
+// OnSomeActivity2 mocks executions of the mycompany.simple.Simple.SomeActivity2 activity whose input satisfies the given
+// matcher, which may be nil to match any input
+func (c *TestSimpleClient) OnSomeActivity2(matcher func(*SomeActivity2Request) bool) *TestSomeActivity2ActivityCall {
+	var req any = mock.Anything
+	if matcher != nil {
+		req = mock.MatchedBy(matcher)
+	}
+	return &TestSomeActivity2ActivityCall{c.env.OnActivity(SomeActivity2ActivityName, mock.Anything, req)}
+}
+
+// TestSomeActivity2ActivityCall describes a mocked mycompany.simple.Simple.SomeActivity2 activity execution
+type TestSomeActivity2ActivityCall struct {
+	call *testsuite.MockCallWrapper
+}
+
+// Return specifies the result of matching activity executions
+func (c *TestSomeActivity2ActivityCall) Return(err error) *TestSomeActivity2ActivityCall {
+	c.call.Return(err)
+	return c
+}
+
+// Times specifies the number of activity executions the mock should match
+func (c *TestSomeActivity2ActivityCall) Times(n int) *TestSomeActivity2ActivityCall {
+	c.call.Times(n)
+	return c
+}
+
+// OnSomeActivity3 mocks executions of the mycompany.simple.Simple.SomeActivity3 activity whose input satisfies the given
+// matcher, which may be nil to match any input
+func (c *TestSimpleClient) OnSomeActivity3(matcher func(*SomeActivity3Request) bool) *TestSomeActivity3ActivityCall {
+	var req any = mock.Anything
+	if matcher != nil {
+		req = mock.MatchedBy(matcher)
+	}
+	return &TestSomeActivity3ActivityCall{c.env.OnActivity(SomeActivity3ActivityName, mock.Anything, req)}
+}
+
+// TestSomeActivity3ActivityCall describes a mocked mycompany.simple.Simple.SomeActivity3 activity execution
+type TestSomeActivity3ActivityCall struct {
+	call *testsuite.MockCallWrapper
+}
+
+// Return specifies the result of matching activity executions
+func (c *TestSomeActivity3ActivityCall) Return(resp *SomeActivity3Response, err error) *TestSomeActivity3ActivityCall {
+	c.call.Return(resp, err)
+	return c
+}
+
+// Times specifies the number of activity executions the mock should match
+func (c *TestSomeActivity3ActivityCall) Times(n int) *TestSomeActivity3ActivityCall {
+	c.call.Times(n)
+	return c
+}
+
+// After returns a TestSimpleDelayed value that schedules queries, signals, and updates
+// to be sent to the test environment's workflow after the given duration of virtual time
+func (c *TestSimpleClient) After(d time.Duration) *TestSimpleDelayed {
+	return &TestSimpleDelayed{c, d}
+}
+
+// TestSimpleDelayed schedules mycompany.simple.Simple queries, signals, and updates using the test
+// environment's RegisterDelayedCallback method
+type TestSimpleDelayed struct {
+	client *TestSimpleClient
+	delay  time.Duration
+}
+
+// SomeQuery1 schedules a(n) mycompany.simple.Simple.SomeQuery1 query, invoking fn with the result
+func (d *TestSimpleDelayed) SomeQuery1(fn func(*SomeQuery1Response, error)) {
+	d.client.env.RegisterDelayedCallback(func() {
+		fn(d.client.SomeQuery1(context.Background(), "", ""))
+	}, d.delay)
+}
+
+// SomeQuery2 schedules a(n) mycompany.simple.Simple.SomeQuery2 query, invoking fn with the result
+func (d *TestSimpleDelayed) SomeQuery2(req *SomeQuery2Request, fn func(*SomeQuery2Response, error)) {
+	d.client.env.RegisterDelayedCallback(func() {
+		fn(d.client.SomeQuery2(context.Background(), "", "", req))
+	}, d.delay)
+}
+
+// SomeSignal1 schedules a(n) mycompany.simple.Simple.SomeSignal1 signal
+func (d *TestSimpleDelayed) SomeSignal1() {
+	d.client.env.RegisterDelayedCallback(func() {
+		d.client.env.SignalWorkflow(SomeSignal1SignalName, nil)
+	}, d.delay)
+}
+
+// SomeSignal2 schedules a(n) mycompany.simple.Simple.SomeSignal2 signal
+func (d *TestSimpleDelayed) SomeSignal2(req *SomeSignal2Request) {
+	d.client.env.RegisterDelayedCallback(func() {
+		d.client.env.SignalWorkflow(SomeSignal2SignalName, req)
+	}, d.delay)
+}
+
+// SomeUpdate1 schedules a(n) mycompany.simple.Simple.SomeUpdate1 update, invoking the given callbacks, which may be nil
+func (d *TestSimpleDelayed) SomeUpdate1(req *SomeUpdate1Request, callbacks *TestSomeUpdate1Callbacks) {
+	if callbacks == nil {
+		callbacks = &TestSomeUpdate1Callbacks{}
+	}
+	d.client.env.RegisterDelayedCallback(func() {
+		d.client.env.UpdateWorkflow(SomeUpdate1UpdateName, callbacks, req)
+	}, d.delay)
+}
+
+// TestSomeUpdate1Callbacks describes optional callbacks invoked in response to a(n) mycompany.simple.Simple.SomeUpdate1 update
+// scheduled in a test environment
+type TestSomeUpdate1Callbacks struct {
+	OnAccept   func()
+	OnReject   func(error)
+	OnComplete func(*SomeUpdate1Response, error)
+}
+
+// Accept invokes the OnAccept callback, if set
+func (c *TestSomeUpdate1Callbacks) Accept() {
+	if c.OnAccept != nil {
+		c.OnAccept()
+	}
+}
+
+// Reject invokes the OnReject callback, if set
+func (c *TestSomeUpdate1Callbacks) Reject(err error) {
+	if c.OnReject != nil {
+		c.OnReject(err)
+	}
+}
+
+// Complete invokes the OnComplete callback, if set
+func (c *TestSomeUpdate1Callbacks) Complete(success any, err error) {
+	if c.OnComplete != nil {
+		resp, _ := success.(*SomeUpdate1Response)
+		c.OnComplete(resp, err)
+	}
+}
+
+// TestSimpleChildWorkflows provides typed mocks for mycompany.simple.Simple child workflow executions in a
+// test environment, including child workflows started by workflows defined in other services
+type TestSimpleChildWorkflows struct {
+	env *testsuite.TestWorkflowEnvironment
+}
+
+// NewTestSimpleChildWorkflows initializes a new TestSimpleChildWorkflows value, registering placeholder workflows
+// that fail unless mocked. It must be called before any mocks are registered with the test
+// environment, and must not be used when the service's workflows are registered with the test
+// environment, in which case the test client's On<Workflow>Child methods can be used instead.
+func NewTestSimpleChildWorkflows(env *testsuite.TestWorkflowEnvironment) *TestSimpleChildWorkflows {
+	env.RegisterWorkflowWithOptions(func(workflow.Context, *SomeWorkflow1Request) (*SomeWorkflow1Response, error) {
+		return nil, testutil.NewNotMockedError(testutil.KindWorkflow, SomeWorkflow1WorkflowName)
+	}, workflow.RegisterOptions{Name: SomeWorkflow1WorkflowName})
+	env.RegisterWorkflowWithOptions(func(workflow.Context) error {
+		return testutil.NewNotMockedError(testutil.KindWorkflow, SomeWorkflow2WorkflowName)
+	}, workflow.RegisterOptions{Name: SomeWorkflow2WorkflowName})
+	env.RegisterWorkflowWithOptions(func(workflow.Context, *SomeWorkflow3Request) error {
+		return testutil.NewNotMockedError(testutil.KindWorkflow, SomeWorkflow3WorkflowName)
+	}, workflow.RegisterOptions{Name: SomeWorkflow3WorkflowName})
+	env.RegisterWorkflowWithOptions(func(workflow.Context) error {
+		return testutil.NewNotMockedError(testutil.KindWorkflow, SomeWorkflow4WorkflowName)
+	}, workflow.RegisterOptions{Name: SomeWorkflow4WorkflowName})
+	return &TestSimpleChildWorkflows{env}
+}
+
+// OnSomeWorkflow1Child mocks child mycompany.simple.SomeWorkflow1 workflow executions whose input satisfies the given
+// matcher, which may be nil to match any input. The workflow must be registered with the test environment.
+func (c *TestSimpleClient) OnSomeWorkflow1Child(matcher func(*SomeWorkflow1Request) bool) *TestSomeWorkflow1ChildCall {
+	return (&TestSimpleChildWorkflows{c.env}).OnSomeWorkflow1Child(matcher)
+}
+
+// OnSomeWorkflow1Child mocks child mycompany.simple.SomeWorkflow1 workflow executions whose input satisfies the given
+// matcher, which may be nil to match any input
+func (c *TestSimpleChildWorkflows) OnSomeWorkflow1Child(matcher func(*SomeWorkflow1Request) bool) *TestSomeWorkflow1ChildCall {
+	var req any = mock.Anything
+	if matcher != nil {
+		req = mock.MatchedBy(matcher)
+	}
+	return &TestSomeWorkflow1ChildCall{c.env.OnWorkflow(SomeWorkflow1WorkflowName, mock.Anything, req)}
+}
+
+// TestSomeWorkflow1ChildCall describes a mocked child mycompany.simple.SomeWorkflow1 workflow execution
+type TestSomeWorkflow1ChildCall struct {
+	call *testsuite.MockCallWrapper
+}
+
+// Return specifies the result of matching child workflow executions
+func (c *TestSomeWorkflow1ChildCall) Return(resp *SomeWorkflow1Response, err error) *TestSomeWorkflow1ChildCall {
+	c.call.Return(resp, err)
+	return c
+}
+
+// Times specifies the number of child workflow executions the mock should match
+func (c *TestSomeWorkflow1ChildCall) Times(n int) *TestSomeWorkflow1ChildCall {
+	c.call.Times(n)
+	return c
+}
+
+// OnSomeWorkflow2Child mocks child mycompany.simple.SomeWorkflow2 workflow executions, which must be registered with the test environment
+func (c *TestSimpleClient) OnSomeWorkflow2Child() *TestSomeWorkflow2ChildCall {
+	return (&TestSimpleChildWorkflows{c.env}).OnSomeWorkflow2Child()
+}
+
+// OnSomeWorkflow2Child mocks child mycompany.simple.SomeWorkflow2 workflow executions
+func (c *TestSimpleChildWorkflows) OnSomeWorkflow2Child() *TestSomeWorkflow2ChildCall {
+	return &TestSomeWorkflow2ChildCall{c.env.OnWorkflow(SomeWorkflow2WorkflowName, mock.Anything)}
+}
+
+// TestSomeWorkflow2ChildCall describes a mocked child mycompany.simple.SomeWorkflow2 workflow execution
+type TestSomeWorkflow2ChildCall struct {
+	call *testsuite.MockCallWrapper
+}
+
+// Return specifies the result of matching child workflow executions
+func (c *TestSomeWorkflow2ChildCall) Return(err error) *TestSomeWorkflow2ChildCall {
+	c.call.Return(err)
+	return c
+}
+
+// Times specifies the number of child workflow executions the mock should match
+func (c *TestSomeWorkflow2ChildCall) Times(n int) *TestSomeWorkflow2ChildCall {
+	c.call.Times(n)
+	return c
+}
+
+// OnSomeWorkflow3Child mocks child mycompany.simple.Simple.SomeWorkflow3 workflow executions whose input satisfies the given
+// matcher, which may be nil to match any input. The workflow must be registered with the test environment.
+func (c *TestSimpleClient) OnSomeWorkflow3Child(matcher func(*SomeWorkflow3Request) bool) *TestSomeWorkflow3ChildCall {
+	return (&TestSimpleChildWorkflows{c.env}).OnSomeWorkflow3Child(matcher)
+}
+
+// OnSomeWorkflow3Child mocks child mycompany.simple.Simple.SomeWorkflow3 workflow executions whose input satisfies the given
+// matcher, which may be nil to match any input
+func (c *TestSimpleChildWorkflows) OnSomeWorkflow3Child(matcher func(*SomeWorkflow3Request) bool) *TestSomeWorkflow3ChildCall {
+	var req any = mock.Anything
+	if matcher != nil {
+		req = mock.MatchedBy(matcher)
+	}
+	return &TestSomeWorkflow3ChildCall{c.env.OnWorkflow(SomeWorkflow3WorkflowName, mock.Anything, req)}
+}
+
+// TestSomeWorkflow3ChildCall describes a mocked child mycompany.simple.Simple.SomeWorkflow3 workflow execution
+type TestSomeWorkflow3ChildCall struct {
+	call *testsuite.MockCallWrapper
+}
+
+// Return specifies the result of matching child workflow executions
+func (c *TestSomeWorkflow3ChildCall) Return(err error) *TestSomeWorkflow3ChildCall {
+	c.call.Return(err)
+	return c
+}
+
+// Times specifies the number of child workflow executions the mock should match
+func (c *TestSomeWorkflow3ChildCall) Times(n int) *TestSomeWorkflow3ChildCall {
+	c.call.Times(n)
+	return c
+}
+
+// OnSomeWorkflow4Child mocks child mycompany.simple.SomeWorkflow4 workflow executions, which must be registered with the test environment
+func (c *TestSimpleClient) OnSomeWorkflow4Child() *TestSomeWorkflow4ChildCall {
+	return (&TestSimpleChildWorkflows{c.env}).OnSomeWorkflow4Child()
+}
+
+// OnSomeWorkflow4Child mocks child mycompany.simple.SomeWorkflow4 workflow executions
+func (c *TestSimpleChildWorkflows) OnSomeWorkflow4Child() *TestSomeWorkflow4ChildCall {
+	return &TestSomeWorkflow4ChildCall{c.env.OnWorkflow(SomeWorkflow4WorkflowName, mock.Anything)}
+}
+
+// TestSomeWorkflow4ChildCall describes a mocked child mycompany.simple.SomeWorkflow4 workflow execution
+type TestSomeWorkflow4ChildCall struct {
+	call *testsuite.MockCallWrapper
+}
+
+// Return specifies the result of matching child workflow executions
+func (c *TestSomeWorkflow4ChildCall) Return(err error) *TestSomeWorkflow4ChildCall {
+	c.call.Return(err)
+	return c
+}
+
+// Times specifies the number of child workflow executions the mock should match
+func (c *TestSomeWorkflow4ChildCall) Times(n int) *TestSomeWorkflow4ChildCall {
+	c.call.Times(n)
+	return c
+}
+
+// CompleteSomeActivity3 completes a(n) mycompany.simple.Simple.SomeActivity3 activity in the test environment
+func (c *TestSimpleClient) CompleteSomeActivity3(ctx context.Context, taskToken []byte, resp *SomeActivity3Response, err error) error {
+	return c.env.CompleteActivity(taskToken, resp, err)
+}
+
+// CompleteSomeActivity3ByID is not supported by the test client, use CompleteSomeActivity3 instead
+func (c *TestSimpleClient) CompleteSomeActivity3ByID(ctx context.Context, namespace string, workflowID string, runID string, activityID string, resp *SomeActivity3Response, err error) error {
+	return errors.New("activity completion by id is not supported by the test client")
+}
+
+var _ SomeWorkflow1Run = &testSomeWorkflow1Run{}
+
+// testSomeWorkflow1Run provides convenience methods for interacting with a(n) SomeWorkflow1 workflow in the test environment
+type testSomeWorkflow1Run struct {
+	client    *TestSimpleClient
+	env       *testsuite.TestWorkflowEnvironment
+	opts      *client.StartWorkflowOptions
+	req       *SomeWorkflow1Request
+	workflows SimpleWorkflows
 }
 
 // Get retrieves a test SomeWorkflow1 workflow result
@@ -2077,6 +4599,15 @@ func (r *testSomeWorkflow1Run) RunID() string {
 	return ""
 }
 
+// Memo returns a test SomeWorkflow1 workflow run's memo, populated from the memo of its start options
+func (r *testSomeWorkflow1Run) Memo(context.Context) (*SomeWorkflow1Memo, error) {
+	memo := &SomeWorkflow1Memo{}
+	if r.opts != nil {
+		memo.RequestVal = r.opts.Memo["requestVal"]
+	}
+	return memo, nil
+}
+
 // SomeQuery1 executes a SomeQuery1 query against a test SomeWorkflow1 workflow
 func (r *testSomeWorkflow1Run) SomeQuery1(ctx context.Context) (*SomeQuery1Response, error) {
 	return r.client.SomeQuery1(ctx, r.ID(), r.RunID())
@@ -2137,12 +4668,12 @@ func (r *testSomeWorkflow2Run) SomeSignal1(ctx context.Context) error {
 	return r.client.SomeSignal1(ctx, r.ID(), r.RunID())
 }
 
-// SomeUpdate1 executes a(n) mycompany.simple.Simple.SomeUpdate1 update against a test mycompany.simple.Simple.SomeWorkflow2 workflow
+// SomeUpdate1 executes a(n) mycompany.simple.Simple.SomeUpdate1 update against a test mycompany.simple.SomeWorkflow2 workflow
 func (r *testSomeWorkflow2Run) SomeUpdate1(ctx context.Context, req *SomeUpdate1Request, opts ...*SomeUpdate1Options) (*SomeUpdate1Response, error) {
 	return r.client.SomeUpdate1(ctx, r.ID(), r.RunID(), req, opts...)
 }
 
-// SomeUpdate1Async executes a(n) mycompany.simple.Simple.SomeUpdate1 update against a test mycompany.simple.Simple.SomeWorkflow2 workflow
+// SomeUpdate1Async executes a(n) mycompany.simple.Simple.SomeUpdate1 update against a test mycompany.simple.SomeWorkflow2 workflow
 func (r *testSomeWorkflow2Run) SomeUpdate1Async(ctx context.Context, req *SomeUpdate1Request, opts ...*SomeUpdate1Options) (SomeUpdate1Handle, error) {
 	return r.client.SomeUpdate1Async(ctx, r.ID(), r.RunID(), req, opts...)
 }
@@ -2188,119 +4719,2638 @@ func (r *testSomeWorkflow3Run) SomeSignal2(ctx context.Context, req *SomeSignal2
 	return r.client.SomeSignal2(ctx, r.ID(), r.RunID(), req)
 }
 
-// SimpleCliOptions describes runtime configuration for mycompany.simple.Simple cli
-type SimpleCliOptions struct {
-	after            func(*v2.Context) error
-	before           func(*v2.Context) error
-	clientForCommand func(*v2.Context) (client.Client, error)
-	worker           func(*v2.Context, client.Client) (worker.Worker, error)
+var _ SomeWorkflow4Run = &testSomeWorkflow4Run{}
+
+// testSomeWorkflow4Run provides convenience methods for interacting with a(n) SomeWorkflow4 workflow in the test environment
+type testSomeWorkflow4Run struct {
+	client    *TestSimpleClient
+	env       *testsuite.TestWorkflowEnvironment
+	opts      *client.StartWorkflowOptions
+	workflows SimpleWorkflows
 }
 
-// NewSimpleCliOptions initializes a new SimpleCliOptions value
-func NewSimpleCliOptions() *SimpleCliOptions {
-	return &SimpleCliOptions{}
+// Get retrieves a test SomeWorkflow4 workflow result
+func (r *testSomeWorkflow4Run) Get(context.Context) error {
+	r.env.ExecuteWorkflow(SomeWorkflow4WorkflowName)
+	if !r.env.IsWorkflowCompleted() {
+		return errors.New("workflow in progress")
+	}
+	if err := r.env.GetWorkflowError(); err != nil {
+		return err
+	}
+	return nil
 }
 
-// WithAfter injects a custom After hook to be run after any command invocation
-func (opts *SimpleCliOptions) WithAfter(fn func(*v2.Context) error) *SimpleCliOptions {
-	opts.after = fn
-	return opts
+// ID returns a test SomeWorkflow4 workflow run's workflow ID
+func (r *testSomeWorkflow4Run) ID() string {
+	if r.opts != nil {
+		return r.opts.ID
+	}
+	return ""
 }
 
-// WithBefore injects a custom Before hook to be run prior to any command invocation
-func (opts *SimpleCliOptions) WithBefore(fn func(*v2.Context) error) *SimpleCliOptions {
-	opts.before = fn
-	return opts
+// RunID noop implementation
+func (r *testSomeWorkflow4Run) RunID() string {
+	return ""
 }
 
-// WithClient provides a Temporal client factory for use by commands
-func (opts *SimpleCliOptions) WithClient(fn func(*v2.Context) (client.Client, error)) *SimpleCliOptions {
-	opts.clientForCommand = fn
-	return opts
+// GetValue executes a GetValue query against a test SomeWorkflow4 workflow
+func (r *testSomeWorkflow4Run) GetValue(ctx context.Context) (*common.GetValueResponse, error) {
+	return common.NewTestCommonClient(r.env, nil, nil).GetValue(ctx, r.ID(), r.RunID())
 }
 
-// WithWorker provides an method for initializing a worker
-func (opts *SimpleCliOptions) WithWorker(fn func(*v2.Context, client.Client) (worker.Worker, error)) *SimpleCliOptions {
-	opts.worker = fn
-	return opts
+// SetValue executes a SetValue signal against a test SomeWorkflow4 workflow
+func (r *testSomeWorkflow4Run) SetValue(ctx context.Context, req *common.SetValueRequest) error {
+	return common.NewTestCommonClient(r.env, nil, nil).SetValue(ctx, r.ID(), r.RunID(), req)
 }
 
-// NewSimpleCli initializes a cli for a(n) mycompany.simple.Simple service
-func NewSimpleCli(options ...*SimpleCliOptions) (*v2.App, error) {
-	commands, err := newSimpleCommands(options...)
+// UpdateValue executes a(n) mycompany.simple.common.Common.UpdateValue update against a test mycompany.simple.SomeWorkflow4 workflow
+func (r *testSomeWorkflow4Run) UpdateValue(ctx context.Context, req *common.UpdateValueRequest, opts ...*common.UpdateValueOptions) (*common.UpdateValueResponse, error) {
+	return common.NewTestCommonClient(r.env, nil, nil).UpdateValue(ctx, r.ID(), r.RunID(), req, opts...)
+}
+
+// UpdateValueAsync executes a(n) mycompany.simple.common.Common.UpdateValue update against a test mycompany.simple.SomeWorkflow4 workflow
+func (r *testSomeWorkflow4Run) UpdateValueAsync(ctx context.Context, req *common.UpdateValueRequest, opts ...*common.UpdateValueOptions) (common.UpdateValueHandle, error) {
+	return common.NewTestCommonClient(r.env, nil, nil).UpdateValueAsync(ctx, r.ID(), r.RunID(), req, opts...)
+}
+
+// TestSimpleActivities executes mycompany.simple.Simple activities in a test activity environment
+type TestSimpleActivities struct {
+	env *testsuite.TestActivityEnvironment
+}
+
+var _ SimpleActivities = &TestSimpleActivities{}
+
+// NewTestSimpleActivities registers the given activities with the test activity environment
+// and initializes a new TestSimpleActivities value
+func NewTestSimpleActivities(env *testsuite.TestActivityEnvironment, activities SimpleActivities) *TestSimpleActivities {
+	RegisterSimpleActivities(env, activities)
+	return &TestSimpleActivities{env}
+}
+
+// SomeActivity1 executes a(n) mycompany.simple.SomeActivity1 activity in the test activity environment.
+// The context is ignored, use the environment's SetWorkerOptions (BackgroundActivityContext)
+// and SetTestTimeout methods to configure the activity context and timeout.
+func (a *TestSimpleActivities) SomeActivity1(_ context.Context) error {
+	_, err := a.env.ExecuteActivity(SomeActivity1ActivityName)
+	return err
+}
+
+// SomeActivity2 executes a(n) mycompany.simple.Simple.SomeActivity2 activity in the test activity environment.
+// The context is ignored, use the environment's SetWorkerOptions (BackgroundActivityContext)
+// and SetTestTimeout methods to configure the activity context and timeout.
+func (a *TestSimpleActivities) SomeActivity2(_ context.Context, req *SomeActivity2Request) error {
+	_, err := a.env.ExecuteActivity(SomeActivity2ActivityName, req)
+	return err
+}
+
+// SetSomeActivity2HeartbeatDetails sets the typed details of a previous mycompany.simple.Simple.SomeActivity2 activity attempt's
+// last heartbeat, returned by GetSomeActivity2HeartbeatDetails in subsequent executions
+func (a *TestSimpleActivities) SetSomeActivity2HeartbeatDetails(details *SomeActivity2Progress) {
+	a.env.SetHeartbeatDetails(details)
+}
+
+// OnSomeActivity2Heartbeat registers a listener that is invoked with the typed details of heartbeats
+// recorded by mycompany.simple.Simple.SomeActivity2 activity executions. The listener replaces any heartbeat
+// listener previously registered with the test activity environment, and may not be invoked
+// for every heartbeat due to heartbeat throttling.
+func (a *TestSimpleActivities) OnSomeActivity2Heartbeat(fn func(*SomeActivity2Progress)) {
+	a.env.SetOnActivityHeartbeatListener(func(info *activity.Info, encoded converter.EncodedValues) {
+		if info.ActivityType.Name != SomeActivity2ActivityName {
+			return
+		}
+		var details SomeActivity2Progress
+		if encoded.HasValues() {
+			if err := encoded.Get(&details); err != nil {
+				return
+			}
+		}
+		fn(&details)
+	})
+}
+
+// SomeActivity3 executes a(n) mycompany.simple.Simple.SomeActivity3 activity in the test activity environment.
+// The context is ignored, use the environment's SetWorkerOptions (BackgroundActivityContext)
+// and SetTestTimeout methods to configure the activity context and timeout.
+func (a *TestSimpleActivities) SomeActivity3(_ context.Context, req *SomeActivity3Request) (*SomeActivity3Response, error) {
+	val, err := a.env.ExecuteActivity(SomeActivity3ActivityName, req)
 	if err != nil {
-		return nil, fmt.Errorf("error initializing subcommands: %w", err)
+		return nil, err
 	}
-	return &v2.App{
-		Name:     "simple",
-		Commands: commands,
-	}, nil
+	var resp SomeActivity3Response
+	if err := val.Get(&resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
 }
 
-// NewSimpleCliCommand initializes a cli command for a mycompany.simple.Simple service with subcommands for each query, signal, update, and workflow
-func NewSimpleCliCommand(options ...*SimpleCliOptions) (*v2.Command, error) {
-	subcommands, err := newSimpleCommands(options...)
-	if err != nil {
-		return nil, fmt.Errorf("error initializing subcommands: %w", err)
+// ReplaySimpleWorkflows replays the given JSON workflow history files, exported from Temporal, against
+// the given mycompany.simple.Simple workflow implementations, registered via RegisterSimpleWorkflows.
+// Each history file that cannot be replayed, such as due to a nondeterminism error, is
+// reported as a test error.
+func ReplaySimpleWorkflows(t testutil.TestingT, workflows SimpleWorkflows, historyFiles ...string) {
+	t.Helper()
+	replayer := worker.NewWorkflowReplayer()
+	RegisterSimpleWorkflows(replayer, workflows)
+	for _, historyFile := range historyFiles {
+		if err := replayer.ReplayWorkflowHistoryFromJSONFile(nil, historyFile); err != nil {
+			t.Errorf("error replaying workflow history %s: %v", historyFile, err)
+		}
 	}
-	return &v2.Command{
-		Name:        "simple",
-		Subcommands: subcommands,
-	}, nil
 }
 
-// newSimpleCommands initializes (sub)commands for a mycompany.simple.Simple cli or command
-func newSimpleCommands(options ...*SimpleCliOptions) ([]*v2.Command, error) {
-	opts := &SimpleCliOptions{}
-	if len(options) > 0 {
-		opts = options[0]
+// MockSimpleClient is a testify mock implementation of SimpleClient
+type MockSimpleClient struct {
+	mock.Mock
+}
+
+var _ SimpleClient = (*MockSimpleClient)(nil)
+
+// NewMockSimpleClient initializes a new MockSimpleClient that asserts its expectations when the test completes
+func NewMockSimpleClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSimpleClient {
+	m := &MockSimpleClient{}
+	m.Mock.Test(t)
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+	return m
+}
+
+// SomeWorkflow1 implements SimpleClient
+func (m *MockSimpleClient) SomeWorkflow1(ctx context.Context, req *SomeWorkflow1Request, opts ...*SomeWorkflow1Options) (*SomeWorkflow1Response, error) {
+	args := m.Called(ctx, req, opts)
+	var r0 *SomeWorkflow1Response
+	if v := args.Get(0); v != nil {
+		r0 = v.(*SomeWorkflow1Response)
 	}
-	if opts.clientForCommand == nil {
-		opts.clientForCommand = func(*v2.Context) (client.Client, error) {
-			return client.Dial(client.Options{})
-		}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
 	}
-	commands := []*v2.Command{
-		// SomeQuery1 queries some thing.,
-		{
-			Name:                   "some-query-1",
-			Usage:                  "SomeQuery1 queries some thing.",
-			UseShortOptionHandling: true,
-			Before:                 opts.before,
-			After:                  opts.after,
-			Flags: []v2.Flag{
-				&v2.StringFlag{
-					Name:     "workflow-id",
-					Usage:    "workflow id",
-					Required: true,
-					Aliases:  []string{"w"},
-				},
-				&v2.StringFlag{
-					Name:    "run-id",
-					Usage:   "run id",
-					Aliases: []string{"r"},
-				},
-			},
-			Action: func(cmd *v2.Context) error {
-				c, err := opts.clientForCommand(cmd)
-				if err != nil {
-					return fmt.Errorf("error initializing client for command: %w", err)
-				}
-				defer c.Close()
-				client := NewSimpleClient(c)
-				if resp, err := client.SomeQuery1(cmd.Context, cmd.String("workflow-id"), cmd.String("run-id")); err != nil {
-					return fmt.Errorf("error executing %q query: %w", SomeQuery1QueryName, err)
-				} else {
-					b, err := protojson.Marshal(resp)
-					if err != nil {
-						return fmt.Errorf("error serializing response json: %w", err)
-					}
-					var out bytes.Buffer
-					if err := json.Indent(&out, b, "", "  "); err != nil {
-						return fmt.Errorf("error formatting json: %w", err)
-					}
-					fmt.Println(out.String())
-					return nil
+	return r0, r1
+}
+
+// OnSomeWorkflow1 registers an expectation for a call to SomeWorkflow1 with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnSomeWorkflow1(ctx any, req any, opts any) *MockSimpleClientSomeWorkflow1Call {
+	return &MockSimpleClientSomeWorkflow1Call{Call: m.On("SomeWorkflow1", ctx, req, opts)}
+}
+
+// MockSimpleClientSomeWorkflow1Call describes an expected call to MockSimpleClient.SomeWorkflow1
+type MockSimpleClientSomeWorkflow1Call struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientSomeWorkflow1Call) Return(resp *SomeWorkflow1Response, err error) *MockSimpleClientSomeWorkflow1Call {
+	c.Call.Return(resp, err)
+	return c
+}
+
+// SomeWorkflow1Async implements SimpleClient
+func (m *MockSimpleClient) SomeWorkflow1Async(ctx context.Context, req *SomeWorkflow1Request, opts ...*SomeWorkflow1Options) (SomeWorkflow1Run, error) {
+	args := m.Called(ctx, req, opts)
+	var r0 SomeWorkflow1Run
+	if v := args.Get(0); v != nil {
+		r0 = v.(SomeWorkflow1Run)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnSomeWorkflow1Async registers an expectation for a call to SomeWorkflow1Async with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnSomeWorkflow1Async(ctx any, req any, opts any) *MockSimpleClientSomeWorkflow1AsyncCall {
+	return &MockSimpleClientSomeWorkflow1AsyncCall{Call: m.On("SomeWorkflow1Async", ctx, req, opts)}
+}
+
+// MockSimpleClientSomeWorkflow1AsyncCall describes an expected call to MockSimpleClient.SomeWorkflow1Async
+type MockSimpleClientSomeWorkflow1AsyncCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientSomeWorkflow1AsyncCall) Return(run SomeWorkflow1Run, err error) *MockSimpleClientSomeWorkflow1AsyncCall {
+	c.Call.Return(run, err)
+	return c
+}
+
+// GetSomeWorkflow1 implements SimpleClient
+func (m *MockSimpleClient) GetSomeWorkflow1(ctx context.Context, workflowID string, runID string) SomeWorkflow1Run {
+	args := m.Called(ctx, workflowID, runID)
+	var r0 SomeWorkflow1Run
+	if v := args.Get(0); v != nil {
+		r0 = v.(SomeWorkflow1Run)
+	}
+	return r0
+}
+
+// OnGetSomeWorkflow1 registers an expectation for a call to GetSomeWorkflow1 with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnGetSomeWorkflow1(ctx any, workflowID any, runID any) *MockSimpleClientGetSomeWorkflow1Call {
+	return &MockSimpleClientGetSomeWorkflow1Call{Call: m.On("GetSomeWorkflow1", ctx, workflowID, runID)}
+}
+
+// MockSimpleClientGetSomeWorkflow1Call describes an expected call to MockSimpleClient.GetSomeWorkflow1
+type MockSimpleClientGetSomeWorkflow1Call struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientGetSomeWorkflow1Call) Return(run SomeWorkflow1Run) *MockSimpleClientGetSomeWorkflow1Call {
+	c.Call.Return(run)
+	return c
+}
+
+// CreateSomeWorkflow1Schedule implements SimpleClient
+func (m *MockSimpleClient) CreateSomeWorkflow1Schedule(ctx context.Context, id string, spec client.ScheduleSpec, req *SomeWorkflow1Request, opts ...*SomeWorkflow1ScheduleOptions) (client.ScheduleHandle, error) {
+	args := m.Called(ctx, id, spec, req, opts)
+	var r0 client.ScheduleHandle
+	if v := args.Get(0); v != nil {
+		r0 = v.(client.ScheduleHandle)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnCreateSomeWorkflow1Schedule registers an expectation for a call to CreateSomeWorkflow1Schedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnCreateSomeWorkflow1Schedule(ctx any, id any, spec any, req any, opts any) *MockSimpleClientCreateSomeWorkflow1ScheduleCall {
+	return &MockSimpleClientCreateSomeWorkflow1ScheduleCall{Call: m.On("CreateSomeWorkflow1Schedule", ctx, id, spec, req, opts)}
+}
+
+// MockSimpleClientCreateSomeWorkflow1ScheduleCall describes an expected call to MockSimpleClient.CreateSomeWorkflow1Schedule
+type MockSimpleClientCreateSomeWorkflow1ScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientCreateSomeWorkflow1ScheduleCall) Return(handle client.ScheduleHandle, err error) *MockSimpleClientCreateSomeWorkflow1ScheduleCall {
+	c.Call.Return(handle, err)
+	return c
+}
+
+// GetSomeWorkflow1Schedule implements SimpleClient
+func (m *MockSimpleClient) GetSomeWorkflow1Schedule(ctx context.Context, id string) (*client.ScheduleDescription, error) {
+	args := m.Called(ctx, id)
+	var r0 *client.ScheduleDescription
+	if v := args.Get(0); v != nil {
+		r0 = v.(*client.ScheduleDescription)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnGetSomeWorkflow1Schedule registers an expectation for a call to GetSomeWorkflow1Schedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnGetSomeWorkflow1Schedule(ctx any, id any) *MockSimpleClientGetSomeWorkflow1ScheduleCall {
+	return &MockSimpleClientGetSomeWorkflow1ScheduleCall{Call: m.On("GetSomeWorkflow1Schedule", ctx, id)}
+}
+
+// MockSimpleClientGetSomeWorkflow1ScheduleCall describes an expected call to MockSimpleClient.GetSomeWorkflow1Schedule
+type MockSimpleClientGetSomeWorkflow1ScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientGetSomeWorkflow1ScheduleCall) Return(desc *client.ScheduleDescription, err error) *MockSimpleClientGetSomeWorkflow1ScheduleCall {
+	c.Call.Return(desc, err)
+	return c
+}
+
+// PauseSomeWorkflow1Schedule implements SimpleClient
+func (m *MockSimpleClient) PauseSomeWorkflow1Schedule(ctx context.Context, id string, note string) error {
+	args := m.Called(ctx, id, note)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnPauseSomeWorkflow1Schedule registers an expectation for a call to PauseSomeWorkflow1Schedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnPauseSomeWorkflow1Schedule(ctx any, id any, note any) *MockSimpleClientPauseSomeWorkflow1ScheduleCall {
+	return &MockSimpleClientPauseSomeWorkflow1ScheduleCall{Call: m.On("PauseSomeWorkflow1Schedule", ctx, id, note)}
+}
+
+// MockSimpleClientPauseSomeWorkflow1ScheduleCall describes an expected call to MockSimpleClient.PauseSomeWorkflow1Schedule
+type MockSimpleClientPauseSomeWorkflow1ScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientPauseSomeWorkflow1ScheduleCall) Return(err error) *MockSimpleClientPauseSomeWorkflow1ScheduleCall {
+	c.Call.Return(err)
+	return c
+}
+
+// UnpauseSomeWorkflow1Schedule implements SimpleClient
+func (m *MockSimpleClient) UnpauseSomeWorkflow1Schedule(ctx context.Context, id string, note string) error {
+	args := m.Called(ctx, id, note)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnUnpauseSomeWorkflow1Schedule registers an expectation for a call to UnpauseSomeWorkflow1Schedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnUnpauseSomeWorkflow1Schedule(ctx any, id any, note any) *MockSimpleClientUnpauseSomeWorkflow1ScheduleCall {
+	return &MockSimpleClientUnpauseSomeWorkflow1ScheduleCall{Call: m.On("UnpauseSomeWorkflow1Schedule", ctx, id, note)}
+}
+
+// MockSimpleClientUnpauseSomeWorkflow1ScheduleCall describes an expected call to MockSimpleClient.UnpauseSomeWorkflow1Schedule
+type MockSimpleClientUnpauseSomeWorkflow1ScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientUnpauseSomeWorkflow1ScheduleCall) Return(err error) *MockSimpleClientUnpauseSomeWorkflow1ScheduleCall {
+	c.Call.Return(err)
+	return c
+}
+
+// TriggerSomeWorkflow1Schedule implements SimpleClient
+func (m *MockSimpleClient) TriggerSomeWorkflow1Schedule(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnTriggerSomeWorkflow1Schedule registers an expectation for a call to TriggerSomeWorkflow1Schedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnTriggerSomeWorkflow1Schedule(ctx any, id any) *MockSimpleClientTriggerSomeWorkflow1ScheduleCall {
+	return &MockSimpleClientTriggerSomeWorkflow1ScheduleCall{Call: m.On("TriggerSomeWorkflow1Schedule", ctx, id)}
+}
+
+// MockSimpleClientTriggerSomeWorkflow1ScheduleCall describes an expected call to MockSimpleClient.TriggerSomeWorkflow1Schedule
+type MockSimpleClientTriggerSomeWorkflow1ScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientTriggerSomeWorkflow1ScheduleCall) Return(err error) *MockSimpleClientTriggerSomeWorkflow1ScheduleCall {
+	c.Call.Return(err)
+	return c
+}
+
+// DeleteSomeWorkflow1Schedule implements SimpleClient
+func (m *MockSimpleClient) DeleteSomeWorkflow1Schedule(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnDeleteSomeWorkflow1Schedule registers an expectation for a call to DeleteSomeWorkflow1Schedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnDeleteSomeWorkflow1Schedule(ctx any, id any) *MockSimpleClientDeleteSomeWorkflow1ScheduleCall {
+	return &MockSimpleClientDeleteSomeWorkflow1ScheduleCall{Call: m.On("DeleteSomeWorkflow1Schedule", ctx, id)}
+}
+
+// MockSimpleClientDeleteSomeWorkflow1ScheduleCall describes an expected call to MockSimpleClient.DeleteSomeWorkflow1Schedule
+type MockSimpleClientDeleteSomeWorkflow1ScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientDeleteSomeWorkflow1ScheduleCall) Return(err error) *MockSimpleClientDeleteSomeWorkflow1ScheduleCall {
+	c.Call.Return(err)
+	return c
+}
+
+// SomeWorkflow2 implements SimpleClient
+func (m *MockSimpleClient) SomeWorkflow2(ctx context.Context, opts ...*SomeWorkflow2Options) error {
+	args := m.Called(ctx, opts)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnSomeWorkflow2 registers an expectation for a call to SomeWorkflow2 with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnSomeWorkflow2(ctx any, opts any) *MockSimpleClientSomeWorkflow2Call {
+	return &MockSimpleClientSomeWorkflow2Call{Call: m.On("SomeWorkflow2", ctx, opts)}
+}
+
+// MockSimpleClientSomeWorkflow2Call describes an expected call to MockSimpleClient.SomeWorkflow2
+type MockSimpleClientSomeWorkflow2Call struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientSomeWorkflow2Call) Return(err error) *MockSimpleClientSomeWorkflow2Call {
+	c.Call.Return(err)
+	return c
+}
+
+// SomeWorkflow2Async implements SimpleClient
+func (m *MockSimpleClient) SomeWorkflow2Async(ctx context.Context, opts ...*SomeWorkflow2Options) (SomeWorkflow2Run, error) {
+	args := m.Called(ctx, opts)
+	var r0 SomeWorkflow2Run
+	if v := args.Get(0); v != nil {
+		r0 = v.(SomeWorkflow2Run)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnSomeWorkflow2Async registers an expectation for a call to SomeWorkflow2Async with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnSomeWorkflow2Async(ctx any, opts any) *MockSimpleClientSomeWorkflow2AsyncCall {
+	return &MockSimpleClientSomeWorkflow2AsyncCall{Call: m.On("SomeWorkflow2Async", ctx, opts)}
+}
+
+// MockSimpleClientSomeWorkflow2AsyncCall describes an expected call to MockSimpleClient.SomeWorkflow2Async
+type MockSimpleClientSomeWorkflow2AsyncCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientSomeWorkflow2AsyncCall) Return(run SomeWorkflow2Run, err error) *MockSimpleClientSomeWorkflow2AsyncCall {
+	c.Call.Return(run, err)
+	return c
+}
+
+// GetSomeWorkflow2 implements SimpleClient
+func (m *MockSimpleClient) GetSomeWorkflow2(ctx context.Context, workflowID string, runID string) SomeWorkflow2Run {
+	args := m.Called(ctx, workflowID, runID)
+	var r0 SomeWorkflow2Run
+	if v := args.Get(0); v != nil {
+		r0 = v.(SomeWorkflow2Run)
+	}
+	return r0
+}
+
+// OnGetSomeWorkflow2 registers an expectation for a call to GetSomeWorkflow2 with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnGetSomeWorkflow2(ctx any, workflowID any, runID any) *MockSimpleClientGetSomeWorkflow2Call {
+	return &MockSimpleClientGetSomeWorkflow2Call{Call: m.On("GetSomeWorkflow2", ctx, workflowID, runID)}
+}
+
+// MockSimpleClientGetSomeWorkflow2Call describes an expected call to MockSimpleClient.GetSomeWorkflow2
+type MockSimpleClientGetSomeWorkflow2Call struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientGetSomeWorkflow2Call) Return(run SomeWorkflow2Run) *MockSimpleClientGetSomeWorkflow2Call {
+	c.Call.Return(run)
+	return c
+}
+
+// CreateSomeWorkflow2Schedule implements SimpleClient
+func (m *MockSimpleClient) CreateSomeWorkflow2Schedule(ctx context.Context, id string, spec client.ScheduleSpec, opts ...*SomeWorkflow2ScheduleOptions) (client.ScheduleHandle, error) {
+	args := m.Called(ctx, id, spec, opts)
+	var r0 client.ScheduleHandle
+	if v := args.Get(0); v != nil {
+		r0 = v.(client.ScheduleHandle)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnCreateSomeWorkflow2Schedule registers an expectation for a call to CreateSomeWorkflow2Schedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnCreateSomeWorkflow2Schedule(ctx any, id any, spec any, opts any) *MockSimpleClientCreateSomeWorkflow2ScheduleCall {
+	return &MockSimpleClientCreateSomeWorkflow2ScheduleCall{Call: m.On("CreateSomeWorkflow2Schedule", ctx, id, spec, opts)}
+}
+
+// MockSimpleClientCreateSomeWorkflow2ScheduleCall describes an expected call to MockSimpleClient.CreateSomeWorkflow2Schedule
+type MockSimpleClientCreateSomeWorkflow2ScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientCreateSomeWorkflow2ScheduleCall) Return(handle client.ScheduleHandle, err error) *MockSimpleClientCreateSomeWorkflow2ScheduleCall {
+	c.Call.Return(handle, err)
+	return c
+}
+
+// GetSomeWorkflow2Schedule implements SimpleClient
+func (m *MockSimpleClient) GetSomeWorkflow2Schedule(ctx context.Context, id string) (*client.ScheduleDescription, error) {
+	args := m.Called(ctx, id)
+	var r0 *client.ScheduleDescription
+	if v := args.Get(0); v != nil {
+		r0 = v.(*client.ScheduleDescription)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnGetSomeWorkflow2Schedule registers an expectation for a call to GetSomeWorkflow2Schedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnGetSomeWorkflow2Schedule(ctx any, id any) *MockSimpleClientGetSomeWorkflow2ScheduleCall {
+	return &MockSimpleClientGetSomeWorkflow2ScheduleCall{Call: m.On("GetSomeWorkflow2Schedule", ctx, id)}
+}
+
+// MockSimpleClientGetSomeWorkflow2ScheduleCall describes an expected call to MockSimpleClient.GetSomeWorkflow2Schedule
+type MockSimpleClientGetSomeWorkflow2ScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientGetSomeWorkflow2ScheduleCall) Return(desc *client.ScheduleDescription, err error) *MockSimpleClientGetSomeWorkflow2ScheduleCall {
+	c.Call.Return(desc, err)
+	return c
+}
+
+// PauseSomeWorkflow2Schedule implements SimpleClient
+func (m *MockSimpleClient) PauseSomeWorkflow2Schedule(ctx context.Context, id string, note string) error {
+	args := m.Called(ctx, id, note)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnPauseSomeWorkflow2Schedule registers an expectation for a call to PauseSomeWorkflow2Schedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnPauseSomeWorkflow2Schedule(ctx any, id any, note any) *MockSimpleClientPauseSomeWorkflow2ScheduleCall {
+	return &MockSimpleClientPauseSomeWorkflow2ScheduleCall{Call: m.On("PauseSomeWorkflow2Schedule", ctx, id, note)}
+}
+
+// MockSimpleClientPauseSomeWorkflow2ScheduleCall describes an expected call to MockSimpleClient.PauseSomeWorkflow2Schedule
+type MockSimpleClientPauseSomeWorkflow2ScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientPauseSomeWorkflow2ScheduleCall) Return(err error) *MockSimpleClientPauseSomeWorkflow2ScheduleCall {
+	c.Call.Return(err)
+	return c
+}
+
+// UnpauseSomeWorkflow2Schedule implements SimpleClient
+func (m *MockSimpleClient) UnpauseSomeWorkflow2Schedule(ctx context.Context, id string, note string) error {
+	args := m.Called(ctx, id, note)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnUnpauseSomeWorkflow2Schedule registers an expectation for a call to UnpauseSomeWorkflow2Schedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnUnpauseSomeWorkflow2Schedule(ctx any, id any, note any) *MockSimpleClientUnpauseSomeWorkflow2ScheduleCall {
+	return &MockSimpleClientUnpauseSomeWorkflow2ScheduleCall{Call: m.On("UnpauseSomeWorkflow2Schedule", ctx, id, note)}
+}
+
+// MockSimpleClientUnpauseSomeWorkflow2ScheduleCall describes an expected call to MockSimpleClient.UnpauseSomeWorkflow2Schedule
+type MockSimpleClientUnpauseSomeWorkflow2ScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientUnpauseSomeWorkflow2ScheduleCall) Return(err error) *MockSimpleClientUnpauseSomeWorkflow2ScheduleCall {
+	c.Call.Return(err)
+	return c
+}
+
+// TriggerSomeWorkflow2Schedule implements SimpleClient
+func (m *MockSimpleClient) TriggerSomeWorkflow2Schedule(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnTriggerSomeWorkflow2Schedule registers an expectation for a call to TriggerSomeWorkflow2Schedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnTriggerSomeWorkflow2Schedule(ctx any, id any) *MockSimpleClientTriggerSomeWorkflow2ScheduleCall {
+	return &MockSimpleClientTriggerSomeWorkflow2ScheduleCall{Call: m.On("TriggerSomeWorkflow2Schedule", ctx, id)}
+}
+
+// MockSimpleClientTriggerSomeWorkflow2ScheduleCall describes an expected call to MockSimpleClient.TriggerSomeWorkflow2Schedule
+type MockSimpleClientTriggerSomeWorkflow2ScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientTriggerSomeWorkflow2ScheduleCall) Return(err error) *MockSimpleClientTriggerSomeWorkflow2ScheduleCall {
+	c.Call.Return(err)
+	return c
+}
+
+// DeleteSomeWorkflow2Schedule implements SimpleClient
+func (m *MockSimpleClient) DeleteSomeWorkflow2Schedule(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnDeleteSomeWorkflow2Schedule registers an expectation for a call to DeleteSomeWorkflow2Schedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnDeleteSomeWorkflow2Schedule(ctx any, id any) *MockSimpleClientDeleteSomeWorkflow2ScheduleCall {
+	return &MockSimpleClientDeleteSomeWorkflow2ScheduleCall{Call: m.On("DeleteSomeWorkflow2Schedule", ctx, id)}
+}
+
+// MockSimpleClientDeleteSomeWorkflow2ScheduleCall describes an expected call to MockSimpleClient.DeleteSomeWorkflow2Schedule
+type MockSimpleClientDeleteSomeWorkflow2ScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientDeleteSomeWorkflow2ScheduleCall) Return(err error) *MockSimpleClientDeleteSomeWorkflow2ScheduleCall {
+	c.Call.Return(err)
+	return c
+}
+
+// SomeWorkflow2WithSomeSignal1 implements SimpleClient
+func (m *MockSimpleClient) SomeWorkflow2WithSomeSignal1(ctx context.Context, opts ...*SomeWorkflow2Options) error {
+	args := m.Called(ctx, opts)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnSomeWorkflow2WithSomeSignal1 registers an expectation for a call to SomeWorkflow2WithSomeSignal1 with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnSomeWorkflow2WithSomeSignal1(ctx any, opts any) *MockSimpleClientSomeWorkflow2WithSomeSignal1Call {
+	return &MockSimpleClientSomeWorkflow2WithSomeSignal1Call{Call: m.On("SomeWorkflow2WithSomeSignal1", ctx, opts)}
+}
+
+// MockSimpleClientSomeWorkflow2WithSomeSignal1Call describes an expected call to MockSimpleClient.SomeWorkflow2WithSomeSignal1
+type MockSimpleClientSomeWorkflow2WithSomeSignal1Call struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientSomeWorkflow2WithSomeSignal1Call) Return(err error) *MockSimpleClientSomeWorkflow2WithSomeSignal1Call {
+	c.Call.Return(err)
+	return c
+}
+
+// SomeWorkflow2WithSomeSignal1Async implements SimpleClient
+func (m *MockSimpleClient) SomeWorkflow2WithSomeSignal1Async(ctx context.Context, opts ...*SomeWorkflow2Options) (SomeWorkflow2Run, error) {
+	args := m.Called(ctx, opts)
+	var r0 SomeWorkflow2Run
+	if v := args.Get(0); v != nil {
+		r0 = v.(SomeWorkflow2Run)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnSomeWorkflow2WithSomeSignal1Async registers an expectation for a call to SomeWorkflow2WithSomeSignal1Async with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnSomeWorkflow2WithSomeSignal1Async(ctx any, opts any) *MockSimpleClientSomeWorkflow2WithSomeSignal1AsyncCall {
+	return &MockSimpleClientSomeWorkflow2WithSomeSignal1AsyncCall{Call: m.On("SomeWorkflow2WithSomeSignal1Async", ctx, opts)}
+}
+
+// MockSimpleClientSomeWorkflow2WithSomeSignal1AsyncCall describes an expected call to MockSimpleClient.SomeWorkflow2WithSomeSignal1Async
+type MockSimpleClientSomeWorkflow2WithSomeSignal1AsyncCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientSomeWorkflow2WithSomeSignal1AsyncCall) Return(run SomeWorkflow2Run, err error) *MockSimpleClientSomeWorkflow2WithSomeSignal1AsyncCall {
+	c.Call.Return(run, err)
+	return c
+}
+
+// SomeWorkflow3 implements SimpleClient
+func (m *MockSimpleClient) SomeWorkflow3(ctx context.Context, req *SomeWorkflow3Request, opts ...*SomeWorkflow3Options) error {
+	args := m.Called(ctx, req, opts)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnSomeWorkflow3 registers an expectation for a call to SomeWorkflow3 with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnSomeWorkflow3(ctx any, req any, opts any) *MockSimpleClientSomeWorkflow3Call {
+	return &MockSimpleClientSomeWorkflow3Call{Call: m.On("SomeWorkflow3", ctx, req, opts)}
+}
+
+// MockSimpleClientSomeWorkflow3Call describes an expected call to MockSimpleClient.SomeWorkflow3
+type MockSimpleClientSomeWorkflow3Call struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientSomeWorkflow3Call) Return(err error) *MockSimpleClientSomeWorkflow3Call {
+	c.Call.Return(err)
+	return c
+}
+
+// SomeWorkflow3Async implements SimpleClient
+func (m *MockSimpleClient) SomeWorkflow3Async(ctx context.Context, req *SomeWorkflow3Request, opts ...*SomeWorkflow3Options) (SomeWorkflow3Run, error) {
+	args := m.Called(ctx, req, opts)
+	var r0 SomeWorkflow3Run
+	if v := args.Get(0); v != nil {
+		r0 = v.(SomeWorkflow3Run)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnSomeWorkflow3Async registers an expectation for a call to SomeWorkflow3Async with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnSomeWorkflow3Async(ctx any, req any, opts any) *MockSimpleClientSomeWorkflow3AsyncCall {
+	return &MockSimpleClientSomeWorkflow3AsyncCall{Call: m.On("SomeWorkflow3Async", ctx, req, opts)}
+}
+
+// MockSimpleClientSomeWorkflow3AsyncCall describes an expected call to MockSimpleClient.SomeWorkflow3Async
+type MockSimpleClientSomeWorkflow3AsyncCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientSomeWorkflow3AsyncCall) Return(run SomeWorkflow3Run, err error) *MockSimpleClientSomeWorkflow3AsyncCall {
+	c.Call.Return(run, err)
+	return c
+}
+
+// GetSomeWorkflow3 implements SimpleClient
+func (m *MockSimpleClient) GetSomeWorkflow3(ctx context.Context, workflowID string, runID string) SomeWorkflow3Run {
+	args := m.Called(ctx, workflowID, runID)
+	var r0 SomeWorkflow3Run
+	if v := args.Get(0); v != nil {
+		r0 = v.(SomeWorkflow3Run)
+	}
+	return r0
+}
+
+// OnGetSomeWorkflow3 registers an expectation for a call to GetSomeWorkflow3 with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnGetSomeWorkflow3(ctx any, workflowID any, runID any) *MockSimpleClientGetSomeWorkflow3Call {
+	return &MockSimpleClientGetSomeWorkflow3Call{Call: m.On("GetSomeWorkflow3", ctx, workflowID, runID)}
+}
+
+// MockSimpleClientGetSomeWorkflow3Call describes an expected call to MockSimpleClient.GetSomeWorkflow3
+type MockSimpleClientGetSomeWorkflow3Call struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientGetSomeWorkflow3Call) Return(run SomeWorkflow3Run) *MockSimpleClientGetSomeWorkflow3Call {
+	c.Call.Return(run)
+	return c
+}
+
+// CreateSomeWorkflow3Schedule implements SimpleClient
+func (m *MockSimpleClient) CreateSomeWorkflow3Schedule(ctx context.Context, id string, spec client.ScheduleSpec, req *SomeWorkflow3Request, opts ...*SomeWorkflow3ScheduleOptions) (client.ScheduleHandle, error) {
+	args := m.Called(ctx, id, spec, req, opts)
+	var r0 client.ScheduleHandle
+	if v := args.Get(0); v != nil {
+		r0 = v.(client.ScheduleHandle)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnCreateSomeWorkflow3Schedule registers an expectation for a call to CreateSomeWorkflow3Schedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnCreateSomeWorkflow3Schedule(ctx any, id any, spec any, req any, opts any) *MockSimpleClientCreateSomeWorkflow3ScheduleCall {
+	return &MockSimpleClientCreateSomeWorkflow3ScheduleCall{Call: m.On("CreateSomeWorkflow3Schedule", ctx, id, spec, req, opts)}
+}
+
+// MockSimpleClientCreateSomeWorkflow3ScheduleCall describes an expected call to MockSimpleClient.CreateSomeWorkflow3Schedule
+type MockSimpleClientCreateSomeWorkflow3ScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientCreateSomeWorkflow3ScheduleCall) Return(handle client.ScheduleHandle, err error) *MockSimpleClientCreateSomeWorkflow3ScheduleCall {
+	c.Call.Return(handle, err)
+	return c
+}
+
+// GetSomeWorkflow3Schedule implements SimpleClient
+func (m *MockSimpleClient) GetSomeWorkflow3Schedule(ctx context.Context, id string) (*client.ScheduleDescription, error) {
+	args := m.Called(ctx, id)
+	var r0 *client.ScheduleDescription
+	if v := args.Get(0); v != nil {
+		r0 = v.(*client.ScheduleDescription)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnGetSomeWorkflow3Schedule registers an expectation for a call to GetSomeWorkflow3Schedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnGetSomeWorkflow3Schedule(ctx any, id any) *MockSimpleClientGetSomeWorkflow3ScheduleCall {
+	return &MockSimpleClientGetSomeWorkflow3ScheduleCall{Call: m.On("GetSomeWorkflow3Schedule", ctx, id)}
+}
+
+// MockSimpleClientGetSomeWorkflow3ScheduleCall describes an expected call to MockSimpleClient.GetSomeWorkflow3Schedule
+type MockSimpleClientGetSomeWorkflow3ScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientGetSomeWorkflow3ScheduleCall) Return(desc *client.ScheduleDescription, err error) *MockSimpleClientGetSomeWorkflow3ScheduleCall {
+	c.Call.Return(desc, err)
+	return c
+}
+
+// PauseSomeWorkflow3Schedule implements SimpleClient
+func (m *MockSimpleClient) PauseSomeWorkflow3Schedule(ctx context.Context, id string, note string) error {
+	args := m.Called(ctx, id, note)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnPauseSomeWorkflow3Schedule registers an expectation for a call to PauseSomeWorkflow3Schedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnPauseSomeWorkflow3Schedule(ctx any, id any, note any) *MockSimpleClientPauseSomeWorkflow3ScheduleCall {
+	return &MockSimpleClientPauseSomeWorkflow3ScheduleCall{Call: m.On("PauseSomeWorkflow3Schedule", ctx, id, note)}
+}
+
+// MockSimpleClientPauseSomeWorkflow3ScheduleCall describes an expected call to MockSimpleClient.PauseSomeWorkflow3Schedule
+type MockSimpleClientPauseSomeWorkflow3ScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientPauseSomeWorkflow3ScheduleCall) Return(err error) *MockSimpleClientPauseSomeWorkflow3ScheduleCall {
+	c.Call.Return(err)
+	return c
+}
+
+// UnpauseSomeWorkflow3Schedule implements SimpleClient
+func (m *MockSimpleClient) UnpauseSomeWorkflow3Schedule(ctx context.Context, id string, note string) error {
+	args := m.Called(ctx, id, note)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnUnpauseSomeWorkflow3Schedule registers an expectation for a call to UnpauseSomeWorkflow3Schedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnUnpauseSomeWorkflow3Schedule(ctx any, id any, note any) *MockSimpleClientUnpauseSomeWorkflow3ScheduleCall {
+	return &MockSimpleClientUnpauseSomeWorkflow3ScheduleCall{Call: m.On("UnpauseSomeWorkflow3Schedule", ctx, id, note)}
+}
+
+// MockSimpleClientUnpauseSomeWorkflow3ScheduleCall describes an expected call to MockSimpleClient.UnpauseSomeWorkflow3Schedule
+type MockSimpleClientUnpauseSomeWorkflow3ScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientUnpauseSomeWorkflow3ScheduleCall) Return(err error) *MockSimpleClientUnpauseSomeWorkflow3ScheduleCall {
+	c.Call.Return(err)
+	return c
+}
+
+// TriggerSomeWorkflow3Schedule implements SimpleClient
+func (m *MockSimpleClient) TriggerSomeWorkflow3Schedule(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnTriggerSomeWorkflow3Schedule registers an expectation for a call to TriggerSomeWorkflow3Schedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnTriggerSomeWorkflow3Schedule(ctx any, id any) *MockSimpleClientTriggerSomeWorkflow3ScheduleCall {
+	return &MockSimpleClientTriggerSomeWorkflow3ScheduleCall{Call: m.On("TriggerSomeWorkflow3Schedule", ctx, id)}
+}
+
+// MockSimpleClientTriggerSomeWorkflow3ScheduleCall describes an expected call to MockSimpleClient.TriggerSomeWorkflow3Schedule
+type MockSimpleClientTriggerSomeWorkflow3ScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientTriggerSomeWorkflow3ScheduleCall) Return(err error) *MockSimpleClientTriggerSomeWorkflow3ScheduleCall {
+	c.Call.Return(err)
+	return c
+}
+
+// DeleteSomeWorkflow3Schedule implements SimpleClient
+func (m *MockSimpleClient) DeleteSomeWorkflow3Schedule(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnDeleteSomeWorkflow3Schedule registers an expectation for a call to DeleteSomeWorkflow3Schedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnDeleteSomeWorkflow3Schedule(ctx any, id any) *MockSimpleClientDeleteSomeWorkflow3ScheduleCall {
+	return &MockSimpleClientDeleteSomeWorkflow3ScheduleCall{Call: m.On("DeleteSomeWorkflow3Schedule", ctx, id)}
+}
+
+// MockSimpleClientDeleteSomeWorkflow3ScheduleCall describes an expected call to MockSimpleClient.DeleteSomeWorkflow3Schedule
+type MockSimpleClientDeleteSomeWorkflow3ScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientDeleteSomeWorkflow3ScheduleCall) Return(err error) *MockSimpleClientDeleteSomeWorkflow3ScheduleCall {
+	c.Call.Return(err)
+	return c
+}
+
+// SomeWorkflow3WithSomeSignal2 implements SimpleClient
+func (m *MockSimpleClient) SomeWorkflow3WithSomeSignal2(ctx context.Context, req *SomeWorkflow3Request, signal *SomeSignal2Request, opts ...*SomeWorkflow3Options) error {
+	args := m.Called(ctx, req, signal, opts)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnSomeWorkflow3WithSomeSignal2 registers an expectation for a call to SomeWorkflow3WithSomeSignal2 with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnSomeWorkflow3WithSomeSignal2(ctx any, req any, signal any, opts any) *MockSimpleClientSomeWorkflow3WithSomeSignal2Call {
+	return &MockSimpleClientSomeWorkflow3WithSomeSignal2Call{Call: m.On("SomeWorkflow3WithSomeSignal2", ctx, req, signal, opts)}
+}
+
+// MockSimpleClientSomeWorkflow3WithSomeSignal2Call describes an expected call to MockSimpleClient.SomeWorkflow3WithSomeSignal2
+type MockSimpleClientSomeWorkflow3WithSomeSignal2Call struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientSomeWorkflow3WithSomeSignal2Call) Return(err error) *MockSimpleClientSomeWorkflow3WithSomeSignal2Call {
+	c.Call.Return(err)
+	return c
+}
+
+// SomeWorkflow3WithSomeSignal2Async implements SimpleClient
+func (m *MockSimpleClient) SomeWorkflow3WithSomeSignal2Async(ctx context.Context, req *SomeWorkflow3Request, signal *SomeSignal2Request, opts ...*SomeWorkflow3Options) (SomeWorkflow3Run, error) {
+	args := m.Called(ctx, req, signal, opts)
+	var r0 SomeWorkflow3Run
+	if v := args.Get(0); v != nil {
+		r0 = v.(SomeWorkflow3Run)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnSomeWorkflow3WithSomeSignal2Async registers an expectation for a call to SomeWorkflow3WithSomeSignal2Async with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnSomeWorkflow3WithSomeSignal2Async(ctx any, req any, signal any, opts any) *MockSimpleClientSomeWorkflow3WithSomeSignal2AsyncCall {
+	return &MockSimpleClientSomeWorkflow3WithSomeSignal2AsyncCall{Call: m.On("SomeWorkflow3WithSomeSignal2Async", ctx, req, signal, opts)}
+}
+
+// MockSimpleClientSomeWorkflow3WithSomeSignal2AsyncCall describes an expected call to MockSimpleClient.SomeWorkflow3WithSomeSignal2Async
+type MockSimpleClientSomeWorkflow3WithSomeSignal2AsyncCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientSomeWorkflow3WithSomeSignal2AsyncCall) Return(run SomeWorkflow3Run, err error) *MockSimpleClientSomeWorkflow3WithSomeSignal2AsyncCall {
+	c.Call.Return(run, err)
+	return c
+}
+
+// SomeWorkflow4 implements SimpleClient
+func (m *MockSimpleClient) SomeWorkflow4(ctx context.Context, opts ...*SomeWorkflow4Options) error {
+	args := m.Called(ctx, opts)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnSomeWorkflow4 registers an expectation for a call to SomeWorkflow4 with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnSomeWorkflow4(ctx any, opts any) *MockSimpleClientSomeWorkflow4Call {
+	return &MockSimpleClientSomeWorkflow4Call{Call: m.On("SomeWorkflow4", ctx, opts)}
+}
+
+// MockSimpleClientSomeWorkflow4Call describes an expected call to MockSimpleClient.SomeWorkflow4
+type MockSimpleClientSomeWorkflow4Call struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientSomeWorkflow4Call) Return(err error) *MockSimpleClientSomeWorkflow4Call {
+	c.Call.Return(err)
+	return c
+}
+
+// SomeWorkflow4Async implements SimpleClient
+func (m *MockSimpleClient) SomeWorkflow4Async(ctx context.Context, opts ...*SomeWorkflow4Options) (SomeWorkflow4Run, error) {
+	args := m.Called(ctx, opts)
+	var r0 SomeWorkflow4Run
+	if v := args.Get(0); v != nil {
+		r0 = v.(SomeWorkflow4Run)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnSomeWorkflow4Async registers an expectation for a call to SomeWorkflow4Async with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnSomeWorkflow4Async(ctx any, opts any) *MockSimpleClientSomeWorkflow4AsyncCall {
+	return &MockSimpleClientSomeWorkflow4AsyncCall{Call: m.On("SomeWorkflow4Async", ctx, opts)}
+}
+
+// MockSimpleClientSomeWorkflow4AsyncCall describes an expected call to MockSimpleClient.SomeWorkflow4Async
+type MockSimpleClientSomeWorkflow4AsyncCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientSomeWorkflow4AsyncCall) Return(run SomeWorkflow4Run, err error) *MockSimpleClientSomeWorkflow4AsyncCall {
+	c.Call.Return(run, err)
+	return c
+}
+
+// GetSomeWorkflow4 implements SimpleClient
+func (m *MockSimpleClient) GetSomeWorkflow4(ctx context.Context, workflowID string, runID string) SomeWorkflow4Run {
+	args := m.Called(ctx, workflowID, runID)
+	var r0 SomeWorkflow4Run
+	if v := args.Get(0); v != nil {
+		r0 = v.(SomeWorkflow4Run)
+	}
+	return r0
+}
+
+// OnGetSomeWorkflow4 registers an expectation for a call to GetSomeWorkflow4 with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnGetSomeWorkflow4(ctx any, workflowID any, runID any) *MockSimpleClientGetSomeWorkflow4Call {
+	return &MockSimpleClientGetSomeWorkflow4Call{Call: m.On("GetSomeWorkflow4", ctx, workflowID, runID)}
+}
+
+// MockSimpleClientGetSomeWorkflow4Call describes an expected call to MockSimpleClient.GetSomeWorkflow4
+type MockSimpleClientGetSomeWorkflow4Call struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientGetSomeWorkflow4Call) Return(run SomeWorkflow4Run) *MockSimpleClientGetSomeWorkflow4Call {
+	c.Call.Return(run)
+	return c
+}
+
+// CreateSomeWorkflow4Schedule implements SimpleClient
+func (m *MockSimpleClient) CreateSomeWorkflow4Schedule(ctx context.Context, id string, spec client.ScheduleSpec, opts ...*SomeWorkflow4ScheduleOptions) (client.ScheduleHandle, error) {
+	args := m.Called(ctx, id, spec, opts)
+	var r0 client.ScheduleHandle
+	if v := args.Get(0); v != nil {
+		r0 = v.(client.ScheduleHandle)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnCreateSomeWorkflow4Schedule registers an expectation for a call to CreateSomeWorkflow4Schedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnCreateSomeWorkflow4Schedule(ctx any, id any, spec any, opts any) *MockSimpleClientCreateSomeWorkflow4ScheduleCall {
+	return &MockSimpleClientCreateSomeWorkflow4ScheduleCall{Call: m.On("CreateSomeWorkflow4Schedule", ctx, id, spec, opts)}
+}
+
+// MockSimpleClientCreateSomeWorkflow4ScheduleCall describes an expected call to MockSimpleClient.CreateSomeWorkflow4Schedule
+type MockSimpleClientCreateSomeWorkflow4ScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientCreateSomeWorkflow4ScheduleCall) Return(handle client.ScheduleHandle, err error) *MockSimpleClientCreateSomeWorkflow4ScheduleCall {
+	c.Call.Return(handle, err)
+	return c
+}
+
+// GetSomeWorkflow4Schedule implements SimpleClient
+func (m *MockSimpleClient) GetSomeWorkflow4Schedule(ctx context.Context, id string) (*client.ScheduleDescription, error) {
+	args := m.Called(ctx, id)
+	var r0 *client.ScheduleDescription
+	if v := args.Get(0); v != nil {
+		r0 = v.(*client.ScheduleDescription)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnGetSomeWorkflow4Schedule registers an expectation for a call to GetSomeWorkflow4Schedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnGetSomeWorkflow4Schedule(ctx any, id any) *MockSimpleClientGetSomeWorkflow4ScheduleCall {
+	return &MockSimpleClientGetSomeWorkflow4ScheduleCall{Call: m.On("GetSomeWorkflow4Schedule", ctx, id)}
+}
+
+// MockSimpleClientGetSomeWorkflow4ScheduleCall describes an expected call to MockSimpleClient.GetSomeWorkflow4Schedule
+type MockSimpleClientGetSomeWorkflow4ScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientGetSomeWorkflow4ScheduleCall) Return(desc *client.ScheduleDescription, err error) *MockSimpleClientGetSomeWorkflow4ScheduleCall {
+	c.Call.Return(desc, err)
+	return c
+}
+
+// PauseSomeWorkflow4Schedule implements SimpleClient
+func (m *MockSimpleClient) PauseSomeWorkflow4Schedule(ctx context.Context, id string, note string) error {
+	args := m.Called(ctx, id, note)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnPauseSomeWorkflow4Schedule registers an expectation for a call to PauseSomeWorkflow4Schedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnPauseSomeWorkflow4Schedule(ctx any, id any, note any) *MockSimpleClientPauseSomeWorkflow4ScheduleCall {
+	return &MockSimpleClientPauseSomeWorkflow4ScheduleCall{Call: m.On("PauseSomeWorkflow4Schedule", ctx, id, note)}
+}
+
+// MockSimpleClientPauseSomeWorkflow4ScheduleCall describes an expected call to MockSimpleClient.PauseSomeWorkflow4Schedule
+type MockSimpleClientPauseSomeWorkflow4ScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientPauseSomeWorkflow4ScheduleCall) Return(err error) *MockSimpleClientPauseSomeWorkflow4ScheduleCall {
+	c.Call.Return(err)
+	return c
+}
+
+// UnpauseSomeWorkflow4Schedule implements SimpleClient
+func (m *MockSimpleClient) UnpauseSomeWorkflow4Schedule(ctx context.Context, id string, note string) error {
+	args := m.Called(ctx, id, note)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnUnpauseSomeWorkflow4Schedule registers an expectation for a call to UnpauseSomeWorkflow4Schedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnUnpauseSomeWorkflow4Schedule(ctx any, id any, note any) *MockSimpleClientUnpauseSomeWorkflow4ScheduleCall {
+	return &MockSimpleClientUnpauseSomeWorkflow4ScheduleCall{Call: m.On("UnpauseSomeWorkflow4Schedule", ctx, id, note)}
+}
+
+// MockSimpleClientUnpauseSomeWorkflow4ScheduleCall describes an expected call to MockSimpleClient.UnpauseSomeWorkflow4Schedule
+type MockSimpleClientUnpauseSomeWorkflow4ScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientUnpauseSomeWorkflow4ScheduleCall) Return(err error) *MockSimpleClientUnpauseSomeWorkflow4ScheduleCall {
+	c.Call.Return(err)
+	return c
+}
+
+// TriggerSomeWorkflow4Schedule implements SimpleClient
+func (m *MockSimpleClient) TriggerSomeWorkflow4Schedule(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnTriggerSomeWorkflow4Schedule registers an expectation for a call to TriggerSomeWorkflow4Schedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnTriggerSomeWorkflow4Schedule(ctx any, id any) *MockSimpleClientTriggerSomeWorkflow4ScheduleCall {
+	return &MockSimpleClientTriggerSomeWorkflow4ScheduleCall{Call: m.On("TriggerSomeWorkflow4Schedule", ctx, id)}
+}
+
+// MockSimpleClientTriggerSomeWorkflow4ScheduleCall describes an expected call to MockSimpleClient.TriggerSomeWorkflow4Schedule
+type MockSimpleClientTriggerSomeWorkflow4ScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientTriggerSomeWorkflow4ScheduleCall) Return(err error) *MockSimpleClientTriggerSomeWorkflow4ScheduleCall {
+	c.Call.Return(err)
+	return c
+}
+
+// DeleteSomeWorkflow4Schedule implements SimpleClient
+func (m *MockSimpleClient) DeleteSomeWorkflow4Schedule(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnDeleteSomeWorkflow4Schedule registers an expectation for a call to DeleteSomeWorkflow4Schedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnDeleteSomeWorkflow4Schedule(ctx any, id any) *MockSimpleClientDeleteSomeWorkflow4ScheduleCall {
+	return &MockSimpleClientDeleteSomeWorkflow4ScheduleCall{Call: m.On("DeleteSomeWorkflow4Schedule", ctx, id)}
+}
+
+// MockSimpleClientDeleteSomeWorkflow4ScheduleCall describes an expected call to MockSimpleClient.DeleteSomeWorkflow4Schedule
+type MockSimpleClientDeleteSomeWorkflow4ScheduleCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientDeleteSomeWorkflow4ScheduleCall) Return(err error) *MockSimpleClientDeleteSomeWorkflow4ScheduleCall {
+	c.Call.Return(err)
+	return c
+}
+
+// SomeWorkflow4WithSetValue implements SimpleClient
+func (m *MockSimpleClient) SomeWorkflow4WithSetValue(ctx context.Context, signal *common.SetValueRequest, opts ...*SomeWorkflow4Options) error {
+	args := m.Called(ctx, signal, opts)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnSomeWorkflow4WithSetValue registers an expectation for a call to SomeWorkflow4WithSetValue with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnSomeWorkflow4WithSetValue(ctx any, signal any, opts any) *MockSimpleClientSomeWorkflow4WithSetValueCall {
+	return &MockSimpleClientSomeWorkflow4WithSetValueCall{Call: m.On("SomeWorkflow4WithSetValue", ctx, signal, opts)}
+}
+
+// MockSimpleClientSomeWorkflow4WithSetValueCall describes an expected call to MockSimpleClient.SomeWorkflow4WithSetValue
+type MockSimpleClientSomeWorkflow4WithSetValueCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientSomeWorkflow4WithSetValueCall) Return(err error) *MockSimpleClientSomeWorkflow4WithSetValueCall {
+	c.Call.Return(err)
+	return c
+}
+
+// SomeWorkflow4WithSetValueAsync implements SimpleClient
+func (m *MockSimpleClient) SomeWorkflow4WithSetValueAsync(ctx context.Context, signal *common.SetValueRequest, opts ...*SomeWorkflow4Options) (SomeWorkflow4Run, error) {
+	args := m.Called(ctx, signal, opts)
+	var r0 SomeWorkflow4Run
+	if v := args.Get(0); v != nil {
+		r0 = v.(SomeWorkflow4Run)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnSomeWorkflow4WithSetValueAsync registers an expectation for a call to SomeWorkflow4WithSetValueAsync with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnSomeWorkflow4WithSetValueAsync(ctx any, signal any, opts any) *MockSimpleClientSomeWorkflow4WithSetValueAsyncCall {
+	return &MockSimpleClientSomeWorkflow4WithSetValueAsyncCall{Call: m.On("SomeWorkflow4WithSetValueAsync", ctx, signal, opts)}
+}
+
+// MockSimpleClientSomeWorkflow4WithSetValueAsyncCall describes an expected call to MockSimpleClient.SomeWorkflow4WithSetValueAsync
+type MockSimpleClientSomeWorkflow4WithSetValueAsyncCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientSomeWorkflow4WithSetValueAsyncCall) Return(run SomeWorkflow4Run, err error) *MockSimpleClientSomeWorkflow4WithSetValueAsyncCall {
+	c.Call.Return(run, err)
+	return c
+}
+
+// SomeQuery1 implements SimpleClient
+func (m *MockSimpleClient) SomeQuery1(ctx context.Context, workflowID string, runID string) (*SomeQuery1Response, error) {
+	args := m.Called(ctx, workflowID, runID)
+	var r0 *SomeQuery1Response
+	if v := args.Get(0); v != nil {
+		r0 = v.(*SomeQuery1Response)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnSomeQuery1 registers an expectation for a call to SomeQuery1 with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnSomeQuery1(ctx any, workflowID any, runID any) *MockSimpleClientSomeQuery1Call {
+	return &MockSimpleClientSomeQuery1Call{Call: m.On("SomeQuery1", ctx, workflowID, runID)}
+}
+
+// MockSimpleClientSomeQuery1Call describes an expected call to MockSimpleClient.SomeQuery1
+type MockSimpleClientSomeQuery1Call struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientSomeQuery1Call) Return(resp *SomeQuery1Response, err error) *MockSimpleClientSomeQuery1Call {
+	c.Call.Return(resp, err)
+	return c
+}
+
+// SomeQuery2 implements SimpleClient
+func (m *MockSimpleClient) SomeQuery2(ctx context.Context, workflowID string, runID string, query *SomeQuery2Request) (*SomeQuery2Response, error) {
+	args := m.Called(ctx, workflowID, runID, query)
+	var r0 *SomeQuery2Response
+	if v := args.Get(0); v != nil {
+		r0 = v.(*SomeQuery2Response)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnSomeQuery2 registers an expectation for a call to SomeQuery2 with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnSomeQuery2(ctx any, workflowID any, runID any, query any) *MockSimpleClientSomeQuery2Call {
+	return &MockSimpleClientSomeQuery2Call{Call: m.On("SomeQuery2", ctx, workflowID, runID, query)}
+}
+
+// MockSimpleClientSomeQuery2Call describes an expected call to MockSimpleClient.SomeQuery2
+type MockSimpleClientSomeQuery2Call struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientSomeQuery2Call) Return(resp *SomeQuery2Response, err error) *MockSimpleClientSomeQuery2Call {
+	c.Call.Return(resp, err)
+	return c
+}
+
+// SomeSignal1 implements SimpleClient
+func (m *MockSimpleClient) SomeSignal1(ctx context.Context, workflowID string, runID string) error {
+	args := m.Called(ctx, workflowID, runID)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnSomeSignal1 registers an expectation for a call to SomeSignal1 with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnSomeSignal1(ctx any, workflowID any, runID any) *MockSimpleClientSomeSignal1Call {
+	return &MockSimpleClientSomeSignal1Call{Call: m.On("SomeSignal1", ctx, workflowID, runID)}
+}
+
+// MockSimpleClientSomeSignal1Call describes an expected call to MockSimpleClient.SomeSignal1
+type MockSimpleClientSomeSignal1Call struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientSomeSignal1Call) Return(err error) *MockSimpleClientSomeSignal1Call {
+	c.Call.Return(err)
+	return c
+}
+
+// SomeSignal2 implements SimpleClient
+func (m *MockSimpleClient) SomeSignal2(ctx context.Context, workflowID string, runID string, signal *SomeSignal2Request) error {
+	args := m.Called(ctx, workflowID, runID, signal)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnSomeSignal2 registers an expectation for a call to SomeSignal2 with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnSomeSignal2(ctx any, workflowID any, runID any, signal any) *MockSimpleClientSomeSignal2Call {
+	return &MockSimpleClientSomeSignal2Call{Call: m.On("SomeSignal2", ctx, workflowID, runID, signal)}
+}
+
+// MockSimpleClientSomeSignal2Call describes an expected call to MockSimpleClient.SomeSignal2
+type MockSimpleClientSomeSignal2Call struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientSomeSignal2Call) Return(err error) *MockSimpleClientSomeSignal2Call {
+	c.Call.Return(err)
+	return c
+}
+
+// SomeUpdate1 implements SimpleClient
+func (m *MockSimpleClient) SomeUpdate1(ctx context.Context, workflowID string, runID string, req *SomeUpdate1Request, opts ...*SomeUpdate1Options) (*SomeUpdate1Response, error) {
+	args := m.Called(ctx, workflowID, runID, req, opts)
+	var r0 *SomeUpdate1Response
+	if v := args.Get(0); v != nil {
+		r0 = v.(*SomeUpdate1Response)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnSomeUpdate1 registers an expectation for a call to SomeUpdate1 with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnSomeUpdate1(ctx any, workflowID any, runID any, req any, opts any) *MockSimpleClientSomeUpdate1Call {
+	return &MockSimpleClientSomeUpdate1Call{Call: m.On("SomeUpdate1", ctx, workflowID, runID, req, opts)}
+}
+
+// MockSimpleClientSomeUpdate1Call describes an expected call to MockSimpleClient.SomeUpdate1
+type MockSimpleClientSomeUpdate1Call struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientSomeUpdate1Call) Return(resp *SomeUpdate1Response, err error) *MockSimpleClientSomeUpdate1Call {
+	c.Call.Return(resp, err)
+	return c
+}
+
+// SomeUpdate1Async implements SimpleClient
+func (m *MockSimpleClient) SomeUpdate1Async(ctx context.Context, workflowID string, runID string, req *SomeUpdate1Request, opts ...*SomeUpdate1Options) (SomeUpdate1Handle, error) {
+	args := m.Called(ctx, workflowID, runID, req, opts)
+	var r0 SomeUpdate1Handle
+	if v := args.Get(0); v != nil {
+		r0 = v.(SomeUpdate1Handle)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnSomeUpdate1Async registers an expectation for a call to SomeUpdate1Async with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnSomeUpdate1Async(ctx any, workflowID any, runID any, req any, opts any) *MockSimpleClientSomeUpdate1AsyncCall {
+	return &MockSimpleClientSomeUpdate1AsyncCall{Call: m.On("SomeUpdate1Async", ctx, workflowID, runID, req, opts)}
+}
+
+// MockSimpleClientSomeUpdate1AsyncCall describes an expected call to MockSimpleClient.SomeUpdate1Async
+type MockSimpleClientSomeUpdate1AsyncCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientSomeUpdate1AsyncCall) Return(handle SomeUpdate1Handle, err error) *MockSimpleClientSomeUpdate1AsyncCall {
+	c.Call.Return(handle, err)
+	return c
+}
+
+// CompleteSomeActivity3 implements SimpleClient
+func (m *MockSimpleClient) CompleteSomeActivity3(ctx context.Context, taskToken []byte, resp *SomeActivity3Response, err error) error {
+	args := m.Called(ctx, taskToken, resp, err)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnCompleteSomeActivity3 registers an expectation for a call to CompleteSomeActivity3 with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnCompleteSomeActivity3(ctx any, taskToken any, resp any, err any) *MockSimpleClientCompleteSomeActivity3Call {
+	return &MockSimpleClientCompleteSomeActivity3Call{Call: m.On("CompleteSomeActivity3", ctx, taskToken, resp, err)}
+}
+
+// MockSimpleClientCompleteSomeActivity3Call describes an expected call to MockSimpleClient.CompleteSomeActivity3
+type MockSimpleClientCompleteSomeActivity3Call struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientCompleteSomeActivity3Call) Return(err error) *MockSimpleClientCompleteSomeActivity3Call {
+	c.Call.Return(err)
+	return c
+}
+
+// CompleteSomeActivity3ByID implements SimpleClient
+func (m *MockSimpleClient) CompleteSomeActivity3ByID(ctx context.Context, namespace string, workflowID string, runID string, activityID string, resp *SomeActivity3Response, err error) error {
+	args := m.Called(ctx, namespace, workflowID, runID, activityID, resp, err)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnCompleteSomeActivity3ByID registers an expectation for a call to CompleteSomeActivity3ByID with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSimpleClient) OnCompleteSomeActivity3ByID(ctx any, namespace any, workflowID any, runID any, activityID any, resp any, err any) *MockSimpleClientCompleteSomeActivity3ByIDCall {
+	return &MockSimpleClientCompleteSomeActivity3ByIDCall{Call: m.On("CompleteSomeActivity3ByID", ctx, namespace, workflowID, runID, activityID, resp, err)}
+}
+
+// MockSimpleClientCompleteSomeActivity3ByIDCall describes an expected call to MockSimpleClient.CompleteSomeActivity3ByID
+type MockSimpleClientCompleteSomeActivity3ByIDCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSimpleClientCompleteSomeActivity3ByIDCall) Return(err error) *MockSimpleClientCompleteSomeActivity3ByIDCall {
+	c.Call.Return(err)
+	return c
+}
+
+// MockSomeWorkflow1Run is a testify mock implementation of SomeWorkflow1Run
+type MockSomeWorkflow1Run struct {
+	mock.Mock
+}
+
+var _ SomeWorkflow1Run = (*MockSomeWorkflow1Run)(nil)
+
+// NewMockSomeWorkflow1Run initializes a new MockSomeWorkflow1Run that asserts its expectations when the test completes
+func NewMockSomeWorkflow1Run(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSomeWorkflow1Run {
+	m := &MockSomeWorkflow1Run{}
+	m.Mock.Test(t)
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+	return m
+}
+
+// ID implements SomeWorkflow1Run
+func (m *MockSomeWorkflow1Run) ID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnID registers an expectation for a call to ID with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeWorkflow1Run) OnID() *MockSomeWorkflow1RunIDCall {
+	return &MockSomeWorkflow1RunIDCall{Call: m.On("ID")}
+}
+
+// MockSomeWorkflow1RunIDCall describes an expected call to MockSomeWorkflow1Run.ID
+type MockSomeWorkflow1RunIDCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeWorkflow1RunIDCall) Return(id string) *MockSomeWorkflow1RunIDCall {
+	c.Call.Return(id)
+	return c
+}
+
+// RunID implements SomeWorkflow1Run
+func (m *MockSomeWorkflow1Run) RunID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnRunID registers an expectation for a call to RunID with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeWorkflow1Run) OnRunID() *MockSomeWorkflow1RunRunIDCall {
+	return &MockSomeWorkflow1RunRunIDCall{Call: m.On("RunID")}
+}
+
+// MockSomeWorkflow1RunRunIDCall describes an expected call to MockSomeWorkflow1Run.RunID
+type MockSomeWorkflow1RunRunIDCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeWorkflow1RunRunIDCall) Return(runID string) *MockSomeWorkflow1RunRunIDCall {
+	c.Call.Return(runID)
+	return c
+}
+
+// Memo implements SomeWorkflow1Run
+func (m *MockSomeWorkflow1Run) Memo(ctx context.Context) (*SomeWorkflow1Memo, error) {
+	args := m.Called(ctx)
+	var r0 *SomeWorkflow1Memo
+	if v := args.Get(0); v != nil {
+		r0 = v.(*SomeWorkflow1Memo)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnMemo registers an expectation for a call to Memo with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeWorkflow1Run) OnMemo(ctx any) *MockSomeWorkflow1RunMemoCall {
+	return &MockSomeWorkflow1RunMemoCall{Call: m.On("Memo", ctx)}
+}
+
+// MockSomeWorkflow1RunMemoCall describes an expected call to MockSomeWorkflow1Run.Memo
+type MockSomeWorkflow1RunMemoCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeWorkflow1RunMemoCall) Return(memo *SomeWorkflow1Memo, err error) *MockSomeWorkflow1RunMemoCall {
+	c.Call.Return(memo, err)
+	return c
+}
+
+// Get implements SomeWorkflow1Run
+func (m *MockSomeWorkflow1Run) Get(ctx context.Context) (*SomeWorkflow1Response, error) {
+	args := m.Called(ctx)
+	var r0 *SomeWorkflow1Response
+	if v := args.Get(0); v != nil {
+		r0 = v.(*SomeWorkflow1Response)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnGet registers an expectation for a call to Get with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeWorkflow1Run) OnGet(ctx any) *MockSomeWorkflow1RunGetCall {
+	return &MockSomeWorkflow1RunGetCall{Call: m.On("Get", ctx)}
+}
+
+// MockSomeWorkflow1RunGetCall describes an expected call to MockSomeWorkflow1Run.Get
+type MockSomeWorkflow1RunGetCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeWorkflow1RunGetCall) Return(resp *SomeWorkflow1Response, err error) *MockSomeWorkflow1RunGetCall {
+	c.Call.Return(resp, err)
+	return c
+}
+
+// SomeQuery1 implements SomeWorkflow1Run
+func (m *MockSomeWorkflow1Run) SomeQuery1(ctx context.Context) (*SomeQuery1Response, error) {
+	args := m.Called(ctx)
+	var r0 *SomeQuery1Response
+	if v := args.Get(0); v != nil {
+		r0 = v.(*SomeQuery1Response)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnSomeQuery1 registers an expectation for a call to SomeQuery1 with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeWorkflow1Run) OnSomeQuery1(ctx any) *MockSomeWorkflow1RunSomeQuery1Call {
+	return &MockSomeWorkflow1RunSomeQuery1Call{Call: m.On("SomeQuery1", ctx)}
+}
+
+// MockSomeWorkflow1RunSomeQuery1Call describes an expected call to MockSomeWorkflow1Run.SomeQuery1
+type MockSomeWorkflow1RunSomeQuery1Call struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeWorkflow1RunSomeQuery1Call) Return(resp *SomeQuery1Response, err error) *MockSomeWorkflow1RunSomeQuery1Call {
+	c.Call.Return(resp, err)
+	return c
+}
+
+// SomeQuery2 implements SomeWorkflow1Run
+func (m *MockSomeWorkflow1Run) SomeQuery2(ctx context.Context, req *SomeQuery2Request) (*SomeQuery2Response, error) {
+	args := m.Called(ctx, req)
+	var r0 *SomeQuery2Response
+	if v := args.Get(0); v != nil {
+		r0 = v.(*SomeQuery2Response)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnSomeQuery2 registers an expectation for a call to SomeQuery2 with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeWorkflow1Run) OnSomeQuery2(ctx any, req any) *MockSomeWorkflow1RunSomeQuery2Call {
+	return &MockSomeWorkflow1RunSomeQuery2Call{Call: m.On("SomeQuery2", ctx, req)}
+}
+
+// MockSomeWorkflow1RunSomeQuery2Call describes an expected call to MockSomeWorkflow1Run.SomeQuery2
+type MockSomeWorkflow1RunSomeQuery2Call struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeWorkflow1RunSomeQuery2Call) Return(resp *SomeQuery2Response, err error) *MockSomeWorkflow1RunSomeQuery2Call {
+	c.Call.Return(resp, err)
+	return c
+}
+
+// SomeSignal1 implements SomeWorkflow1Run
+func (m *MockSomeWorkflow1Run) SomeSignal1(ctx context.Context) error {
+	args := m.Called(ctx)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnSomeSignal1 registers an expectation for a call to SomeSignal1 with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeWorkflow1Run) OnSomeSignal1(ctx any) *MockSomeWorkflow1RunSomeSignal1Call {
+	return &MockSomeWorkflow1RunSomeSignal1Call{Call: m.On("SomeSignal1", ctx)}
+}
+
+// MockSomeWorkflow1RunSomeSignal1Call describes an expected call to MockSomeWorkflow1Run.SomeSignal1
+type MockSomeWorkflow1RunSomeSignal1Call struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeWorkflow1RunSomeSignal1Call) Return(err error) *MockSomeWorkflow1RunSomeSignal1Call {
+	c.Call.Return(err)
+	return c
+}
+
+// SomeSignal2 implements SomeWorkflow1Run
+func (m *MockSomeWorkflow1Run) SomeSignal2(ctx context.Context, req *SomeSignal2Request) error {
+	args := m.Called(ctx, req)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnSomeSignal2 registers an expectation for a call to SomeSignal2 with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeWorkflow1Run) OnSomeSignal2(ctx any, req any) *MockSomeWorkflow1RunSomeSignal2Call {
+	return &MockSomeWorkflow1RunSomeSignal2Call{Call: m.On("SomeSignal2", ctx, req)}
+}
+
+// MockSomeWorkflow1RunSomeSignal2Call describes an expected call to MockSomeWorkflow1Run.SomeSignal2
+type MockSomeWorkflow1RunSomeSignal2Call struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeWorkflow1RunSomeSignal2Call) Return(err error) *MockSomeWorkflow1RunSomeSignal2Call {
+	c.Call.Return(err)
+	return c
+}
+
+// MockSomeWorkflow2Run is a testify mock implementation of SomeWorkflow2Run
+type MockSomeWorkflow2Run struct {
+	mock.Mock
+}
+
+var _ SomeWorkflow2Run = (*MockSomeWorkflow2Run)(nil)
+
+// NewMockSomeWorkflow2Run initializes a new MockSomeWorkflow2Run that asserts its expectations when the test completes
+func NewMockSomeWorkflow2Run(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSomeWorkflow2Run {
+	m := &MockSomeWorkflow2Run{}
+	m.Mock.Test(t)
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+	return m
+}
+
+// ID implements SomeWorkflow2Run
+func (m *MockSomeWorkflow2Run) ID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnID registers an expectation for a call to ID with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeWorkflow2Run) OnID() *MockSomeWorkflow2RunIDCall {
+	return &MockSomeWorkflow2RunIDCall{Call: m.On("ID")}
+}
+
+// MockSomeWorkflow2RunIDCall describes an expected call to MockSomeWorkflow2Run.ID
+type MockSomeWorkflow2RunIDCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeWorkflow2RunIDCall) Return(id string) *MockSomeWorkflow2RunIDCall {
+	c.Call.Return(id)
+	return c
+}
+
+// RunID implements SomeWorkflow2Run
+func (m *MockSomeWorkflow2Run) RunID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnRunID registers an expectation for a call to RunID with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeWorkflow2Run) OnRunID() *MockSomeWorkflow2RunRunIDCall {
+	return &MockSomeWorkflow2RunRunIDCall{Call: m.On("RunID")}
+}
+
+// MockSomeWorkflow2RunRunIDCall describes an expected call to MockSomeWorkflow2Run.RunID
+type MockSomeWorkflow2RunRunIDCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeWorkflow2RunRunIDCall) Return(runID string) *MockSomeWorkflow2RunRunIDCall {
+	c.Call.Return(runID)
+	return c
+}
+
+// Get implements SomeWorkflow2Run
+func (m *MockSomeWorkflow2Run) Get(ctx context.Context) error {
+	args := m.Called(ctx)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnGet registers an expectation for a call to Get with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeWorkflow2Run) OnGet(ctx any) *MockSomeWorkflow2RunGetCall {
+	return &MockSomeWorkflow2RunGetCall{Call: m.On("Get", ctx)}
+}
+
+// MockSomeWorkflow2RunGetCall describes an expected call to MockSomeWorkflow2Run.Get
+type MockSomeWorkflow2RunGetCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeWorkflow2RunGetCall) Return(err error) *MockSomeWorkflow2RunGetCall {
+	c.Call.Return(err)
+	return c
+}
+
+// SomeSignal1 implements SomeWorkflow2Run
+func (m *MockSomeWorkflow2Run) SomeSignal1(ctx context.Context) error {
+	args := m.Called(ctx)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnSomeSignal1 registers an expectation for a call to SomeSignal1 with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeWorkflow2Run) OnSomeSignal1(ctx any) *MockSomeWorkflow2RunSomeSignal1Call {
+	return &MockSomeWorkflow2RunSomeSignal1Call{Call: m.On("SomeSignal1", ctx)}
+}
+
+// MockSomeWorkflow2RunSomeSignal1Call describes an expected call to MockSomeWorkflow2Run.SomeSignal1
+type MockSomeWorkflow2RunSomeSignal1Call struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeWorkflow2RunSomeSignal1Call) Return(err error) *MockSomeWorkflow2RunSomeSignal1Call {
+	c.Call.Return(err)
+	return c
+}
+
+// SomeUpdate1 implements SomeWorkflow2Run
+func (m *MockSomeWorkflow2Run) SomeUpdate1(ctx context.Context, req *SomeUpdate1Request, opts ...*SomeUpdate1Options) (*SomeUpdate1Response, error) {
+	args := m.Called(ctx, req, opts)
+	var r0 *SomeUpdate1Response
+	if v := args.Get(0); v != nil {
+		r0 = v.(*SomeUpdate1Response)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnSomeUpdate1 registers an expectation for a call to SomeUpdate1 with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeWorkflow2Run) OnSomeUpdate1(ctx any, req any, opts any) *MockSomeWorkflow2RunSomeUpdate1Call {
+	return &MockSomeWorkflow2RunSomeUpdate1Call{Call: m.On("SomeUpdate1", ctx, req, opts)}
+}
+
+// MockSomeWorkflow2RunSomeUpdate1Call describes an expected call to MockSomeWorkflow2Run.SomeUpdate1
+type MockSomeWorkflow2RunSomeUpdate1Call struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeWorkflow2RunSomeUpdate1Call) Return(resp *SomeUpdate1Response, err error) *MockSomeWorkflow2RunSomeUpdate1Call {
+	c.Call.Return(resp, err)
+	return c
+}
+
+// SomeUpdate1Async implements SomeWorkflow2Run
+func (m *MockSomeWorkflow2Run) SomeUpdate1Async(ctx context.Context, req *SomeUpdate1Request, opts ...*SomeUpdate1Options) (SomeUpdate1Handle, error) {
+	args := m.Called(ctx, req, opts)
+	var r0 SomeUpdate1Handle
+	if v := args.Get(0); v != nil {
+		r0 = v.(SomeUpdate1Handle)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnSomeUpdate1Async registers an expectation for a call to SomeUpdate1Async with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeWorkflow2Run) OnSomeUpdate1Async(ctx any, req any, opts any) *MockSomeWorkflow2RunSomeUpdate1AsyncCall {
+	return &MockSomeWorkflow2RunSomeUpdate1AsyncCall{Call: m.On("SomeUpdate1Async", ctx, req, opts)}
+}
+
+// MockSomeWorkflow2RunSomeUpdate1AsyncCall describes an expected call to MockSomeWorkflow2Run.SomeUpdate1Async
+type MockSomeWorkflow2RunSomeUpdate1AsyncCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeWorkflow2RunSomeUpdate1AsyncCall) Return(handle SomeUpdate1Handle, err error) *MockSomeWorkflow2RunSomeUpdate1AsyncCall {
+	c.Call.Return(handle, err)
+	return c
+}
+
+// MockSomeWorkflow3Run is a testify mock implementation of SomeWorkflow3Run
+type MockSomeWorkflow3Run struct {
+	mock.Mock
+}
+
+var _ SomeWorkflow3Run = (*MockSomeWorkflow3Run)(nil)
+
+// NewMockSomeWorkflow3Run initializes a new MockSomeWorkflow3Run that asserts its expectations when the test completes
+func NewMockSomeWorkflow3Run(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSomeWorkflow3Run {
+	m := &MockSomeWorkflow3Run{}
+	m.Mock.Test(t)
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+	return m
+}
+
+// ID implements SomeWorkflow3Run
+func (m *MockSomeWorkflow3Run) ID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnID registers an expectation for a call to ID with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeWorkflow3Run) OnID() *MockSomeWorkflow3RunIDCall {
+	return &MockSomeWorkflow3RunIDCall{Call: m.On("ID")}
+}
+
+// MockSomeWorkflow3RunIDCall describes an expected call to MockSomeWorkflow3Run.ID
+type MockSomeWorkflow3RunIDCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeWorkflow3RunIDCall) Return(id string) *MockSomeWorkflow3RunIDCall {
+	c.Call.Return(id)
+	return c
+}
+
+// RunID implements SomeWorkflow3Run
+func (m *MockSomeWorkflow3Run) RunID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnRunID registers an expectation for a call to RunID with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeWorkflow3Run) OnRunID() *MockSomeWorkflow3RunRunIDCall {
+	return &MockSomeWorkflow3RunRunIDCall{Call: m.On("RunID")}
+}
+
+// MockSomeWorkflow3RunRunIDCall describes an expected call to MockSomeWorkflow3Run.RunID
+type MockSomeWorkflow3RunRunIDCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeWorkflow3RunRunIDCall) Return(runID string) *MockSomeWorkflow3RunRunIDCall {
+	c.Call.Return(runID)
+	return c
+}
+
+// Get implements SomeWorkflow3Run
+func (m *MockSomeWorkflow3Run) Get(ctx context.Context) error {
+	args := m.Called(ctx)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnGet registers an expectation for a call to Get with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeWorkflow3Run) OnGet(ctx any) *MockSomeWorkflow3RunGetCall {
+	return &MockSomeWorkflow3RunGetCall{Call: m.On("Get", ctx)}
+}
+
+// MockSomeWorkflow3RunGetCall describes an expected call to MockSomeWorkflow3Run.Get
+type MockSomeWorkflow3RunGetCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeWorkflow3RunGetCall) Return(err error) *MockSomeWorkflow3RunGetCall {
+	c.Call.Return(err)
+	return c
+}
+
+// SomeSignal2 implements SomeWorkflow3Run
+func (m *MockSomeWorkflow3Run) SomeSignal2(ctx context.Context, req *SomeSignal2Request) error {
+	args := m.Called(ctx, req)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnSomeSignal2 registers an expectation for a call to SomeSignal2 with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeWorkflow3Run) OnSomeSignal2(ctx any, req any) *MockSomeWorkflow3RunSomeSignal2Call {
+	return &MockSomeWorkflow3RunSomeSignal2Call{Call: m.On("SomeSignal2", ctx, req)}
+}
+
+// MockSomeWorkflow3RunSomeSignal2Call describes an expected call to MockSomeWorkflow3Run.SomeSignal2
+type MockSomeWorkflow3RunSomeSignal2Call struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeWorkflow3RunSomeSignal2Call) Return(err error) *MockSomeWorkflow3RunSomeSignal2Call {
+	c.Call.Return(err)
+	return c
+}
+
+// MockSomeWorkflow4Run is a testify mock implementation of SomeWorkflow4Run
+type MockSomeWorkflow4Run struct {
+	mock.Mock
+}
+
+var _ SomeWorkflow4Run = (*MockSomeWorkflow4Run)(nil)
+
+// NewMockSomeWorkflow4Run initializes a new MockSomeWorkflow4Run that asserts its expectations when the test completes
+func NewMockSomeWorkflow4Run(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSomeWorkflow4Run {
+	m := &MockSomeWorkflow4Run{}
+	m.Mock.Test(t)
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+	return m
+}
+
+// ID implements SomeWorkflow4Run
+func (m *MockSomeWorkflow4Run) ID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnID registers an expectation for a call to ID with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeWorkflow4Run) OnID() *MockSomeWorkflow4RunIDCall {
+	return &MockSomeWorkflow4RunIDCall{Call: m.On("ID")}
+}
+
+// MockSomeWorkflow4RunIDCall describes an expected call to MockSomeWorkflow4Run.ID
+type MockSomeWorkflow4RunIDCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeWorkflow4RunIDCall) Return(id string) *MockSomeWorkflow4RunIDCall {
+	c.Call.Return(id)
+	return c
+}
+
+// RunID implements SomeWorkflow4Run
+func (m *MockSomeWorkflow4Run) RunID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnRunID registers an expectation for a call to RunID with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeWorkflow4Run) OnRunID() *MockSomeWorkflow4RunRunIDCall {
+	return &MockSomeWorkflow4RunRunIDCall{Call: m.On("RunID")}
+}
+
+// MockSomeWorkflow4RunRunIDCall describes an expected call to MockSomeWorkflow4Run.RunID
+type MockSomeWorkflow4RunRunIDCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeWorkflow4RunRunIDCall) Return(runID string) *MockSomeWorkflow4RunRunIDCall {
+	c.Call.Return(runID)
+	return c
+}
+
+// Get implements SomeWorkflow4Run
+func (m *MockSomeWorkflow4Run) Get(ctx context.Context) error {
+	args := m.Called(ctx)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnGet registers an expectation for a call to Get with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeWorkflow4Run) OnGet(ctx any) *MockSomeWorkflow4RunGetCall {
+	return &MockSomeWorkflow4RunGetCall{Call: m.On("Get", ctx)}
+}
+
+// MockSomeWorkflow4RunGetCall describes an expected call to MockSomeWorkflow4Run.Get
+type MockSomeWorkflow4RunGetCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeWorkflow4RunGetCall) Return(err error) *MockSomeWorkflow4RunGetCall {
+	c.Call.Return(err)
+	return c
+}
+
+// GetValue implements SomeWorkflow4Run
+func (m *MockSomeWorkflow4Run) GetValue(ctx context.Context) (*common.GetValueResponse, error) {
+	args := m.Called(ctx)
+	var r0 *common.GetValueResponse
+	if v := args.Get(0); v != nil {
+		r0 = v.(*common.GetValueResponse)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnGetValue registers an expectation for a call to GetValue with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeWorkflow4Run) OnGetValue(ctx any) *MockSomeWorkflow4RunGetValueCall {
+	return &MockSomeWorkflow4RunGetValueCall{Call: m.On("GetValue", ctx)}
+}
+
+// MockSomeWorkflow4RunGetValueCall describes an expected call to MockSomeWorkflow4Run.GetValue
+type MockSomeWorkflow4RunGetValueCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeWorkflow4RunGetValueCall) Return(resp *common.GetValueResponse, err error) *MockSomeWorkflow4RunGetValueCall {
+	c.Call.Return(resp, err)
+	return c
+}
+
+// SetValue implements SomeWorkflow4Run
+func (m *MockSomeWorkflow4Run) SetValue(ctx context.Context, req *common.SetValueRequest) error {
+	args := m.Called(ctx, req)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OnSetValue registers an expectation for a call to SetValue with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeWorkflow4Run) OnSetValue(ctx any, req any) *MockSomeWorkflow4RunSetValueCall {
+	return &MockSomeWorkflow4RunSetValueCall{Call: m.On("SetValue", ctx, req)}
+}
+
+// MockSomeWorkflow4RunSetValueCall describes an expected call to MockSomeWorkflow4Run.SetValue
+type MockSomeWorkflow4RunSetValueCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeWorkflow4RunSetValueCall) Return(err error) *MockSomeWorkflow4RunSetValueCall {
+	c.Call.Return(err)
+	return c
+}
+
+// UpdateValue implements SomeWorkflow4Run
+func (m *MockSomeWorkflow4Run) UpdateValue(ctx context.Context, req *common.UpdateValueRequest, opts ...*common.UpdateValueOptions) (*common.UpdateValueResponse, error) {
+	args := m.Called(ctx, req, opts)
+	var r0 *common.UpdateValueResponse
+	if v := args.Get(0); v != nil {
+		r0 = v.(*common.UpdateValueResponse)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnUpdateValue registers an expectation for a call to UpdateValue with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeWorkflow4Run) OnUpdateValue(ctx any, req any, opts any) *MockSomeWorkflow4RunUpdateValueCall {
+	return &MockSomeWorkflow4RunUpdateValueCall{Call: m.On("UpdateValue", ctx, req, opts)}
+}
+
+// MockSomeWorkflow4RunUpdateValueCall describes an expected call to MockSomeWorkflow4Run.UpdateValue
+type MockSomeWorkflow4RunUpdateValueCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeWorkflow4RunUpdateValueCall) Return(resp *common.UpdateValueResponse, err error) *MockSomeWorkflow4RunUpdateValueCall {
+	c.Call.Return(resp, err)
+	return c
+}
+
+// UpdateValueAsync implements SomeWorkflow4Run
+func (m *MockSomeWorkflow4Run) UpdateValueAsync(ctx context.Context, req *common.UpdateValueRequest, opts ...*common.UpdateValueOptions) (common.UpdateValueHandle, error) {
+	args := m.Called(ctx, req, opts)
+	var r0 common.UpdateValueHandle
+	if v := args.Get(0); v != nil {
+		r0 = v.(common.UpdateValueHandle)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnUpdateValueAsync registers an expectation for a call to UpdateValueAsync with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeWorkflow4Run) OnUpdateValueAsync(ctx any, req any, opts any) *MockSomeWorkflow4RunUpdateValueAsyncCall {
+	return &MockSomeWorkflow4RunUpdateValueAsyncCall{Call: m.On("UpdateValueAsync", ctx, req, opts)}
+}
+
+// MockSomeWorkflow4RunUpdateValueAsyncCall describes an expected call to MockSomeWorkflow4Run.UpdateValueAsync
+type MockSomeWorkflow4RunUpdateValueAsyncCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeWorkflow4RunUpdateValueAsyncCall) Return(handle common.UpdateValueHandle, err error) *MockSomeWorkflow4RunUpdateValueAsyncCall {
+	c.Call.Return(handle, err)
+	return c
+}
+
+// MockSomeUpdate1Handle is a testify mock implementation of SomeUpdate1Handle
+type MockSomeUpdate1Handle struct {
+	mock.Mock
+}
+
+var _ SomeUpdate1Handle = (*MockSomeUpdate1Handle)(nil)
+
+// NewMockSomeUpdate1Handle initializes a new MockSomeUpdate1Handle that asserts its expectations when the test completes
+func NewMockSomeUpdate1Handle(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSomeUpdate1Handle {
+	m := &MockSomeUpdate1Handle{}
+	m.Mock.Test(t)
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+	return m
+}
+
+// WorkflowID implements SomeUpdate1Handle
+func (m *MockSomeUpdate1Handle) WorkflowID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnWorkflowID registers an expectation for a call to WorkflowID with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeUpdate1Handle) OnWorkflowID() *MockSomeUpdate1HandleWorkflowIDCall {
+	return &MockSomeUpdate1HandleWorkflowIDCall{Call: m.On("WorkflowID")}
+}
+
+// MockSomeUpdate1HandleWorkflowIDCall describes an expected call to MockSomeUpdate1Handle.WorkflowID
+type MockSomeUpdate1HandleWorkflowIDCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeUpdate1HandleWorkflowIDCall) Return(workflowID string) *MockSomeUpdate1HandleWorkflowIDCall {
+	c.Call.Return(workflowID)
+	return c
+}
+
+// RunID implements SomeUpdate1Handle
+func (m *MockSomeUpdate1Handle) RunID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnRunID registers an expectation for a call to RunID with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeUpdate1Handle) OnRunID() *MockSomeUpdate1HandleRunIDCall {
+	return &MockSomeUpdate1HandleRunIDCall{Call: m.On("RunID")}
+}
+
+// MockSomeUpdate1HandleRunIDCall describes an expected call to MockSomeUpdate1Handle.RunID
+type MockSomeUpdate1HandleRunIDCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeUpdate1HandleRunIDCall) Return(runID string) *MockSomeUpdate1HandleRunIDCall {
+	c.Call.Return(runID)
+	return c
+}
+
+// UpdateID implements SomeUpdate1Handle
+func (m *MockSomeUpdate1Handle) UpdateID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnUpdateID registers an expectation for a call to UpdateID with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeUpdate1Handle) OnUpdateID() *MockSomeUpdate1HandleUpdateIDCall {
+	return &MockSomeUpdate1HandleUpdateIDCall{Call: m.On("UpdateID")}
+}
+
+// MockSomeUpdate1HandleUpdateIDCall describes an expected call to MockSomeUpdate1Handle.UpdateID
+type MockSomeUpdate1HandleUpdateIDCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeUpdate1HandleUpdateIDCall) Return(updateID string) *MockSomeUpdate1HandleUpdateIDCall {
+	c.Call.Return(updateID)
+	return c
+}
+
+// Get implements SomeUpdate1Handle
+func (m *MockSomeUpdate1Handle) Get(ctx context.Context) (*SomeUpdate1Response, error) {
+	args := m.Called(ctx)
+	var r0 *SomeUpdate1Response
+	if v := args.Get(0); v != nil {
+		r0 = v.(*SomeUpdate1Response)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// OnGet registers an expectation for a call to Get with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockSomeUpdate1Handle) OnGet(ctx any) *MockSomeUpdate1HandleGetCall {
+	return &MockSomeUpdate1HandleGetCall{Call: m.On("Get", ctx)}
+}
+
+// MockSomeUpdate1HandleGetCall describes an expected call to MockSomeUpdate1Handle.Get
+type MockSomeUpdate1HandleGetCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockSomeUpdate1HandleGetCall) Return(resp *SomeUpdate1Response, err error) *MockSomeUpdate1HandleGetCall {
+	c.Call.Return(resp, err)
+	return c
+}
+
+// SimpleCliOptions describes runtime configuration for mycompany.simple.Simple cli
+type SimpleCliOptions struct {
+	after            func(*v2.Context) error
+	before           func(*v2.Context) error
+	clientForCommand func(*v2.Context) (client.Client, error)
+	worker           func(*v2.Context, client.Client) (worker.Worker, error)
+}
+
+// NewSimpleCliOptions initializes a new SimpleCliOptions value
+func NewSimpleCliOptions() *SimpleCliOptions {
+	return &SimpleCliOptions{}
+}
+
+// WithAfter injects a custom After hook to be run after any command invocation
+func (opts *SimpleCliOptions) WithAfter(fn func(*v2.Context) error) *SimpleCliOptions {
+	opts.after = fn
+	return opts
+}
+
+// WithBefore injects a custom Before hook to be run prior to any command invocation
+func (opts *SimpleCliOptions) WithBefore(fn func(*v2.Context) error) *SimpleCliOptions {
+	opts.before = fn
+	return opts
+}
+
+// WithClient provides a Temporal client factory for use by commands
+func (opts *SimpleCliOptions) WithClient(fn func(*v2.Context) (client.Client, error)) *SimpleCliOptions {
+	opts.clientForCommand = fn
+	return opts
+}
+
+// WithWorker provides an method for initializing a worker
+func (opts *SimpleCliOptions) WithWorker(fn func(*v2.Context, client.Client) (worker.Worker, error)) *SimpleCliOptions {
+	opts.worker = fn
+	return opts
+}
+
+// NewSimpleCliWorkerOptions returns the options for a mycompany.simple.Simple worker initialized by the cli
+// worker command, with EnableSessionWorker set via the --enable-session-worker flag
+func NewSimpleCliWorkerOptions(cmd *v2.Context) worker.Options {
+	return worker.Options{EnableSessionWorker: cmd.Bool("enable-session-worker")}
+}
+
+// NewSimpleCli initializes a cli for a(n) mycompany.simple.Simple service
+func NewSimpleCli(options ...*SimpleCliOptions) (*v2.App, error) {
+	commands, err := newSimpleCommands(options...)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing subcommands: %w", err)
+	}
+	return &v2.App{
+		Name:     "simple",
+		Commands: commands,
+	}, nil
+}
+
+// NewSimpleCliCommand initializes a cli command for a mycompany.simple.Simple service with subcommands for each query, signal, update, and workflow
+func NewSimpleCliCommand(options ...*SimpleCliOptions) (*v2.Command, error) {
+	subcommands, err := newSimpleCommands(options...)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing subcommands: %w", err)
+	}
+	return &v2.Command{
+		Name:        "simple",
+		Subcommands: subcommands,
+	}, nil
+}
+
+// newSimpleCommands initializes (sub)commands for a mycompany.simple.Simple cli or command
+func newSimpleCommands(options ...*SimpleCliOptions) ([]*v2.Command, error) {
+	opts := &SimpleCliOptions{}
+	if len(options) > 0 {
+		opts = options[0]
+	}
+	if opts.clientForCommand == nil {
+		opts.clientForCommand = func(*v2.Context) (client.Client, error) {
+			return client.Dial(client.Options{})
+		}
+	}
+	commands := []*v2.Command{
+		// SomeQuery1 queries some thing.,
+		{
+			Name:                   "some-query-1",
+			Usage:                  "SomeQuery1 queries some thing.",
+			UseShortOptionHandling: true,
+			Before:                 opts.before,
+			After:                  opts.after,
+			Flags: []v2.Flag{
+				&v2.StringFlag{
+					Name:     "workflow-id",
+					Usage:    "workflow id",
+					Required: true,
+					Aliases:  []string{"w"},
+				},
+				&v2.StringFlag{
+					Name:    "run-id",
+					Usage:   "run id",
+					Aliases: []string{"r"},
+				},
+			},
+			Action: func(cmd *v2.Context) error {
+				c, err := opts.clientForCommand(cmd)
+				if err != nil {
+					return fmt.Errorf("error initializing client for command: %w", err)
+				}
+				defer c.Close()
+				client := NewSimpleClient(c)
+				if resp, err := client.SomeQuery1(cmd.Context, cmd.String("workflow-id"), cmd.String("run-id")); err != nil {
+					return fmt.Errorf("error executing %q query: %w", SomeQuery1QueryName, err)
+				} else {
+					b, err := protojson.Marshal(resp)
+					if err != nil {
+						return fmt.Errorf("error serializing response json: %w", err)
+					}
+					var out bytes.Buffer
+					if err := json.Indent(&out, b, "", "  "); err != nil {
+						return fmt.Errorf("error formatting json: %w", err)
+					}
+					fmt.Println(out.String())
+					return nil
 				}
 			},
 		},
@@ -2511,6 +7561,10 @@ func newSimpleCommands(options ...*SimpleCliOptions) ([]*v2.Command, error) {
 					Usage:   "run workflow in the background and print workflow and execution id",
 					Aliases: []string{"d"},
 				},
+				&v2.StringFlag{
+					Name:  "cron",
+					Usage: "cron schedule for the workflow, overriding the default schedule if any",
+				},
 				&v2.StringFlag{
 					Name:  "request-val",
 					Usage: "set the value of the operation's \"RequestVal\" parameter",
@@ -2531,7 +7585,11 @@ func newSimpleCommands(options ...*SimpleCliOptions) ([]*v2.Command, error) {
 				if err != nil {
 					return fmt.Errorf("error unmarshalling request: %w", err)
 				}
-				run, err := client.SomeWorkflow1Async(cmd.Context, req)
+				workflowOpts := NewSomeWorkflow1Options()
+				if cmd.IsSet("cron") {
+					workflowOpts.WithCronSchedule(cmd.String("cron"))
+				}
+				run, err := client.SomeWorkflow1Async(cmd.Context, req, workflowOpts)
 				if err != nil {
 					return fmt.Errorf("error starting %s workflow: %w", SomeWorkflow1WorkflowName, err)
 				}
@@ -2557,6 +7615,214 @@ func newSimpleCommands(options ...*SimpleCliOptions) ([]*v2.Command, error) {
 				}
 			},
 		},
+		// manages mycompany.simple.SomeWorkflow1 workflow schedules,
+		{
+			Name:                   "some-workflow-1-schedule",
+			Usage:                  "manages mycompany.simple.SomeWorkflow1 workflow schedules",
+			UseShortOptionHandling: true,
+			Before:                 opts.before,
+			After:                  opts.after,
+			Subcommands: []*v2.Command{
+				{
+					Name:  "create",
+					Usage: "creates a schedule that periodically starts a(n) mycompany.simple.SomeWorkflow1 workflow",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+						&v2.StringSliceFlag{
+							Name:  "cron",
+							Usage: "cron expression describing when to start the workflow, overriding the default spec",
+						},
+						&v2.StringSliceFlag{
+							Name:  "interval",
+							Usage: "interval (e.g. 1h) describing how often to start the workflow, overriding the default spec",
+						},
+						&v2.StringFlag{
+							Name:  "request-val",
+							Usage: "set the value of the operation's \"RequestVal\" parameter",
+						},
+						&v2.StringFlag{
+							Name:  "id",
+							Usage: "set the value of the operation's \"Id\" parameter",
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						req, err := unmarshalCliFlagsToSomeWorkflow1Request(cmd)
+						if err != nil {
+							return fmt.Errorf("error unmarshalling request: %w", err)
+						}
+						spec := client.ScheduleSpec{CronExpressions: cmd.StringSlice("cron")}
+						for _, interval := range cmd.StringSlice("interval") {
+							every, err := time.ParseDuration(interval)
+							if err != nil {
+								return fmt.Errorf("error parsing interval %q: %w", interval, err)
+							}
+							spec.Intervals = append(spec.Intervals, client.ScheduleIntervalSpec{Every: every})
+						}
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewSimpleClient(c)
+						handle, err := client.CreateSomeWorkflow1Schedule(cmd.Context, cmd.String("schedule-id"), spec, req)
+						if err != nil {
+							return fmt.Errorf("error creating schedule: %w", err)
+						}
+						fmt.Println("success")
+						fmt.Printf("schedule id: %s\n", handle.GetID())
+						return nil
+					},
+				},
+				{
+					Name:  "get",
+					Usage: "describes an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewSimpleClient(c)
+						desc, err := client.GetSomeWorkflow1Schedule(cmd.Context, cmd.String("schedule-id"))
+						if err != nil {
+							return fmt.Errorf("error describing schedule: %w", err)
+						}
+						if state := desc.Schedule.State; state != nil {
+							fmt.Printf("paused: %t\n", state.Paused)
+							fmt.Printf("note: %s\n", state.Note)
+						}
+						for _, t := range desc.Info.NextActionTimes {
+							fmt.Printf("next action: %s\n", t.Format(time.RFC3339))
+						}
+						for _, a := range desc.Info.RecentActions {
+							fmt.Printf("recent action: %s\n", a.ActualTime.Format(time.RFC3339))
+						}
+						return nil
+					},
+				},
+				{
+					Name:  "pause",
+					Usage: "pauses an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+						&v2.StringFlag{
+							Name:  "note",
+							Usage: "informative note describing the change",
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewSimpleClient(c)
+						if err := client.PauseSomeWorkflow1Schedule(cmd.Context, cmd.String("schedule-id"), cmd.String("note")); err != nil {
+							return fmt.Errorf("error executing pause schedule command: %w", err)
+						}
+						fmt.Println("success")
+						return nil
+					},
+				},
+				{
+					Name:  "unpause",
+					Usage: "unpauses an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+						&v2.StringFlag{
+							Name:  "note",
+							Usage: "informative note describing the change",
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewSimpleClient(c)
+						if err := client.UnpauseSomeWorkflow1Schedule(cmd.Context, cmd.String("schedule-id"), cmd.String("note")); err != nil {
+							return fmt.Errorf("error executing unpause schedule command: %w", err)
+						}
+						fmt.Println("success")
+						return nil
+					},
+				},
+				{
+					Name:  "trigger",
+					Usage: "immediately starts a workflow using an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewSimpleClient(c)
+						if err := client.TriggerSomeWorkflow1Schedule(cmd.Context, cmd.String("schedule-id")); err != nil {
+							return fmt.Errorf("error executing trigger schedule command: %w", err)
+						}
+						fmt.Println("success")
+						return nil
+					},
+				},
+				{
+					Name:  "delete",
+					Usage: "deletes an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewSimpleClient(c)
+						if err := client.DeleteSomeWorkflow1Schedule(cmd.Context, cmd.String("schedule-id")); err != nil {
+							return fmt.Errorf("error executing delete schedule command: %w", err)
+						}
+						fmt.Println("success")
+						return nil
+					},
+				},
+			},
+		},
 		// SomeWorkflow2 does some workflow thing.,
 		{
 			Name:                   "some-workflow-2",
@@ -2570,6 +7836,10 @@ func newSimpleCommands(options ...*SimpleCliOptions) ([]*v2.Command, error) {
 					Usage:   "run workflow in the background and print workflow and execution id",
 					Aliases: []string{"d"},
 				},
+				&v2.StringFlag{
+					Name:  "cron",
+					Usage: "cron schedule for the workflow, overriding the default schedule if any",
+				},
 			},
 			Action: func(cmd *v2.Context) error {
 				c, err := opts.clientForCommand(cmd)
@@ -2578,7 +7848,11 @@ func newSimpleCommands(options ...*SimpleCliOptions) ([]*v2.Command, error) {
 				}
 				defer c.Close()
 				client := NewSimpleClient(c)
-				run, err := client.SomeWorkflow2Async(cmd.Context)
+				workflowOpts := NewSomeWorkflow2Options()
+				if cmd.IsSet("cron") {
+					workflowOpts.WithCronSchedule(cmd.String("cron"))
+				}
+				run, err := client.SomeWorkflow2Async(cmd.Context, workflowOpts)
 				if err != nil {
 					return fmt.Errorf("error starting %s workflow: %w", SomeWorkflow2WorkflowName, err)
 				}
@@ -2595,6 +7869,202 @@ func newSimpleCommands(options ...*SimpleCliOptions) ([]*v2.Command, error) {
 				}
 			},
 		},
+		// manages mycompany.simple.SomeWorkflow2 workflow schedules,
+		{
+			Name:                   "some-workflow-2-schedule",
+			Usage:                  "manages mycompany.simple.SomeWorkflow2 workflow schedules",
+			UseShortOptionHandling: true,
+			Before:                 opts.before,
+			After:                  opts.after,
+			Subcommands: []*v2.Command{
+				{
+					Name:  "create",
+					Usage: "creates a schedule that periodically starts a(n) mycompany.simple.SomeWorkflow2 workflow",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+						&v2.StringSliceFlag{
+							Name:  "cron",
+							Usage: "cron expression describing when to start the workflow, overriding the default spec",
+						},
+						&v2.StringSliceFlag{
+							Name:  "interval",
+							Usage: "interval (e.g. 1h) describing how often to start the workflow, overriding the default spec",
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						spec := client.ScheduleSpec{CronExpressions: cmd.StringSlice("cron")}
+						for _, interval := range cmd.StringSlice("interval") {
+							every, err := time.ParseDuration(interval)
+							if err != nil {
+								return fmt.Errorf("error parsing interval %q: %w", interval, err)
+							}
+							spec.Intervals = append(spec.Intervals, client.ScheduleIntervalSpec{Every: every})
+						}
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewSimpleClient(c)
+						handle, err := client.CreateSomeWorkflow2Schedule(cmd.Context, cmd.String("schedule-id"), spec)
+						if err != nil {
+							return fmt.Errorf("error creating schedule: %w", err)
+						}
+						fmt.Println("success")
+						fmt.Printf("schedule id: %s\n", handle.GetID())
+						return nil
+					},
+				},
+				{
+					Name:  "get",
+					Usage: "describes an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewSimpleClient(c)
+						desc, err := client.GetSomeWorkflow2Schedule(cmd.Context, cmd.String("schedule-id"))
+						if err != nil {
+							return fmt.Errorf("error describing schedule: %w", err)
+						}
+						if state := desc.Schedule.State; state != nil {
+							fmt.Printf("paused: %t\n", state.Paused)
+							fmt.Printf("note: %s\n", state.Note)
+						}
+						for _, t := range desc.Info.NextActionTimes {
+							fmt.Printf("next action: %s\n", t.Format(time.RFC3339))
+						}
+						for _, a := range desc.Info.RecentActions {
+							fmt.Printf("recent action: %s\n", a.ActualTime.Format(time.RFC3339))
+						}
+						return nil
+					},
+				},
+				{
+					Name:  "pause",
+					Usage: "pauses an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+						&v2.StringFlag{
+							Name:  "note",
+							Usage: "informative note describing the change",
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewSimpleClient(c)
+						if err := client.PauseSomeWorkflow2Schedule(cmd.Context, cmd.String("schedule-id"), cmd.String("note")); err != nil {
+							return fmt.Errorf("error executing pause schedule command: %w", err)
+						}
+						fmt.Println("success")
+						return nil
+					},
+				},
+				{
+					Name:  "unpause",
+					Usage: "unpauses an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+						&v2.StringFlag{
+							Name:  "note",
+							Usage: "informative note describing the change",
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewSimpleClient(c)
+						if err := client.UnpauseSomeWorkflow2Schedule(cmd.Context, cmd.String("schedule-id"), cmd.String("note")); err != nil {
+							return fmt.Errorf("error executing unpause schedule command: %w", err)
+						}
+						fmt.Println("success")
+						return nil
+					},
+				},
+				{
+					Name:  "trigger",
+					Usage: "immediately starts a workflow using an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewSimpleClient(c)
+						if err := client.TriggerSomeWorkflow2Schedule(cmd.Context, cmd.String("schedule-id")); err != nil {
+							return fmt.Errorf("error executing trigger schedule command: %w", err)
+						}
+						fmt.Println("success")
+						return nil
+					},
+				},
+				{
+					Name:  "delete",
+					Usage: "deletes an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewSimpleClient(c)
+						if err := client.DeleteSomeWorkflow2Schedule(cmd.Context, cmd.String("schedule-id")); err != nil {
+							return fmt.Errorf("error executing delete schedule command: %w", err)
+						}
+						fmt.Println("success")
+						return nil
+					},
+				},
+			},
+		},
 		// sends a SomeSignal1 signal to a SomeWorkflow2 worklow, starting it if necessary,
 		{
 			Name:                   "some-workflow-2-with-some-signal-1",
@@ -2646,6 +8116,10 @@ func newSimpleCommands(options ...*SimpleCliOptions) ([]*v2.Command, error) {
 					Usage:   "run workflow in the background and print workflow and execution id",
 					Aliases: []string{"d"},
 				},
+				&v2.StringFlag{
+					Name:  "cron",
+					Usage: "cron schedule for the workflow, overriding the default schedule if any",
+				},
 				&v2.StringFlag{
 					Name:  "id",
 					Usage: "set the value of the operation's \"Id\" parameter",
@@ -2666,7 +8140,11 @@ func newSimpleCommands(options ...*SimpleCliOptions) ([]*v2.Command, error) {
 				if err != nil {
 					return fmt.Errorf("error unmarshalling request: %w", err)
 				}
-				run, err := client.SomeWorkflow3Async(cmd.Context, req)
+				workflowOpts := NewSomeWorkflow3Options()
+				if cmd.IsSet("cron") {
+					workflowOpts.WithCronSchedule(cmd.String("cron"))
+				}
+				run, err := client.SomeWorkflow3Async(cmd.Context, req, workflowOpts)
 				if err != nil {
 					return fmt.Errorf("error starting %s workflow: %w", SomeWorkflow3WorkflowName, err)
 				}
@@ -2683,6 +8161,214 @@ func newSimpleCommands(options ...*SimpleCliOptions) ([]*v2.Command, error) {
 				}
 			},
 		},
+		// manages mycompany.simple.Simple.SomeWorkflow3 workflow schedules,
+		{
+			Name:                   "some-workflow-3-schedule",
+			Usage:                  "manages mycompany.simple.Simple.SomeWorkflow3 workflow schedules",
+			UseShortOptionHandling: true,
+			Before:                 opts.before,
+			After:                  opts.after,
+			Subcommands: []*v2.Command{
+				{
+					Name:  "create",
+					Usage: "creates a schedule that periodically starts a(n) mycompany.simple.Simple.SomeWorkflow3 workflow",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+						&v2.StringSliceFlag{
+							Name:  "cron",
+							Usage: "cron expression describing when to start the workflow, overriding the default spec",
+						},
+						&v2.StringSliceFlag{
+							Name:  "interval",
+							Usage: "interval (e.g. 1h) describing how often to start the workflow, overriding the default spec",
+						},
+						&v2.StringFlag{
+							Name:  "id",
+							Usage: "set the value of the operation's \"Id\" parameter",
+						},
+						&v2.StringFlag{
+							Name:  "request-val",
+							Usage: "set the value of the operation's \"RequestVal\" parameter",
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						req, err := unmarshalCliFlagsToSomeWorkflow3Request(cmd)
+						if err != nil {
+							return fmt.Errorf("error unmarshalling request: %w", err)
+						}
+						spec := client.ScheduleSpec{CronExpressions: cmd.StringSlice("cron")}
+						for _, interval := range cmd.StringSlice("interval") {
+							every, err := time.ParseDuration(interval)
+							if err != nil {
+								return fmt.Errorf("error parsing interval %q: %w", interval, err)
+							}
+							spec.Intervals = append(spec.Intervals, client.ScheduleIntervalSpec{Every: every})
+						}
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewSimpleClient(c)
+						handle, err := client.CreateSomeWorkflow3Schedule(cmd.Context, cmd.String("schedule-id"), spec, req)
+						if err != nil {
+							return fmt.Errorf("error creating schedule: %w", err)
+						}
+						fmt.Println("success")
+						fmt.Printf("schedule id: %s\n", handle.GetID())
+						return nil
+					},
+				},
+				{
+					Name:  "get",
+					Usage: "describes an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewSimpleClient(c)
+						desc, err := client.GetSomeWorkflow3Schedule(cmd.Context, cmd.String("schedule-id"))
+						if err != nil {
+							return fmt.Errorf("error describing schedule: %w", err)
+						}
+						if state := desc.Schedule.State; state != nil {
+							fmt.Printf("paused: %t\n", state.Paused)
+							fmt.Printf("note: %s\n", state.Note)
+						}
+						for _, t := range desc.Info.NextActionTimes {
+							fmt.Printf("next action: %s\n", t.Format(time.RFC3339))
+						}
+						for _, a := range desc.Info.RecentActions {
+							fmt.Printf("recent action: %s\n", a.ActualTime.Format(time.RFC3339))
+						}
+						return nil
+					},
+				},
+				{
+					Name:  "pause",
+					Usage: "pauses an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+						&v2.StringFlag{
+							Name:  "note",
+							Usage: "informative note describing the change",
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewSimpleClient(c)
+						if err := client.PauseSomeWorkflow3Schedule(cmd.Context, cmd.String("schedule-id"), cmd.String("note")); err != nil {
+							return fmt.Errorf("error executing pause schedule command: %w", err)
+						}
+						fmt.Println("success")
+						return nil
+					},
+				},
+				{
+					Name:  "unpause",
+					Usage: "unpauses an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+						&v2.StringFlag{
+							Name:  "note",
+							Usage: "informative note describing the change",
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewSimpleClient(c)
+						if err := client.UnpauseSomeWorkflow3Schedule(cmd.Context, cmd.String("schedule-id"), cmd.String("note")); err != nil {
+							return fmt.Errorf("error executing unpause schedule command: %w", err)
+						}
+						fmt.Println("success")
+						return nil
+					},
+				},
+				{
+					Name:  "trigger",
+					Usage: "immediately starts a workflow using an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewSimpleClient(c)
+						if err := client.TriggerSomeWorkflow3Schedule(cmd.Context, cmd.String("schedule-id")); err != nil {
+							return fmt.Errorf("error executing trigger schedule command: %w", err)
+						}
+						fmt.Println("success")
+						return nil
+					},
+				},
+				{
+					Name:  "delete",
+					Usage: "deletes an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewSimpleClient(c)
+						if err := client.DeleteSomeWorkflow3Schedule(cmd.Context, cmd.String("schedule-id")); err != nil {
+							return fmt.Errorf("error executing delete schedule command: %w", err)
+						}
+						fmt.Println("success")
+						return nil
+					},
+				},
+			},
+		},
 		// sends a SomeSignal2 signal to a SomeWorkflow3 worklow, starting it if necessary,
 		{
 			Name:                   "some-workflow-3-with-some-signal-2",
@@ -2705,8 +8391,300 @@ func newSimpleCommands(options ...*SimpleCliOptions) ([]*v2.Command, error) {
 					Usage: "set the value of the operation's \"RequestVal\" parameter",
 				},
 				&v2.StringFlag{
-					Name:  "request-val",
-					Usage: "set the value of the operation's \"RequestVal\" parameter",
+					Name:  "request-val",
+					Usage: "set the value of the operation's \"RequestVal\" parameter",
+				},
+			},
+			Action: func(cmd *v2.Context) error {
+				c, err := opts.clientForCommand(cmd)
+				if err != nil {
+					return fmt.Errorf("error initializing client for command: %w", err)
+				}
+				defer c.Close()
+				client := NewSimpleClient(c)
+				req, err := unmarshalCliFlagsToSomeWorkflow3Request(cmd)
+				if err != nil {
+					return fmt.Errorf("error unmarshalling request: %w", err)
+				}
+				signal, err := unmarshalCliFlagsToSomeSignal2Request(cmd)
+				if err != nil {
+					return fmt.Errorf("error unmarshalling signal: %w", err)
+				}
+				run, err := client.SomeWorkflow3WithSomeSignal2Async(cmd.Context, req, signal)
+				if err != nil {
+					return fmt.Errorf("error starting %s workflow with %s signal: %w", SomeWorkflow3WorkflowName, SomeSignal2SignalName, err)
+				}
+				if cmd.Bool("detach") {
+					fmt.Println("success")
+					fmt.Printf("workflow id: %s\n", run.ID())
+					fmt.Printf("run id: %s\n", run.RunID())
+					return nil
+				}
+				if err := run.Get(cmd.Context); err != nil {
+					return err
+				} else {
+					return nil
+				}
+			},
+		},
+		// SomeWorkflow4 does some workflow thing using queries, signals, and updates defined by another package.,
+		{
+			Name:                   "some-workflow-4",
+			Usage:                  "SomeWorkflow4 does some workflow thing using queries, signals, and updates defined by another package.",
+			UseShortOptionHandling: true,
+			Before:                 opts.before,
+			After:                  opts.after,
+			Flags: []v2.Flag{
+				&v2.BoolFlag{
+					Name:    "detach",
+					Usage:   "run workflow in the background and print workflow and execution id",
+					Aliases: []string{"d"},
+				},
+				&v2.StringFlag{
+					Name:  "cron",
+					Usage: "cron schedule for the workflow, overriding the default schedule if any",
+				},
+			},
+			Action: func(cmd *v2.Context) error {
+				c, err := opts.clientForCommand(cmd)
+				if err != nil {
+					return fmt.Errorf("error initializing client for command: %w", err)
+				}
+				defer c.Close()
+				client := NewSimpleClient(c)
+				workflowOpts := NewSomeWorkflow4Options()
+				if cmd.IsSet("cron") {
+					workflowOpts.WithCronSchedule(cmd.String("cron"))
+				}
+				run, err := client.SomeWorkflow4Async(cmd.Context, workflowOpts)
+				if err != nil {
+					return fmt.Errorf("error starting %s workflow: %w", SomeWorkflow4WorkflowName, err)
+				}
+				if cmd.Bool("detach") {
+					fmt.Println("success")
+					fmt.Printf("workflow id: %s\n", run.ID())
+					fmt.Printf("run id: %s\n", run.RunID())
+					return nil
+				}
+				if err := run.Get(cmd.Context); err != nil {
+					return err
+				} else {
+					return nil
+				}
+			},
+		},
+		// manages mycompany.simple.SomeWorkflow4 workflow schedules,
+		{
+			Name:                   "some-workflow-4-schedule",
+			Usage:                  "manages mycompany.simple.SomeWorkflow4 workflow schedules",
+			UseShortOptionHandling: true,
+			Before:                 opts.before,
+			After:                  opts.after,
+			Subcommands: []*v2.Command{
+				{
+					Name:  "create",
+					Usage: "creates a schedule that periodically starts a(n) mycompany.simple.SomeWorkflow4 workflow",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+						&v2.StringSliceFlag{
+							Name:  "cron",
+							Usage: "cron expression describing when to start the workflow, overriding the default spec",
+						},
+						&v2.StringSliceFlag{
+							Name:  "interval",
+							Usage: "interval (e.g. 1h) describing how often to start the workflow, overriding the default spec",
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						spec := client.ScheduleSpec{CronExpressions: cmd.StringSlice("cron")}
+						for _, interval := range cmd.StringSlice("interval") {
+							every, err := time.ParseDuration(interval)
+							if err != nil {
+								return fmt.Errorf("error parsing interval %q: %w", interval, err)
+							}
+							spec.Intervals = append(spec.Intervals, client.ScheduleIntervalSpec{Every: every})
+						}
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewSimpleClient(c)
+						handle, err := client.CreateSomeWorkflow4Schedule(cmd.Context, cmd.String("schedule-id"), spec)
+						if err != nil {
+							return fmt.Errorf("error creating schedule: %w", err)
+						}
+						fmt.Println("success")
+						fmt.Printf("schedule id: %s\n", handle.GetID())
+						return nil
+					},
+				},
+				{
+					Name:  "get",
+					Usage: "describes an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewSimpleClient(c)
+						desc, err := client.GetSomeWorkflow4Schedule(cmd.Context, cmd.String("schedule-id"))
+						if err != nil {
+							return fmt.Errorf("error describing schedule: %w", err)
+						}
+						if state := desc.Schedule.State; state != nil {
+							fmt.Printf("paused: %t\n", state.Paused)
+							fmt.Printf("note: %s\n", state.Note)
+						}
+						for _, t := range desc.Info.NextActionTimes {
+							fmt.Printf("next action: %s\n", t.Format(time.RFC3339))
+						}
+						for _, a := range desc.Info.RecentActions {
+							fmt.Printf("recent action: %s\n", a.ActualTime.Format(time.RFC3339))
+						}
+						return nil
+					},
+				},
+				{
+					Name:  "pause",
+					Usage: "pauses an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+						&v2.StringFlag{
+							Name:  "note",
+							Usage: "informative note describing the change",
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewSimpleClient(c)
+						if err := client.PauseSomeWorkflow4Schedule(cmd.Context, cmd.String("schedule-id"), cmd.String("note")); err != nil {
+							return fmt.Errorf("error executing pause schedule command: %w", err)
+						}
+						fmt.Println("success")
+						return nil
+					},
+				},
+				{
+					Name:  "unpause",
+					Usage: "unpauses an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+						&v2.StringFlag{
+							Name:  "note",
+							Usage: "informative note describing the change",
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewSimpleClient(c)
+						if err := client.UnpauseSomeWorkflow4Schedule(cmd.Context, cmd.String("schedule-id"), cmd.String("note")); err != nil {
+							return fmt.Errorf("error executing unpause schedule command: %w", err)
+						}
+						fmt.Println("success")
+						return nil
+					},
+				},
+				{
+					Name:  "trigger",
+					Usage: "immediately starts a workflow using an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewSimpleClient(c)
+						if err := client.TriggerSomeWorkflow4Schedule(cmd.Context, cmd.String("schedule-id")); err != nil {
+							return fmt.Errorf("error executing trigger schedule command: %w", err)
+						}
+						fmt.Println("success")
+						return nil
+					},
+				},
+				{
+					Name:  "delete",
+					Usage: "deletes an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewSimpleClient(c)
+						if err := client.DeleteSomeWorkflow4Schedule(cmd.Context, cmd.String("schedule-id")); err != nil {
+							return fmt.Errorf("error executing delete schedule command: %w", err)
+						}
+						fmt.Println("success")
+						return nil
+					},
+				},
+			},
+		},
+		// sends a SetValue signal to a SomeWorkflow4 worklow, starting it if necessary,
+		{
+			Name:                   "some-workflow-4-with-set-value",
+			Usage:                  "sends a SetValue signal to a SomeWorkflow4 worklow, starting it if necessary",
+			UseShortOptionHandling: true,
+			Before:                 opts.before,
+			After:                  opts.after,
+			Flags: []v2.Flag{
+				&v2.BoolFlag{
+					Name:    "detach",
+					Usage:   "run workflow in the background and print workflow and execution id",
+					Aliases: []string{"d"},
+				},
+				&v2.StringFlag{
+					Name:  "value",
+					Usage: "set the value of the operation's \"Value\" parameter",
 				},
 			},
 			Action: func(cmd *v2.Context) error {
@@ -2716,17 +8694,13 @@ func newSimpleCommands(options ...*SimpleCliOptions) ([]*v2.Command, error) {
 				}
 				defer c.Close()
 				client := NewSimpleClient(c)
-				req, err := unmarshalCliFlagsToSomeWorkflow3Request(cmd)
-				if err != nil {
-					return fmt.Errorf("error unmarshalling request: %w", err)
-				}
-				signal, err := unmarshalCliFlagsToSomeSignal2Request(cmd)
+				signal, err := unmarshalCliFlagsToSetValueRequest(cmd)
 				if err != nil {
 					return fmt.Errorf("error unmarshalling signal: %w", err)
 				}
-				run, err := client.SomeWorkflow3WithSomeSignal2Async(cmd.Context, req, signal)
+				run, err := client.SomeWorkflow4WithSetValueAsync(cmd.Context, signal)
 				if err != nil {
-					return fmt.Errorf("error starting %s workflow with %s signal: %w", SomeWorkflow3WorkflowName, SomeSignal2SignalName, err)
+					return fmt.Errorf("error starting %s workflow with %s signal: %w", SomeWorkflow4WorkflowName, common.SetValueSignalName, err)
 				}
 				if cmd.Bool("detach") {
 					fmt.Println("success")
@@ -2742,945 +8716,2195 @@ func newSimpleCommands(options ...*SimpleCliOptions) ([]*v2.Command, error) {
 			},
 		},
 	}
-	if opts.worker != nil {
-		commands = append(commands, []*v2.Command{
-			{
-				Name:                   "worker",
-				Usage:                  "runs a mycompany.simple.Simple worker process",
-				UseShortOptionHandling: true,
-				Before:                 opts.before,
-				After:                  opts.after,
-				Action: func(cmd *v2.Context) error {
-					c, err := opts.clientForCommand(cmd)
-					if err != nil {
-						return fmt.Errorf("error initializing client for command: %w", err)
-					}
-					defer c.Close()
-					w, err := opts.worker(cmd, c)
-					if opts.worker != nil {
-						if err != nil {
-							return fmt.Errorf("error initializing worker: %w", err)
-						}
-					}
-					if err := w.Start(); err != nil {
-						return fmt.Errorf("error starting worker: %w", err)
-					}
-					defer w.Stop()
-					<-cmd.Context.Done()
-					return nil
-				},
-			},
-		}...)
+	if opts.worker != nil {
+		commands = append(commands, []*v2.Command{
+			{
+				Name:                   "worker",
+				Usage:                  "runs a mycompany.simple.Simple worker process",
+				UseShortOptionHandling: true,
+				Before:                 opts.before,
+				After:                  opts.after,
+				Flags: []v2.Flag{
+					&v2.BoolFlag{
+						Name:  "enable-session-worker",
+						Usage: "enable the session worker required by session activities",
+						Value: true,
+					},
+				},
+				Action: func(cmd *v2.Context) error {
+					c, err := opts.clientForCommand(cmd)
+					if err != nil {
+						return fmt.Errorf("error initializing client for command: %w", err)
+					}
+					defer c.Close()
+					w, err := opts.worker(cmd, c)
+					if opts.worker != nil {
+						if err != nil {
+							return fmt.Errorf("error initializing worker: %w", err)
+						}
+					}
+					if err := w.Start(); err != nil {
+						return fmt.Errorf("error starting worker: %w", err)
+					}
+					defer w.Stop()
+					<-cmd.Context.Done()
+					return nil
+				},
+			},
+		}...)
+	}
+	sort.Slice(commands, func(i, j int) bool {
+		return commands[i].Name < commands[j].Name
+	})
+	return commands, nil
+}
+
+// unmarshalCliFlagsToSomeQuery2Request unmarshals a SomeQuery2Request from command line flags
+func unmarshalCliFlagsToSomeQuery2Request(cmd *v2.Context) (*SomeQuery2Request, error) {
+	var result SomeQuery2Request
+	var hasValues bool
+	if cmd.IsSet("request-val") {
+		hasValues = true
+		result.RequestVal = cmd.String("request-val")
+	}
+	if !hasValues {
+		return nil, nil
+	}
+	return &result, nil
+}
+
+// unmarshalCliFlagsToSomeSignal2Request unmarshals a SomeSignal2Request from command line flags
+func unmarshalCliFlagsToSomeSignal2Request(cmd *v2.Context) (*SomeSignal2Request, error) {
+	var result SomeSignal2Request
+	var hasValues bool
+	if cmd.IsSet("request-val") {
+		hasValues = true
+		result.RequestVal = cmd.String("request-val")
+	}
+	if !hasValues {
+		return nil, nil
+	}
+	return &result, nil
+}
+
+// unmarshalCliFlagsToSomeUpdate1Request unmarshals a SomeUpdate1Request from command line flags
+func unmarshalCliFlagsToSomeUpdate1Request(cmd *v2.Context) (*SomeUpdate1Request, error) {
+	var result SomeUpdate1Request
+	var hasValues bool
+	if cmd.IsSet("request-val") {
+		hasValues = true
+		result.RequestVal = cmd.String("request-val")
+	}
+	if !hasValues {
+		return nil, nil
+	}
+	return &result, nil
+}
+
+// unmarshalCliFlagsToSomeWorkflow1Request unmarshals a SomeWorkflow1Request from command line flags
+func unmarshalCliFlagsToSomeWorkflow1Request(cmd *v2.Context) (*SomeWorkflow1Request, error) {
+	var result SomeWorkflow1Request
+	var hasValues bool
+	if cmd.IsSet("request-val") {
+		hasValues = true
+		result.RequestVal = cmd.String("request-val")
+	}
+	if cmd.IsSet("id") {
+		hasValues = true
+		result.Id = cmd.String("id")
+	}
+	if !hasValues {
+		return nil, nil
+	}
+	return &result, nil
+}
+
+// unmarshalCliFlagsToSomeWorkflow3Request unmarshals a SomeWorkflow3Request from command line flags
+func unmarshalCliFlagsToSomeWorkflow3Request(cmd *v2.Context) (*SomeWorkflow3Request, error) {
+	var result SomeWorkflow3Request
+	var hasValues bool
+	if cmd.IsSet("id") {
+		hasValues = true
+		result.Id = cmd.String("id")
+	}
+	if cmd.IsSet("request-val") {
+		hasValues = true
+		result.RequestVal = cmd.String("request-val")
+	}
+	if !hasValues {
+		return nil, nil
+	}
+	return &result, nil
+}
+
+// unmarshalCliFlagsToSetValueRequest unmarshals a SetValueRequest from command line flags
+func unmarshalCliFlagsToSetValueRequest(cmd *v2.Context) (*common.SetValueRequest, error) {
+	var result common.SetValueRequest
+	var hasValues bool
+	if cmd.IsSet("value") {
+		hasValues = true
+		result.Value = cmd.String("value")
+	}
+	if !hasValues {
+		return nil, nil
+	}
+	return &result, nil
+}
+
+// OtherTaskQueue= is the default task-queue for a mycompany.simple.Other worker
+const OtherTaskQueue = "other-task-queue"
+
+// mycompany.simple.Other workflow names
+const (
+	OtherWorkflowWorkflowName = "mycompany.simple.Other.OtherWorkflow"
+)
+
+// mycompany.simple.Other workflow id expressions
+var (
+	OtherWorkflowIDExpression = expression.MustParseExpression("other-workflow/${!uuid_v4()}")
+)
+
+// mycompany.simple.Other activity names
+const (
+	OtherWorkflowActivityName = "mycompany.simple.Other.OtherWorkflow"
+)
+
+// mycompany.simple.Other query names
+const (
+	OtherQueryQueryName = "mycompany.simple.Other.OtherQuery"
+)
+
+// mycompany.simple.Other signal names
+const (
+	OtherSignalSignalName = "mycompany.simple.Other.OtherSignal"
+)
+
+// mycompany.simple.Other update names
+const (
+	OtherUpdateUpdateName = "mycompany.simple.Other.OtherUpdate"
+)
+
+// mycompany.simple.Other update id expressions
+var (
+	OtherUpdateIDExpression = expression.MustParseExpression("other-update/${!uuid_v4()}")
+)
+
+// OtherClient describes a client for a(n) mycompany.simple.Other worker
+type OtherClient interface {
+	// OtherWorkflow executes a(n) mycompany.simple.Other.OtherWorkflow workflow and blocks until error or response received
+	OtherWorkflow(ctx context.Context, req *OtherWorkflowRequest, opts ...*OtherWorkflowOptions) (*OtherWorkflowResponse, error)
+	// OtherWorkflowAsync executes a(n) mycompany.simple.Other.OtherWorkflow workflow asynchronously
+	OtherWorkflowAsync(ctx context.Context, req *OtherWorkflowRequest, opts ...*OtherWorkflowOptions) (OtherWorkflowRun, error)
+	// GetOtherWorkflow retrieves a handle to an existing mycompany.simple.Other.OtherWorkflow workflow execution
+	GetOtherWorkflow(ctx context.Context, workflowID string, runID string) OtherWorkflowRun
+	// CreateOtherWorkflowSchedule creates a Temporal Schedule that periodically starts a(n) mycompany.simple.Other.OtherWorkflow workflow
+	CreateOtherWorkflowSchedule(ctx context.Context, id string, spec client.ScheduleSpec, req *OtherWorkflowRequest, opts ...*OtherWorkflowScheduleOptions) (client.ScheduleHandle, error)
+	// GetOtherWorkflowSchedule describes an existing mycompany.simple.Other.OtherWorkflow workflow schedule
+	GetOtherWorkflowSchedule(ctx context.Context, id string) (*client.ScheduleDescription, error)
+	// PauseOtherWorkflowSchedule pauses an existing mycompany.simple.Other.OtherWorkflow workflow schedule with an optional note, failing if the schedule starts a different workflow
+	PauseOtherWorkflowSchedule(ctx context.Context, id string, note string) error
+	// UnpauseOtherWorkflowSchedule unpauses an existing mycompany.simple.Other.OtherWorkflow workflow schedule with an optional note, failing if the schedule starts a different workflow
+	UnpauseOtherWorkflowSchedule(ctx context.Context, id string, note string) error
+	// TriggerOtherWorkflowSchedule immediately starts a(n) mycompany.simple.Other.OtherWorkflow workflow using an existing schedule, failing if the schedule starts a different workflow
+	TriggerOtherWorkflowSchedule(ctx context.Context, id string) error
+	// DeleteOtherWorkflowSchedule deletes an existing mycompany.simple.Other.OtherWorkflow workflow schedule, failing if the schedule starts a different workflow
+	DeleteOtherWorkflowSchedule(ctx context.Context, id string) error
+	// OtherQuery executes a(n) mycompany.simple.Other.OtherQuery query
+	OtherQuery(ctx context.Context, workflowID string, runID string) (*OtherQueryResponse, error)
+	// OtherSignal sends a(n) mycompany.simple.Other.OtherSignal signal
+	OtherSignal(ctx context.Context, workflowID string, runID string, signal *OtherSignalRequest) error
+	// OtherUpdate executes a(n) mycompany.simple.Other.OtherUpdate update and blocks until update completion
+	OtherUpdate(ctx context.Context, workflowID string, runID string, req *OtherUpdateRequest, opts ...*OtherUpdateOptions) (*OtherUpdateResponse, error)
+	// OtherUpdate executes a(n) mycompany.simple.Other.OtherUpdate update and blocks until update completion
+	OtherUpdateAsync(ctx context.Context, workflowID string, runID string, req *OtherUpdateRequest, opts ...*OtherUpdateOptions) (OtherUpdateHandle, error)
+}
+
+// otherClient implements a temporal client for a mycompany.simple.Other service
+type otherClient struct {
+	client       client.Client
+	interceptors otherClientInterceptors
+}
+
+// NewOtherClient initializes a new mycompany.simple.Other client that invokes the hooks of the given interceptors
+func NewOtherClient(c client.Client, interceptors ...OtherClientInterceptor) OtherClient {
+	return &otherClient{client: c, interceptors: interceptors}
+}
+
+// NewOtherClientWithOptions initializes a new Other client with the given options that invokes the hooks of the given interceptors
+func NewOtherClientWithOptions(c client.Client, opts client.Options, interceptors ...OtherClientInterceptor) (OtherClient, error) {
+	var err error
+	c, err = client.NewClientFromExisting(c, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing client with options: %w", err)
+	}
+	return &otherClient{client: c, interceptors: interceptors}, nil
+}
+
+// OtherClientInterceptor describes typed hooks invoked around mycompany.simple.Other workflows, queries, signals,
+// and updates sent by a client, which can be installed via the NewOtherClient functions
+type OtherClientInterceptor interface {
+	// BeforeOtherWorkflow is invoked with the input of a(n) mycompany.simple.Other.OtherWorkflow workflow before it is started
+	BeforeOtherWorkflow(ctx context.Context, req *OtherWorkflowRequest)
+	// AfterOtherWorkflow is invoked with the result of a(n) mycompany.simple.Other.OtherWorkflow workflow when it is retrieved
+	AfterOtherWorkflow(ctx context.Context, resp *OtherWorkflowResponse, err error)
+	// BeforeOtherQuery is invoked with the input of a(n) mycompany.simple.Other.OtherQuery query before it is sent
+	BeforeOtherQuery(ctx context.Context)
+	// AfterOtherQuery is invoked with the result of a(n) mycompany.simple.Other.OtherQuery query after it is received
+	AfterOtherQuery(ctx context.Context, resp *OtherQueryResponse, err error)
+	// OnOtherSignal is invoked with the input of a(n) mycompany.simple.Other.OtherSignal signal before it is sent
+	OnOtherSignal(ctx context.Context, req *OtherSignalRequest)
+	// BeforeOtherUpdate is invoked with the input of a(n) mycompany.simple.Other.OtherUpdate update before it is sent
+	BeforeOtherUpdate(ctx context.Context, req *OtherUpdateRequest)
+	// AfterOtherUpdate is invoked with the result of a(n) mycompany.simple.Other.OtherUpdate update when it is retrieved
+	AfterOtherUpdate(ctx context.Context, resp *OtherUpdateResponse, err error)
+}
+
+// NoopOtherClientInterceptor provides a no-op OtherClientInterceptor implementation that can be embedded
+// to implement a subset of hooks
+type NoopOtherClientInterceptor struct{}
+
+// BeforeOtherWorkflow implements OtherClientInterceptor
+func (NoopOtherClientInterceptor) BeforeOtherWorkflow(context.Context, *OtherWorkflowRequest) {}
+
+// AfterOtherWorkflow implements OtherClientInterceptor
+func (NoopOtherClientInterceptor) AfterOtherWorkflow(context.Context, *OtherWorkflowResponse, error) {
+}
+
+// BeforeOtherQuery implements OtherClientInterceptor
+func (NoopOtherClientInterceptor) BeforeOtherQuery(context.Context) {}
+
+// AfterOtherQuery implements OtherClientInterceptor
+func (NoopOtherClientInterceptor) AfterOtherQuery(context.Context, *OtherQueryResponse, error) {}
+
+// OnOtherSignal implements OtherClientInterceptor
+func (NoopOtherClientInterceptor) OnOtherSignal(context.Context, *OtherSignalRequest) {}
+
+// BeforeOtherUpdate implements OtherClientInterceptor
+func (NoopOtherClientInterceptor) BeforeOtherUpdate(context.Context, *OtherUpdateRequest) {}
+
+// AfterOtherUpdate implements OtherClientInterceptor
+func (NoopOtherClientInterceptor) AfterOtherUpdate(context.Context, *OtherUpdateResponse, error) {}
+
+// otherClientInterceptors invokes the hooks of multiple OtherClientInterceptor values, in order for
+// Before<Method> and On<Method> hooks and in reverse order for After<Method> hooks
+type otherClientInterceptors []OtherClientInterceptor
+
+// BeforeOtherWorkflow implements OtherClientInterceptor
+func (c otherClientInterceptors) BeforeOtherWorkflow(ctx context.Context, req *OtherWorkflowRequest) {
+	for _, interceptor := range c {
+		interceptor.BeforeOtherWorkflow(ctx, req)
+	}
+}
+
+// AfterOtherWorkflow implements OtherClientInterceptor
+func (c otherClientInterceptors) AfterOtherWorkflow(ctx context.Context, resp *OtherWorkflowResponse, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterOtherWorkflow(ctx, resp, err)
+	}
+}
+
+// BeforeOtherQuery implements OtherClientInterceptor
+func (c otherClientInterceptors) BeforeOtherQuery(ctx context.Context) {
+	for _, interceptor := range c {
+		interceptor.BeforeOtherQuery(ctx)
+	}
+}
+
+// AfterOtherQuery implements OtherClientInterceptor
+func (c otherClientInterceptors) AfterOtherQuery(ctx context.Context, resp *OtherQueryResponse, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterOtherQuery(ctx, resp, err)
+	}
+}
+
+// OnOtherSignal implements OtherClientInterceptor
+func (c otherClientInterceptors) OnOtherSignal(ctx context.Context, req *OtherSignalRequest) {
+	for _, interceptor := range c {
+		interceptor.OnOtherSignal(ctx, req)
+	}
+}
+
+// BeforeOtherUpdate implements OtherClientInterceptor
+func (c otherClientInterceptors) BeforeOtherUpdate(ctx context.Context, req *OtherUpdateRequest) {
+	for _, interceptor := range c {
+		interceptor.BeforeOtherUpdate(ctx, req)
+	}
+}
+
+// AfterOtherUpdate implements OtherClientInterceptor
+func (c otherClientInterceptors) AfterOtherUpdate(ctx context.Context, resp *OtherUpdateResponse, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterOtherUpdate(ctx, resp, err)
+	}
+}
+
+// OtherWorkflow executes a mycompany.simple.Other.OtherWorkflow workflow and blocks until error or response received
+func (c *otherClient) OtherWorkflow(ctx context.Context, req *OtherWorkflowRequest, options ...*OtherWorkflowOptions) (*OtherWorkflowResponse, error) {
+	run, err := c.OtherWorkflowAsync(ctx, req, options...)
+	if err != nil {
+		return nil, err
+	}
+	return run.Get(ctx)
+}
+
+// OtherWorkflowAsync starts a(n) mycompany.simple.Other.OtherWorkflow workflow
+func (c *otherClient) OtherWorkflowAsync(ctx context.Context, req *OtherWorkflowRequest, options ...*OtherWorkflowOptions) (OtherWorkflowRun, error) {
+	var o *OtherWorkflowOptions
+	if len(options) > 0 {
+		o = options[0]
+	}
+	opts, err := o.Build(req)
+	if err != nil {
+		return nil, err
+	}
+	c.interceptors.BeforeOtherWorkflow(ctx, req)
+	run, err := c.client.ExecuteWorkflow(ctx, *opts, OtherWorkflowWorkflowName, req)
+	if err != nil {
+		c.interceptors.AfterOtherWorkflow(ctx, nil, err)
+		return nil, err
+	}
+	if run == nil {
+		return nil, errors.New("execute workflow returned nil run")
+	}
+	return &otherWorkflowRun{
+		client: c,
+		run:    run,
+	}, nil
+}
+
+// GetOtherWorkflow fetches an existing mycompany.simple.Other.OtherWorkflow execution
+func (c *otherClient) GetOtherWorkflow(ctx context.Context, workflowID string, runID string) OtherWorkflowRun {
+	return &otherWorkflowRun{
+		client: c,
+		run:    c.client.GetWorkflow(ctx, workflowID, runID),
+	}
+}
+
+// CreateOtherWorkflowSchedule creates a Temporal Schedule that periodically starts a(n) mycompany.simple.Other.OtherWorkflow workflow
+func (c *otherClient) CreateOtherWorkflowSchedule(ctx context.Context, id string, spec client.ScheduleSpec, req *OtherWorkflowRequest, options ...*OtherWorkflowScheduleOptions) (client.ScheduleHandle, error) {
+	var o *OtherWorkflowScheduleOptions
+	if len(options) > 0 {
+		o = options[0]
+	}
+	opts, err := o.Build(id, spec, req)
+	if err != nil {
+		return nil, err
+	}
+	return c.client.ScheduleClient().Create(ctx, *opts)
+}
+
+// GetOtherWorkflowSchedule describes an existing mycompany.simple.Other.OtherWorkflow workflow schedule
+func (c *otherClient) GetOtherWorkflowSchedule(ctx context.Context, id string) (*client.ScheduleDescription, error) {
+	desc, err := c.client.ScheduleClient().GetHandle(ctx, id).Describe(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if action, ok := desc.Schedule.Action.(*client.ScheduleWorkflowAction); !ok || action.Workflow != OtherWorkflowWorkflowName {
+		return nil, fmt.Errorf("schedule %q does not start a(n) %s workflow", id, OtherWorkflowWorkflowName)
+	}
+	return desc, nil
+}
+
+// PauseOtherWorkflowSchedule pauses an existing mycompany.simple.Other.OtherWorkflow workflow schedule with an optional note, failing if the schedule starts a different workflow
+func (c *otherClient) PauseOtherWorkflowSchedule(ctx context.Context, id string, note string) error {
+	if _, err := c.GetOtherWorkflowSchedule(ctx, id); err != nil {
+		return err
+	}
+	return c.client.ScheduleClient().GetHandle(ctx, id).Pause(ctx, client.SchedulePauseOptions{Note: note})
+}
+
+// UnpauseOtherWorkflowSchedule unpauses an existing mycompany.simple.Other.OtherWorkflow workflow schedule with an optional note, failing if the schedule starts a different workflow
+func (c *otherClient) UnpauseOtherWorkflowSchedule(ctx context.Context, id string, note string) error {
+	if _, err := c.GetOtherWorkflowSchedule(ctx, id); err != nil {
+		return err
+	}
+	return c.client.ScheduleClient().GetHandle(ctx, id).Unpause(ctx, client.ScheduleUnpauseOptions{Note: note})
+}
+
+// TriggerOtherWorkflowSchedule immediately starts a(n) mycompany.simple.Other.OtherWorkflow workflow using an existing schedule, failing if the schedule starts a different workflow
+func (c *otherClient) TriggerOtherWorkflowSchedule(ctx context.Context, id string) error {
+	if _, err := c.GetOtherWorkflowSchedule(ctx, id); err != nil {
+		return err
+	}
+	return c.client.ScheduleClient().GetHandle(ctx, id).Trigger(ctx, client.ScheduleTriggerOptions{})
+}
+
+// DeleteOtherWorkflowSchedule deletes an existing mycompany.simple.Other.OtherWorkflow workflow schedule, failing if the schedule starts a different workflow
+func (c *otherClient) DeleteOtherWorkflowSchedule(ctx context.Context, id string) error {
+	if _, err := c.GetOtherWorkflowSchedule(ctx, id); err != nil {
+		return err
+	}
+	return c.client.ScheduleClient().GetHandle(ctx, id).Delete(ctx)
+}
+
+// OtherQuery sends a(n) mycompany.simple.Other.OtherQuery query to an existing workflow
+func (c *otherClient) OtherQuery(ctx context.Context, workflowID string, runID string) (*OtherQueryResponse, error) {
+	c.interceptors.BeforeOtherQuery(ctx)
+	var resp OtherQueryResponse
+	if val, err := c.client.QueryWorkflow(ctx, workflowID, runID, OtherQueryQueryName); err != nil {
+		c.interceptors.AfterOtherQuery(ctx, nil, err)
+		return nil, err
+	} else if err = val.Get(&resp); err != nil {
+		c.interceptors.AfterOtherQuery(ctx, nil, err)
+		return nil, err
+	}
+	c.interceptors.AfterOtherQuery(ctx, &resp, nil)
+	return &resp, nil
+}
+
+// OtherSignal sends a(n) mycompany.simple.Other.OtherSignal signal to an existing workflow
+func (c *otherClient) OtherSignal(ctx context.Context, workflowID string, runID string, signal *OtherSignalRequest) error {
+	c.interceptors.OnOtherSignal(ctx, signal)
+	return c.client.SignalWorkflow(ctx, workflowID, runID, OtherSignalSignalName, signal)
+}
+
+// OtherUpdate sends a(n) mycompany.simple.Other.OtherUpdate update to an existing workflow
+func (c *otherClient) OtherUpdate(ctx context.Context, workflowID string, runID string, req *OtherUpdateRequest, opts ...*OtherUpdateOptions) (*OtherUpdateResponse, error) {
+	options := NewOtherUpdateOptions()
+	if len(opts) > 0 && opts[0].opts != nil {
+		options = opts[0]
+	}
+	options.opts.WaitPolicy = &v1.WaitPolicy{LifecycleStage: v11.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED}
+	handle, err := c.OtherUpdateAsync(ctx, workflowID, runID, req, options)
+	if err != nil {
+		return nil, err
+	}
+	return handle.Get(ctx)
+}
+
+// OtherUpdateAsync sends a(n) mycompany.simple.Other.OtherUpdate update to an existing workflow
+func (c *otherClient) OtherUpdateAsync(ctx context.Context, workflowID string, runID string, req *OtherUpdateRequest, opts ...*OtherUpdateOptions) (OtherUpdateHandle, error) {
+	var o *OtherUpdateOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	options, err := o.Build(workflowID, runID, req)
+	if err != nil {
+		return nil, err
+	}
+	c.interceptors.BeforeOtherUpdate(ctx, req)
+	handle, err := c.client.UpdateWorkflowWithOptions(ctx, options)
+	if err != nil {
+		c.interceptors.AfterOtherUpdate(ctx, nil, err)
+		return nil, err
+	}
+	return &otherUpdateHandle{client: c, handle: handle}, nil
+}
+
+// OtherWorkflowOptions provides configuration for a mycompany.simple.Other.OtherWorkflow workflow operation
+type OtherWorkflowOptions struct {
+	opts         *client.StartWorkflowOptions
+	cronSchedule *string
+	memo         map[string]any
+}
+
+// NewOtherWorkflowOptions initializes a new OtherWorkflowOptions value
+func NewOtherWorkflowOptions() *OtherWorkflowOptions {
+	return &OtherWorkflowOptions{}
+}
+
+// WithStartWorkflowOptions sets the initial client.StartWorkflowOptions
+func (opts *OtherWorkflowOptions) WithStartWorkflowOptions(options client.StartWorkflowOptions) *OtherWorkflowOptions {
+	opts.opts = &options
+	return opts
+}
+
+// WithCronSchedule overrides the default cron schedule, an empty schedule starts a non-cron workflow
+func (opts *OtherWorkflowOptions) WithCronSchedule(schedule string) *OtherWorkflowOptions {
+	opts.cronSchedule = &schedule
+	return opts
+}
+
+// WithMemo overrides the default workflow memo
+func (opts *OtherWorkflowOptions) WithMemo(memo map[string]any) *OtherWorkflowOptions {
+	opts.memo = memo
+	return opts
+}
+
+// Build initializes a client.StartWorkflowOptions with defaults for a(n) mycompany.simple.Other.OtherWorkflow workflow operation
+func (o *OtherWorkflowOptions) Build(req *OtherWorkflowRequest) (*client.StartWorkflowOptions, error) {
+	opts := &client.StartWorkflowOptions{}
+	if o != nil && o.opts != nil {
+		opts = o.opts
+	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = OtherTaskQueue
+	}
+	if o != nil && o.cronSchedule != nil {
+		opts.CronSchedule = *o.cronSchedule
+	}
+	if opts.ID == "" {
+		id, err := expression.EvalExpression(OtherWorkflowIDExpression, req.ProtoReflect())
+		if err != nil {
+			return nil, err
+		}
+		opts.ID = id
+	}
+	if o != nil && o.memo != nil {
+		opts.Memo = o.memo
+	}
+	return opts, nil
+}
+
+// OtherWorkflowScheduleOptions provides configuration for a mycompany.simple.Other.OtherWorkflow workflow schedule
+type OtherWorkflowScheduleOptions struct {
+	opts         *client.ScheduleOptions
+	workflowOpts *OtherWorkflowOptions
+}
+
+// NewOtherWorkflowScheduleOptions initializes a new OtherWorkflowScheduleOptions value
+func NewOtherWorkflowScheduleOptions() *OtherWorkflowScheduleOptions {
+	return &OtherWorkflowScheduleOptions{}
+}
+
+// WithScheduleOptions sets the initial client.ScheduleOptions
+func (opts *OtherWorkflowScheduleOptions) WithScheduleOptions(options client.ScheduleOptions) *OtherWorkflowScheduleOptions {
+	opts.opts = &options
+	return opts
+}
+
+// WithWorkflowOptions sets the options used to initialize the scheduled workflow action
+func (opts *OtherWorkflowScheduleOptions) WithWorkflowOptions(options *OtherWorkflowOptions) *OtherWorkflowScheduleOptions {
+	opts.workflowOpts = options
+	return opts
+}
+
+// Build initializes a client.ScheduleOptions with defaults for a(n) mycompany.simple.Other.OtherWorkflow workflow schedule
+func (o *OtherWorkflowScheduleOptions) Build(id string, spec client.ScheduleSpec, req *OtherWorkflowRequest) (*client.ScheduleOptions, error) {
+	opts := &client.ScheduleOptions{}
+	if o != nil && o.opts != nil {
+		v := *o.opts
+		opts = &v
+	}
+	opts.ID = id
+	opts.Spec = spec
+	var workflowOpts *OtherWorkflowOptions
+	if o != nil {
+		workflowOpts = o.workflowOpts
+	}
+	wopts, err := workflowOpts.Build(req)
+	if err != nil {
+		return nil, err
+	}
+	opts.Action = &client.ScheduleWorkflowAction{
+		ID:                       wopts.ID,
+		Workflow:                 OtherWorkflowWorkflowName,
+		Args:                     []any{req},
+		TaskQueue:                wopts.TaskQueue,
+		WorkflowExecutionTimeout: wopts.WorkflowExecutionTimeout,
+		WorkflowRunTimeout:       wopts.WorkflowRunTimeout,
+		WorkflowTaskTimeout:      wopts.WorkflowTaskTimeout,
+		RetryPolicy:              wopts.RetryPolicy,
+		Memo:                     wopts.Memo,
+		SearchAttributes:         wopts.SearchAttributes,
+	}
+	return opts, nil
+}
+
+// OtherWorkflowRun describes a(n) mycompany.simple.Other.OtherWorkflow workflow run
+type OtherWorkflowRun interface {
+	// ID returns the workflow ID
+	ID() string
+	// RunID returns the workflow instance ID
+	RunID() string
+	// Get blocks until the workflow is complete and returns the result
+	Get(ctx context.Context) (*OtherWorkflowResponse, error)
+}
+
+// otherWorkflowRun provides an internal implementation of a(n) OtherWorkflowRunRun
+type otherWorkflowRun struct {
+	client *otherClient
+	run    client.WorkflowRun
+}
+
+// ID returns the workflow ID
+func (r *otherWorkflowRun) ID() string {
+	return r.run.GetID()
+}
+
+// RunID returns the execution ID
+func (r *otherWorkflowRun) RunID() string {
+	return r.run.GetRunID()
+}
+
+// Get blocks until the workflow is complete, returning the result if applicable
+func (r *otherWorkflowRun) Get(ctx context.Context) (*OtherWorkflowResponse, error) {
+	var resp OtherWorkflowResponse
+	if err := r.run.Get(ctx, &resp); err != nil {
+		r.client.interceptors.AfterOtherWorkflow(ctx, nil, err)
+		return nil, err
+	}
+	r.client.interceptors.AfterOtherWorkflow(ctx, &resp, nil)
+	return &resp, nil
+}
+
+// OtherUpdateHandle describes a(n) mycompany.simple.Other.OtherUpdate update handle
+type OtherUpdateHandle interface {
+	// WorkflowID returns the workflow ID
+	WorkflowID() string
+	// RunID returns the workflow instance ID
+	RunID() string
+	// UpdateID returns the update ID
+	UpdateID() string
+	// Get blocks until the workflow is complete and returns the result
+	Get(ctx context.Context) (*OtherUpdateResponse, error)
+}
+
+// otherUpdateHandle provides an internal implementation of a(n) OtherUpdateHandle
+type otherUpdateHandle struct {
+	client *otherClient
+	handle client.WorkflowUpdateHandle
+}
+
+// WorkflowID returns the workflow ID
+func (h *otherUpdateHandle) WorkflowID() string {
+	return h.handle.WorkflowID()
+}
+
+// RunID returns the execution ID
+func (h *otherUpdateHandle) RunID() string {
+	return h.handle.RunID()
+}
+
+// UpdateID returns the update ID
+func (h *otherUpdateHandle) UpdateID() string {
+	return h.handle.UpdateID()
+}
+
+// Get blocks until the update wait policy is met, returning the result if applicable
+func (h *otherUpdateHandle) Get(ctx context.Context) (*OtherUpdateResponse, error) {
+	var resp OtherUpdateResponse
+	if err := h.handle.Get(ctx, &resp); err != nil {
+		h.client.interceptors.AfterOtherUpdate(ctx, nil, err)
+		return nil, err
+	}
+	h.client.interceptors.AfterOtherUpdate(ctx, &resp, nil)
+	return &resp, nil
+}
+
+// OtherUpdateOptions provides configuration for a mycompany.simple.Other.OtherUpdate update operation
+type OtherUpdateOptions struct {
+	opts *client.UpdateWorkflowWithOptionsRequest
+}
+
+// NewOtherUpdateOptions initializes a new OtherUpdateOptions value
+func NewOtherUpdateOptions() *OtherUpdateOptions {
+	return &OtherUpdateOptions{opts: &client.UpdateWorkflowWithOptionsRequest{}}
+}
+
+// WithUpdateWorkflowOptions sets the initial client.UpdateWorkflowWithOptionsRequest
+func (opts *OtherUpdateOptions) WithUpdateWorkflowOptions(options client.UpdateWorkflowWithOptionsRequest) *OtherUpdateOptions {
+	opts.opts = &options
+	return opts
+}
+
+// Build initializes a client.UpdateWorkflowWithOptionsRequest with defaults for a(n) mycompany.simple.Other.OtherUpdate update operation
+func (o *OtherUpdateOptions) Build(workflowID string, runID string, req *OtherUpdateRequest) (*client.UpdateWorkflowWithOptionsRequest, error) {
+	options := &client.UpdateWorkflowWithOptionsRequest{}
+	if o != nil && o.opts != nil {
+		options = o.opts
+	}
+	options.Args = []any{req}
+	options.RunID = runID
+	options.UpdateName = OtherUpdateUpdateName
+	options.WorkflowID = workflowID
+	if options.UpdateID == "" {
+		id, err := expression.EvalExpression(OtherUpdateIDExpression, req.ProtoReflect())
+		if err != nil {
+			return nil, fmt.Errorf("error evaluating %s id expression: %w", OtherUpdateUpdateName, err)
+		}
+		options.UpdateID = id
+	}
+	return options, nil
+}
+
+// Reference to generated workflow functions
+var (
+	// OtherWorkflowFunction implements a "OtherWorkflowWorkflow" workflow
+	OtherWorkflowFunction func(workflow.Context, *OtherWorkflowRequest) (*OtherWorkflowResponse, error)
+)
+
+// OtherWorkflows provides methods for initializing new mycompany.simple.Other workflow values
+type OtherWorkflows interface {
+	OtherWorkflow(ctx workflow.Context, input *OtherWorkflowInput) (OtherWorkflowWorkflow, error)
+}
+
+// OtherWorkflow initializes a new a(n) OtherWorkflowWorkflow implementation
+// RegisterOtherWorkflows registers mycompany.simple.Other workflows with the given worker, invoking the hooks
+// of the given interceptors
+func RegisterOtherWorkflows(r worker.WorkflowRegistry, workflows OtherWorkflows, interceptors ...OtherInterceptor) {
+	RegisterOtherWorkflowWorkflow(r, workflows.OtherWorkflow, interceptors...)
+}
+
+// OtherInterceptor describes typed hooks invoked around mycompany.simple.Other workflows, queries, signals, updates,
+// and activities executed by a worker, which can be installed via the RegisterOtherWorkflows,
+// Register<Workflow>Workflow, RegisterOtherActivities, and Register<Activity>Activity functions.
+// Queries, signals, and updates defined by other services are not intercepted, and workflow,
+// signal, and update hooks are not invoked while a workflow is replaying history.
+type OtherInterceptor interface {
+	// BeforeOtherWorkflow is invoked with the input of a(n) mycompany.simple.Other.OtherWorkflow workflow before it executes
+	BeforeOtherWorkflow(ctx workflow.Context, req *OtherWorkflowRequest)
+	// AfterOtherWorkflow is invoked with the result of a(n) mycompany.simple.Other.OtherWorkflow workflow after it executes
+	AfterOtherWorkflow(ctx workflow.Context, resp *OtherWorkflowResponse, err error)
+	// BeforeOtherWorkflowActivity is invoked with the input of a(n) mycompany.simple.Other.OtherWorkflow activity before it executes
+	BeforeOtherWorkflowActivity(ctx context.Context, req *OtherWorkflowRequest)
+	// AfterOtherWorkflowActivity is invoked with the result of a(n) mycompany.simple.Other.OtherWorkflow activity after it executes
+	AfterOtherWorkflowActivity(ctx context.Context, resp *OtherWorkflowResponse, err error)
+}
+
+// NoopOtherInterceptor provides a no-op OtherInterceptor implementation that can be embedded
+// to implement a subset of hooks
+type NoopOtherInterceptor struct{}
+
+// BeforeOtherWorkflow implements OtherInterceptor
+func (NoopOtherInterceptor) BeforeOtherWorkflow(workflow.Context, *OtherWorkflowRequest) {}
+
+// AfterOtherWorkflow implements OtherInterceptor
+func (NoopOtherInterceptor) AfterOtherWorkflow(workflow.Context, *OtherWorkflowResponse, error) {}
+
+// BeforeOtherWorkflowActivity implements OtherInterceptor
+func (NoopOtherInterceptor) BeforeOtherWorkflowActivity(context.Context, *OtherWorkflowRequest) {}
+
+// AfterOtherWorkflowActivity implements OtherInterceptor
+func (NoopOtherInterceptor) AfterOtherWorkflowActivity(context.Context, *OtherWorkflowResponse, error) {
+}
+
+// otherInterceptors invokes the hooks of multiple OtherInterceptor values, in order for
+// Before<Method> and On<Method> hooks and in reverse order for After<Method> hooks
+type otherInterceptors []OtherInterceptor
+
+// BeforeOtherWorkflow implements OtherInterceptor
+func (c otherInterceptors) BeforeOtherWorkflow(ctx workflow.Context, req *OtherWorkflowRequest) {
+	for _, interceptor := range c {
+		interceptor.BeforeOtherWorkflow(ctx, req)
+	}
+}
+
+// AfterOtherWorkflow implements OtherInterceptor
+func (c otherInterceptors) AfterOtherWorkflow(ctx workflow.Context, resp *OtherWorkflowResponse, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterOtherWorkflow(ctx, resp, err)
+	}
+}
+
+// BeforeOtherWorkflowActivity implements OtherInterceptor
+func (c otherInterceptors) BeforeOtherWorkflowActivity(ctx context.Context, req *OtherWorkflowRequest) {
+	for _, interceptor := range c {
+		interceptor.BeforeOtherWorkflowActivity(ctx, req)
+	}
+}
+
+// AfterOtherWorkflowActivity implements OtherInterceptor
+func (c otherInterceptors) AfterOtherWorkflowActivity(ctx context.Context, resp *OtherWorkflowResponse, err error) {
+	for i := len(c) - 1; i >= 0; i-- {
+		c[i].AfterOtherWorkflowActivity(ctx, resp, err)
+	}
+}
+
+// RegisterOtherWorkflowWorkflow registers a mycompany.simple.Other.OtherWorkflow workflow with the given worker, invoking the hooks
+// of the given interceptors
+func RegisterOtherWorkflowWorkflow(r worker.WorkflowRegistry, wf func(workflow.Context, *OtherWorkflowInput) (OtherWorkflowWorkflow, error), interceptors ...OtherInterceptor) {
+	OtherWorkflowFunction = buildOtherWorkflow(wf, interceptors...)
+	r.RegisterWorkflowWithOptions(OtherWorkflowFunction, workflow.RegisterOptions{Name: OtherWorkflowWorkflowName})
+}
+
+// buildOtherWorkflow converts a OtherWorkflow workflow struct into a valid workflow function that invokes
+// the hooks of the given interceptors
+func buildOtherWorkflow(ctor func(workflow.Context, *OtherWorkflowInput) (OtherWorkflowWorkflow, error), interceptors ...OtherInterceptor) func(workflow.Context, *OtherWorkflowRequest) (*OtherWorkflowResponse, error) {
+	interceptor := otherInterceptors(interceptors)
+	return func(ctx workflow.Context, req *OtherWorkflowRequest) (resp *OtherWorkflowResponse, err error) {
+		if !workflow.IsReplaying(ctx) {
+			interceptor.BeforeOtherWorkflow(ctx, req)
+		}
+		defer func() {
+			if !workflow.IsReplaying(ctx) {
+				interceptor.AfterOtherWorkflow(ctx, resp, err)
+			}
+		}()
+		input := &OtherWorkflowInput{
+			Req: req,
+		}
+		wf, err := ctor(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		return wf.Execute(ctx)
+	}
+}
+
+// OtherWorkflowInput describes the input to a(n) mycompany.simple.Other.OtherWorkflow workflow constructor
+type OtherWorkflowInput struct {
+	Req *OtherWorkflowRequest
+}
+
+// OtherWorkflowWorkflow describes a(n) mycompany.simple.Other.OtherWorkflow workflow implementation
+type OtherWorkflowWorkflow interface {
+	// Execute defines the entrypoint to a(n) mycompany.simple.Other.OtherWorkflow workflow
+	Execute(ctx workflow.Context) (*OtherWorkflowResponse, error)
+}
+
+// OtherWorkflowChild executes a child mycompany.simple.Other.OtherWorkflow workflow
+func OtherWorkflowChild(ctx workflow.Context, req *OtherWorkflowRequest, options ...*OtherWorkflowChildOptions) (*OtherWorkflowResponse, error) {
+	childRun, err := OtherWorkflowChildAsync(ctx, req, options...)
+	if err != nil {
+		return nil, err
+	}
+	return childRun.Get(ctx)
+}
+
+// OtherWorkflowChildAsync executes a child mycompany.simple.Other.OtherWorkflow workflow
+func OtherWorkflowChildAsync(ctx workflow.Context, req *OtherWorkflowRequest, options ...*OtherWorkflowChildOptions) (*OtherWorkflowChildRun, error) {
+	var opts *workflow.ChildWorkflowOptions
+	if len(options) > 0 && options[0].opts != nil {
+		opts = options[0].opts
+	} else {
+		childOpts := workflow.GetChildWorkflowOptions(ctx)
+		opts = &childOpts
+	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = OtherTaskQueue
+	}
+	if opts.WorkflowID == "" {
+		id, err := expression.EvalExpression(OtherWorkflowIDExpression, req.ProtoReflect())
+		if err != nil {
+			panic(err)
+		}
+		opts.WorkflowID = id
+	}
+	ctx = workflow.WithChildOptions(ctx, *opts)
+	return &OtherWorkflowChildRun{Future: workflow.ExecuteChildWorkflow(ctx, OtherWorkflowWorkflowName, req)}, nil
+}
+
+// OtherWorkflowChildOptions provides configuration for a mycompany.simple.Other.OtherWorkflow workflow operation
+type OtherWorkflowChildOptions struct {
+	opts *workflow.ChildWorkflowOptions
+}
+
+// NewOtherWorkflowChildOptions initializes a new OtherWorkflowChildOptions value
+func NewOtherWorkflowChildOptions() *OtherWorkflowChildOptions {
+	return &OtherWorkflowChildOptions{}
+}
+
+// WithStartWorkflowOptions sets the initial client.StartWorkflowOptions
+func (opts *OtherWorkflowChildOptions) WithStartWorkflowOptions(options workflow.ChildWorkflowOptions) *OtherWorkflowChildOptions {
+	opts.opts = &options
+	return opts
+}
+
+// OtherWorkflowChildRun describes a child mycompany.simple.Other.OtherWorkflow workflow run
+type OtherWorkflowChildRun struct {
+	Future workflow.ChildWorkflowFuture
+}
+
+// Get blocks until the workflow is completed, returning the response value
+func (r *OtherWorkflowChildRun) Get(ctx workflow.Context) (*OtherWorkflowResponse, error) {
+	var resp OtherWorkflowResponse
+	if err := r.Future.Get(ctx, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Select adds this completion to the selector. Callback can be nil.
+func (r *OtherWorkflowChildRun) Select(sel workflow.Selector, fn func(OtherWorkflowChildRun)) workflow.Selector {
+	return sel.AddFuture(r.Future, func(workflow.Future) {
+		if fn != nil {
+			fn(*r)
+		}
+	})
+}
+
+// SelectStart adds waiting for start to the selector. Callback can be nil.
+func (r *OtherWorkflowChildRun) SelectStart(sel workflow.Selector, fn func(OtherWorkflowChildRun)) workflow.Selector {
+	return sel.AddFuture(r.Future.GetChildWorkflowExecution(), func(workflow.Future) {
+		if fn != nil {
+			fn(*r)
+		}
+	})
+}
+
+// WaitStart waits for the child workflow to start
+func (r *OtherWorkflowChildRun) WaitStart(ctx workflow.Context) (*workflow.Execution, error) {
+	var exec workflow.Execution
+	if err := r.Future.GetChildWorkflowExecution().Get(ctx, &exec); err != nil {
+		return nil, err
+	}
+	return &exec, nil
+}
+
+// OtherSignalSignal describes a(n) mycompany.simple.Other.OtherSignal signal
+type OtherSignalSignal struct {
+	Channel workflow.ReceiveChannel
+}
+
+// Receive blocks until a(n) mycompany.simple.Other.OtherSignal signal is received
+func (s *OtherSignalSignal) Receive(ctx workflow.Context) (*OtherSignalRequest, bool) {
+	var resp OtherSignalRequest
+	more := s.Channel.Receive(ctx, &resp)
+	return &resp, more
+}
+
+// ReceiveAsync checks for a mycompany.simple.Other.OtherSignal signal without blocking
+func (s *OtherSignalSignal) ReceiveAsync() *OtherSignalRequest {
+	var resp OtherSignalRequest
+	if ok := s.Channel.ReceiveAsync(&resp); !ok {
+		return nil
+	}
+	return &resp
+}
+
+// Select checks for a(n) mycompany.simple.Other.OtherSignal signal without blocking
+func (s *OtherSignalSignal) Select(sel workflow.Selector, fn func(*OtherSignalRequest)) workflow.Selector {
+	return sel.AddReceive(s.Channel, func(workflow.ReceiveChannel, bool) {
+		req := s.ReceiveAsync()
+		if fn != nil {
+			fn(req)
+		}
+	})
+}
+
+// OtherSignalExternal sends a(n) mycompany.simple.Other.OtherSignal signal to an existing workflow
+func OtherSignalExternal(ctx workflow.Context, workflowID string, runID string, req *OtherSignalRequest) error {
+	return workflow.SignalExternalWorkflow(ctx, workflowID, runID, OtherSignalSignalName, req).Get(ctx, nil)
+}
+
+// OtherActivities describes available worker activites
+type OtherActivities interface {
+	OtherWorkflow(ctx context.Context, req *OtherWorkflowRequest) (*OtherWorkflowResponse, error)
+}
+
+// RegisterOtherActivities registers activities with a worker, invoking the hooks of the given interceptors
+func RegisterOtherActivities(r worker.ActivityRegistry, activities OtherActivities, interceptors ...OtherInterceptor) {
+	RegisterOtherWorkflowActivity(r, activities.OtherWorkflow, interceptors...)
+}
+
+// RegisterOtherWorkflowActivity registers a mycompany.simple.Other.OtherWorkflow activity, invoking the hooks of the given interceptors
+func RegisterOtherWorkflowActivity(r worker.ActivityRegistry, fn func(context.Context, *OtherWorkflowRequest) (*OtherWorkflowResponse, error), interceptors ...OtherInterceptor) {
+	interceptor := otherInterceptors(interceptors)
+	impl := func(ctx context.Context, req *OtherWorkflowRequest) (*OtherWorkflowResponse, error) {
+		interceptor.BeforeOtherWorkflowActivity(ctx, req)
+		resp, err := fn(ctx, req)
+		interceptor.AfterOtherWorkflowActivity(ctx, resp, err)
+		return resp, err
+	}
+	r.RegisterActivityWithOptions(impl, activity.RegisterOptions{
+		Name: OtherWorkflowActivityName,
+	})
+}
+
+// OtherWorkflowFuture describes a(n) mycompany.simple.Other.OtherWorkflow activity execution
+type OtherWorkflowFuture struct {
+	Future workflow.Future
+}
+
+// Get blocks on the activity's completion, returning the response
+func (f *OtherWorkflowFuture) Get(ctx workflow.Context) (*OtherWorkflowResponse, error) {
+	var resp OtherWorkflowResponse
+	if err := f.Future.Get(ctx, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Select adds the activity's completion to the selector, callback can be nil
+func (f *OtherWorkflowFuture) Select(sel workflow.Selector, fn func(*OtherWorkflowFuture)) workflow.Selector {
+	return sel.AddFuture(f.Future, func(workflow.Future) {
+		if fn != nil {
+			fn(f)
+		}
+	})
+}
+
+// OtherWorkflow executes a(n) mycompany.simple.Other.OtherWorkflow activity
+func OtherWorkflow(ctx workflow.Context, req *OtherWorkflowRequest, options ...*OtherWorkflowActivityOptions) (*OtherWorkflowResponse, error) {
+	var opts *OtherWorkflowActivityOptions
+	if len(options) > 0 && options[0] != nil {
+		opts = options[0]
+	} else {
+		opts = NewOtherWorkflowActivityOptions()
+	}
+	if opts.opts == nil {
+		activityOpts := workflow.GetActivityOptions(ctx)
+		opts.opts = &activityOpts
 	}
-	sort.Slice(commands, func(i, j int) bool {
-		return commands[i].Name < commands[j].Name
-	})
-	return commands, nil
-}
-
-// unmarshalCliFlagsToSomeQuery2Request unmarshals a SomeQuery2Request from command line flags
-func unmarshalCliFlagsToSomeQuery2Request(cmd *v2.Context) (*SomeQuery2Request, error) {
-	var result SomeQuery2Request
-	var hasValues bool
-	if cmd.IsSet("request-val") {
-		hasValues = true
-		result.RequestVal = cmd.String("request-val")
+	if opts.opts.StartToCloseTimeout == 0 {
+		opts.opts.StartToCloseTimeout = 30000000000 // 30s
 	}
-	if !hasValues {
-		return nil, nil
+	activityID := opts.opts.ActivityID
+	if opts.activityID != nil {
+		activityID = *opts.activityID
 	}
-	return &result, nil
+	activityOpts := *opts.opts
+	activityOpts.ActivityID = activityID
+	ctx = workflow.WithActivityOptions(ctx, activityOpts)
+	var activity any
+	activity = OtherWorkflowActivityName
+	future := &OtherWorkflowFuture{Future: workflow.ExecuteActivity(ctx, activity, req)}
+	return future.Get(ctx)
 }
 
-// unmarshalCliFlagsToSomeSignal2Request unmarshals a SomeSignal2Request from command line flags
-func unmarshalCliFlagsToSomeSignal2Request(cmd *v2.Context) (*SomeSignal2Request, error) {
-	var result SomeSignal2Request
-	var hasValues bool
-	if cmd.IsSet("request-val") {
-		hasValues = true
-		result.RequestVal = cmd.String("request-val")
+// OtherWorkflowAsync executes a(n) mycompany.simple.Other.OtherWorkflow activity (asynchronously)
+func OtherWorkflowAsync(ctx workflow.Context, req *OtherWorkflowRequest, options ...*OtherWorkflowActivityOptions) *OtherWorkflowFuture {
+	var opts *OtherWorkflowActivityOptions
+	if len(options) > 0 && options[0] != nil {
+		opts = options[0]
+	} else {
+		opts = NewOtherWorkflowActivityOptions()
 	}
-	if !hasValues {
-		return nil, nil
+	if opts.opts == nil {
+		activityOpts := workflow.GetActivityOptions(ctx)
+		opts.opts = &activityOpts
 	}
-	return &result, nil
-}
-
-// unmarshalCliFlagsToSomeUpdate1Request unmarshals a SomeUpdate1Request from command line flags
-func unmarshalCliFlagsToSomeUpdate1Request(cmd *v2.Context) (*SomeUpdate1Request, error) {
-	var result SomeUpdate1Request
-	var hasValues bool
-	if cmd.IsSet("request-val") {
-		hasValues = true
-		result.RequestVal = cmd.String("request-val")
+	if opts.opts.StartToCloseTimeout == 0 {
+		opts.opts.StartToCloseTimeout = 30000000000 // 30s
 	}
-	if !hasValues {
-		return nil, nil
+	activityID := opts.opts.ActivityID
+	if opts.activityID != nil {
+		activityID = *opts.activityID
 	}
-	return &result, nil
+	activityOpts := *opts.opts
+	activityOpts.ActivityID = activityID
+	ctx = workflow.WithActivityOptions(ctx, activityOpts)
+	var activity any
+	activity = OtherWorkflowActivityName
+	future := &OtherWorkflowFuture{Future: workflow.ExecuteActivity(ctx, activity, req)}
+	return future
 }
 
-// unmarshalCliFlagsToSomeWorkflow1Request unmarshals a SomeWorkflow1Request from command line flags
-func unmarshalCliFlagsToSomeWorkflow1Request(cmd *v2.Context) (*SomeWorkflow1Request, error) {
-	var result SomeWorkflow1Request
-	var hasValues bool
-	if cmd.IsSet("request-val") {
-		hasValues = true
-		result.RequestVal = cmd.String("request-val")
+// OtherWorkflowLocal executes a(n) mycompany.simple.Other.OtherWorkflow activity (locally)
+func OtherWorkflowLocal(ctx workflow.Context, req *OtherWorkflowRequest, options ...*OtherWorkflowLocalActivityOptions) (*OtherWorkflowResponse, error) {
+	var opts *OtherWorkflowLocalActivityOptions
+	if len(options) > 0 && options[0] != nil {
+		opts = options[0]
+	} else {
+		opts = NewOtherWorkflowLocalActivityOptions()
 	}
-	if cmd.IsSet("id") {
-		hasValues = true
-		result.Id = cmd.String("id")
+	if opts.opts == nil {
+		activityOpts := workflow.GetLocalActivityOptions(ctx)
+		opts.opts = &activityOpts
 	}
-	if !hasValues {
-		return nil, nil
+	if opts.opts.StartToCloseTimeout == 0 {
+		opts.opts.StartToCloseTimeout = 30000000000 // 30s
 	}
-	return &result, nil
+	ctx = workflow.WithLocalActivityOptions(ctx, *opts.opts)
+	var activity any
+	if opts.fn != nil {
+		activity = opts.fn
+	} else {
+		activity = OtherWorkflowActivityName
+	}
+	future := &OtherWorkflowFuture{Future: workflow.ExecuteLocalActivity(ctx, activity, req)}
+	return future.Get(ctx)
 }
 
-// unmarshalCliFlagsToSomeWorkflow3Request unmarshals a SomeWorkflow3Request from command line flags
-func unmarshalCliFlagsToSomeWorkflow3Request(cmd *v2.Context) (*SomeWorkflow3Request, error) {
-	var result SomeWorkflow3Request
-	var hasValues bool
-	if cmd.IsSet("id") {
-		hasValues = true
-		result.Id = cmd.String("id")
+// OtherWorkflowLocalAsync executes a(n) mycompany.simple.Other.OtherWorkflow activity (asynchronously, locally)
+func OtherWorkflowLocalAsync(ctx workflow.Context, req *OtherWorkflowRequest, options ...*OtherWorkflowLocalActivityOptions) *OtherWorkflowFuture {
+	var opts *OtherWorkflowLocalActivityOptions
+	if len(options) > 0 && options[0] != nil {
+		opts = options[0]
+	} else {
+		opts = NewOtherWorkflowLocalActivityOptions()
 	}
-	if cmd.IsSet("request-val") {
-		hasValues = true
-		result.RequestVal = cmd.String("request-val")
+	if opts.opts == nil {
+		activityOpts := workflow.GetLocalActivityOptions(ctx)
+		opts.opts = &activityOpts
 	}
-	if !hasValues {
-		return nil, nil
+	if opts.opts.StartToCloseTimeout == 0 {
+		opts.opts.StartToCloseTimeout = 30000000000 // 30s
 	}
-	return &result, nil
+	ctx = workflow.WithLocalActivityOptions(ctx, *opts.opts)
+	var activity any
+	if opts.fn != nil {
+		activity = opts.fn
+	} else {
+		activity = OtherWorkflowActivityName
+	}
+	future := &OtherWorkflowFuture{Future: workflow.ExecuteLocalActivity(ctx, activity, req)}
+	return future
 }
 
-// OtherTaskQueue= is the default task-queue for a mycompany.simple.Other worker
-const OtherTaskQueue = "other-task-queue"
+// OtherWorkflowLocalActivityOptions provides configuration for a local mycompany.simple.Other.OtherWorkflow activity
+type OtherWorkflowLocalActivityOptions struct {
+	fn   func(context.Context, *OtherWorkflowRequest) (*OtherWorkflowResponse, error)
+	opts *workflow.LocalActivityOptions
+}
 
-// mycompany.simple.Other workflow names
-const (
-	OtherWorkflowWorkflowName = "mycompany.simple.Other.OtherWorkflow"
-)
+// NewOtherWorkflowLocalActivityOptions sets default LocalActivityOptions
+func NewOtherWorkflowLocalActivityOptions() *OtherWorkflowLocalActivityOptions {
+	return &OtherWorkflowLocalActivityOptions{}
+}
 
-// mycompany.simple.Other workflow id expressions
-var (
-	OtherWorkflowIDExpression = expression.MustParseExpression("other-workflow/${!uuid_v4()}")
-)
+// Local provides a local mycompany.simple.Other.OtherWorkflow activity implementation
+func (opts *OtherWorkflowLocalActivityOptions) Local(fn func(context.Context, *OtherWorkflowRequest) (*OtherWorkflowResponse, error)) *OtherWorkflowLocalActivityOptions {
+	opts.fn = fn
+	return opts
+}
 
-// mycompany.simple.Other activity names
-const (
-	OtherWorkflowActivityName = "mycompany.simple.Other.OtherWorkflow"
-)
+// WithLocalActivityOptions sets default LocalActivityOptions
+func (opts *OtherWorkflowLocalActivityOptions) WithLocalActivityOptions(options workflow.LocalActivityOptions) *OtherWorkflowLocalActivityOptions {
+	opts.opts = &options
+	return opts
+}
 
-// mycompany.simple.Other query names
-const (
-	OtherQueryQueryName = "mycompany.simple.Other.OtherQuery"
-)
+// OtherWorkflowActivityOptions provides configuration for a(n) mycompany.simple.Other.OtherWorkflow activity
+type OtherWorkflowActivityOptions struct {
+	opts       *workflow.ActivityOptions
+	activityID *string
+}
 
-// mycompany.simple.Other signal names
-const (
-	OtherSignalSignalName = "mycompany.simple.Other.OtherSignal"
-)
+// NewOtherWorkflowActivityOptions sets default ActivityOptions
+func NewOtherWorkflowActivityOptions() *OtherWorkflowActivityOptions {
+	return &OtherWorkflowActivityOptions{}
+}
 
-// mycompany.simple.Other update names
-const (
-	OtherUpdateUpdateName = "mycompany.simple.Other.OtherUpdate"
-)
+// WithActivityOptions sets default ActivityOptions
+func (opts *OtherWorkflowActivityOptions) WithActivityOptions(options workflow.ActivityOptions) *OtherWorkflowActivityOptions {
+	opts.opts = &options
+	return opts
+}
 
-// mycompany.simple.Other update id expressions
-var (
-	OtherUpdateIDExpression = expression.MustParseExpression("other-update/${!uuid_v4()}")
-)
+// WithActivityID overrides the default activity ID
+func (opts *OtherWorkflowActivityOptions) WithActivityID(id string) *OtherWorkflowActivityOptions {
+	opts.activityID = &id
+	return opts
+}
 
-// OtherClient describes a client for a(n) mycompany.simple.Other worker
-type OtherClient interface {
-	// OtherWorkflow executes a(n) mycompany.simple.Other.OtherWorkflow workflow and blocks until error or response received
-	OtherWorkflow(ctx context.Context, req *OtherWorkflowRequest, opts ...*OtherWorkflowOptions) (*OtherWorkflowResponse, error)
-	// OtherWorkflowAsync executes a(n) mycompany.simple.Other.OtherWorkflow workflow asynchronously
-	OtherWorkflowAsync(ctx context.Context, req *OtherWorkflowRequest, opts ...*OtherWorkflowOptions) (OtherWorkflowRun, error)
-	// GetOtherWorkflow retrieves a handle to an existing mycompany.simple.Other.OtherWorkflow workflow execution
-	GetOtherWorkflow(ctx context.Context, workflowID string, runID string) OtherWorkflowRun
-	// OtherQuery executes a(n) mycompany.simple.Other.OtherQuery query
-	OtherQuery(ctx context.Context, workflowID string, runID string) (*OtherQueryResponse, error)
-	// OtherSignal sends a(n) mycompany.simple.Other.OtherSignal signal
-	OtherSignal(ctx context.Context, workflowID string, runID string, signal *OtherSignalRequest) error
-	// OtherUpdate executes a(n) mycompany.simple.Other.OtherUpdate update and blocks until update completion
-	OtherUpdate(ctx context.Context, workflowID string, runID string, req *OtherUpdateRequest, opts ...*OtherUpdateOptions) (*OtherUpdateResponse, error)
-	// OtherUpdate executes a(n) mycompany.simple.Other.OtherUpdate update and blocks until update completion
-	OtherUpdateAsync(ctx context.Context, workflowID string, runID string, req *OtherUpdateRequest, opts ...*OtherUpdateOptions) (OtherUpdateHandle, error)
+// TestClient provides a testsuite-compatible Client
+type TestOtherClient struct {
+	env       *testsuite.TestWorkflowEnvironment
+	workflows OtherWorkflows
+}
+
+var _ OtherClient = &TestOtherClient{}
+
+// NewTestOtherClient initializes a new TestOtherClient value
+// If workflows are provided without activities, placeholder activities are registered that
+// fail unless mocked using the client's On<Activity> methods
+func NewTestOtherClient(env *testsuite.TestWorkflowEnvironment, workflows OtherWorkflows, activities OtherActivities) *TestOtherClient {
+	if workflows != nil {
+		RegisterOtherWorkflows(env, workflows)
+	}
+	if activities != nil {
+		RegisterOtherActivities(env, activities)
+	} else if workflows != nil {
+		RegisterOtherWorkflowActivity(env, func(context.Context, *OtherWorkflowRequest) (*OtherWorkflowResponse, error) {
+			return nil, testutil.NewNotMockedError(testutil.KindActivity, OtherWorkflowActivityName)
+		})
+	}
+	return &TestOtherClient{env, workflows}
+}
+
+// OtherWorkflow executes a(n) OtherWorkflow workflow in the test environment
+func (c *TestOtherClient) OtherWorkflow(ctx context.Context, req *OtherWorkflowRequest, opts ...*OtherWorkflowOptions) (*OtherWorkflowResponse, error) {
+	run, err := c.OtherWorkflowAsync(ctx, req, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return run.Get(ctx)
+}
+
+// OtherWorkflowAsync executes a(n) OtherWorkflow workflow in the test environment
+func (c *TestOtherClient) OtherWorkflowAsync(ctx context.Context, req *OtherWorkflowRequest, options ...*OtherWorkflowOptions) (OtherWorkflowRun, error) {
+	var o *OtherWorkflowOptions
+	if len(options) > 0 {
+		o = options[0]
+	}
+	opts, err := o.Build(req)
+	if err != nil {
+		return nil, err
+	}
+	return &testOtherWorkflowRun{client: c, env: c.env, opts: opts, req: req, workflows: c.workflows}, nil
+}
+
+// GetOtherWorkflow is a noop
+func (c *TestOtherClient) GetOtherWorkflow(ctx context.Context, workflowID string, runID string) OtherWorkflowRun {
+	return &testOtherWorkflowRun{env: c.env, workflows: c.workflows}
+}
+
+// CreateOtherWorkflowSchedule is not supported by the test client
+func (c *TestOtherClient) CreateOtherWorkflowSchedule(ctx context.Context, id string, spec client.ScheduleSpec, req *OtherWorkflowRequest, options ...*OtherWorkflowScheduleOptions) (client.ScheduleHandle, error) {
+	return nil, errors.New("schedules are not supported by the test client")
+}
+
+// GetOtherWorkflowSchedule is not supported by the test client
+func (c *TestOtherClient) GetOtherWorkflowSchedule(ctx context.Context, id string) (*client.ScheduleDescription, error) {
+	return nil, errors.New("schedules are not supported by the test client")
+}
+
+// PauseOtherWorkflowSchedule is not supported by the test client
+func (c *TestOtherClient) PauseOtherWorkflowSchedule(ctx context.Context, id string, note string) error {
+	return errors.New("schedules are not supported by the test client")
+}
+
+// UnpauseOtherWorkflowSchedule is not supported by the test client
+func (c *TestOtherClient) UnpauseOtherWorkflowSchedule(ctx context.Context, id string, note string) error {
+	return errors.New("schedules are not supported by the test client")
 }
 
-// otherClient implements a temporal client for a mycompany.simple.Other service
-type otherClient struct {
-	client client.Client
+// TriggerOtherWorkflowSchedule is not supported by the test client
+func (c *TestOtherClient) TriggerOtherWorkflowSchedule(ctx context.Context, id string) error {
+	return errors.New("schedules are not supported by the test client")
 }
 
-// NewOtherClient initializes a new mycompany.simple.Other client
-func NewOtherClient(c client.Client) OtherClient {
-	return &otherClient{client: c}
+// DeleteOtherWorkflowSchedule is not supported by the test client
+func (c *TestOtherClient) DeleteOtherWorkflowSchedule(ctx context.Context, id string) error {
+	return errors.New("schedules are not supported by the test client")
 }
 
-// NewOtherClientWithOptions initializes a new Other client with the given options
-func NewOtherClientWithOptions(c client.Client, opts client.Options) (OtherClient, error) {
-	var err error
-	c, err = client.NewClientFromExisting(c, opts)
+// OtherQuery executes a OtherQuery query
+func (c *TestOtherClient) OtherQuery(ctx context.Context, workflowID string, runID string) (*OtherQueryResponse, error) {
+	val, err := c.env.QueryWorkflow(OtherQueryQueryName)
 	if err != nil {
-		return nil, fmt.Errorf("error initializing client with options: %w", err)
+		return nil, err
+	} else if !val.HasValue() {
+		return nil, nil
+	} else {
+		var result OtherQueryResponse
+		if err := val.Get(&result); err != nil {
+			return nil, err
+		}
+		return &result, nil
 	}
-	return &otherClient{client: c}, nil
 }
 
-// OtherWorkflow executes a mycompany.simple.Other.OtherWorkflow workflow and blocks until error or response received
-func (c *otherClient) OtherWorkflow(ctx context.Context, req *OtherWorkflowRequest, options ...*OtherWorkflowOptions) (*OtherWorkflowResponse, error) {
-	run, err := c.OtherWorkflowAsync(ctx, req, options...)
+// OtherSignal executes a OtherSignal signal
+func (c *TestOtherClient) OtherSignal(ctx context.Context, workflowID string, runID string, req *OtherSignalRequest) error {
+	c.env.SignalWorkflow(OtherSignalSignalName, req)
+	return nil
+}
+
+// OtherUpdate executes a(n) mycompany.simple.Other.OtherUpdate update in the test environment
+func (c *TestOtherClient) OtherUpdate(ctx context.Context, workflowID string, runID string, req *OtherUpdateRequest, opts ...*OtherUpdateOptions) (*OtherUpdateResponse, error) {
+	handle, err := c.OtherUpdateAsync(ctx, workflowID, runID, req, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return run.Get(ctx)
+	return handle.Get(ctx)
 }
 
-// OtherWorkflowAsync starts a(n) mycompany.simple.Other.OtherWorkflow workflow
-func (c *otherClient) OtherWorkflowAsync(ctx context.Context, req *OtherWorkflowRequest, options ...*OtherWorkflowOptions) (OtherWorkflowRun, error) {
-	opts := &client.StartWorkflowOptions{}
-	if len(options) > 0 && options[0].opts != nil {
-		opts = options[0].opts
-	}
-	if opts.TaskQueue == "" {
-		opts.TaskQueue = OtherTaskQueue
-	}
-	if opts.ID == "" {
-		id, err := expression.EvalExpression(OtherWorkflowIDExpression, req.ProtoReflect())
-		if err != nil {
-			return nil, err
-		}
-		opts.ID = id
+// OtherUpdateAsync executes a(n) mycompany.simple.Other.OtherUpdate update in the test environment
+func (c *TestOtherClient) OtherUpdateAsync(ctx context.Context, workflowID string, runID string, req *OtherUpdateRequest, opts ...*OtherUpdateOptions) (OtherUpdateHandle, error) {
+	var o *OtherUpdateOptions
+	if len(opts) > 0 {
+		o = opts[0]
 	}
-	run, err := c.client.ExecuteWorkflow(ctx, *opts, OtherWorkflowWorkflowName, req)
+	options, err := o.Build(workflowID, runID, req)
 	if err != nil {
 		return nil, err
 	}
-	if run == nil {
-		return nil, errors.New("execute workflow returned nil run")
-	}
-	return &otherWorkflowRun{
-		client: c,
-		run:    run,
+	uc := testutil.NewUpdateCallbacks()
+	c.env.UpdateWorkflow(OtherUpdateUpdateName, uc, req)
+	return &testOtherUpdateHandle{
+		callbacks:  uc,
+		env:        c.env,
+		opts:       options,
+		runID:      runID,
+		workflowID: workflowID,
+		req:        req,
 	}, nil
 }
 
-// GetOtherWorkflow fetches an existing mycompany.simple.Other.OtherWorkflow execution
-func (c *otherClient) GetOtherWorkflow(ctx context.Context, workflowID string, runID string) OtherWorkflowRun {
-	return &otherWorkflowRun{
-		client: c,
-		run:    c.client.GetWorkflow(ctx, workflowID, runID),
-	}
+var _ OtherUpdateHandle = &testOtherUpdateHandle{}
+
+// testOtherUpdateHandle provides an internal implementation of a(n) OtherUpdateHandle
+type testOtherUpdateHandle struct {
+	callbacks  *testutil.UpdateCallbacks
+	env        *testsuite.TestWorkflowEnvironment
+	opts       *client.UpdateWorkflowWithOptionsRequest
+	req        *OtherUpdateRequest
+	runID      string
+	workflowID string
 }
 
-// OtherQuery sends a(n) mycompany.simple.Other.OtherQuery query to an existing workflow
-func (c *otherClient) OtherQuery(ctx context.Context, workflowID string, runID string) (*OtherQueryResponse, error) {
-	var resp OtherQueryResponse
-	if val, err := c.client.QueryWorkflow(ctx, workflowID, runID, OtherQueryQueryName); err != nil {
-		return nil, err
-	} else if err = val.Get(&resp); err != nil {
+// Get retrieves a test mycompany.simple.Other.OtherUpdate update result
+func (h *testOtherUpdateHandle) Get(ctx context.Context) (*OtherUpdateResponse, error) {
+	if resp, err := h.callbacks.Get(ctx); err != nil {
 		return nil, err
+	} else {
+		return resp.(*OtherUpdateResponse), nil
 	}
-	return &resp, nil
 }
 
-// OtherSignal sends a(n) mycompany.simple.Other.OtherSignal signal to an existing workflow
-func (c *otherClient) OtherSignal(ctx context.Context, workflowID string, runID string, signal *OtherSignalRequest) error {
-	return c.client.SignalWorkflow(ctx, workflowID, runID, OtherSignalSignalName, signal)
+// RunID implementation
+func (h *testOtherUpdateHandle) RunID() string {
+	return h.runID
 }
 
-// OtherUpdate sends a(n) mycompany.simple.Other.OtherUpdate update to an existing workflow
-func (c *otherClient) OtherUpdate(ctx context.Context, workflowID string, runID string, req *OtherUpdateRequest, opts ...*OtherUpdateOptions) (*OtherUpdateResponse, error) {
-	options := NewOtherUpdateOptions()
-	if len(opts) > 0 && opts[0].opts != nil {
-		options = opts[0]
+// UpdateID implementation
+func (h *testOtherUpdateHandle) UpdateID() string {
+	if h.opts != nil {
+		return h.opts.UpdateID
 	}
-	options.opts.WaitPolicy = &v11.WaitPolicy{LifecycleStage: v1.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED}
-	handle, err := c.OtherUpdateAsync(ctx, workflowID, runID, req, options)
-	if err != nil {
-		return nil, err
+	return ""
+}
+
+// WorkflowID implementation
+func (h *testOtherUpdateHandle) WorkflowID() string {
+	return h.workflowID
+}
+
+// OnOtherWorkflow mocks executions of the mycompany.simple.Other.OtherWorkflow activity whose input satisfies the given
+// matcher, which may be nil to match any input
+func (c *TestOtherClient) OnOtherWorkflow(matcher func(*OtherWorkflowRequest) bool) *TestOtherWorkflowActivityCall {
+	var req any = mock.Anything
+	if matcher != nil {
+		req = mock.MatchedBy(matcher)
 	}
-	return handle.Get(ctx)
+	return &TestOtherWorkflowActivityCall{c.env.OnActivity(OtherWorkflowActivityName, mock.Anything, req)}
 }
 
-// OtherUpdateAsync sends a(n) mycompany.simple.Other.OtherUpdate update to an existing workflow
-func (c *otherClient) OtherUpdateAsync(ctx context.Context, workflowID string, runID string, req *OtherUpdateRequest, opts ...*OtherUpdateOptions) (OtherUpdateHandle, error) {
-	options := &client.UpdateWorkflowWithOptionsRequest{}
-	if len(opts) > 0 && opts[0].opts != nil {
-		options = opts[0].opts
+// TestOtherWorkflowActivityCall describes a mocked mycompany.simple.Other.OtherWorkflow activity execution
+type TestOtherWorkflowActivityCall struct {
+	call *testsuite.MockCallWrapper
+}
+
+// Return specifies the result of matching activity executions
+func (c *TestOtherWorkflowActivityCall) Return(resp *OtherWorkflowResponse, err error) *TestOtherWorkflowActivityCall {
+	c.call.Return(resp, err)
+	return c
+}
+
+// Times specifies the number of activity executions the mock should match
+func (c *TestOtherWorkflowActivityCall) Times(n int) *TestOtherWorkflowActivityCall {
+	c.call.Times(n)
+	return c
+}
+
+// After returns a TestOtherDelayed value that schedules queries, signals, and updates
+// to be sent to the test environment's workflow after the given duration of virtual time
+func (c *TestOtherClient) After(d time.Duration) *TestOtherDelayed {
+	return &TestOtherDelayed{c, d}
+}
+
+// TestOtherDelayed schedules mycompany.simple.Other queries, signals, and updates using the test
+// environment's RegisterDelayedCallback method
+type TestOtherDelayed struct {
+	client *TestOtherClient
+	delay  time.Duration
+}
+
+// OtherQuery schedules a(n) mycompany.simple.Other.OtherQuery query, invoking fn with the result
+func (d *TestOtherDelayed) OtherQuery(fn func(*OtherQueryResponse, error)) {
+	d.client.env.RegisterDelayedCallback(func() {
+		fn(d.client.OtherQuery(context.Background(), "", ""))
+	}, d.delay)
+}
+
+// OtherSignal schedules a(n) mycompany.simple.Other.OtherSignal signal
+func (d *TestOtherDelayed) OtherSignal(req *OtherSignalRequest) {
+	d.client.env.RegisterDelayedCallback(func() {
+		d.client.env.SignalWorkflow(OtherSignalSignalName, req)
+	}, d.delay)
+}
+
+// OtherUpdate schedules a(n) mycompany.simple.Other.OtherUpdate update, invoking the given callbacks, which may be nil
+func (d *TestOtherDelayed) OtherUpdate(req *OtherUpdateRequest, callbacks *TestOtherUpdateCallbacks) {
+	if callbacks == nil {
+		callbacks = &TestOtherUpdateCallbacks{}
 	}
-	options.Args = []any{req}
-	options.RunID = runID
-	options.UpdateName = OtherUpdateUpdateName
-	options.WorkflowID = workflowID
-	if options.UpdateID == "" {
-		id, err := expression.EvalExpression(OtherUpdateIDExpression, req.ProtoReflect())
-		if err != nil {
-			return nil, fmt.Errorf("error evaluating %s id expression: %w", OtherUpdateUpdateName, err)
-		}
-		options.UpdateID = id
+	d.client.env.RegisterDelayedCallback(func() {
+		d.client.env.UpdateWorkflow(OtherUpdateUpdateName, callbacks, req)
+	}, d.delay)
+}
+
+// TestOtherUpdateCallbacks describes optional callbacks invoked in response to a(n) mycompany.simple.Other.OtherUpdate update
+// scheduled in a test environment
+type TestOtherUpdateCallbacks struct {
+	OnAccept   func()
+	OnReject   func(error)
+	OnComplete func(*OtherUpdateResponse, error)
+}
+
+// Accept invokes the OnAccept callback, if set
+func (c *TestOtherUpdateCallbacks) Accept() {
+	if c.OnAccept != nil {
+		c.OnAccept()
 	}
-	handle, err := c.client.UpdateWorkflowWithOptions(ctx, options)
-	if err != nil {
-		return nil, err
+}
+
+// Reject invokes the OnReject callback, if set
+func (c *TestOtherUpdateCallbacks) Reject(err error) {
+	if c.OnReject != nil {
+		c.OnReject(err)
 	}
-	return &otherUpdateHandle{client: c, handle: handle}, nil
 }
 
-// OtherWorkflowOptions provides configuration for a mycompany.simple.Other.OtherWorkflow workflow operation
-type OtherWorkflowOptions struct {
-	opts *client.StartWorkflowOptions
+// Complete invokes the OnComplete callback, if set
+func (c *TestOtherUpdateCallbacks) Complete(success any, err error) {
+	if c.OnComplete != nil {
+		resp, _ := success.(*OtherUpdateResponse)
+		c.OnComplete(resp, err)
+	}
 }
 
-// NewOtherWorkflowOptions initializes a new OtherWorkflowOptions value
-func NewOtherWorkflowOptions() *OtherWorkflowOptions {
-	return &OtherWorkflowOptions{}
+// TestOtherChildWorkflows provides typed mocks for mycompany.simple.Other child workflow executions in a
+// test environment, including child workflows started by workflows defined in other services
+type TestOtherChildWorkflows struct {
+	env *testsuite.TestWorkflowEnvironment
 }
 
-// WithStartWorkflowOptions sets the initial client.StartWorkflowOptions
-func (opts *OtherWorkflowOptions) WithStartWorkflowOptions(options client.StartWorkflowOptions) *OtherWorkflowOptions {
-	opts.opts = &options
-	return opts
+// NewTestOtherChildWorkflows initializes a new TestOtherChildWorkflows value, registering placeholder workflows
+// that fail unless mocked. It must be called before any mocks are registered with the test
+// environment, and must not be used when the service's workflows are registered with the test
+// environment, in which case the test client's On<Workflow>Child methods can be used instead.
+func NewTestOtherChildWorkflows(env *testsuite.TestWorkflowEnvironment) *TestOtherChildWorkflows {
+	env.RegisterWorkflowWithOptions(func(workflow.Context, *OtherWorkflowRequest) (*OtherWorkflowResponse, error) {
+		return nil, testutil.NewNotMockedError(testutil.KindWorkflow, OtherWorkflowWorkflowName)
+	}, workflow.RegisterOptions{Name: OtherWorkflowWorkflowName})
+	return &TestOtherChildWorkflows{env}
 }
 
-// OtherWorkflowRun describes a(n) mycompany.simple.Other.OtherWorkflow workflow run
-type OtherWorkflowRun interface {
-	// ID returns the workflow ID
-	ID() string
-	// RunID returns the workflow instance ID
-	RunID() string
-	// Get blocks until the workflow is complete and returns the result
-	Get(ctx context.Context) (*OtherWorkflowResponse, error)
+// OnOtherWorkflowChild mocks child mycompany.simple.Other.OtherWorkflow workflow executions whose input satisfies the given
+// matcher, which may be nil to match any input. The workflow must be registered with the test environment.
+func (c *TestOtherClient) OnOtherWorkflowChild(matcher func(*OtherWorkflowRequest) bool) *TestOtherWorkflowChildCall {
+	return (&TestOtherChildWorkflows{c.env}).OnOtherWorkflowChild(matcher)
 }
 
-// otherWorkflowRun provides an internal implementation of a(n) OtherWorkflowRunRun
-type otherWorkflowRun struct {
-	client *otherClient
-	run    client.WorkflowRun
+// OnOtherWorkflowChild mocks child mycompany.simple.Other.OtherWorkflow workflow executions whose input satisfies the given
+// matcher, which may be nil to match any input
+func (c *TestOtherChildWorkflows) OnOtherWorkflowChild(matcher func(*OtherWorkflowRequest) bool) *TestOtherWorkflowChildCall {
+	var req any = mock.Anything
+	if matcher != nil {
+		req = mock.MatchedBy(matcher)
+	}
+	return &TestOtherWorkflowChildCall{c.env.OnWorkflow(OtherWorkflowWorkflowName, mock.Anything, req)}
 }
 
-// ID returns the workflow ID
-func (r *otherWorkflowRun) ID() string {
-	return r.run.GetID()
+// TestOtherWorkflowChildCall describes a mocked child mycompany.simple.Other.OtherWorkflow workflow execution
+type TestOtherWorkflowChildCall struct {
+	call *testsuite.MockCallWrapper
 }
 
-// RunID returns the execution ID
-func (r *otherWorkflowRun) RunID() string {
-	return r.run.GetRunID()
+// Return specifies the result of matching child workflow executions
+func (c *TestOtherWorkflowChildCall) Return(resp *OtherWorkflowResponse, err error) *TestOtherWorkflowChildCall {
+	c.call.Return(resp, err)
+	return c
 }
 
-// Get blocks until the workflow is complete, returning the result if applicable
-func (r *otherWorkflowRun) Get(ctx context.Context) (*OtherWorkflowResponse, error) {
-	var resp OtherWorkflowResponse
-	if err := r.run.Get(ctx, &resp); err != nil {
+// Times specifies the number of child workflow executions the mock should match
+func (c *TestOtherWorkflowChildCall) Times(n int) *TestOtherWorkflowChildCall {
+	c.call.Times(n)
+	return c
+}
+
+var _ OtherWorkflowRun = &testOtherWorkflowRun{}
+
+// testOtherWorkflowRun provides convenience methods for interacting with a(n) OtherWorkflow workflow in the test environment
+type testOtherWorkflowRun struct {
+	client    *TestOtherClient
+	env       *testsuite.TestWorkflowEnvironment
+	opts      *client.StartWorkflowOptions
+	req       *OtherWorkflowRequest
+	workflows OtherWorkflows
+}
+
+// Get retrieves a test OtherWorkflow workflow result
+func (r *testOtherWorkflowRun) Get(context.Context) (*OtherWorkflowResponse, error) {
+	r.env.ExecuteWorkflow(OtherWorkflowWorkflowName, r.req)
+	if !r.env.IsWorkflowCompleted() {
+		return nil, errors.New("workflow in progress")
+	}
+	if err := r.env.GetWorkflowError(); err != nil {
 		return nil, err
 	}
-	return &resp, nil
+	var result OtherWorkflowResponse
+	if err := r.env.GetWorkflowResult(&result); err != nil {
+		return nil, err
+	}
+	return &result, nil
 }
 
-// OtherUpdateHandle describes a(n) mycompany.simple.Other.OtherUpdate update handle
-type OtherUpdateHandle interface {
-	// WorkflowID returns the workflow ID
-	WorkflowID() string
-	// RunID returns the workflow instance ID
-	RunID() string
-	// UpdateID returns the update ID
-	UpdateID() string
-	// Get blocks until the workflow is complete and returns the result
-	Get(ctx context.Context) (*OtherUpdateResponse, error)
+// ID returns a test OtherWorkflow workflow run's workflow ID
+func (r *testOtherWorkflowRun) ID() string {
+	if r.opts != nil {
+		return r.opts.ID
+	}
+	return ""
 }
 
-// otherUpdateHandle provides an internal implementation of a(n) OtherUpdateHandle
-type otherUpdateHandle struct {
-	client *otherClient
-	handle client.WorkflowUpdateHandle
+// RunID noop implementation
+func (r *testOtherWorkflowRun) RunID() string {
+	return ""
 }
 
-// WorkflowID returns the workflow ID
-func (h *otherUpdateHandle) WorkflowID() string {
-	return h.handle.WorkflowID()
+// TestOtherActivities executes mycompany.simple.Other activities in a test activity environment
+type TestOtherActivities struct {
+	env *testsuite.TestActivityEnvironment
+}
+
+var _ OtherActivities = &TestOtherActivities{}
+
+// NewTestOtherActivities registers the given activities with the test activity environment
+// and initializes a new TestOtherActivities value
+func NewTestOtherActivities(env *testsuite.TestActivityEnvironment, activities OtherActivities) *TestOtherActivities {
+	RegisterOtherActivities(env, activities)
+	return &TestOtherActivities{env}
+}
+
+// OtherWorkflow executes a(n) mycompany.simple.Other.OtherWorkflow activity in the test activity environment.
+// The context is ignored, use the environment's SetWorkerOptions (BackgroundActivityContext)
+// and SetTestTimeout methods to configure the activity context and timeout.
+func (a *TestOtherActivities) OtherWorkflow(_ context.Context, req *OtherWorkflowRequest) (*OtherWorkflowResponse, error) {
+	val, err := a.env.ExecuteActivity(OtherWorkflowActivityName, req)
+	if err != nil {
+		return nil, err
+	}
+	var resp OtherWorkflowResponse
+	if err := val.Get(&resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ReplayOtherWorkflows replays the given JSON workflow history files, exported from Temporal, against
+// the given mycompany.simple.Other workflow implementations, registered via RegisterOtherWorkflows.
+// Each history file that cannot be replayed, such as due to a nondeterminism error, is
+// reported as a test error.
+func ReplayOtherWorkflows(t testutil.TestingT, workflows OtherWorkflows, historyFiles ...string) {
+	t.Helper()
+	replayer := worker.NewWorkflowReplayer()
+	RegisterOtherWorkflows(replayer, workflows)
+	for _, historyFile := range historyFiles {
+		if err := replayer.ReplayWorkflowHistoryFromJSONFile(nil, historyFile); err != nil {
+			t.Errorf("error replaying workflow history %s: %v", historyFile, err)
+		}
+	}
 }
 
-// RunID returns the execution ID
-func (h *otherUpdateHandle) RunID() string {
-	return h.handle.RunID()
+// MockOtherClient is a testify mock implementation of OtherClient
+type MockOtherClient struct {
+	mock.Mock
 }
 
-// UpdateID returns the update ID
-func (h *otherUpdateHandle) UpdateID() string {
-	return h.handle.UpdateID()
+var _ OtherClient = (*MockOtherClient)(nil)
+
+// NewMockOtherClient initializes a new MockOtherClient that asserts its expectations when the test completes
+func NewMockOtherClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOtherClient {
+	m := &MockOtherClient{}
+	m.Mock.Test(t)
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+	return m
 }
 
-// Get blocks until the update wait policy is met, returning the result if applicable
-func (h *otherUpdateHandle) Get(ctx context.Context) (*OtherUpdateResponse, error) {
-	var resp OtherUpdateResponse
-	if err := h.handle.Get(ctx, &resp); err != nil {
-		return nil, err
+// OtherWorkflow implements OtherClient
+func (m *MockOtherClient) OtherWorkflow(ctx context.Context, req *OtherWorkflowRequest, opts ...*OtherWorkflowOptions) (*OtherWorkflowResponse, error) {
+	args := m.Called(ctx, req, opts)
+	var r0 *OtherWorkflowResponse
+	if v := args.Get(0); v != nil {
+		r0 = v.(*OtherWorkflowResponse)
 	}
-	return &resp, nil
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
 }
 
-// OtherUpdateOptions provides configuration for a mycompany.simple.Other.OtherUpdate update operation
-type OtherUpdateOptions struct {
-	opts *client.UpdateWorkflowWithOptionsRequest
+// OnOtherWorkflow registers an expectation for a call to OtherWorkflow with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockOtherClient) OnOtherWorkflow(ctx any, req any, opts any) *MockOtherClientOtherWorkflowCall {
+	return &MockOtherClientOtherWorkflowCall{Call: m.On("OtherWorkflow", ctx, req, opts)}
 }
 
-// NewOtherUpdateOptions initializes a new OtherUpdateOptions value
-func NewOtherUpdateOptions() *OtherUpdateOptions {
-	return &OtherUpdateOptions{opts: &client.UpdateWorkflowWithOptionsRequest{}}
+// MockOtherClientOtherWorkflowCall describes an expected call to MockOtherClient.OtherWorkflow
+type MockOtherClientOtherWorkflowCall struct {
+	*mock.Call
 }
 
-// WithUpdateWorkflowOptions sets the initial client.UpdateWorkflowWithOptionsRequest
-func (opts *OtherUpdateOptions) WithUpdateWorkflowOptions(options client.UpdateWorkflowWithOptionsRequest) *OtherUpdateOptions {
-	opts.opts = &options
-	return opts
+// Return specifies the values returned by the call
+func (c *MockOtherClientOtherWorkflowCall) Return(resp *OtherWorkflowResponse, err error) *MockOtherClientOtherWorkflowCall {
+	c.Call.Return(resp, err)
+	return c
 }
 
-// Reference to generated workflow functions
-var (
-	// OtherWorkflowFunction implements a "OtherWorkflowWorkflow" workflow
-	OtherWorkflowFunction func(workflow.Context, *OtherWorkflowRequest) (*OtherWorkflowResponse, error)
-)
+// OtherWorkflowAsync implements OtherClient
+func (m *MockOtherClient) OtherWorkflowAsync(ctx context.Context, req *OtherWorkflowRequest, opts ...*OtherWorkflowOptions) (OtherWorkflowRun, error) {
+	args := m.Called(ctx, req, opts)
+	var r0 OtherWorkflowRun
+	if v := args.Get(0); v != nil {
+		r0 = v.(OtherWorkflowRun)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
 
-// OtherWorkflows provides methods for initializing new mycompany.simple.Other workflow values
-type OtherWorkflows interface {
-	OtherWorkflow(ctx workflow.Context, input *OtherWorkflowInput) (OtherWorkflowWorkflow, error)
+// OnOtherWorkflowAsync registers an expectation for a call to OtherWorkflowAsync with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockOtherClient) OnOtherWorkflowAsync(ctx any, req any, opts any) *MockOtherClientOtherWorkflowAsyncCall {
+	return &MockOtherClientOtherWorkflowAsyncCall{Call: m.On("OtherWorkflowAsync", ctx, req, opts)}
 }
 
-// OtherWorkflow initializes a new a(n) OtherWorkflowWorkflow implementation
-// RegisterOtherWorkflows registers mycompany.simple.Other workflows with the given worker
-func RegisterOtherWorkflows(r worker.Registry, workflows OtherWorkflows) {
-	RegisterOtherWorkflowWorkflow(r, workflows.OtherWorkflow)
+// MockOtherClientOtherWorkflowAsyncCall describes an expected call to MockOtherClient.OtherWorkflowAsync
+type MockOtherClientOtherWorkflowAsyncCall struct {
+	*mock.Call
 }
 
-// RegisterOtherWorkflowWorkflow registers a mycompany.simple.Other.OtherWorkflow workflow with the given worker
-func RegisterOtherWorkflowWorkflow(r worker.Registry, wf func(workflow.Context, *OtherWorkflowInput) (OtherWorkflowWorkflow, error)) {
-	OtherWorkflowFunction = buildOtherWorkflow(wf)
-	r.RegisterWorkflowWithOptions(OtherWorkflowFunction, workflow.RegisterOptions{Name: OtherWorkflowWorkflowName})
+// Return specifies the values returned by the call
+func (c *MockOtherClientOtherWorkflowAsyncCall) Return(run OtherWorkflowRun, err error) *MockOtherClientOtherWorkflowAsyncCall {
+	c.Call.Return(run, err)
+	return c
 }
 
-// buildOtherWorkflow converts a OtherWorkflow workflow struct into a valid workflow function
-func buildOtherWorkflow(ctor func(workflow.Context, *OtherWorkflowInput) (OtherWorkflowWorkflow, error)) func(workflow.Context, *OtherWorkflowRequest) (*OtherWorkflowResponse, error) {
-	return func(ctx workflow.Context, req *OtherWorkflowRequest) (*OtherWorkflowResponse, error) {
-		input := &OtherWorkflowInput{
-			Req: req,
-		}
-		wf, err := ctor(ctx, input)
-		if err != nil {
-			return nil, err
-		}
-		return wf.Execute(ctx)
+// GetOtherWorkflow implements OtherClient
+func (m *MockOtherClient) GetOtherWorkflow(ctx context.Context, workflowID string, runID string) OtherWorkflowRun {
+	args := m.Called(ctx, workflowID, runID)
+	var r0 OtherWorkflowRun
+	if v := args.Get(0); v != nil {
+		r0 = v.(OtherWorkflowRun)
 	}
+	return r0
 }
 
-// OtherWorkflowInput describes the input to a(n) mycompany.simple.Other.OtherWorkflow workflow constructor
-type OtherWorkflowInput struct {
-	Req *OtherWorkflowRequest
+// OnGetOtherWorkflow registers an expectation for a call to GetOtherWorkflow with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockOtherClient) OnGetOtherWorkflow(ctx any, workflowID any, runID any) *MockOtherClientGetOtherWorkflowCall {
+	return &MockOtherClientGetOtherWorkflowCall{Call: m.On("GetOtherWorkflow", ctx, workflowID, runID)}
 }
 
-// OtherWorkflowWorkflow describes a(n) mycompany.simple.Other.OtherWorkflow workflow implementation
-type OtherWorkflowWorkflow interface {
-	// Execute defines the entrypoint to a(n) mycompany.simple.Other.OtherWorkflow workflow
-	Execute(ctx workflow.Context) (*OtherWorkflowResponse, error)
+// MockOtherClientGetOtherWorkflowCall describes an expected call to MockOtherClient.GetOtherWorkflow
+type MockOtherClientGetOtherWorkflowCall struct {
+	*mock.Call
 }
 
-// OtherWorkflowChild executes a child mycompany.simple.Other.OtherWorkflow workflow
-func OtherWorkflowChild(ctx workflow.Context, req *OtherWorkflowRequest, options ...*OtherWorkflowChildOptions) (*OtherWorkflowResponse, error) {
-	childRun, err := OtherWorkflowChildAsync(ctx, req, options...)
-	if err != nil {
-		return nil, err
-	}
-	return childRun.Get(ctx)
+// Return specifies the values returned by the call
+func (c *MockOtherClientGetOtherWorkflowCall) Return(run OtherWorkflowRun) *MockOtherClientGetOtherWorkflowCall {
+	c.Call.Return(run)
+	return c
 }
 
-// OtherWorkflowChildAsync executes a child mycompany.simple.Other.OtherWorkflow workflow
-func OtherWorkflowChildAsync(ctx workflow.Context, req *OtherWorkflowRequest, options ...*OtherWorkflowChildOptions) (*OtherWorkflowChildRun, error) {
-	var opts *workflow.ChildWorkflowOptions
-	if len(options) > 0 && options[0].opts != nil {
-		opts = options[0].opts
-	} else {
-		childOpts := workflow.GetChildWorkflowOptions(ctx)
-		opts = &childOpts
-	}
-	if opts.TaskQueue == "" {
-		opts.TaskQueue = OtherTaskQueue
+// CreateOtherWorkflowSchedule implements OtherClient
+func (m *MockOtherClient) CreateOtherWorkflowSchedule(ctx context.Context, id string, spec client.ScheduleSpec, req *OtherWorkflowRequest, opts ...*OtherWorkflowScheduleOptions) (client.ScheduleHandle, error) {
+	args := m.Called(ctx, id, spec, req, opts)
+	var r0 client.ScheduleHandle
+	if v := args.Get(0); v != nil {
+		r0 = v.(client.ScheduleHandle)
 	}
-	if opts.WorkflowID == "" {
-		id, err := expression.EvalExpression(OtherWorkflowIDExpression, req.ProtoReflect())
-		if err != nil {
-			panic(err)
-		}
-		opts.WorkflowID = id
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
 	}
-	ctx = workflow.WithChildOptions(ctx, *opts)
-	return &OtherWorkflowChildRun{Future: workflow.ExecuteChildWorkflow(ctx, OtherWorkflowWorkflowName, req)}, nil
+	return r0, r1
 }
 
-// OtherWorkflowChildOptions provides configuration for a mycompany.simple.Other.OtherWorkflow workflow operation
-type OtherWorkflowChildOptions struct {
-	opts *workflow.ChildWorkflowOptions
+// OnCreateOtherWorkflowSchedule registers an expectation for a call to CreateOtherWorkflowSchedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockOtherClient) OnCreateOtherWorkflowSchedule(ctx any, id any, spec any, req any, opts any) *MockOtherClientCreateOtherWorkflowScheduleCall {
+	return &MockOtherClientCreateOtherWorkflowScheduleCall{Call: m.On("CreateOtherWorkflowSchedule", ctx, id, spec, req, opts)}
 }
 
-// NewOtherWorkflowChildOptions initializes a new OtherWorkflowChildOptions value
-func NewOtherWorkflowChildOptions() *OtherWorkflowChildOptions {
-	return &OtherWorkflowChildOptions{}
+// MockOtherClientCreateOtherWorkflowScheduleCall describes an expected call to MockOtherClient.CreateOtherWorkflowSchedule
+type MockOtherClientCreateOtherWorkflowScheduleCall struct {
+	*mock.Call
 }
 
-// WithStartWorkflowOptions sets the initial client.StartWorkflowOptions
-func (opts *OtherWorkflowChildOptions) WithStartWorkflowOptions(options workflow.ChildWorkflowOptions) *OtherWorkflowChildOptions {
-	opts.opts = &options
-	return opts
+// Return specifies the values returned by the call
+func (c *MockOtherClientCreateOtherWorkflowScheduleCall) Return(handle client.ScheduleHandle, err error) *MockOtherClientCreateOtherWorkflowScheduleCall {
+	c.Call.Return(handle, err)
+	return c
 }
 
-// OtherWorkflowChildRun describes a child mycompany.simple.Other.OtherWorkflow workflow run
-type OtherWorkflowChildRun struct {
-	Future workflow.ChildWorkflowFuture
+// GetOtherWorkflowSchedule implements OtherClient
+func (m *MockOtherClient) GetOtherWorkflowSchedule(ctx context.Context, id string) (*client.ScheduleDescription, error) {
+	args := m.Called(ctx, id)
+	var r0 *client.ScheduleDescription
+	if v := args.Get(0); v != nil {
+		r0 = v.(*client.ScheduleDescription)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
 }
 
-// Get blocks until the workflow is completed, returning the response value
-func (r *OtherWorkflowChildRun) Get(ctx workflow.Context) (*OtherWorkflowResponse, error) {
-	var resp OtherWorkflowResponse
-	if err := r.Future.Get(ctx, &resp); err != nil {
-		return nil, err
-	}
-	return &resp, nil
+// OnGetOtherWorkflowSchedule registers an expectation for a call to GetOtherWorkflowSchedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockOtherClient) OnGetOtherWorkflowSchedule(ctx any, id any) *MockOtherClientGetOtherWorkflowScheduleCall {
+	return &MockOtherClientGetOtherWorkflowScheduleCall{Call: m.On("GetOtherWorkflowSchedule", ctx, id)}
 }
 
-// Select adds this completion to the selector. Callback can be nil.
-func (r *OtherWorkflowChildRun) Select(sel workflow.Selector, fn func(OtherWorkflowChildRun)) workflow.Selector {
-	return sel.AddFuture(r.Future, func(workflow.Future) {
-		if fn != nil {
-			fn(*r)
-		}
-	})
+// MockOtherClientGetOtherWorkflowScheduleCall describes an expected call to MockOtherClient.GetOtherWorkflowSchedule
+type MockOtherClientGetOtherWorkflowScheduleCall struct {
+	*mock.Call
 }
 
-// SelectStart adds waiting for start to the selector. Callback can be nil.
-func (r *OtherWorkflowChildRun) SelectStart(sel workflow.Selector, fn func(OtherWorkflowChildRun)) workflow.Selector {
-	return sel.AddFuture(r.Future.GetChildWorkflowExecution(), func(workflow.Future) {
-		if fn != nil {
-			fn(*r)
-		}
-	})
+// Return specifies the values returned by the call
+func (c *MockOtherClientGetOtherWorkflowScheduleCall) Return(desc *client.ScheduleDescription, err error) *MockOtherClientGetOtherWorkflowScheduleCall {
+	c.Call.Return(desc, err)
+	return c
 }
 
-// WaitStart waits for the child workflow to start
-func (r *OtherWorkflowChildRun) WaitStart(ctx workflow.Context) (*workflow.Execution, error) {
-	var exec workflow.Execution
-	if err := r.Future.GetChildWorkflowExecution().Get(ctx, &exec); err != nil {
-		return nil, err
+// PauseOtherWorkflowSchedule implements OtherClient
+func (m *MockOtherClient) PauseOtherWorkflowSchedule(ctx context.Context, id string, note string) error {
+	args := m.Called(ctx, id, note)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
 	}
-	return &exec, nil
+	return r0
 }
 
-// OtherSignalSignal describes a(n) mycompany.simple.Other.OtherSignal signal
-type OtherSignalSignal struct {
-	Channel workflow.ReceiveChannel
+// OnPauseOtherWorkflowSchedule registers an expectation for a call to PauseOtherWorkflowSchedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockOtherClient) OnPauseOtherWorkflowSchedule(ctx any, id any, note any) *MockOtherClientPauseOtherWorkflowScheduleCall {
+	return &MockOtherClientPauseOtherWorkflowScheduleCall{Call: m.On("PauseOtherWorkflowSchedule", ctx, id, note)}
 }
 
-// Receive blocks until a(n) mycompany.simple.Other.OtherSignal signal is received
-func (s *OtherSignalSignal) Receive(ctx workflow.Context) (*OtherSignalRequest, bool) {
-	var resp OtherSignalRequest
-	more := s.Channel.Receive(ctx, &resp)
-	return &resp, more
+// MockOtherClientPauseOtherWorkflowScheduleCall describes an expected call to MockOtherClient.PauseOtherWorkflowSchedule
+type MockOtherClientPauseOtherWorkflowScheduleCall struct {
+	*mock.Call
 }
 
-// ReceiveAsync checks for a mycompany.simple.Other.OtherSignal signal without blocking
-func (s *OtherSignalSignal) ReceiveAsync() *OtherSignalRequest {
-	var resp OtherSignalRequest
-	if ok := s.Channel.ReceiveAsync(&resp); !ok {
-		return nil
-	}
-	return &resp
+// Return specifies the values returned by the call
+func (c *MockOtherClientPauseOtherWorkflowScheduleCall) Return(err error) *MockOtherClientPauseOtherWorkflowScheduleCall {
+	c.Call.Return(err)
+	return c
 }
 
-// Select checks for a(n) mycompany.simple.Other.OtherSignal signal without blocking
-func (s *OtherSignalSignal) Select(sel workflow.Selector, fn func(*OtherSignalRequest)) workflow.Selector {
-	return sel.AddReceive(s.Channel, func(workflow.ReceiveChannel, bool) {
-		req := s.ReceiveAsync()
-		if fn != nil {
-			fn(req)
-		}
-	})
+// UnpauseOtherWorkflowSchedule implements OtherClient
+func (m *MockOtherClient) UnpauseOtherWorkflowSchedule(ctx context.Context, id string, note string) error {
+	args := m.Called(ctx, id, note)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
 }
 
-// OtherSignalExternal sends a(n) mycompany.simple.Other.OtherSignal signal to an existing workflow
-func OtherSignalExternal(ctx workflow.Context, workflowID string, runID string, req *OtherSignalRequest) error {
-	return workflow.SignalExternalWorkflow(ctx, workflowID, runID, OtherSignalSignalName, req).Get(ctx, nil)
+// OnUnpauseOtherWorkflowSchedule registers an expectation for a call to UnpauseOtherWorkflowSchedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockOtherClient) OnUnpauseOtherWorkflowSchedule(ctx any, id any, note any) *MockOtherClientUnpauseOtherWorkflowScheduleCall {
+	return &MockOtherClientUnpauseOtherWorkflowScheduleCall{Call: m.On("UnpauseOtherWorkflowSchedule", ctx, id, note)}
 }
 
-// OtherActivities describes available worker activites
-type OtherActivities interface {
-	OtherWorkflow(ctx context.Context, req *OtherWorkflowRequest) (*OtherWorkflowResponse, error)
+// MockOtherClientUnpauseOtherWorkflowScheduleCall describes an expected call to MockOtherClient.UnpauseOtherWorkflowSchedule
+type MockOtherClientUnpauseOtherWorkflowScheduleCall struct {
+	*mock.Call
 }
 
-// RegisterOtherActivities registers activities with a worker
-func RegisterOtherActivities(r worker.Registry, activities OtherActivities) {
-	RegisterOtherWorkflowActivity(r, activities.OtherWorkflow)
+// Return specifies the values returned by the call
+func (c *MockOtherClientUnpauseOtherWorkflowScheduleCall) Return(err error) *MockOtherClientUnpauseOtherWorkflowScheduleCall {
+	c.Call.Return(err)
+	return c
 }
 
-// RegisterOtherWorkflowActivity registers a mycompany.simple.Other.OtherWorkflow activity
-func RegisterOtherWorkflowActivity(r worker.Registry, fn func(context.Context, *OtherWorkflowRequest) (*OtherWorkflowResponse, error)) {
-	r.RegisterActivityWithOptions(fn, activity.RegisterOptions{
-		Name: OtherWorkflowActivityName,
-	})
+// TriggerOtherWorkflowSchedule implements OtherClient
+func (m *MockOtherClient) TriggerOtherWorkflowSchedule(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
 }
 
-// OtherWorkflowFuture describes a(n) mycompany.simple.Other.OtherWorkflow activity execution
-type OtherWorkflowFuture struct {
-	Future workflow.Future
+// OnTriggerOtherWorkflowSchedule registers an expectation for a call to TriggerOtherWorkflowSchedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockOtherClient) OnTriggerOtherWorkflowSchedule(ctx any, id any) *MockOtherClientTriggerOtherWorkflowScheduleCall {
+	return &MockOtherClientTriggerOtherWorkflowScheduleCall{Call: m.On("TriggerOtherWorkflowSchedule", ctx, id)}
 }
 
-// Get blocks on the activity's completion, returning the response
-func (f *OtherWorkflowFuture) Get(ctx workflow.Context) (*OtherWorkflowResponse, error) {
-	var resp OtherWorkflowResponse
-	if err := f.Future.Get(ctx, &resp); err != nil {
-		return nil, err
-	}
-	return &resp, nil
+// MockOtherClientTriggerOtherWorkflowScheduleCall describes an expected call to MockOtherClient.TriggerOtherWorkflowSchedule
+type MockOtherClientTriggerOtherWorkflowScheduleCall struct {
+	*mock.Call
 }
 
-// Select adds the activity's completion to the selector, callback can be nil
-func (f *OtherWorkflowFuture) Select(sel workflow.Selector, fn func(*OtherWorkflowFuture)) workflow.Selector {
-	return sel.AddFuture(f.Future, func(workflow.Future) {
-		if fn != nil {
-			fn(f)
-		}
-	})
+// Return specifies the values returned by the call
+func (c *MockOtherClientTriggerOtherWorkflowScheduleCall) Return(err error) *MockOtherClientTriggerOtherWorkflowScheduleCall {
+	c.Call.Return(err)
+	return c
 }
 
-// OtherWorkflow executes a(n) mycompany.simple.Other.OtherWorkflow activity
-func OtherWorkflow(ctx workflow.Context, req *OtherWorkflowRequest, options ...*OtherWorkflowActivityOptions) (*OtherWorkflowResponse, error) {
-	var opts *OtherWorkflowActivityOptions
-	if len(options) > 0 && options[0] != nil {
-		opts = options[0]
-	} else {
-		opts = NewOtherWorkflowActivityOptions()
-	}
-	if opts.opts == nil {
-		activityOpts := workflow.GetActivityOptions(ctx)
-		opts.opts = &activityOpts
+// DeleteOtherWorkflowSchedule implements OtherClient
+func (m *MockOtherClient) DeleteOtherWorkflowSchedule(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
 	}
-	if opts.opts.StartToCloseTimeout == 0 {
-		opts.opts.StartToCloseTimeout = 30000000000 // 30s
-	}
-	ctx = workflow.WithActivityOptions(ctx, *opts.opts)
-	var activity any
-	activity = OtherWorkflowActivityName
-	future := &OtherWorkflowFuture{Future: workflow.ExecuteActivity(ctx, activity, req)}
-	return future.Get(ctx)
+	return r0
 }
 
-// OtherWorkflowAsync executes a(n) mycompany.simple.Other.OtherWorkflow activity (asynchronously)
-func OtherWorkflowAsync(ctx workflow.Context, req *OtherWorkflowRequest, options ...*OtherWorkflowActivityOptions) *OtherWorkflowFuture {
-	var opts *OtherWorkflowActivityOptions
-	if len(options) > 0 && options[0] != nil {
-		opts = options[0]
-	} else {
-		opts = NewOtherWorkflowActivityOptions()
-	}
-	if opts.opts == nil {
-		activityOpts := workflow.GetActivityOptions(ctx)
-		opts.opts = &activityOpts
-	}
-	if opts.opts.StartToCloseTimeout == 0 {
-		opts.opts.StartToCloseTimeout = 30000000000 // 30s
-	}
-	ctx = workflow.WithActivityOptions(ctx, *opts.opts)
-	var activity any
-	activity = OtherWorkflowActivityName
-	future := &OtherWorkflowFuture{Future: workflow.ExecuteActivity(ctx, activity, req)}
-	return future
+// OnDeleteOtherWorkflowSchedule registers an expectation for a call to DeleteOtherWorkflowSchedule with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockOtherClient) OnDeleteOtherWorkflowSchedule(ctx any, id any) *MockOtherClientDeleteOtherWorkflowScheduleCall {
+	return &MockOtherClientDeleteOtherWorkflowScheduleCall{Call: m.On("DeleteOtherWorkflowSchedule", ctx, id)}
 }
 
-// OtherWorkflowLocal executes a(n) mycompany.simple.Other.OtherWorkflow activity (locally)
-func OtherWorkflowLocal(ctx workflow.Context, req *OtherWorkflowRequest, options ...*OtherWorkflowLocalActivityOptions) (*OtherWorkflowResponse, error) {
-	var opts *OtherWorkflowLocalActivityOptions
-	if len(options) > 0 && options[0] != nil {
-		opts = options[0]
-	} else {
-		opts = NewOtherWorkflowLocalActivityOptions()
-	}
-	if opts.opts == nil {
-		activityOpts := workflow.GetLocalActivityOptions(ctx)
-		opts.opts = &activityOpts
-	}
-	if opts.opts.StartToCloseTimeout == 0 {
-		opts.opts.StartToCloseTimeout = 30000000000 // 30s
-	}
-	ctx = workflow.WithLocalActivityOptions(ctx, *opts.opts)
-	var activity any
-	if opts.fn != nil {
-		activity = opts.fn
-	} else {
-		activity = OtherWorkflowActivityName
-	}
-	future := &OtherWorkflowFuture{Future: workflow.ExecuteLocalActivity(ctx, activity, req)}
-	return future.Get(ctx)
+// MockOtherClientDeleteOtherWorkflowScheduleCall describes an expected call to MockOtherClient.DeleteOtherWorkflowSchedule
+type MockOtherClientDeleteOtherWorkflowScheduleCall struct {
+	*mock.Call
 }
 
-// OtherWorkflowLocalAsync executes a(n) mycompany.simple.Other.OtherWorkflow activity (asynchronously, locally)
-func OtherWorkflowLocalAsync(ctx workflow.Context, req *OtherWorkflowRequest, options ...*OtherWorkflowLocalActivityOptions) *OtherWorkflowFuture {
-	var opts *OtherWorkflowLocalActivityOptions
-	if len(options) > 0 && options[0] != nil {
-		opts = options[0]
-	} else {
-		opts = NewOtherWorkflowLocalActivityOptions()
-	}
-	if opts.opts == nil {
-		activityOpts := workflow.GetLocalActivityOptions(ctx)
-		opts.opts = &activityOpts
-	}
-	if opts.opts.StartToCloseTimeout == 0 {
-		opts.opts.StartToCloseTimeout = 30000000000 // 30s
+// Return specifies the values returned by the call
+func (c *MockOtherClientDeleteOtherWorkflowScheduleCall) Return(err error) *MockOtherClientDeleteOtherWorkflowScheduleCall {
+	c.Call.Return(err)
+	return c
+}
+
+// OtherQuery implements OtherClient
+func (m *MockOtherClient) OtherQuery(ctx context.Context, workflowID string, runID string) (*OtherQueryResponse, error) {
+	args := m.Called(ctx, workflowID, runID)
+	var r0 *OtherQueryResponse
+	if v := args.Get(0); v != nil {
+		r0 = v.(*OtherQueryResponse)
 	}
-	ctx = workflow.WithLocalActivityOptions(ctx, *opts.opts)
-	var activity any
-	if opts.fn != nil {
-		activity = opts.fn
-	} else {
-		activity = OtherWorkflowActivityName
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
 	}
-	future := &OtherWorkflowFuture{Future: workflow.ExecuteLocalActivity(ctx, activity, req)}
-	return future
+	return r0, r1
 }
 
-// OtherWorkflowLocalActivityOptions provides configuration for a local mycompany.simple.Other.OtherWorkflow activity
-type OtherWorkflowLocalActivityOptions struct {
-	fn   func(context.Context, *OtherWorkflowRequest) (*OtherWorkflowResponse, error)
-	opts *workflow.LocalActivityOptions
+// OnOtherQuery registers an expectation for a call to OtherQuery with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockOtherClient) OnOtherQuery(ctx any, workflowID any, runID any) *MockOtherClientOtherQueryCall {
+	return &MockOtherClientOtherQueryCall{Call: m.On("OtherQuery", ctx, workflowID, runID)}
 }
 
-// NewOtherWorkflowLocalActivityOptions sets default LocalActivityOptions
-func NewOtherWorkflowLocalActivityOptions() *OtherWorkflowLocalActivityOptions {
-	return &OtherWorkflowLocalActivityOptions{}
+// MockOtherClientOtherQueryCall describes an expected call to MockOtherClient.OtherQuery
+type MockOtherClientOtherQueryCall struct {
+	*mock.Call
 }
 
-// Local provides a local mycompany.simple.Other.OtherWorkflow activity implementation
-func (opts *OtherWorkflowLocalActivityOptions) Local(fn func(context.Context, *OtherWorkflowRequest) (*OtherWorkflowResponse, error)) *OtherWorkflowLocalActivityOptions {
-	opts.fn = fn
-	return opts
+// Return specifies the values returned by the call
+func (c *MockOtherClientOtherQueryCall) Return(resp *OtherQueryResponse, err error) *MockOtherClientOtherQueryCall {
+	c.Call.Return(resp, err)
+	return c
 }
 
-// WithLocalActivityOptions sets default LocalActivityOptions
-func (opts *OtherWorkflowLocalActivityOptions) WithLocalActivityOptions(options workflow.LocalActivityOptions) *OtherWorkflowLocalActivityOptions {
-	opts.opts = &options
-	return opts
+// OtherSignal implements OtherClient
+func (m *MockOtherClient) OtherSignal(ctx context.Context, workflowID string, runID string, signal *OtherSignalRequest) error {
+	args := m.Called(ctx, workflowID, runID, signal)
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
 }
 
-// OtherWorkflowActivityOptions provides configuration for a(n) mycompany.simple.Other.OtherWorkflow activity
-type OtherWorkflowActivityOptions struct {
-	opts *workflow.ActivityOptions
+// OnOtherSignal registers an expectation for a call to OtherSignal with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockOtherClient) OnOtherSignal(ctx any, workflowID any, runID any, signal any) *MockOtherClientOtherSignalCall {
+	return &MockOtherClientOtherSignalCall{Call: m.On("OtherSignal", ctx, workflowID, runID, signal)}
 }
 
-// NewOtherWorkflowActivityOptions sets default ActivityOptions
-func NewOtherWorkflowActivityOptions() *OtherWorkflowActivityOptions {
-	return &OtherWorkflowActivityOptions{}
+// MockOtherClientOtherSignalCall describes an expected call to MockOtherClient.OtherSignal
+type MockOtherClientOtherSignalCall struct {
+	*mock.Call
 }
 
-// WithActivityOptions sets default ActivityOptions
-func (opts *OtherWorkflowActivityOptions) WithActivityOptions(options workflow.ActivityOptions) *OtherWorkflowActivityOptions {
-	opts.opts = &options
-	return opts
+// Return specifies the values returned by the call
+func (c *MockOtherClientOtherSignalCall) Return(err error) *MockOtherClientOtherSignalCall {
+	c.Call.Return(err)
+	return c
 }
 
-// TestClient provides a testsuite-compatible Client
-type TestOtherClient struct {
-	env       *testsuite.TestWorkflowEnvironment
-	workflows OtherWorkflows
+// OtherUpdate implements OtherClient
+func (m *MockOtherClient) OtherUpdate(ctx context.Context, workflowID string, runID string, req *OtherUpdateRequest, opts ...*OtherUpdateOptions) (*OtherUpdateResponse, error) {
+	args := m.Called(ctx, workflowID, runID, req, opts)
+	var r0 *OtherUpdateResponse
+	if v := args.Get(0); v != nil {
+		r0 = v.(*OtherUpdateResponse)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
 }
 
-var _ OtherClient = &TestOtherClient{}
+// OnOtherUpdate registers an expectation for a call to OtherUpdate with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockOtherClient) OnOtherUpdate(ctx any, workflowID any, runID any, req any, opts any) *MockOtherClientOtherUpdateCall {
+	return &MockOtherClientOtherUpdateCall{Call: m.On("OtherUpdate", ctx, workflowID, runID, req, opts)}
+}
 
-// NewTestOtherClient initializes a new TestOtherClient value
-func NewTestOtherClient(env *testsuite.TestWorkflowEnvironment, workflows OtherWorkflows, activities OtherActivities) *TestOtherClient {
-	RegisterOtherWorkflows(env, workflows)
-	if activities != nil {
-		RegisterOtherActivities(env, activities)
-	}
-	return &TestOtherClient{env, workflows}
+// MockOtherClientOtherUpdateCall describes an expected call to MockOtherClient.OtherUpdate
+type MockOtherClientOtherUpdateCall struct {
+	*mock.Call
 }
 
-// OtherWorkflow executes a(n) OtherWorkflow workflow in the test environment
-func (c *TestOtherClient) OtherWorkflow(ctx context.Context, req *OtherWorkflowRequest, opts ...*OtherWorkflowOptions) (*OtherWorkflowResponse, error) {
-	run, err := c.OtherWorkflowAsync(ctx, req, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return run.Get(ctx)
+// Return specifies the values returned by the call
+func (c *MockOtherClientOtherUpdateCall) Return(resp *OtherUpdateResponse, err error) *MockOtherClientOtherUpdateCall {
+	c.Call.Return(resp, err)
+	return c
 }
 
-// OtherWorkflowAsync executes a(n) OtherWorkflow workflow in the test environment
-func (c *TestOtherClient) OtherWorkflowAsync(ctx context.Context, req *OtherWorkflowRequest, options ...*OtherWorkflowOptions) (OtherWorkflowRun, error) {
-	opts := &client.StartWorkflowOptions{}
-	if len(options) > 0 && options[0].opts != nil {
-		opts = options[0].opts
-	}
-	if opts.TaskQueue == "" {
-		opts.TaskQueue = OtherTaskQueue
+// OtherUpdateAsync implements OtherClient
+func (m *MockOtherClient) OtherUpdateAsync(ctx context.Context, workflowID string, runID string, req *OtherUpdateRequest, opts ...*OtherUpdateOptions) (OtherUpdateHandle, error) {
+	args := m.Called(ctx, workflowID, runID, req, opts)
+	var r0 OtherUpdateHandle
+	if v := args.Get(0); v != nil {
+		r0 = v.(OtherUpdateHandle)
 	}
-	if opts.ID == "" {
-		id, err := expression.EvalExpression(OtherWorkflowIDExpression, req.ProtoReflect())
-		if err != nil {
-			return nil, err
-		}
-		opts.ID = id
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
 	}
-	return &testOtherWorkflowRun{client: c, env: c.env, opts: opts, req: req, workflows: c.workflows}, nil
+	return r0, r1
 }
 
-// GetOtherWorkflow is a noop
-func (c *TestOtherClient) GetOtherWorkflow(ctx context.Context, workflowID string, runID string) OtherWorkflowRun {
-	return &testOtherWorkflowRun{env: c.env, workflows: c.workflows}
+// OnOtherUpdateAsync registers an expectation for a call to OtherUpdateAsync with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockOtherClient) OnOtherUpdateAsync(ctx any, workflowID any, runID any, req any, opts any) *MockOtherClientOtherUpdateAsyncCall {
+	return &MockOtherClientOtherUpdateAsyncCall{Call: m.On("OtherUpdateAsync", ctx, workflowID, runID, req, opts)}
 }
 
-// OtherQuery executes a OtherQuery query
-func (c *TestOtherClient) OtherQuery(ctx context.Context, workflowID string, runID string) (*OtherQueryResponse, error) {
-	val, err := c.env.QueryWorkflow(OtherQueryQueryName)
-	if err != nil {
-		return nil, err
-	} else if !val.HasValue() {
-		return nil, nil
-	} else {
-		var result OtherQueryResponse
-		if err := val.Get(&result); err != nil {
-			return nil, err
-		}
-		return &result, nil
-	}
+// MockOtherClientOtherUpdateAsyncCall describes an expected call to MockOtherClient.OtherUpdateAsync
+type MockOtherClientOtherUpdateAsyncCall struct {
+	*mock.Call
 }
 
-// OtherSignal executes a OtherSignal signal
-func (c *TestOtherClient) OtherSignal(ctx context.Context, workflowID string, runID string, req *OtherSignalRequest) error {
-	c.env.SignalWorkflow(OtherSignalSignalName, req)
-	return nil
+// Return specifies the values returned by the call
+func (c *MockOtherClientOtherUpdateAsyncCall) Return(handle OtherUpdateHandle, err error) *MockOtherClientOtherUpdateAsyncCall {
+	c.Call.Return(handle, err)
+	return c
 }
 
-// OtherUpdate executes a(n) mycompany.simple.Other.OtherUpdate update in the test environment
-func (c *TestOtherClient) OtherUpdate(ctx context.Context, workflowID string, runID string, req *OtherUpdateRequest, opts ...*OtherUpdateOptions) (*OtherUpdateResponse, error) {
-	options := NewOtherUpdateOptions()
-	if len(opts) > 0 && opts[0].opts != nil {
-		options = opts[0]
+// MockOtherWorkflowRun is a testify mock implementation of OtherWorkflowRun
+type MockOtherWorkflowRun struct {
+	mock.Mock
+}
+
+var _ OtherWorkflowRun = (*MockOtherWorkflowRun)(nil)
+
+// NewMockOtherWorkflowRun initializes a new MockOtherWorkflowRun that asserts its expectations when the test completes
+func NewMockOtherWorkflowRun(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOtherWorkflowRun {
+	m := &MockOtherWorkflowRun{}
+	m.Mock.Test(t)
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+	return m
+}
+
+// ID implements OtherWorkflowRun
+func (m *MockOtherWorkflowRun) ID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
 	}
-	options.opts.WaitPolicy = &v11.WaitPolicy{LifecycleStage: v1.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED}
-	handle, err := c.OtherUpdateAsync(ctx, workflowID, runID, req, options)
-	if err != nil {
-		return nil, err
+	return r0
+}
+
+// OnID registers an expectation for a call to ID with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockOtherWorkflowRun) OnID() *MockOtherWorkflowRunIDCall {
+	return &MockOtherWorkflowRunIDCall{Call: m.On("ID")}
+}
+
+// MockOtherWorkflowRunIDCall describes an expected call to MockOtherWorkflowRun.ID
+type MockOtherWorkflowRunIDCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockOtherWorkflowRunIDCall) Return(id string) *MockOtherWorkflowRunIDCall {
+	c.Call.Return(id)
+	return c
+}
+
+// RunID implements OtherWorkflowRun
+func (m *MockOtherWorkflowRun) RunID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
 	}
-	return handle.Get(ctx)
+	return r0
 }
 
-// OtherUpdateAsync executes a(n) mycompany.simple.Other.OtherUpdate update in the test environment
-func (c *TestOtherClient) OtherUpdateAsync(ctx context.Context, workflowID string, runID string, req *OtherUpdateRequest, opts ...*OtherUpdateOptions) (OtherUpdateHandle, error) {
-	options := &client.UpdateWorkflowWithOptionsRequest{}
-	if len(opts) > 0 && opts[0].opts != nil {
-		options = opts[0].opts
+// OnRunID registers an expectation for a call to RunID with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockOtherWorkflowRun) OnRunID() *MockOtherWorkflowRunRunIDCall {
+	return &MockOtherWorkflowRunRunIDCall{Call: m.On("RunID")}
+}
+
+// MockOtherWorkflowRunRunIDCall describes an expected call to MockOtherWorkflowRun.RunID
+type MockOtherWorkflowRunRunIDCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockOtherWorkflowRunRunIDCall) Return(runID string) *MockOtherWorkflowRunRunIDCall {
+	c.Call.Return(runID)
+	return c
+}
+
+// Get implements OtherWorkflowRun
+func (m *MockOtherWorkflowRun) Get(ctx context.Context) (*OtherWorkflowResponse, error) {
+	args := m.Called(ctx)
+	var r0 *OtherWorkflowResponse
+	if v := args.Get(0); v != nil {
+		r0 = v.(*OtherWorkflowResponse)
 	}
-	options.Args = []any{req}
-	options.RunID = runID
-	options.UpdateName = OtherUpdateUpdateName
-	options.WorkflowID = workflowID
-	if options.UpdateID == "" {
-		id, err := expression.EvalExpression(OtherUpdateIDExpression, req.ProtoReflect())
-		if err != nil {
-			return nil, fmt.Errorf("error evaluating %s id expression: %w", OtherUpdateUpdateName, err)
-		}
-		options.UpdateID = id
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
 	}
-	uc := testutil.NewUpdateCallbacks()
-	c.env.UpdateWorkflow(OtherUpdateUpdateName, uc, req)
-	return &testOtherUpdateHandle{
-		callbacks:  uc,
-		env:        c.env,
-		opts:       options,
-		runID:      runID,
-		workflowID: workflowID,
-		req:        req,
-	}, nil
+	return r0, r1
 }
 
-var _ OtherUpdateHandle = &testOtherUpdateHandle{}
+// OnGet registers an expectation for a call to Get with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockOtherWorkflowRun) OnGet(ctx any) *MockOtherWorkflowRunGetCall {
+	return &MockOtherWorkflowRunGetCall{Call: m.On("Get", ctx)}
+}
 
-// testOtherUpdateHandle provides an internal implementation of a(n) OtherUpdateHandle
-type testOtherUpdateHandle struct {
-	callbacks  *testutil.UpdateCallbacks
-	env        *testsuite.TestWorkflowEnvironment
-	opts       *client.UpdateWorkflowWithOptionsRequest
-	req        *OtherUpdateRequest
-	runID      string
-	workflowID string
+// MockOtherWorkflowRunGetCall describes an expected call to MockOtherWorkflowRun.Get
+type MockOtherWorkflowRunGetCall struct {
+	*mock.Call
 }
 
-// Get retrieves a test mycompany.simple.Other.OtherUpdate update result
-func (h *testOtherUpdateHandle) Get(ctx context.Context) (*OtherUpdateResponse, error) {
-	if resp, err := h.callbacks.Get(ctx); err != nil {
-		return nil, err
-	} else {
-		return resp.(*OtherUpdateResponse), nil
+// Return specifies the values returned by the call
+func (c *MockOtherWorkflowRunGetCall) Return(resp *OtherWorkflowResponse, err error) *MockOtherWorkflowRunGetCall {
+	c.Call.Return(resp, err)
+	return c
+}
+
+// MockOtherUpdateHandle is a testify mock implementation of OtherUpdateHandle
+type MockOtherUpdateHandle struct {
+	mock.Mock
+}
+
+var _ OtherUpdateHandle = (*MockOtherUpdateHandle)(nil)
+
+// NewMockOtherUpdateHandle initializes a new MockOtherUpdateHandle that asserts its expectations when the test completes
+func NewMockOtherUpdateHandle(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOtherUpdateHandle {
+	m := &MockOtherUpdateHandle{}
+	m.Mock.Test(t)
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+	return m
+}
+
+// WorkflowID implements OtherUpdateHandle
+func (m *MockOtherUpdateHandle) WorkflowID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
 	}
+	return r0
 }
 
-// RunID implementation
-func (h *testOtherUpdateHandle) RunID() string {
-	return h.runID
+// OnWorkflowID registers an expectation for a call to WorkflowID with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockOtherUpdateHandle) OnWorkflowID() *MockOtherUpdateHandleWorkflowIDCall {
+	return &MockOtherUpdateHandleWorkflowIDCall{Call: m.On("WorkflowID")}
 }
 
-// UpdateID implementation
-func (h *testOtherUpdateHandle) UpdateID() string {
-	if h.opts != nil {
-		return h.opts.UpdateID
+// MockOtherUpdateHandleWorkflowIDCall describes an expected call to MockOtherUpdateHandle.WorkflowID
+type MockOtherUpdateHandleWorkflowIDCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockOtherUpdateHandleWorkflowIDCall) Return(workflowID string) *MockOtherUpdateHandleWorkflowIDCall {
+	c.Call.Return(workflowID)
+	return c
+}
+
+// RunID implements OtherUpdateHandle
+func (m *MockOtherUpdateHandle) RunID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
 	}
-	return ""
+	return r0
 }
 
-// WorkflowID implementation
-func (h *testOtherUpdateHandle) WorkflowID() string {
-	return h.workflowID
+// OnRunID registers an expectation for a call to RunID with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockOtherUpdateHandle) OnRunID() *MockOtherUpdateHandleRunIDCall {
+	return &MockOtherUpdateHandleRunIDCall{Call: m.On("RunID")}
 }
 
-var _ OtherWorkflowRun = &testOtherWorkflowRun{}
+// MockOtherUpdateHandleRunIDCall describes an expected call to MockOtherUpdateHandle.RunID
+type MockOtherUpdateHandleRunIDCall struct {
+	*mock.Call
+}
 
-// testOtherWorkflowRun provides convenience methods for interacting with a(n) OtherWorkflow workflow in the test environment
-type testOtherWorkflowRun struct {
-	client    *TestOtherClient
-	env       *testsuite.TestWorkflowEnvironment
-	opts      *client.StartWorkflowOptions
-	req       *OtherWorkflowRequest
-	workflows OtherWorkflows
+// Return specifies the values returned by the call
+func (c *MockOtherUpdateHandleRunIDCall) Return(runID string) *MockOtherUpdateHandleRunIDCall {
+	c.Call.Return(runID)
+	return c
 }
 
-// Get retrieves a test OtherWorkflow workflow result
-func (r *testOtherWorkflowRun) Get(context.Context) (*OtherWorkflowResponse, error) {
-	r.env.ExecuteWorkflow(OtherWorkflowWorkflowName, r.req)
-	if !r.env.IsWorkflowCompleted() {
-		return nil, errors.New("workflow in progress")
+// UpdateID implements OtherUpdateHandle
+func (m *MockOtherUpdateHandle) UpdateID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
 	}
-	if err := r.env.GetWorkflowError(); err != nil {
-		return nil, err
+	return r0
+}
+
+// OnUpdateID registers an expectation for a call to UpdateID with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockOtherUpdateHandle) OnUpdateID() *MockOtherUpdateHandleUpdateIDCall {
+	return &MockOtherUpdateHandleUpdateIDCall{Call: m.On("UpdateID")}
+}
+
+// MockOtherUpdateHandleUpdateIDCall describes an expected call to MockOtherUpdateHandle.UpdateID
+type MockOtherUpdateHandleUpdateIDCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockOtherUpdateHandleUpdateIDCall) Return(updateID string) *MockOtherUpdateHandleUpdateIDCall {
+	c.Call.Return(updateID)
+	return c
+}
+
+// Get implements OtherUpdateHandle
+func (m *MockOtherUpdateHandle) Get(ctx context.Context) (*OtherUpdateResponse, error) {
+	args := m.Called(ctx)
+	var r0 *OtherUpdateResponse
+	if v := args.Get(0); v != nil {
+		r0 = v.(*OtherUpdateResponse)
 	}
-	var result OtherWorkflowResponse
-	if err := r.env.GetWorkflowResult(&result); err != nil {
-		return nil, err
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
 	}
-	return &result, nil
+	return r0, r1
 }
 
-// ID returns a test OtherWorkflow workflow run's workflow ID
-func (r *testOtherWorkflowRun) ID() string {
-	if r.opts != nil {
-		return r.opts.ID
-	}
-	return ""
+// OnGet registers an expectation for a call to Get with the given arguments, which may
+// be exact values, mock.Anything, or other testify argument matchers
+func (m *MockOtherUpdateHandle) OnGet(ctx any) *MockOtherUpdateHandleGetCall {
+	return &MockOtherUpdateHandleGetCall{Call: m.On("Get", ctx)}
 }
 
-// RunID noop implementation
-func (r *testOtherWorkflowRun) RunID() string {
-	return ""
+// MockOtherUpdateHandleGetCall describes an expected call to MockOtherUpdateHandle.Get
+type MockOtherUpdateHandleGetCall struct {
+	*mock.Call
+}
+
+// Return specifies the values returned by the call
+func (c *MockOtherUpdateHandleGetCall) Return(resp *OtherUpdateResponse, err error) *MockOtherUpdateHandleGetCall {
+	c.Call.Return(resp, err)
+	return c
 }
 
 // OtherCliOptions describes runtime configuration for mycompany.simple.Other cli
@@ -3921,6 +11145,10 @@ func newOtherCommands(options ...*OtherCliOptions) ([]*v2.Command, error) {
 					Usage:   "run workflow in the background and print workflow and execution id",
 					Aliases: []string{"d"},
 				},
+				&v2.StringFlag{
+					Name:  "cron",
+					Usage: "cron schedule for the workflow, overriding the default schedule if any",
+				},
 				&v2.StringFlag{
 					Name:  "some-val",
 					Usage: "set the value of the operation's \"SomeVal\" parameter",
@@ -3937,7 +11165,11 @@ func newOtherCommands(options ...*OtherCliOptions) ([]*v2.Command, error) {
 				if err != nil {
 					return fmt.Errorf("error unmarshalling request: %w", err)
 				}
-				run, err := client.OtherWorkflowAsync(cmd.Context, req)
+				workflowOpts := NewOtherWorkflowOptions()
+				if cmd.IsSet("cron") {
+					workflowOpts.WithCronSchedule(cmd.String("cron"))
+				}
+				run, err := client.OtherWorkflowAsync(cmd.Context, req, workflowOpts)
 				if err != nil {
 					return fmt.Errorf("error starting %s workflow: %w", OtherWorkflowWorkflowName, err)
 				}
@@ -3963,6 +11195,210 @@ func newOtherCommands(options ...*OtherCliOptions) ([]*v2.Command, error) {
 				}
 			},
 		},
+		// manages mycompany.simple.Other.OtherWorkflow workflow schedules,
+		{
+			Name:                   "other-workflow-schedule",
+			Usage:                  "manages mycompany.simple.Other.OtherWorkflow workflow schedules",
+			UseShortOptionHandling: true,
+			Before:                 opts.before,
+			After:                  opts.after,
+			Subcommands: []*v2.Command{
+				{
+					Name:  "create",
+					Usage: "creates a schedule that periodically starts a(n) mycompany.simple.Other.OtherWorkflow workflow",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+						&v2.StringSliceFlag{
+							Name:  "cron",
+							Usage: "cron expression describing when to start the workflow, overriding the default spec",
+						},
+						&v2.StringSliceFlag{
+							Name:  "interval",
+							Usage: "interval (e.g. 1h) describing how often to start the workflow, overriding the default spec",
+						},
+						&v2.StringFlag{
+							Name:  "some-val",
+							Usage: "set the value of the operation's \"SomeVal\" parameter",
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						req, err := unmarshalCliFlagsToOtherWorkflowRequest(cmd)
+						if err != nil {
+							return fmt.Errorf("error unmarshalling request: %w", err)
+						}
+						spec := client.ScheduleSpec{CronExpressions: cmd.StringSlice("cron")}
+						for _, interval := range cmd.StringSlice("interval") {
+							every, err := time.ParseDuration(interval)
+							if err != nil {
+								return fmt.Errorf("error parsing interval %q: %w", interval, err)
+							}
+							spec.Intervals = append(spec.Intervals, client.ScheduleIntervalSpec{Every: every})
+						}
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewOtherClient(c)
+						handle, err := client.CreateOtherWorkflowSchedule(cmd.Context, cmd.String("schedule-id"), spec, req)
+						if err != nil {
+							return fmt.Errorf("error creating schedule: %w", err)
+						}
+						fmt.Println("success")
+						fmt.Printf("schedule id: %s\n", handle.GetID())
+						return nil
+					},
+				},
+				{
+					Name:  "get",
+					Usage: "describes an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewOtherClient(c)
+						desc, err := client.GetOtherWorkflowSchedule(cmd.Context, cmd.String("schedule-id"))
+						if err != nil {
+							return fmt.Errorf("error describing schedule: %w", err)
+						}
+						if state := desc.Schedule.State; state != nil {
+							fmt.Printf("paused: %t\n", state.Paused)
+							fmt.Printf("note: %s\n", state.Note)
+						}
+						for _, t := range desc.Info.NextActionTimes {
+							fmt.Printf("next action: %s\n", t.Format(time.RFC3339))
+						}
+						for _, a := range desc.Info.RecentActions {
+							fmt.Printf("recent action: %s\n", a.ActualTime.Format(time.RFC3339))
+						}
+						return nil
+					},
+				},
+				{
+					Name:  "pause",
+					Usage: "pauses an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+						&v2.StringFlag{
+							Name:  "note",
+							Usage: "informative note describing the change",
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewOtherClient(c)
+						if err := client.PauseOtherWorkflowSchedule(cmd.Context, cmd.String("schedule-id"), cmd.String("note")); err != nil {
+							return fmt.Errorf("error executing pause schedule command: %w", err)
+						}
+						fmt.Println("success")
+						return nil
+					},
+				},
+				{
+					Name:  "unpause",
+					Usage: "unpauses an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+						&v2.StringFlag{
+							Name:  "note",
+							Usage: "informative note describing the change",
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewOtherClient(c)
+						if err := client.UnpauseOtherWorkflowSchedule(cmd.Context, cmd.String("schedule-id"), cmd.String("note")); err != nil {
+							return fmt.Errorf("error executing unpause schedule command: %w", err)
+						}
+						fmt.Println("success")
+						return nil
+					},
+				},
+				{
+					Name:  "trigger",
+					Usage: "immediately starts a workflow using an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewOtherClient(c)
+						if err := client.TriggerOtherWorkflowSchedule(cmd.Context, cmd.String("schedule-id")); err != nil {
+							return fmt.Errorf("error executing trigger schedule command: %w", err)
+						}
+						fmt.Println("success")
+						return nil
+					},
+				},
+				{
+					Name:  "delete",
+					Usage: "deletes an existing schedule",
+					Flags: []v2.Flag{
+						&v2.StringFlag{
+							Name:     "schedule-id",
+							Usage:    "schedule id",
+							Required: true,
+							Aliases:  []string{"s"},
+						},
+					},
+					Action: func(cmd *v2.Context) error {
+						c, err := opts.clientForCommand(cmd)
+						if err != nil {
+							return fmt.Errorf("error initializing client for command: %w", err)
+						}
+						defer c.Close()
+						client := NewOtherClient(c)
+						if err := client.DeleteOtherWorkflowSchedule(cmd.Context, cmd.String("schedule-id")); err != nil {
+							return fmt.Errorf("error executing delete schedule command: %w", err)
+						}
+						fmt.Println("success")
+						return nil
+					},
+				},
+			},
+		},
 	}
 	if opts.worker != nil {
 		commands = append(commands, []*v2.Command{
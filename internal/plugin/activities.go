@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/cludden/protoc-gen-go-temporal/pkg/expression"
 	g "github.com/dave/jennifer/jen"
 )
 
@@ -22,12 +23,12 @@ func (svc *Service) genActivitiesInterface(f *g.File) {
 				ParamsFunc(func(args *g.Group) {
 					args.Id("ctx").Qual("context", "Context")
 					if hasInput {
-						args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+						args.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
 					}
 				}).
 				ParamsFunc(func(returnVals *g.Group) {
 					if hasOutput {
-						returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+						returnVals.Op("*").Add(goIdent(method.Output.GoIdent))
 					}
 					returnVals.Error()
 				})
@@ -58,13 +59,13 @@ func (svc *Service) genActivityFutureGetMethod(f *g.File, activity string) {
 		Params(g.Id("ctx").Qual(workflowPkg, "Context")).
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasOutput {
-				returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+				returnVals.Op("*").Add(goIdent(method.Output.GoIdent))
 			}
 			returnVals.Error()
 		}).
 		BlockFunc(func(fn *g.Group) {
 			if hasOutput {
-				fn.Var().Id("resp").Id(method.Output.GoIdent.GoName)
+				fn.Var().Id("resp").Add(goIdent(method.Output.GoIdent))
 				fn.If(
 					g.Err().Op(":=").Id("f").Dot("Future").Dot("Get").Call(
 						g.Id("ctx"), g.Op("&").Id("resp"),
@@ -150,7 +151,7 @@ func (svc *Service) genActivityFunction(f *g.File, activity string, local, async
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual(workflowPkg, "Context")
 			if hasInput {
-				args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
 			}
 			if local {
 				args.Id("options").Op("...").Op("*").Id(toCamel("%sLocalActivityOptions", activity))
@@ -163,7 +164,7 @@ func (svc *Service) genActivityFunction(f *g.File, activity string, local, async
 				returnVals.Op("*").Id(fmt.Sprintf("%sFuture", method.GoName))
 			} else {
 				if hasOutput {
-					returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+					returnVals.Op("*").Add(goIdent(method.Output.GoIdent))
 				}
 				returnVals.Error()
 			}
@@ -205,7 +206,7 @@ func (svc *Service) genActivityFunction(f *g.File, activity string, local, async
 			})
 
 			// set default retry policy
-			if policy := opts.GetRetryPolicy(); policy != nil {
+			if policy := retryPolicyFor(opts.GetRetryPolicy(), opts.GetErrors()); policy != nil {
 				fn.If(g.Id("opts").Dot("opts").Dot("RetryPolicy").Op("==").Nil()).Block(
 					g.Id("opts").Dot("opts").Dot("RetryPolicy").Op("=").Op("&").Qual(temporalPkg, "RetryPolicy").ValuesFunc(func(fields *g.Group) {
 						if d := policy.GetInitialInterval(); d.IsValid() {
@@ -221,7 +222,11 @@ func (svc *Service) genActivityFunction(f *g.File, activity string, local, async
 							fields.Id("MaximumAttempts").Op(":").Lit(n)
 						}
 						if errs := policy.GetNonRetryableErrorTypes(); len(errs) > 0 {
-							fields.Id("NonRetryableErrorTypes").Op(":").Lit(errs)
+							fields.Id("NonRetryableErrorTypes").Op(":").Index().String().ValuesFunc(func(vals *g.Group) {
+								for _, errType := range errs {
+									vals.Lit(errType)
+								}
+							})
 						}
 					}),
 				)
@@ -255,6 +260,65 @@ func (svc *Service) genActivityFunction(f *g.File, activity string, local, async
 				)
 			}
 
+			// determine activity id if overridden, or unset and id expression available, without
+			// modifying the provided options so that they can be reused
+			if !local {
+				fn.Id("activityID").Op(":=").Id("opts").Dot("opts").Dot("ActivityID")
+				fn.If(g.Id("opts").Dot("activityID").Op("!=").Nil()).Block(
+					g.Id("activityID").Op("=").Op("*").Id("opts").Dot("activityID"),
+				)
+			}
+			if expr := opts.GetId(); expr != "" {
+				fn.If(g.Id("activityID").Op("==").Lit("")).BlockFunc(func(bl *g.Group) {
+					evalExpr := g.Qual(expressionPkg, "EvalExpression").CallFunc(func(args *g.Group) {
+						args.Id(toCamel("%sActivityIDExpression", activity))
+						if hasInput {
+							args.Id("req").Dot("ProtoReflect").Call()
+						} else {
+							args.Nil()
+						}
+					})
+					if parsed, err := expression.ParseExpression(expr); err == nil && parsed.IsDeterministic() {
+						// evaluate deterministic id expressions directly, which records no
+						// additional events in workflow history
+						bl.List(g.Id("id"), g.Id("idErr")).Op(":=").Add(evalExpr)
+						bl.If(g.Id("id").Op("==").Lit("").Op("&&").Id("idErr").Op("==").Nil()).Block(
+							g.Id("idErr").Op("=").Qual("errors", "New").Call(g.Lit("expression evaluated to an empty string")),
+						)
+					} else {
+						// evaluate nondeterministic id expressions as a side effect
+						bl.Var().Id("idErr").Error()
+						bl.Var().Id("id").String()
+						bl.Id("encoded").Op(":=").Qual(workflowPkg, "SideEffect").Call(
+							g.Id("ctx"),
+							g.Func().Params(g.Qual(workflowPkg, "Context")).Any().Block(
+								g.List(g.Id("id"), g.Err()).Op(":=").Add(evalExpr),
+								g.Id("idErr").Op("=").Err(),
+								g.Return(g.Id("id")),
+							),
+						)
+						bl.If(g.Err().Op(":=").Id("encoded").Dot("Get").Call(g.Op("&").Id("id")), g.Err().Op("!=").Nil()).Block(
+							g.Id("idErr").Op("=").Err(),
+						).Else().If(g.Id("id").Op("==").Lit("").Op("&&").Id("idErr").Op("==").Nil()).Block(
+							g.Id("idErr").Op("=").Qual("errors", "New").Call(g.Lit("expression evaluated to an empty string")),
+						)
+					}
+					bl.If(g.Id("idErr").Op("!=").Nil()).Block(
+						g.List(g.Id("f"), g.Id("settable")).Op(":=").Qual(workflowPkg, "NewFuture").Call(g.Id("ctx")),
+						g.Id("settable").Dot("SetError").Call(g.Qual("fmt", "Errorf").Call(g.Lit(fmt.Sprintf("error evaluating %q activity id expression: %%w", activity)), g.Id("idErr"))),
+						g.Id("future").Op(":=").Op("&").Id(toCamel("%sFuture", activity)).Values(g.Id("Future").Op(":").Id("f")),
+						g.ReturnFunc(func(returnVals *g.Group) {
+							if async {
+								returnVals.Add(g.Id("future"))
+							} else {
+								returnVals.Add(g.Id("future").Dot("Get").Call(g.Id("ctx")))
+							}
+						}),
+					)
+					bl.Id("activityID").Op("=").Id("id")
+				})
+			}
+
 			// inject ctx with activity options
 			if local {
 				fn.Id("ctx").Op("=").Qual(workflowPkg, "WithLocalActivityOptions").Call(
@@ -262,8 +326,10 @@ func (svc *Service) genActivityFunction(f *g.File, activity string, local, async
 				)
 
 			} else {
+				fn.Id("activityOpts").Op(":=").Op("*").Id("opts").Dot("opts")
+				fn.Id("activityOpts").Dot("ActivityID").Op("=").Id("activityID")
 				fn.Id("ctx").Op("=").Qual(workflowPkg, "WithActivityOptions").Call(
-					g.Id("ctx"), g.Op("*").Id("opts").Dot("opts"),
+					g.Id("ctx"), g.Id("activityOpts"),
 				)
 			}
 
@@ -310,16 +376,23 @@ func (svc *Service) genActivityFunction(f *g.File, activity string, local, async
 
 // genActivityRegisterAllFunction generates a Register<Service>Activities public function
 func (svc *Service) genActivityRegisterAllFunction(f *g.File) {
-	f.Commentf("Register%sActivities registers activities with a worker", svc.Service.GoName)
+	if len(svc.activitiesOrdered) == 0 {
+		f.Commentf("Register%sActivities registers activities with a worker", svc.Service.GoName)
+	} else {
+		f.Commentf("Register%sActivities registers activities with a worker, invoking the hooks of the given interceptors", svc.Service.GoName)
+	}
 	f.Func().Id(fmt.Sprintf("Register%sActivities", svc.Service.GoName)).
-		Params(
-			g.Id("r").Qual(workerPkg, "Registry"),
-			g.Id("activities").Id(toCamel("%sActivities", svc.Service.GoName)),
-		).
+		ParamsFunc(func(args *g.Group) {
+			args.Id("r").Qual(workerPkg, "ActivityRegistry")
+			args.Id("activities").Id(toCamel("%sActivities", svc.Service.GoName))
+			if len(svc.activitiesOrdered) > 0 {
+				args.Id("interceptors").Op("...").Id(toCamel("%sInterceptor", svc.Service.GoName))
+			}
+		}).
 		BlockFunc(func(fn *g.Group) {
 			for _, activity := range svc.activitiesOrdered {
 				fn.Id(fmt.Sprintf("Register%sActivity", activity)).Call(
-					g.Id("r"), g.Id("activities").Dot(activity),
+					g.Id("r"), g.Id("activities").Dot(activity), g.Id("interceptors").Op("..."),
 				)
 			}
 		})
@@ -328,35 +401,208 @@ func (svc *Service) genActivityRegisterAllFunction(f *g.File) {
 // genActivityRegisterOneFunction generates a Register<Activity> public function
 func (svc *Service) genActivityRegisterOneFunction(f *g.File, activity string) {
 	method := svc.methods[activity]
+	opts := svc.activities[activity]
 	hasInput := !isEmpty(method.Input)
 	hasOutput := !isEmpty(method.Output)
-	f.Commentf("Register%sActivity registers a %s activity", activity, svc.fqnForActivity(activity))
+	returnVals := func(returnVals *g.Group) {
+		if hasOutput {
+			returnVals.Op("*").Add(goIdent(method.Output.GoIdent))
+		}
+		returnVals.Error()
+	}
+
+	// wrap activity function to invoke interceptor hooks
+	intercepted := g.Func().
+		ParamsFunc(func(args *g.Group) {
+			args.Id("ctx").Qual("context", "Context")
+			if hasInput {
+				args.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
+			}
+		}).
+		ParamsFunc(returnVals).
+		BlockFunc(func(fn *g.Group) {
+			if svc.tracingEnabled() {
+				svc.genSpanStart(fn, "StartActivity", "RunActivity", g.Id(toCamel("%sActivityName", activity)), svc.genSpanAttributes(modeActivity, activity, method.Input, g.Id("req"),
+					g.Qual(tracingPkg, "ActivityNameKey").Dot("String").Call(g.Id(toCamel("%sActivityName", activity))),
+				))
+			}
+			fn.Id("interceptor").Dot(svc.activityHookName("Before", activity)).CallFunc(func(args *g.Group) {
+				args.Id("ctx")
+				if hasInput {
+					args.Id("req")
+				}
+			})
+			fn.ListFunc(func(vals *g.Group) {
+				if hasOutput {
+					vals.Id("resp")
+				}
+				vals.Err()
+			}).Op(":=").Id("fn").CallFunc(func(args *g.Group) {
+				args.Id("ctx")
+				if hasInput {
+					args.Id("req")
+				}
+			})
+			fn.Id("interceptor").Dot(svc.activityHookName("After", activity)).CallFunc(func(args *g.Group) {
+				args.Id("ctx")
+				if hasOutput {
+					args.Id("resp")
+				}
+				args.Err()
+			})
+			if svc.tracingEnabled() {
+				fn.Add(genSpanEnd(g.Err()))
+			}
+			fn.ReturnFunc(func(returnVals *g.Group) {
+				if hasOutput {
+					returnVals.Id("resp")
+				}
+				returnVals.Err()
+			})
+		})
+
+	// wrap activity function to record heartbeats automatically if enabled
+	impl := g.Id("impl")
+	if opts.GetAutoHeartbeat() {
+		impl = g.Func().
+			ParamsFunc(func(args *g.Group) {
+				args.Id("ctx").Qual("context", "Context")
+				if hasInput {
+					args.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
+				}
+			}).
+			ParamsFunc(returnVals).
+			BlockFunc(func(fn *g.Group) {
+				// seed automatic heartbeats with the details recorded by a previous attempt
+				var details []g.Code
+				if svc.lookupMessage(opts.GetHeartbeat()) != nil {
+					fn.Var().Id("details").Index().Any()
+					fn.If(
+						g.List(g.Id("d"), g.Id("ok")).Op(":=").Id(toCamel("Get%sHeartbeatDetails", activity)).Call(g.Id("ctx")),
+						g.Id("ok"),
+					).Block(
+						g.Id("details").Op("=").Append(g.Id("details"), g.Id("d")),
+					)
+					details = append(details, g.Id("details").Op("..."))
+				}
+				fn.List(g.Id("ctx"), g.Id("stop")).Op(":=").Qual(heartbeatPkg, "Auto").Call(append([]g.Code{g.Id("ctx")}, details...)...)
+				fn.Defer().Id("stop").Call()
+				fn.Return(g.Id("impl").CallFunc(func(args *g.Group) {
+					args.Id("ctx")
+					if hasInput {
+						args.Id("req")
+					}
+				}))
+			})
+	}
+
+	f.Commentf("Register%sActivity registers a %s activity, invoking the hooks of the given interceptors", activity, svc.fqnForActivity(activity))
 	f.Func().Id(fmt.Sprintf("Register%sActivity", activity)).
 		Params(
-			g.Id("r").Qual(workerPkg, "Registry"),
+			g.Id("r").Qual(workerPkg, "ActivityRegistry"),
 			g.Id("fn").Func().
 				ParamsFunc(func(args *g.Group) {
 					args.Qual("context", "Context")
 					if hasInput {
-						args.Op("*").Id(method.Input.GoIdent.GoName)
+						args.Op("*").Add(goIdent(method.Input.GoIdent))
 					}
 				}).
-				ParamsFunc(func(returnVals *g.Group) {
-					if hasOutput {
-						returnVals.Op("*").Id(method.Output.GoIdent.GoName)
-					}
-					returnVals.Error()
-				}),
+				ParamsFunc(returnVals),
+			g.Id("interceptors").Op("...").Id(toCamel("%sInterceptor", svc.Service.GoName)),
 		).
 		Block(
+			g.Id("interceptor").Op(":=").Id(toLowerCamel("%sInterceptors", svc.Service.GoName)).Call(g.Id("interceptors")),
+			g.Id("impl").Op(":=").Add(intercepted),
 			g.Id("r").Dot("RegisterActivityWithOptions").Call(
-				g.Id("fn"), g.Qual(activityPkg, "RegisterOptions").Block(
+				impl, g.Qual(activityPkg, "RegisterOptions").Block(
 					g.Id("Name").Op(":").Id(toCamel("%sActivityName", activity)).Op(","),
 				),
 			),
 		)
 }
 
+// genActivityHeartbeatFunctions generates public Record<Activity>Heartbeat,
+// Get<Activity>HeartbeatDetails, and Get<Activity>LastHeartbeatDetails functions
+func (svc *Service) genActivityHeartbeatFunctions(f *g.File, activity string) {
+	message := svc.lookupMessage(svc.activities[activity].GetHeartbeat())
+	if message == nil {
+		return
+	}
+	fqn := svc.fqnForActivity(activity)
+
+	f.Commentf("Record%sHeartbeat records a typed heartbeat from within a(n) %s activity", activity, fqn)
+	f.Func().Id(toCamel("Record%sHeartbeat", activity)).
+		Params(
+			g.Id("ctx").Qual("context", "Context"),
+			g.Id("details").Op("*").Add(goIdent(message.GoIdent)),
+		).
+		Block(
+			g.Qual(heartbeatPkg, "Record").Call(g.Id("ctx"), g.Id("details")),
+		)
+
+	f.Commentf("Get%sHeartbeatDetails returns the typed details of the last heartbeat recorded by a", activity)
+	f.Commentf("previous attempt of a(n) %s activity, if any", fqn)
+	f.Func().Id(toCamel("Get%sHeartbeatDetails", activity)).
+		Params(g.Id("ctx").Qual("context", "Context")).
+		Params(g.Op("*").Add(goIdent(message.GoIdent)), g.Bool()).
+		Block(
+			g.If(g.Op("!").Qual(activityPkg, "HasHeartbeatDetails").Call(g.Id("ctx"))).Block(
+				g.Return(g.Nil(), g.False()),
+			),
+			g.Var().Id("details").Op("*").Add(goIdent(message.GoIdent)),
+			g.If(
+				g.Err().Op(":=").Qual(activityPkg, "GetHeartbeatDetails").Call(g.Id("ctx"), g.Op("&").Id("details")),
+				g.Err().Op("!=").Nil(),
+			).Block(
+				g.Return(g.Nil(), g.False()),
+			),
+			g.Return(g.Id("details"), g.True()),
+		)
+
+	f.Commentf("Get%sLastHeartbeatDetails returns the typed details of the last heartbeat recorded by", activity)
+	f.Commentf("a(n) %s activity that timed out, if any", fqn)
+	f.Func().Id(toCamel("Get%sLastHeartbeatDetails", activity)).
+		Params(g.Id("err").Error()).
+		Params(g.Op("*").Add(goIdent(message.GoIdent)), g.Bool()).
+		Block(
+			g.Var().Id("timeoutErr").Op("*").Qual(temporalPkg, "TimeoutError"),
+			g.If(g.Op("!").Qual("errors", "As").Call(g.Err(), g.Op("&").Id("timeoutErr")).Op("||").Op("!").Id("timeoutErr").Dot("HasLastHeartbeatDetails").Call()).Block(
+				g.Return(g.Nil(), g.False()),
+			),
+			g.Var().Id("details").Op("*").Add(goIdent(message.GoIdent)),
+			g.If(
+				g.Err().Op(":=").Id("timeoutErr").Dot("LastHeartbeatDetails").Call(g.Op("&").Id("details")),
+				g.Err().Op("!=").Nil(),
+			).Block(
+				g.Return(g.Nil(), g.False()),
+			),
+			g.Return(g.Id("details"), g.True()),
+		)
+}
+
+// genActivityFutureLastHeartbeatDetailsMethod generates a <Activity>Future's
+// LastHeartbeatDetails method
+func (svc *Service) genActivityFutureLastHeartbeatDetailsMethod(f *g.File, activity string) {
+	message := svc.lookupMessage(svc.activities[activity].GetHeartbeat())
+	if message == nil {
+		return
+	}
+	future := toCamel("%sFuture", activity)
+
+	f.Comment("LastHeartbeatDetails blocks on the activity's completion, returning the typed details of")
+	f.Comment("the last heartbeat recorded by the activity if it timed out")
+	f.Func().
+		Params(g.Id("f").Op("*").Id(future)).
+		Id("LastHeartbeatDetails").
+		Params(g.Id("ctx").Qual(workflowPkg, "Context")).
+		Params(g.Op("*").Add(goIdent(message.GoIdent)), g.Bool()).
+		Block(
+			g.Return(g.Id(toCamel("Get%sLastHeartbeatDetails", activity)).Call(
+				g.Id("f").Dot("Future").Dot("Get").Call(g.Id("ctx"), g.Nil()),
+			)),
+		)
+}
+
 // genActivityLocalOptions generates an <Activity>LocalActivityOptions struct
 func (svc *Service) genActivityLocalOptions(f *g.File, activity string) {
 	typeName := toCamel("%sLocalActivityOptions", activity)
@@ -371,12 +617,12 @@ func (svc *Service) genActivityLocalOptions(f *g.File, activity string) {
 			ParamsFunc(func(args *g.Group) {
 				args.Qual("context", "Context")
 				if hasInput {
-					args.Op("*").Id(method.Input.GoIdent.GoName)
+					args.Op("*").Add(goIdent(method.Input.GoIdent))
 				}
 			}).
 			ParamsFunc(func(returnVals *g.Group) {
 				if hasOutput {
-					returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+					returnVals.Op("*").Add(goIdent(method.Output.GoIdent))
 				}
 				returnVals.Error()
 			}),
@@ -404,12 +650,12 @@ func (svc *Service) genActivityLocalOptions(f *g.File, activity string) {
 				ParamsFunc(func(args *g.Group) {
 					args.Qual("context", "Context")
 					if hasInput {
-						args.Op("*").Id(method.Input.GoIdent.GoName)
+						args.Op("*").Add(goIdent(method.Input.GoIdent))
 					}
 				}).
 				ParamsFunc(func(returnVals *g.Group) {
 					if hasOutput {
-						returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+						returnVals.Op("*").Add(goIdent(method.Output.GoIdent))
 					}
 					returnVals.Error()
 				}),
@@ -443,6 +689,7 @@ func (svc *Service) genActivityOptions(f *g.File, activity string) {
 	f.Commentf("%s provides configuration for a(n) %s activity", typeName, svc.fqnForActivity(activity))
 	f.Type().Id(typeName).Struct(
 		g.Id("opts").Op("*").Qual(workflowPkg, "ActivityOptions"),
+		g.Id("activityID").Op("*").String(),
 	)
 
 	// generate New<Activity>ActivityOptions method
@@ -469,4 +716,16 @@ func (svc *Service) genActivityOptions(f *g.File, activity string) {
 			g.Id("opts").Dot("opts").Op("=").Op("&").Id("options"),
 			g.Return(g.Id("opts")),
 		)
+
+	// generate WithActivityID method
+	f.Comment("WithActivityID overrides the default activity ID")
+	f.Func().
+		Params(g.Id("opts").Op("*").Id(typeName)).
+		Id("WithActivityID").
+		Params(g.Id("id").String()).
+		Op("*").Id(typeName).
+		Block(
+			g.Id("opts").Dot("activityID").Op("=").Op("&").Id("id"),
+			g.Return(g.Id("opts")),
+		)
 }
@@ -36,6 +36,7 @@ type Cli struct {
 // renderCLI generates cli resources
 func (svc *Service) renderCLI(f *g.File) {
 	svc.genCliOptionsImpl(f)
+	svc.genCliWorkerOptions(f)
 	svc.genCliNew(f)
 	svc.genCliNewCommand(f)
 	svc.genCliNewCommands(f)
@@ -90,6 +91,21 @@ func (svc *Service) renderCLI(f *g.File) {
 		unmarshallers[svc.methods[workflow].Input.GoIdent.GoName] = struct{}{}
 		svc.genCliUnmarshalMessage(f, svc.methods[workflow].Input)
 	}
+
+	// generate signal request unmarshallers for signal-with-start signals defined by other services
+	for _, workflow := range svc.workflowsOrdered {
+		for _, signalOpts := range svc.workflows[workflow].GetSignal() {
+			owner, signal := svc.lookupRef(signalOpts.GetRef())
+			if !signalOpts.GetStart() || owner == svc || isEmpty(owner.methods[signal].Input) {
+				continue
+			}
+			if _, ok := unmarshallers[owner.methods[signal].Input.GoIdent.GoName]; ok {
+				continue
+			}
+			unmarshallers[owner.methods[signal].Input.GoIdent.GoName] = struct{}{}
+			svc.genCliUnmarshalMessage(f, owner.methods[signal].Input)
+		}
+	}
 }
 
 // genCliFlagForField generates a cli flag for a message field
@@ -283,6 +299,7 @@ func (svc *Service) genCliNewCommands(f *g.File) {
 				// generate client workflow methods
 				for _, workflow := range svc.workflowsOrdered {
 					svc.genCliWorkflowCommand(cmds, workflow)
+					svc.genCliScheduleCommand(cmds, workflow)
 					for _, signal := range svc.workflows[workflow].GetSignal() {
 						if !signal.GetStart() {
 							continue
@@ -402,6 +419,25 @@ func (svc *Service) genCliOptionsImpl(f *g.File) {
 		)
 }
 
+// genCliWorkerOptions generates a New<Service>CliWorkerOptions function that returns
+// worker options derived from the worker command flags
+func (svc *Service) genCliWorkerOptions(f *g.File) {
+	if len(svc.sessionActivities()) == 0 {
+		return
+	}
+	functionName := toCamel("New%sCliWorkerOptions", svc.Service.GoName)
+	f.Commentf("%s returns the options for a %s worker initialized by the cli", functionName, svc.Service.Desc.FullName())
+	f.Comment("worker command, with EnableSessionWorker set via the --enable-session-worker flag")
+	f.Func().Id(functionName).
+		Params(g.Id("cmd").Op("*").Qual(cliPkg, "Context")).
+		Qual(workerPkg, "Options").
+		Block(
+			g.Return(g.Qual(workerPkg, "Options").Values(g.Dict{
+				g.Id("EnableSessionWorker"): g.Id("cmd").Dot("Bool").Call(g.Lit("enable-session-worker")),
+			})),
+		)
+}
+
 // genCliPrintMessage serializes a proto message as json and pretty prints it
 func genCliPrintMessage(b *g.Group, varName string) {
 	b.List(g.Id("b"), g.Err()).Op(":=").Qual(protojsonPkg, "Marshal").Call(g.Id(varName))
@@ -467,7 +503,7 @@ func (svc *Service) genCliQueryCommand(cmds *g.Group, query string) {
 				g.Return(g.Qual("fmt", "Errorf").Call(g.Lit("error initializing client for command: %w"), g.Err())),
 			)
 			fn.Defer().Id("c").Dot("Close").Call()
-			fn.Id("client").Op(":=").Id(toCamel("New%sClient", svc.Service.GoName)).Call(g.Id("c"))
+			fn.Id("client").Op(":=").Add(svc.qual(toCamel("New%sClient", svc.Service.GoName))).Call(g.Id("c"))
 
 			// unmarshal input
 			if hasInput {
@@ -497,7 +533,7 @@ func (svc *Service) genCliQueryCommand(cmds *g.Group, query string) {
 					g.Err().Op("!=").Nil(),
 				).
 				Block(
-					g.Return(g.Qual("fmt", "Errorf").Call(g.Lit("error executing %q query: %w"), g.Id(fmt.Sprintf("%sQueryName", query)), g.Err())),
+					g.Return(g.Qual("fmt", "Errorf").Call(g.Lit("error executing %q query: %w"), svc.qual(fmt.Sprintf("%sQueryName", query)), g.Err())),
 				).
 				Else().
 				BlockFunc(func(b *g.Group) {
@@ -561,7 +597,7 @@ func (svc *Service) genCliSignalCommand(cmds *g.Group, signal string) {
 				g.Return(g.Qual("fmt", "Errorf").Call(g.Lit("error initializing client for command: %w"), g.Err())),
 			)
 			fn.Defer().Id("c").Dot("Close").Call()
-			fn.Id("client").Op(":=").Id(toCamel("New%sClient", svc.Service.GoName)).Call(g.Id("c"))
+			fn.Id("client").Op(":=").Add(svc.qual(toCamel("New%sClient", svc.Service.GoName))).Call(g.Id("c"))
 
 			// unmarshal input
 			if hasInput {
@@ -583,7 +619,7 @@ func (svc *Service) genCliSignalCommand(cmds *g.Group, signal string) {
 				}),
 				g.Err().Op("!=").Nil(),
 			).Block(
-				g.Return(g.Qual("fmt", "Errorf").Call(g.Lit("error sending %q signal: %w"), g.Id(fmt.Sprintf("%sSignalName", signal)), g.Err())),
+				g.Return(g.Qual("fmt", "Errorf").Call(g.Lit("error sending %q signal: %w"), svc.qual(fmt.Sprintf("%sSignalName", signal)), g.Err())),
 			)
 
 			// print response
@@ -648,7 +684,7 @@ func (svc *Service) genCliUpdateCommand(f *g.Group, update string) {
 				g.Return(g.Qual("fmt", "Errorf").Call(g.Lit("error initializing client for command: %w"), g.Err())),
 			)
 			fn.Defer().Id("c").Dot("Close").Call()
-			fn.Id("client").Op(":=").Id(toCamel("New%sClient", svc.Service.GoName)).Call(g.Id("c"))
+			fn.Id("client").Op(":=").Add(svc.qual(toCamel("New%sClient", svc.Service.GoName))).Call(g.Id("c"))
 
 			// unmarshal input
 			if hasInput {
@@ -669,7 +705,7 @@ func (svc *Service) genCliUpdateCommand(f *g.Group, update string) {
 				}
 			})
 			fn.If(g.Err().Op("!=").Nil()).Block(
-				g.Return(g.Qual("fmt", "Errorf").Call(g.Lit("error executing %s update: %w"), g.Id(fmt.Sprintf("%sUpdateName", update)), g.Err())),
+				g.Return(g.Qual("fmt", "Errorf").Call(g.Lit("error executing %s update: %w"), svc.qual(fmt.Sprintf("%sUpdateName", update)), g.Err())),
 			)
 
 			// handle async invocation
@@ -715,10 +751,10 @@ func (svc *Service) genCliUnmarshalMessage(f *g.File, msg *protogen.Message) {
 	f.Func().Id(fnName).
 		Params(g.Id("cmd").Op("*").Qual(cliPkg, "Context")).
 		Params(
-			g.Op("*").Id(name),
+			g.Op("*").Add(goIdent(msg.GoIdent)),
 			g.Error(),
 		).BlockFunc(func(fn *g.Group) {
-		fn.Var().Id("result").Id(name)
+		fn.Var().Id("result").Add(goIdent(msg.GoIdent))
 		fn.Var().Id("hasValues").Bool()
 		for _, field := range msg.Fields {
 			flag := strcase.ToKebab(field.GoName)
@@ -730,7 +766,7 @@ func (svc *Service) genCliUnmarshalMessage(f *g.File, msg *protogen.Message) {
 				case field.Desc.IsList():
 					fallthrough
 				case field.Desc.IsMap():
-					b.Var().Id("tmp").Id(name)
+					b.Var().Id("tmp").Add(goIdent(msg.GoIdent))
 					b.If(
 						g.Err().Op(":=").Qual(protojsonPkg, "Unmarshal").Call(
 							g.Index().Byte().Call(g.Qual("fmt", "Sprintf").Call(g.Lit(fmt.Sprintf(`{"%s":%%s}`, field.Desc.JSONName())), g.Id("cmd").Dot("String").Call(g.Lit(flag)))),
@@ -756,11 +792,11 @@ func (svc *Service) genCliUnmarshalMessage(f *g.File, msg *protogen.Message) {
 				case protoreflect.DoubleKind:
 					b.Id("result").Dot(field.GoName).Op("=").Id("cmd").Dot("Float64").Call(g.Lit(flag))
 				case protoreflect.EnumKind:
-					b.List(g.Id("v"), g.Id("ok")).Op(":=").Id(fmt.Sprintf("%s_value", field.Enum.GoIdent.GoName)).Index(g.Id("cmd").Dot("String").Call(g.Lit(flag)))
+					b.List(g.Id("v"), g.Id("ok")).Op(":=").Qual(string(field.Enum.GoIdent.GoImportPath), fmt.Sprintf("%s_value", field.Enum.GoIdent.GoName)).Index(g.Id("cmd").Dot("String").Call(g.Lit(flag)))
 					b.If(g.Op("!").Id("ok")).Block(
 						g.Return(g.Nil(), g.Qual("fmt", "Errorf").Call(g.Lit(fmt.Sprintf("unsupported enum value for %q flag: %%q", flag)), g.Id("cmd").Dot("String").Call(g.Lit(flag)))),
 					)
-					b.Id("result").Dot(field.GoName).Op("=").Id(field.Enum.GoIdent.GoName).Call(g.Id("v"))
+					b.Id("result").Dot(field.GoName).Op("=").Add(goIdent(field.Enum.GoIdent)).Call(g.Id("v"))
 				case protoreflect.Fixed32Kind, protoreflect.Uint32Kind:
 					b.Id("result").Dot(field.GoName).Op("=").Uint32().Call(g.Id("cmd").Dot("Uint64").Call(g.Lit(flag)))
 				case protoreflect.Fixed64Kind, protoreflect.Uint64Kind:
@@ -773,11 +809,7 @@ func (svc *Service) genCliUnmarshalMessage(f *g.File, msg *protogen.Message) {
 					b.Id("result").Dot(field.GoName).Op("=").Id("cmd").Dot("Int64").Call(g.Lit(flag))
 				case protoreflect.GroupKind:
 				case protoreflect.MessageKind:
-					if field.Message.GoIdent.GoImportPath != svc.File.GoImportPath {
-						b.Var().Id("v").Qual(string(field.Message.GoIdent.GoImportPath), field.Message.GoIdent.GoName)
-					} else {
-						b.Var().Id("v").Id(field.Message.GoIdent.GoName)
-					}
+					b.Var().Id("v").Add(goIdent(field.Message.GoIdent))
 					b.If(g.Err().Op(":=").Qual(protojsonPkg, "Unmarshal").Call(g.Index().Byte().Call(g.Id("cmd").Dot("String").Call(g.Lit(flag))), g.Op("&").Id("v")), g.Err().Op("!=").Nil()).Block(
 						g.Return(g.Nil(), g.Qual("fmt", "Errorf").Call(g.Lit(fmt.Sprintf("error unmarhsalling %q flag: %%w", flag)), g.Err())),
 					)
@@ -802,6 +834,17 @@ func (svc *Service) genCliWorkerCommand(f *g.Group) {
 		cmd.Id("UseShortOptionHandling").Op(":").True()
 		cmd.Id("Before").Op(":").Id("opts").Dot("before")
 		cmd.Id("After").Op(":").Id("opts").Dot("after")
+		// add session worker flag, which New<Service>CliWorkerOptions uses to set
+		// worker.Options.EnableSessionWorker
+		if len(svc.sessionActivities()) > 0 {
+			cmd.Id("Flags").Op(":").Index().Qual(cliPkg, "Flag").CustomFunc(multiLineValues, func(flags *g.Group) {
+				flags.Op("&").Qual(cliPkg, "BoolFlag").CustomFunc(multiLineValues, func(fields *g.Group) {
+					fields.Id("Name").Op(":").Lit("enable-session-worker")
+					fields.Id("Usage").Op(":").Lit("enable the session worker required by session activities")
+					fields.Id("Value").Op(":").True()
+				})
+			})
+		}
 		cmd.Id("Action").Op(":").Func().Params(g.Id("cmd").Op("*").Qual(cliPkg, "Context")).Error().BlockFunc(func(fn *g.Group) {
 			// initialize client
 			fn.List(g.Id("c"), g.Err()).Op(":=").Id("opts").Dot("clientForCommand").Call(g.Id("cmd"))
@@ -858,6 +901,11 @@ func (svc *Service) genCliWorkflowCommand(f *g.Group, workflow string) {
 				fields.Id("Usage").Op(":").Lit(strings.TrimSpace("run workflow in the background and print workflow and execution id"))
 				fields.Id("Aliases").Op(":").Index().String().Values(g.Lit("d"))
 			})
+			// add cron flag
+			flags.Op("&").Qual(cliPkg, "StringFlag").CustomFunc(multiLineValues, func(fields *g.Group) {
+				fields.Id("Name").Op(":").Lit("cron")
+				fields.Id("Usage").Op(":").Lit("cron schedule for the workflow, overriding the default schedule if any")
+			})
 			if hasInput {
 				// add request flags
 				for _, field := range method.Input.Fields {
@@ -872,7 +920,7 @@ func (svc *Service) genCliWorkflowCommand(f *g.Group, workflow string) {
 				g.Return(g.Qual("fmt", "Errorf").Call(g.Lit("error initializing client for command: %w"), g.Err())),
 			)
 			fn.Defer().Id("c").Dot("Close").Call()
-			fn.Id("client").Op(":=").Id(toCamel("New%sClient", svc.Service.GoName)).Call(g.Id("c"))
+			fn.Id("client").Op(":=").Add(svc.qual(toCamel("New%sClient", svc.Service.GoName))).Call(g.Id("c"))
 
 			// unmarshal input
 			if hasInput {
@@ -883,15 +931,22 @@ func (svc *Service) genCliWorkflowCommand(f *g.Group, workflow string) {
 				)
 			}
 
+			// initialize workflow options
+			fn.Id("workflowOpts").Op(":=").Add(svc.qual(toCamel("New%sOptions", workflow))).Call()
+			fn.If(g.Id("cmd").Dot("IsSet").Call(g.Lit("cron"))).Block(
+				g.Id("workflowOpts").Dot("WithCronSchedule").Call(g.Id("cmd").Dot("String").Call(g.Lit("cron"))),
+			)
+
 			// execute operation
 			fn.List(g.Id("run"), g.Err()).Op(":=").Id("client").Dot(fmt.Sprintf("%sAsync", workflow)).CallFunc(func(args *g.Group) {
 				args.Id("cmd").Dot("Context")
 				if hasInput {
 					args.Id("req")
 				}
+				args.Id("workflowOpts")
 			})
 			fn.If(g.Err().Op("!=").Nil()).Block(
-				g.Return(g.Qual("fmt", "Errorf").Call(g.Lit("error starting %s workflow: %w"), g.Id(fmt.Sprintf("%sWorkflowName", workflow)), g.Err())),
+				g.Return(g.Qual("fmt", "Errorf").Call(g.Lit("error starting %s workflow: %w"), svc.qual(fmt.Sprintf("%sWorkflowName", workflow)), g.Err())),
 			)
 
 			// handle async invocation
@@ -929,11 +984,12 @@ func (svc *Service) genCliWorkflowCommand(f *g.Group, workflow string) {
 }
 
 // genCliWorkflowWithSignalCommand generates a <Workflow>-with-<Signal> command
-func (svc *Service) genCliWorkflowWithSignalCommand(cmds *g.Group, workflow, signal string) {
+func (svc *Service) genCliWorkflowWithSignalCommand(cmds *g.Group, workflow, ref string) {
 	method := svc.methods[workflow]
 	hasInput := !isEmpty(method.Input)
 	hasOutput := !isEmpty(method.Output)
-	handler := svc.methods[signal]
+	owner, signal := svc.lookupRef(ref)
+	handler := owner.methods[signal]
 	hasSignalInput := !isEmpty(handler.Input)
 
 	cmdName := strcase.ToKebab(strings.Join([]string{workflow, "with", signal}, "-"))
@@ -983,7 +1039,7 @@ func (svc *Service) genCliWorkflowWithSignalCommand(cmds *g.Group, workflow, sig
 				g.Return(g.Qual("fmt", "Errorf").Call(g.Lit("error initializing client for command: %w"), g.Err())),
 			)
 			fn.Defer().Id("c").Dot("Close").Call()
-			fn.Id("client").Op(":=").Id(toCamel("New%sClient", svc.Service.GoName)).Call(g.Id("c"))
+			fn.Id("client").Op(":=").Add(svc.qual(toCamel("New%sClient", svc.Service.GoName))).Call(g.Id("c"))
 
 			// unmarshal request
 			if hasInput {
@@ -1014,7 +1070,7 @@ func (svc *Service) genCliWorkflowWithSignalCommand(cmds *g.Group, workflow, sig
 				}
 			})
 			fn.If(g.Err().Op("!=").Nil()).Block(
-				g.Return(g.Qual("fmt", "Errorf").Call(g.Lit("error starting %s workflow with %s signal: %w"), g.Id(fmt.Sprintf("%sWorkflowName", workflow)), g.Id(fmt.Sprintf("%sSignalName", signal)), g.Err())),
+				g.Return(g.Qual("fmt", "Errorf").Call(g.Lit("error starting %s workflow with %s signal: %w"), svc.qual(fmt.Sprintf("%sWorkflowName", workflow)), owner.qual(fmt.Sprintf("%sSignalName", signal)), g.Err())),
 			)
 
 			// handle async invocation
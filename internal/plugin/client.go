@@ -6,7 +6,9 @@ import (
 	"strings"
 
 	temporalv1 "github.com/cludden/protoc-gen-go-temporal/gen/temporal/v1"
+	"github.com/cludden/protoc-gen-go-temporal/pkg/expression"
 	g "github.com/dave/jennifer/jen"
+	"google.golang.org/protobuf/compiler/protogen"
 )
 
 // genClientImpl generates a <service>Client implementation
@@ -18,6 +20,12 @@ func (svc *Service) genClientImpl(f *g.File) {
 		Id(typeName).
 		StructFunc(func(fields *g.Group) {
 			fields.Id("client").Qual(clientPkg, "Client")
+			if svc.hasClientInterceptor() {
+				fields.Id("interceptors").Id(toLowerCamel("%sClientInterceptors", svc.Service.GoName))
+			}
+			if svc.hasMemo() {
+				fields.Id("dataConverter").Qual(converterPkg, "DataConverter")
+			}
 		})
 }
 
@@ -27,48 +35,79 @@ func (svc *Service) genClientImplConstructor(f *g.File) {
 	implName := toLowerCamel("%sClient", svc.Service.GoName)
 	interfaceName := toCamel("%sClient", svc.Service.GoName)
 
-	f.Commentf("%s initializes a new %s client", methodName, svc.Service.Desc.FullName())
+	hasInterceptor := svc.hasClientInterceptor()
+	interceptorsParam := func(args *g.Group) {
+		if hasInterceptor {
+			args.Id("interceptors").Op("...").Id(toCamel("%sClientInterceptor", svc.Service.GoName))
+		}
+	}
+	implValues := func(dataConverter g.Code) func(fields *g.Group) {
+		return func(fields *g.Group) {
+			fields.Id("client").Op(":").Id("c")
+			if hasInterceptor {
+				fields.Id("interceptors").Op(":").Id("interceptors")
+			}
+			if svc.hasMemo() {
+				fields.Id("dataConverter").Op(":").Add(dataConverter)
+			}
+		}
+	}
+
+	if hasInterceptor {
+		f.Commentf("%s initializes a new %s client that invokes the hooks of the given interceptors", methodName, svc.Service.Desc.FullName())
+	} else {
+		f.Commentf("%s initializes a new %s client", methodName, svc.Service.Desc.FullName())
+	}
 	f.Func().
 		Id(methodName).
-		Params(
-			g.Id("c").Qual(clientPkg, "Client"),
-		).
+		ParamsFunc(func(args *g.Group) {
+			args.Id("c").Qual(clientPkg, "Client")
+			interceptorsParam(args)
+		}).
 		Params(
 			g.Id(interfaceName),
 		).
 		Block(
 			g.Return(
-				g.Op("&").Id(implName).Values(
-					g.Id("client").Op(":").Id("c"),
-				),
+				g.Op("&").Id(implName).ValuesFunc(implValues(g.Qual(converterPkg, "GetDefaultDataConverter").Call())),
 			),
 		)
 
 	methodName += "WithOptions"
-	f.Commentf("%s initializes a new %s client with the given options", methodName, svc.Service.GoName)
+	if hasInterceptor {
+		f.Commentf("%s initializes a new %s client with the given options that invokes the hooks of the given interceptors", methodName, svc.Service.GoName)
+	} else {
+		f.Commentf("%s initializes a new %s client with the given options", methodName, svc.Service.GoName)
+	}
 	f.Func().
 		Id(methodName).
-		Params(
-			g.Id("c").Qual(clientPkg, "Client"),
-			g.Id("opts").Qual(clientPkg, "Options"),
-		).
+		ParamsFunc(func(args *g.Group) {
+			args.Id("c").Qual(clientPkg, "Client")
+			args.Id("opts").Qual(clientPkg, "Options")
+			interceptorsParam(args)
+		}).
 		Params(
 			g.Id(interfaceName),
 			g.Error(),
 		).
-		Block(
-			g.Var().Err().Error(),
-			g.List(g.Id("c"), g.Err()).Op("=").Qual(clientPkg, "NewClientFromExisting").Call(g.Id("c"), g.Id("opts")),
-			g.If().Err().Op("!=").Nil().Block(
+		BlockFunc(func(fn *g.Group) {
+			fn.Var().Err().Error()
+			fn.List(g.Id("c"), g.Err()).Op("=").Qual(clientPkg, "NewClientFromExisting").Call(g.Id("c"), g.Id("opts"))
+			fn.If().Err().Op("!=").Nil().Block(
 				g.Return(g.Nil(), g.Qual("fmt", "Errorf").Call(g.Lit("error initializing client with options: %w"), g.Err())),
-			),
-			g.Return(
-				g.Op("&").Id(implName).Values(
-					g.Id("client").Op(":").Id("c"),
-				),
+			)
+			if svc.hasMemo() {
+				// decode memos with the same data converter used to encode them
+				fn.Id("dataConverter").Op(":=").Id("opts").Dot("DataConverter")
+				fn.If(g.Id("dataConverter").Op("==").Nil()).Block(
+					g.Id("dataConverter").Op("=").Qual(converterPkg, "GetDefaultDataConverter").Call(),
+				)
+			}
+			fn.Return(
+				g.Op("&").Id(implName).ValuesFunc(implValues(g.Id("dataConverter"))),
 				g.Nil(),
-			),
-		)
+			)
+		})
 }
 
 // genClientImplQueryMethod adds a <Query> method to a workflowClient
@@ -86,16 +125,30 @@ func (svc *Service) genClientImplQueryMethod(f *g.File, query string) {
 			args.Id("workflowID").String()
 			args.Id("runID").String()
 			if hasInput {
-				args.Id("query").Op("*").Id(method.Input.GoIdent.GoName)
+				args.Id("query").Op("*").Add(goIdent(method.Input.GoIdent))
 			}
 		}).
 		Params(
-			g.Op("*").Id(method.Output.GoIdent.GoName),
+			g.Op("*").Add(goIdent(method.Output.GoIdent)),
 			g.Error(),
 		).
-		Block(
-			g.Var().Id("resp").Id(method.Output.GoIdent.GoName),
-			g.If(
+		BlockFunc(func(fn *g.Group) {
+			fn.Id("c").Dot("interceptors").Dot(toCamel("Before%s", query)).CallFunc(func(args *g.Group) {
+				args.Id("ctx")
+				if hasInput {
+					args.Id("query")
+				}
+			})
+			var spanEnd []g.Code
+			if svc.tracingEnabled() {
+				svc.genSpanStart(fn, "Start", "QueryWorkflow", g.Id(toCamel("%sQueryName", query)), svc.genSpanAttributes(modeQuery, query, method.Input, g.Id("query"),
+					g.Qual(tracingPkg, "QueryNameKey").Dot("String").Call(g.Id(toCamel("%sQueryName", query))),
+					g.Qual(tracingPkg, "WorkflowIDKey").Dot("String").Call(g.Id("workflowID")),
+				))
+				spanEnd = append(spanEnd, genSpanEnd(g.Err()))
+			}
+			fn.Var().Id("resp").Add(goIdent(method.Output.GoIdent))
+			fn.If(
 				g.List(g.Id("val"), g.Err()).Op(":=").Id("c").Dot("client").Dot("QueryWorkflow").CallFunc(func(args *g.Group) {
 					args.Id("ctx")
 					args.Id("workflowID")
@@ -107,19 +160,29 @@ func (svc *Service) genClientImplQueryMethod(f *g.File, query string) {
 				}),
 				g.Err().Op("!=").Nil(),
 			).Block(
-				g.Return(g.Nil(), g.Err()),
+				append(spanEnd,
+					g.Id("c").Dot("interceptors").Dot(toCamel("After%s", query)).Call(g.Id("ctx"), g.Nil(), g.Err()),
+					g.Return(g.Nil(), g.Err()),
+				)...,
 			).Else().If(
 				g.Err().Op("=").Id("val").Dot("Get").Call(
 					g.Op("&").Id("resp"),
 				),
 				g.Err().Op("!=").Nil(),
 			).Block(
-				g.Return(g.Nil(), g.Err()),
-			),
-			g.Return(
+				append(spanEnd,
+					g.Id("c").Dot("interceptors").Dot(toCamel("After%s", query)).Call(g.Id("ctx"), g.Nil(), g.Err()),
+					g.Return(g.Nil(), g.Err()),
+				)...,
+			)
+			if svc.tracingEnabled() {
+				fn.Add(genSpanEnd(g.Nil()))
+			}
+			fn.Id("c").Dot("interceptors").Dot(toCamel("After%s", query)).Call(g.Id("ctx"), g.Op("&").Id("resp"), g.Nil())
+			fn.Return(
 				g.Op("&").Id("resp"), g.Nil(),
-			),
-		)
+			)
+		})
 }
 
 // genClientImplSignalMethod adds a <Signal> method to a workflowClient
@@ -137,48 +200,64 @@ func (svc *Service) genClientImplSignalMethod(f *g.File, signal string) {
 			args.Id("workflowID").String()
 			args.Id("runID").String()
 			if hasInput {
-				args.Id("signal").Op("*").Id(method.Input.GoIdent.GoName)
+				args.Id("signal").Op("*").Add(goIdent(method.Input.GoIdent))
 			}
 		}).
 		Params(g.Error()).
-		Block(
-			g.Return(
-				g.Id("c").Dot("client").Dot("SignalWorkflow").CallFunc(func(args *g.Group) {
-					args.Id("ctx")
-					args.Id("workflowID")
-					args.Id("runID")
-					args.Id(fmt.Sprintf("%sSignalName", signal))
-					if hasInput {
-						args.Id("signal")
-					} else {
-						args.Nil()
-					}
-				}),
-			),
-		)
+		BlockFunc(func(fn *g.Group) {
+			fn.Id("c").Dot("interceptors").Dot(toCamel("On%s", signal)).CallFunc(func(args *g.Group) {
+				args.Id("ctx")
+				if hasInput {
+					args.Id("signal")
+				}
+			})
+			send := g.Id("c").Dot("client").Dot("SignalWorkflow").CallFunc(func(args *g.Group) {
+				args.Id("ctx")
+				args.Id("workflowID")
+				args.Id("runID")
+				args.Id(fmt.Sprintf("%sSignalName", signal))
+				if hasInput {
+					args.Id("signal")
+				} else {
+					args.Nil()
+				}
+			})
+			if !svc.tracingEnabled() {
+				fn.Return(send)
+				return
+			}
+			svc.genSpanStart(fn, "Start", "SignalWorkflow", g.Id(toCamel("%sSignalName", signal)), svc.genSpanAttributes(modeSignal, signal, method.Input, g.Id("signal"),
+				g.Qual(tracingPkg, "SignalNameKey").Dot("String").Call(g.Id(toCamel("%sSignalName", signal))),
+				g.Qual(tracingPkg, "WorkflowIDKey").Dot("String").Call(g.Id("workflowID")),
+			))
+			fn.Err().Op(":=").Add(send)
+			fn.Add(genSpanEnd(g.Err()))
+			fn.Return(g.Err())
+		})
 }
 
 // genClientImplSignalWithStartAsyncMethod adds a <Workflow>With<Signal>Async client method
-func (svc *Service) genClientImplSignalWithStartAsyncMethod(f *g.File, workflow, signal string) {
+func (svc *Service) genClientImplSignalWithStartAsyncMethod(f *g.File, workflow, ref string) {
 	clientType := toLowerCamel("%sClient", svc.Service.GoName)
 	method := svc.methods[workflow]
-	handler := svc.methods[signal]
+	owner, signal := svc.lookupRef(ref)
+	handler := owner.methods[signal]
 	name := toCamel("%sWith%sAsync", workflow, signal)
 	runName := toLowerCamel("%sRun", workflow)
 	hasWorkflowInput := !isEmpty(method.Input)
 	hasSignalInput := !isEmpty(handler.Input)
 
-	f.Commentf("%s starts a(n) %s workflow and sends a(n) %s signal in a transaction", name, svc.fqnForWorkflow(workflow), svc.fqnForSignal(signal))
+	f.Commentf("%s starts a(n) %s workflow and sends a(n) %s signal in a transaction", name, svc.fqnForWorkflow(workflow), owner.fqnForSignal(signal))
 	f.Func().
 		Params(g.Id("c").Op("*").Id(clientType)).
 		Id(name).
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual("context", "Context")
 			if hasWorkflowInput {
-				args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
 			}
 			if hasSignalInput {
-				args.Id("signal").Op("*").Id(handler.Input.GoIdent.GoName)
+				args.Id("signal").Op("*").Add(goIdent(handler.Input.GoIdent))
 			}
 			args.Id("options").Op("...").Op("*").Id(toCamel("%sOptions", workflow))
 		}).
@@ -188,13 +267,36 @@ func (svc *Service) genClientImplSignalWithStartAsyncMethod(f *g.File, workflow,
 		).
 		BlockFunc(func(fn *g.Group) {
 			// initialize StartWorkflowOptions
-			svc.genClientStartWorkflowOptions(fn, workflow, false)
+			svc.genClientWorkflowOptionsBuild(fn, workflow)
+
+			// invoke interceptor hooks
+			fn.Id("c").Dot("interceptors").Dot(toCamel("Before%s", workflow)).CallFunc(func(args *g.Group) {
+				args.Id("ctx")
+				if hasWorkflowInput {
+					args.Id("req")
+				}
+			})
+			if owner == svc {
+				fn.Id("c").Dot("interceptors").Dot(toCamel("On%s", signal)).CallFunc(func(args *g.Group) {
+					args.Id("ctx")
+					if hasSignalInput {
+						args.Id("signal")
+					}
+				})
+			}
 
 			// signal with start workflow
+			if svc.tracingEnabled() {
+				svc.genSpanStart(fn, "Start", "SignalWithStartWorkflow", g.Id(toCamel("%sWorkflowName", workflow)), svc.genSpanAttributes(modeWorkflow, workflow, method.Input, g.Id("req"),
+					g.Qual(tracingPkg, "WorkflowNameKey").Dot("String").Call(g.Id(toCamel("%sWorkflowName", workflow))),
+					g.Qual(tracingPkg, "SignalNameKey").Dot("String").Call(owner.qual(toCamel("%sSignalName", signal))),
+					g.Qual(tracingPkg, "WorkflowIDKey").Dot("String").Call(g.Id("opts").Dot("ID")),
+				))
+			}
 			fn.Id("run").Op(",").Err().Op(":=").Id("c").Dot("client").Dot("SignalWithStartWorkflow").CallFunc(func(args *g.Group) {
 				args.Id("ctx")
 				args.Id("opts").Dot("ID")
-				args.Id(toCamel("%sSignalName", signal))
+				args.Add(owner.qual(toCamel("%sSignalName", signal)))
 				if hasSignalInput {
 					args.Id("signal")
 				} else {
@@ -206,7 +308,17 @@ func (svc *Service) genClientImplSignalWithStartAsyncMethod(f *g.File, workflow,
 					args.Id("req")
 				}
 			})
+			if svc.tracingEnabled() {
+				fn.Add(genSpanEnd(g.Err()))
+			}
 			fn.If(g.Id("run").Op("==").Nil().Op("||").Err().Op("!=").Nil()).Block(
+				g.Id("c").Dot("interceptors").Dot(toCamel("After%s", workflow)).CallFunc(func(args *g.Group) {
+					args.Id("ctx")
+					if !isEmpty(method.Output) {
+						args.Nil()
+					}
+					args.Err()
+				}),
 				g.Return(g.Nil(), g.Err()),
 			)
 			fn.Return(
@@ -220,32 +332,33 @@ func (svc *Service) genClientImplSignalWithStartAsyncMethod(f *g.File, workflow,
 }
 
 // genClientImplSignalWithStartMethod adds a Start<Workflow>With<Signal> client method
-func (svc *Service) genClientImplSignalWithStartMethod(f *g.File, workflow, signal string) {
+func (svc *Service) genClientImplSignalWithStartMethod(f *g.File, workflow, ref string) {
 	clientType := toLowerCamel("%sClient", svc.Service.GoName)
 	method := svc.methods[workflow]
-	handler := svc.methods[signal]
+	owner, signal := svc.lookupRef(ref)
+	handler := owner.methods[signal]
 	name := toCamel("%sWith%s", workflow, signal)
 	hasWorkflowInput := !isEmpty(method.Input)
 	hasWorkflowOutput := !isEmpty(method.Output)
 	hasSignalInput := !isEmpty(handler.Input)
 
-	f.Commentf("%s starts a(n) %s workflow and sends a(n) %s signal in a transaction", name, svc.fqnForWorkflow(workflow), svc.fqnForSignal(signal))
+	f.Commentf("%s starts a(n) %s workflow and sends a(n) %s signal in a transaction", name, svc.fqnForWorkflow(workflow), owner.fqnForSignal(signal))
 	f.Func().
 		Params(g.Id("c").Op("*").Id(clientType)).
 		Id(name).
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual("context", "Context")
 			if hasWorkflowInput {
-				args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
 			}
 			if hasSignalInput {
-				args.Id("signal").Op("*").Id(handler.Input.GoIdent.GoName)
+				args.Id("signal").Op("*").Add(goIdent(handler.Input.GoIdent))
 			}
 			args.Id("options").Op("...").Op("*").Id(toCamel("%sOptions", workflow))
 		}).
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasWorkflowOutput {
-				returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+				returnVals.Op("*").Add(goIdent(method.Output.GoIdent))
 			}
 			returnVals.Error()
 		}).
@@ -291,13 +404,13 @@ func (svc *Service) genClientImplUpdateMethod(f *g.File, update string) {
 			args.Id("workflowID").String()
 			args.Id("runID").String()
 			if hasInput {
-				args.Id("req").Op("*").Id(handler.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(goIdent(handler.Input.GoIdent))
 			}
 			args.Id("opts").Op("...").Op("*").Id(toCamel("%sOptions", update))
 		}).
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasOutput {
-				returnVals.Op("*").Id(handler.Output.GoIdent.GoName)
+				returnVals.Op("*").Add(goIdent(handler.Output.GoIdent))
 			}
 			returnVals.Error()
 		}).
@@ -354,7 +467,7 @@ func (svc *Service) genClientImplUpdateMethodAsync(f *g.File, update string) {
 			args.Id("workflowID").String()
 			args.Id("runID").String()
 			if hasInput {
-				args.Id("req").Op("*").Id(handler.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(goIdent(handler.Input.GoIdent))
 			}
 			args.Id("opts").Op("...").Op("*").Id(toCamel("%sOptions", update))
 		}).
@@ -363,11 +476,33 @@ func (svc *Service) genClientImplUpdateMethodAsync(f *g.File, update string) {
 			g.Error(),
 		).
 		BlockFunc(func(method *g.Group) {
-			svc.genClientUpdateWorkflowOptions(method, update)
+			svc.genClientUpdateOptionsBuild(method, update)
 
 			// update workflow
+			method.Id("c").Dot("interceptors").Dot(toCamel("Before%s", update)).CallFunc(func(args *g.Group) {
+				args.Id("ctx")
+				if hasInput {
+					args.Id("req")
+				}
+			})
+			if svc.tracingEnabled() {
+				svc.genSpanStart(method, "Start", "UpdateWorkflow", g.Id(toCamel("%sUpdateName", update)), svc.genSpanAttributes(modeUpdate, update, handler.Input, g.Id("req"),
+					g.Qual(tracingPkg, "UpdateNameKey").Dot("String").Call(g.Id(toCamel("%sUpdateName", update))),
+					g.Qual(tracingPkg, "WorkflowIDKey").Dot("String").Call(g.Id("workflowID")),
+				))
+			}
 			method.List(g.Id("handle"), g.Err()).Op(":=").Id("c").Dot("client").Dot("UpdateWorkflowWithOptions").Call(g.Id("ctx"), g.Id("options"))
+			if svc.tracingEnabled() {
+				method.Add(genSpanEnd(g.Err()))
+			}
 			method.If(g.Err().Op("!=").Nil()).Block(
+				g.Id("c").Dot("interceptors").Dot(toCamel("After%s", update)).CallFunc(func(args *g.Group) {
+					args.Id("ctx")
+					if !isEmpty(handler.Output) {
+						args.Nil()
+					}
+					args.Err()
+				}),
 				g.Return(g.Nil(), g.Err()),
 			)
 
@@ -397,7 +532,7 @@ func (svc *Service) genClientImplWorkflowAsyncMethod(f *g.File, workflow string)
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual("context", "Context")
 			if hasInput {
-				args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
 			}
 			args.Id("options").Op("...").Op("*").Id(toCamel("%sOptions", workflow))
 		}).
@@ -407,9 +542,21 @@ func (svc *Service) genClientImplWorkflowAsyncMethod(f *g.File, workflow string)
 		).
 		BlockFunc(func(fn *g.Group) {
 			// initialize StartWorkflowOptions with defaults
-			svc.genClientStartWorkflowOptions(fn, workflow, false)
+			svc.genClientWorkflowOptionsBuild(fn, workflow)
 
 			// execute workflow
+			fn.Id("c").Dot("interceptors").Dot(toCamel("Before%s", workflow)).CallFunc(func(args *g.Group) {
+				args.Id("ctx")
+				if hasInput {
+					args.Id("req")
+				}
+			})
+			if svc.tracingEnabled() {
+				svc.genSpanStart(fn, "Start", "StartWorkflow", g.Id(toCamel("%sWorkflowName", workflow)), svc.genSpanAttributes(modeWorkflow, workflow, method.Input, g.Id("req"),
+					g.Qual(tracingPkg, "WorkflowNameKey").Dot("String").Call(g.Id(toCamel("%sWorkflowName", workflow))),
+					g.Qual(tracingPkg, "WorkflowIDKey").Dot("String").Call(g.Id("opts").Dot("ID")),
+				))
+			}
 			fn.Id("run").Op(",").Err().Op(":=").Id("c").Dot("client").Dot("ExecuteWorkflow").CallFunc(func(args *g.Group) {
 				args.Id("ctx")
 				args.Op("*").Id("opts")
@@ -418,7 +565,17 @@ func (svc *Service) genClientImplWorkflowAsyncMethod(f *g.File, workflow string)
 					args.Id("req")
 				}
 			})
+			if svc.tracingEnabled() {
+				fn.Add(genSpanEnd(g.Err()))
+			}
 			fn.If(g.Err().Op("!=").Nil()).Block(
+				g.Id("c").Dot("interceptors").Dot(toCamel("After%s", workflow)).CallFunc(func(args *g.Group) {
+					args.Id("ctx")
+					if !isEmpty(method.Output) {
+						args.Nil()
+					}
+					args.Err()
+				}),
 				g.Return(g.Nil(), g.Err()),
 			)
 			fn.If(g.Id("run").Op("==").Nil()).Block(
@@ -479,13 +636,13 @@ func (svc *Service) genClientImplWorkflowMethod(f *g.File, workflow string) {
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual("context", "Context")
 			if hasInput {
-				args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
 			}
 			args.Id("options").Op("...").Op("*").Id(toCamel("%sOptions", workflow))
 		}).
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasOutput {
-				returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+				returnVals.Op("*").Add(goIdent(method.Output.GoIdent))
 			}
 			returnVals.Error()
 		}).
@@ -512,230 +669,226 @@ func (svc *Service) genClientImplWorkflowMethod(f *g.File, workflow string) {
 		})
 }
 
+// interfaceMethod describes a method of a generated interface, which is used to render both
+// the interface and its generated mock implementation so that the two never drift
+type interfaceMethod struct {
+	name    string
+	comment string
+	params  []interfaceMethodParam
+	results []interfaceMethodParam
+}
+
+// interfaceMethodParam describes a parameter or named result of an interface method
+type interfaceMethodParam struct {
+	name     string
+	typ      g.Code
+	variadic bool
+}
+
+// genParams adds the method's parameters to a parameter list
+func (m *interfaceMethod) genParams(args *g.Group) {
+	for _, p := range m.params {
+		if p.variadic {
+			args.Id(p.name).Op("...").Add(p.typ)
+		} else {
+			args.Id(p.name).Add(p.typ)
+		}
+	}
+}
+
+// genInterface generates an interface with the given methods
+func genInterface(f *g.File, typeName string, methods []*interfaceMethod) {
+	f.Type().Id(typeName).InterfaceFunc(func(group *g.Group) {
+		for _, m := range methods {
+			group.Comment(m.comment)
+			group.Id(m.name).ParamsFunc(m.genParams).ParamsFunc(func(results *g.Group) {
+				for _, r := range m.results {
+					results.Add(r.typ)
+				}
+			})
+		}
+	})
+}
+
+// handlerComment returns the leading comments of a query, signal, or update handler
+// as a single line comment, or the given default comment if the handler is undocumented
+func handlerComment(handler *protogen.Method, format string, a ...any) string {
+	if desc := handler.Comments.Leading.String(); desc != "" {
+		return strings.ReplaceAll(strings.TrimPrefix(desc, "//"), "\n//", "")
+	}
+	return fmt.Sprintf(format, a...)
+}
+
+// ctxParam returns a context.Context parameter
+func ctxParam() interfaceMethodParam {
+	return interfaceMethodParam{name: "ctx", typ: g.Qual("context", "Context")}
+}
+
+// inputParam returns a parameter of the given name for the given message, if not empty
+func inputParam(name string, message *protogen.Message) []interfaceMethodParam {
+	if isEmpty(message) {
+		return nil
+	}
+	return []interfaceMethodParam{{name: name, typ: g.Op("*").Add(goIdent(message.GoIdent))}}
+}
+
+// outputResults returns the results of a method that returns the given message, if not
+// empty, along with an error
+func outputResults(message *protogen.Message) []interfaceMethodParam {
+	return append(inputParam("resp", message), errResult())
+}
+
+// errResult returns an error result
+func errResult() interfaceMethodParam {
+	return interfaceMethodParam{name: "err", typ: g.Error()}
+}
+
 // genClientInterface generates a Client interface for a given service
 func (svc *Service) genClientInterface(f *g.File) {
 	typeName := toCamel("%sClient", svc.Service.GoName)
-
 	f.Commentf("%s describes a client for a(n) %s worker", typeName, svc.Service.Desc.FullName())
-	f.Type().Id(typeName).InterfaceFunc(func(methods *g.Group) {
-		for _, workflow := range svc.workflowsOrdered {
-			opts := svc.workflows[workflow]
-
-			method := svc.methods[workflow]
-			hasInput := !isEmpty(method.Input)
-			hasOutput := !isEmpty(method.Output)
-			runInterfaceType := toCamel("%sRun", workflow)
-
-			// generate <Workflow> method
-			methodName := workflow
-			if method.Comments.Leading.String() != "" {
-				methods.Comment(strings.TrimSuffix(method.Comments.Leading.String(), "\n"))
-			} else {
-				methods.Commentf("%s executes a(n) %s workflow and blocks until error or response received", methodName, svc.fqnForWorkflow(workflow))
-			}
-			methods.Id(workflow).
-				ParamsFunc(func(args *g.Group) {
-					args.Id("ctx").Qual("context", "Context")
-					if hasInput {
-						args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
-					}
-					args.Id("opts").Op("...").Op("*").Id(toCamel("%sOptions", workflow))
-				}).
-				ParamsFunc(func(returnVals *g.Group) {
-					if hasOutput {
-						returnVals.Op("*").Id(method.Output.GoIdent.GoName)
-					}
-					returnVals.Error()
-				})
+	genInterface(f, typeName, svc.clientInterfaceMethods())
+}
 
-			// generate <Workflow>Async method
-			methodName = toCamel("%sAsync", workflow)
-			methods.Commentf("%s executes a(n) %s workflow asynchronously", methodName, svc.fqnForWorkflow(workflow))
-			methods.Id(methodName).
-				ParamsFunc(func(args *g.Group) {
-					args.Id("ctx").Qual("context", "Context")
-					if hasInput {
-						args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
-					}
-					args.Id("opts").Op("...").Op("*").Id(toCamel("%sOptions", workflow))
-				}).
-				Params(
-					g.Id(runInterfaceType),
-					g.Error(),
-				)
+// clientInterfaceMethods returns the methods of a <Service>Client interface
+func (svc *Service) clientInterfaceMethods() (methods []*interfaceMethod) {
+	for _, workflow := range svc.workflowsOrdered {
+		opts := svc.workflows[workflow]
+		method := svc.methods[workflow]
+		runInterfaceType := svc.qual(toCamel("%sRun", workflow))
+		optsParam := interfaceMethodParam{name: "opts", typ: g.Op("*").Add(svc.qual(toCamel("%sOptions", workflow))), variadic: true}
+
+		// add <Workflow> method
+		comment := fmt.Sprintf("%s executes a(n) %s workflow and blocks until error or response received", workflow, svc.fqnForWorkflow(workflow))
+		if desc := method.Comments.Leading.String(); desc != "" {
+			comment = strings.TrimSuffix(desc, "\n")
+		}
+		methods = append(methods, &interfaceMethod{
+			name:    workflow,
+			comment: comment,
+			params:  append(append([]interfaceMethodParam{ctxParam()}, inputParam("req", method.Input)...), optsParam),
+			results: outputResults(method.Output),
+		})
 
-			// generate Get<Workflow> method
-			methodName = toCamel("Get%s", workflow)
-			methods.Commentf("%s retrieves a handle to an existing %s workflow execution", methodName, svc.fqnForWorkflow(workflow))
-			methods.Id(toCamel("Get%s", workflow)).
-				Params(
-					g.Id("ctx").Qual("context", "Context"),
-					g.Id("workflowID").String(),
-					g.Id("runID").String(),
-				).
-				Params(
-					g.Id(runInterfaceType),
-				)
+		// add <Workflow>Async method
+		methodName := toCamel("%sAsync", workflow)
+		methods = append(methods, &interfaceMethod{
+			name:    methodName,
+			comment: fmt.Sprintf("%s executes a(n) %s workflow asynchronously", methodName, svc.fqnForWorkflow(workflow)),
+			params:  append(append([]interfaceMethodParam{ctxParam()}, inputParam("req", method.Input)...), optsParam),
+			results: []interfaceMethodParam{{name: "run", typ: runInterfaceType}, errResult()},
+		})
 
-			// add <Workflow>With<Signal> methods
-			for _, signalOpts := range opts.GetSignal() {
-				if !signalOpts.GetStart() {
-					continue
-				}
-				method := svc.methods[workflow]
-				signal := signalOpts.GetRef()
-				handler := svc.methods[signal]
-				hasWorkflowInput := !isEmpty(method.Input)
-				hasWorkflowOutput := !isEmpty(method.Output)
-				hasSignalInput := !isEmpty(handler.Input)
-
-				// add synchronous flavor
-				methodName := toCamel("%sWith%s", workflow, signal)
-				if desc := handler.Comments.Leading.String(); desc != "" {
-					methods.Comment(strings.ReplaceAll(strings.TrimPrefix(desc, "//"), "\n//", ""))
-				} else {
-					methods.Commentf("%s sends a(n) %s signal to a(n) %s workflow, starting it if necessary, and blocks until workflow completion", methodName, svc.fqnForSignal(signal), svc.fqnForWorkflow(workflow))
-				}
-				methods.Id(methodName).
-					ParamsFunc(func(args *g.Group) {
-						args.Id("ctx").Qual("context", "Context")
-						if hasWorkflowInput {
-							args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
-						}
-						if hasSignalInput {
-							args.Id("signal").Op("*").Id(handler.Input.GoIdent.GoName)
-						}
-						args.Id("opts").Op("...").Op("*").Id(toCamel("%sOptions", workflow))
-					}).
-					ParamsFunc(func(returnVals *g.Group) {
-						if hasWorkflowOutput {
-							returnVals.Op("*").Id(method.Output.GoIdent.GoName)
-						}
-						returnVals.Error()
-					})
+		// add Get<Workflow> method
+		methodName = toCamel("Get%s", workflow)
+		methods = append(methods, &interfaceMethod{
+			name:    methodName,
+			comment: fmt.Sprintf("%s retrieves a handle to an existing %s workflow execution", methodName, svc.fqnForWorkflow(workflow)),
+			params: []interfaceMethodParam{
+				ctxParam(),
+				{name: "workflowID", typ: g.String()},
+				{name: "runID", typ: g.String()},
+			},
+			results: []interfaceMethodParam{{name: "run", typ: runInterfaceType}},
+		})
 
-				// add async flavor
-				methodName += "Async"
-				if desc := handler.Comments.Leading.String(); desc != "" {
-					methods.Comment(strings.ReplaceAll(strings.TrimPrefix(desc, "//"), "\n//", ""))
-				} else {
-					methods.Commentf("%s sends a(n) %s signal to a(n) %s workflow, starting it if necessary, and returns a handle to the workflow execution", methodName, svc.fqnForSignal(signal), svc.fqnForWorkflow(workflow))
-				}
-				methods.Id(methodName).
-					ParamsFunc(func(args *g.Group) {
-						args.Id("ctx").Qual("context", "Context")
-						if hasWorkflowInput {
-							args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
-						}
-						if hasSignalInput {
-							args.Id("signal").Op("*").Id(handler.Input.GoIdent.GoName)
-						}
-						args.Id("opts").Op("...").Op("*").Id(toCamel("%sOptions", workflow))
-					}).
-					Params(
-						g.Id(runInterfaceType),
-						g.Error(),
-					)
-			}
-		}
+		// add <Action><Workflow>Schedule methods
+		methods = append(methods, svc.clientScheduleInterfaceMethods(workflow)...)
 
-		// add <Query> methods
-		for _, query := range svc.queriesOrdered {
-			handler := svc.methods[query]
-			hasInput := !isEmpty(handler.Input)
-			if desc := handler.Comments.Leading.String(); desc != "" {
-				methods.Comment(strings.ReplaceAll(strings.TrimPrefix(desc, "//"), "\n//", ""))
-			} else {
-				methods.Commentf("%s executes a(n) %s query", query, svc.fqnForQuery(query))
+		// add <Workflow>With<Signal> methods
+		for _, signalOpts := range opts.GetSignal() {
+			if !signalOpts.GetStart() {
+				continue
 			}
-			methods.Id(query).
-				ParamsFunc(func(args *g.Group) {
-					args.Id("ctx").Qual("context", "Context")
-					args.Id("workflowID").String()
-					args.Id("runID").String()
-					if hasInput {
-						args.Id("query").Op("*").Id(handler.Input.GoIdent.GoName)
-					}
-				}).
-				Params(
-					g.Op("*").Id(handler.Output.GoIdent.GoName),
-					g.Error(),
-				)
-		}
+			owner, signal := svc.lookupRef(signalOpts.GetRef())
+			handler := owner.methods[signal]
+			params := append(append(append([]interfaceMethodParam{ctxParam()}, inputParam("req", method.Input)...), inputParam("signal", handler.Input)...), optsParam)
 
-		// add <Signal> methods
-		for _, signal := range svc.signalsOrdered {
-			handler := svc.methods[signal]
-			hasInput := !isEmpty(handler.Input)
-			if desc := handler.Comments.Leading.String(); desc != "" {
-				methods.Comment(strings.ReplaceAll(strings.TrimPrefix(desc, "//"), "\n//", ""))
-			} else {
-				methods.Commentf("%s sends a(n) %s signal", signal, svc.fqnForSignal(signal))
-			}
-			methods.Id(signal).
-				ParamsFunc(func(args *g.Group) {
-					args.Id("ctx").Qual("context", "Context")
-					args.Id("workflowID").String()
-					args.Id("runID").String()
-					if hasInput {
-						args.Id("signal").Op("*").Id(handler.Input.GoIdent.GoName)
-					}
-				}).
-				Params(g.Error())
+			// add synchronous flavor
+			methodName := toCamel("%sWith%s", workflow, signal)
+			methods = append(methods, &interfaceMethod{
+				name:    methodName,
+				comment: handlerComment(handler, "%s sends a(n) %s signal to a(n) %s workflow, starting it if necessary, and blocks until workflow completion", methodName, owner.fqnForSignal(signal), svc.fqnForWorkflow(workflow)),
+				params:  params,
+				results: outputResults(method.Output),
+			})
+
+			// add async flavor
+			methodName += "Async"
+			methods = append(methods, &interfaceMethod{
+				name:    methodName,
+				comment: handlerComment(handler, "%s sends a(n) %s signal to a(n) %s workflow, starting it if necessary, and returns a handle to the workflow execution", methodName, owner.fqnForSignal(signal), svc.fqnForWorkflow(workflow)),
+				params:  params,
+				results: []interfaceMethodParam{{name: "run", typ: runInterfaceType}, errResult()},
+			})
 		}
+	}
 
-		// add <Update> methods
-		for _, update := range svc.updatesOrdered {
-			handler := svc.methods[update]
-			hasInput := !isEmpty(handler.Input)
-			hasOutput := !isEmpty(handler.Output)
+	// add <Query> methods
+	for _, query := range svc.queriesOrdered {
+		handler := svc.methods[query]
+		methods = append(methods, &interfaceMethod{
+			name:    query,
+			comment: handlerComment(handler, "%s executes a(n) %s query", query, svc.fqnForQuery(query)),
+			params: append([]interfaceMethodParam{
+				ctxParam(),
+				{name: "workflowID", typ: g.String()},
+				{name: "runID", typ: g.String()},
+			}, inputParam("query", handler.Input)...),
+			results: []interfaceMethodParam{{name: "resp", typ: g.Op("*").Add(goIdent(handler.Output.GoIdent))}, errResult()},
+		})
+	}
 
-			// add synchronous flavor
-			if desc := handler.Comments.Leading.String(); desc != "" {
-				methods.Comment(strings.ReplaceAll(strings.TrimPrefix(desc, "//"), "\n//", ""))
-			} else {
-				methods.Commentf("%s executes a(n) %s update and blocks until update completion", update, svc.fqnForUpdate(update))
-			}
-			methods.Id(update).
-				ParamsFunc(func(args *g.Group) {
-					args.Id("ctx").Qual("context", "Context")
-					args.Id("workflowID").String()
-					args.Id("runID").String()
-					if hasInput {
-						args.Id("req").Op("*").Id(handler.Input.GoIdent.GoName)
-					}
-					args.Id("opts").Op("...").Op("*").Id(toCamel("%sOptions", update))
-				}).
-				ParamsFunc(func(returnVals *g.Group) {
-					if hasOutput {
-						returnVals.Op("*").Id(handler.Output.GoIdent.GoName)
-					}
-					returnVals.Error()
-				})
+	// add <Signal> methods
+	for _, signal := range svc.signalsOrdered {
+		handler := svc.methods[signal]
+		methods = append(methods, &interfaceMethod{
+			name:    signal,
+			comment: handlerComment(handler, "%s sends a(n) %s signal", signal, svc.fqnForSignal(signal)),
+			params: append([]interfaceMethodParam{
+				ctxParam(),
+				{name: "workflowID", typ: g.String()},
+				{name: "runID", typ: g.String()},
+			}, inputParam("signal", handler.Input)...),
+			results: []interfaceMethodParam{errResult()},
+		})
+	}
 
-			// add async flavor
-			methodName := toCamel("%sAsync", update)
-			if desc := handler.Comments.Leading.String(); desc != "" {
-				methods.Comment(strings.ReplaceAll(strings.TrimPrefix(desc, "//"), "\n//", ""))
-			} else {
-				methods.Commentf("%s executes a(n) %s update and blocks until update completion", update, svc.fqnForUpdate(update))
-			}
-			methods.Id(methodName).
-				ParamsFunc(func(args *g.Group) {
-					args.Id("ctx").Qual("context", "Context")
-					args.Id("workflowID").String()
-					args.Id("runID").String()
-					if hasInput {
-						args.Id("req").Op("*").Id(handler.Input.GoIdent.GoName)
-					}
-					args.Id("opts").Op("...").Op("*").Id(toCamel("%sOptions", update))
-				}).
-				Params(
-					g.Id(toCamel("%sHandle", update)),
-					g.Error(),
-				)
+	// add <Update> methods
+	for _, update := range svc.updatesOrdered {
+		handler := svc.methods[update]
+		params := append(append([]interfaceMethodParam{
+			ctxParam(),
+			{name: "workflowID", typ: g.String()},
+			{name: "runID", typ: g.String()},
+		}, inputParam("req", handler.Input)...), interfaceMethodParam{
+			name: "opts", typ: g.Op("*").Add(svc.qual(toCamel("%sOptions", update))), variadic: true,
+		})
+
+		// add synchronous flavor
+		methods = append(methods, &interfaceMethod{
+			name:    update,
+			comment: handlerComment(handler, "%s executes a(n) %s update and blocks until update completion", update, svc.fqnForUpdate(update)),
+			params:  params,
+			results: outputResults(handler.Output),
+		})
+
+		// add async flavor
+		methods = append(methods, &interfaceMethod{
+			name:    toCamel("%sAsync", update),
+			comment: handlerComment(handler, "%s executes a(n) %s update and blocks until update completion", update, svc.fqnForUpdate(update)),
+			params:  params,
+			results: []interfaceMethodParam{{name: "handle", typ: svc.qual(toCamel("%sHandle", update))}, errResult()},
+		})
+	}
+
+	// add Complete<Activity> methods
+	for _, activity := range svc.activitiesOrdered {
+		if svc.activities[activity].GetAsyncCompletion() {
+			methods = append(methods, svc.clientActivityCompletionInterfaceMethods(activity)...)
 		}
-	})
+	}
+	return methods
 }
 
 // genClientStartWorkflowOptions adds logic for initializing StartWorkflowOptions with default values
@@ -758,8 +911,8 @@ func (svc *Service) genClientStartWorkflowOptions(fn *g.Group, workflow string,
 			)
 	} else {
 		fn.Id("opts").Op(":=").Op("&").Qual(clientPkg, "StartWorkflowOptions").Values()
-		fn.If(g.Len(g.Id("options")).Op(">").Lit(0).Op("&&").Id("options").Index(g.Lit(0)).Dot("opts").Op("!=").Nil()).Block(
-			g.Id("opts").Op("=").Id("options").Index(g.Lit(0)).Dot("opts"),
+		fn.If(g.Id("o").Op("!=").Nil().Op("&&").Id("o").Dot("opts").Op("!=").Nil()).Block(
+			g.Id("opts").Op("=").Id("o").Dot("opts"),
 		)
 	}
 
@@ -776,6 +929,18 @@ func (svc *Service) genClientStartWorkflowOptions(fn *g.Group, workflow string,
 		)
 	}
 
+	// set cron schedule if overridden, or unset and default available
+	if !child {
+		override := fn.If(g.Id("o").Op("!=").Nil().Op("&&").Id("o").Dot("cronSchedule").Op("!=").Nil()).Block(
+			g.Id("opts").Dot("CronSchedule").Op("=").Op("*").Id("o").Dot("cronSchedule"),
+		)
+		if cron := opts.GetCronSchedule(); cron != "" {
+			override.Else().If(g.Id("opts").Dot("CronSchedule").Op("==").Lit("")).Block(
+				g.Id("opts").Dot("CronSchedule").Op("=").Lit(cron),
+			)
+		}
+	}
+
 	idFieldName := "ID"
 	if child {
 		idFieldName = "WorkflowID"
@@ -823,7 +988,7 @@ func (svc *Service) genClientStartWorkflowOptions(fn *g.Group, workflow string,
 			)
 	}
 
-	if policy := opts.GetRetryPolicy(); policy != nil {
+	if policy := retryPolicyFor(opts.GetRetryPolicy(), opts.GetErrors()); policy != nil {
 		fn.If(g.Id("opts").Dot("RetryPolicy").Op("==").Nil()).Block(
 			g.Id("opts").Dot("RetryPolicy").Op("=").Op("&").Qual(temporalPkg, "RetryPolicy").ValuesFunc(func(fields *g.Group) {
 				if d := policy.GetInitialInterval(); d.IsValid() {
@@ -839,7 +1004,11 @@ func (svc *Service) genClientStartWorkflowOptions(fn *g.Group, workflow string,
 					fields.Id("MaximumAttempts").Op(":").Lit(n)
 				}
 				if errs := policy.GetNonRetryableErrorTypes(); len(errs) > 0 {
-					fields.Id("NonRetryableErrorTypes").Op(":").Lit(errs)
+					fields.Id("NonRetryableErrorTypes").Op(":").Index().String().ValuesFunc(func(vals *g.Group) {
+						for _, errType := range errs {
+							vals.Lit(errType)
+						}
+					})
 				}
 			}),
 		)
@@ -867,28 +1036,17 @@ func (svc *Service) genClientStartWorkflowOptions(fn *g.Group, workflow string,
 	}
 
 	if mapping := opts.GetSearchAttributes(); mapping != "" {
-		fn.If(g.Id("opts").Dot("SearchAttributes").Op("==").Nil()).
-			BlockFunc(func(bl *g.Group) {
-				// initalize mapping input
-				if hasInput {
-					bl.List(g.Id("structured"), g.Err()).Op(":=").Qual(expressionPkg, "ToStructured").Call(g.Id("req").Dot("ProtoReflect").Call())
-					bl.If(g.Err().Op("!=").Nil()).Block(
-						g.Return(g.Nil(), g.Qual("fmt", "Errorf").Call(g.Lit(fmt.Sprintf("error serializing input for %q search attribute mapping: %%v", workflow)), g.Err())),
-					)
-				} else {
-					bl.Var().Id("structured").Any()
-				}
+		svc.genClientStartWorkflowMapping(fn, workflow, "SearchAttributes", "search attribute")
+	}
 
-				bl.List(g.Id("result"), g.Err()).Op(":=").Id(toCamel("%sSearchAttributesMapping", workflow)).Dot("Query").Call(g.Id("structured"))
-				bl.If(g.Err().Op("!=").Nil()).Block(
-					g.Return(g.Nil(), g.Qual("fmt", "Errorf").Call(g.Lit(fmt.Sprintf("error executing %q search attribute mapping: %%v", workflow)), g.Err())),
-				)
-				bl.List(g.Id("searchAttributes"), g.Id("ok")).Op(":=").Id("result").Op(".").Parens(g.Map(g.String()).Interface())
-				bl.If(g.Op("!").Id("ok")).Block(
-					g.Return(g.Nil(), g.Qual("fmt", "Errorf").Call(g.Lit(fmt.Sprintf("expected %q search attribute mapping to return map[string]any, got: %%T", workflow)), g.Id("result"))),
-				)
-				bl.Id("opts").Dot("SearchAttributes").Op("=").Id("searchAttributes")
-			})
+	// set memo if overridden, or unset and default mapping available
+	if !child {
+		fn.If(g.Id("o").Op("!=").Nil().Op("&&").Id("o").Dot("memo").Op("!=").Nil()).Block(
+			g.Id("opts").Dot("Memo").Op("=").Id("o").Dot("memo"),
+		)
+	}
+	if mapping := opts.GetMemo(); mapping != "" {
+		svc.genClientStartWorkflowMapping(fn, workflow, "Memo", "memo")
 	}
 
 	// add child workflow default options
@@ -924,6 +1082,34 @@ func (svc *Service) genClientStartWorkflowOptions(fn *g.Group, workflow string,
 	}
 }
 
+// genClientStartWorkflowMapping adds logic for initializing a StartWorkflowOptions map field
+// by executing the corresponding bloblang mapping against the workflow input, if unset
+func (svc *Service) genClientStartWorkflowMapping(fn *g.Group, workflow, field, desc string) {
+	hasInput := !isEmpty(svc.methods[workflow].Input)
+	fn.If(g.Id("opts").Dot(field).Op("==").Nil()).
+		BlockFunc(func(bl *g.Group) {
+			// initalize mapping input
+			if hasInput {
+				bl.List(g.Id("structured"), g.Err()).Op(":=").Qual(expressionPkg, "ToStructured").Call(g.Id("req").Dot("ProtoReflect").Call())
+				bl.If(g.Err().Op("!=").Nil()).Block(
+					g.Return(g.Nil(), g.Qual("fmt", "Errorf").Call(g.Lit(fmt.Sprintf("error serializing input for %q %s mapping: %%v", workflow, desc)), g.Err())),
+				)
+			} else {
+				bl.Var().Id("structured").Any()
+			}
+
+			bl.List(g.Id("result"), g.Err()).Op(":=").Id(toCamel("%s%sMapping", workflow, field)).Dot("Query").Call(g.Id("structured"))
+			bl.If(g.Err().Op("!=").Nil()).Block(
+				g.Return(g.Nil(), g.Qual("fmt", "Errorf").Call(g.Lit(fmt.Sprintf("error executing %q %s mapping: %%v", workflow, desc)), g.Err())),
+			)
+			bl.List(g.Id("values"), g.Id("ok")).Op(":=").Id("result").Op(".").Parens(g.Map(g.String()).Interface())
+			bl.If(g.Op("!").Id("ok")).Block(
+				g.Return(g.Nil(), g.Qual("fmt", "Errorf").Call(g.Lit(fmt.Sprintf("expected %q %s mapping to return map[string]any, got: %%T", workflow, desc)), g.Id("result"))),
+			)
+			bl.Id("opts").Dot(field).Op("=").Id("values")
+		})
+}
+
 // genClientUpdateHandleImpl generates a <Update>Handle struct
 func (svc *Service) genClientUpdateHandleImpl(f *g.File, update string) {
 	clientImplType := toLowerCamel("%sClient", svc.Service.GoName)
@@ -953,13 +1139,14 @@ func (svc *Service) genClientUpdateHandleImplGetMethod(f *g.File, update string)
 		Params(g.Id("ctx").Qual("context", "Context")).
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasOutput {
-				returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+				returnVals.Op("*").Add(goIdent(method.Output.GoIdent))
 			}
 			returnVals.Error()
 		}).
 		BlockFunc(func(fn *g.Group) {
+			after := g.Id("h").Dot("client").Dot("interceptors").Dot(toCamel("After%s", update))
 			if hasOutput {
-				fn.Var().Id("resp").Id(method.Output.GoIdent.GoName)
+				fn.Var().Id("resp").Add(goIdent(method.Output.GoIdent))
 				fn.If(
 					g.Err().Op(":=").Id("h").Dot("handle").Dot("Get").Call(
 						g.Id("ctx"),
@@ -967,20 +1154,22 @@ func (svc *Service) genClientUpdateHandleImplGetMethod(f *g.File, update string)
 					),
 					g.Err().Op("!=").Nil(),
 				).Block(
+					g.Add(after).Call(g.Id("ctx"), g.Nil(), g.Err()),
 					g.Return(
 						g.Nil(), g.Err(),
 					),
 				)
+				fn.Add(after).Call(g.Id("ctx"), g.Op("&").Id("resp"), g.Nil())
 				fn.Return(
 					g.Op("&").Id("resp"), g.Nil(),
 				)
 			} else {
-				fn.Return(
-					g.Id("h").Dot("handle").Dot("Get").Call(
-						g.Id("ctx"),
-						g.Nil(),
-					),
+				fn.Err().Op(":=").Id("h").Dot("handle").Dot("Get").Call(
+					g.Id("ctx"),
+					g.Nil(),
 				)
+				fn.Add(after).Call(g.Id("ctx"), g.Err())
+				fn.Return(g.Err())
 			}
 		})
 }
@@ -1030,33 +1219,26 @@ func (svc *Service) genClientUpdateHandleImplWorkflowIDMethod(f *g.File, update
 		)
 }
 
-// genClientUpdateHandleInterface generates a <Workflow>Run interface
+// genClientUpdateHandleInterface generates a <Update>Handle interface
 func (svc *Service) genClientUpdateHandleInterface(f *g.File, update string) {
 	typeName := toCamel("%sHandle", update)
-	method := svc.methods[update]
-	hasOutput := !isEmpty(method.Output)
-
 	f.Commentf("%s describes a(n) %s update handle", typeName, svc.fqnForUpdate(update))
-	f.Type().Id(typeName).InterfaceFunc(func(methods *g.Group) {
-		methods.Comment("WorkflowID returns the workflow ID")
-		methods.Id("WorkflowID").Params().String()
-
-		methods.Comment("RunID returns the workflow instance ID")
-		methods.Id("RunID").Params().String()
-
-		methods.Comment("UpdateID returns the update ID")
-		methods.Id("UpdateID").Params().String()
-
-		methods.Comment("Get blocks until the workflow is complete and returns the result")
-		methods.Id("Get").
-			Params(g.Id("ctx").Qual("context", "Context")).
-			ParamsFunc(func(returnVals *g.Group) {
-				if hasOutput {
-					returnVals.Op("*").Id(method.Output.GoIdent.GoName)
-				}
-				returnVals.Error()
-			})
-	})
+	genInterface(f, typeName, svc.clientUpdateHandleInterfaceMethods(update))
+}
+
+// clientUpdateHandleInterfaceMethods returns the methods of an <Update>Handle interface
+func (svc *Service) clientUpdateHandleInterfaceMethods(update string) []*interfaceMethod {
+	return []*interfaceMethod{
+		{name: "WorkflowID", comment: "WorkflowID returns the workflow ID", results: []interfaceMethodParam{{name: "workflowID", typ: g.String()}}},
+		{name: "RunID", comment: "RunID returns the workflow instance ID", results: []interfaceMethodParam{{name: "runID", typ: g.String()}}},
+		{name: "UpdateID", comment: "UpdateID returns the update ID", results: []interfaceMethodParam{{name: "updateID", typ: g.String()}}},
+		{
+			name:    "Get",
+			comment: "Get blocks until the workflow is complete and returns the result",
+			params:  []interfaceMethodParam{ctxParam()},
+			results: outputResults(svc.methods[update].Output),
+		},
+	}
 }
 
 // genClientUpdateOptions generates a <Update>Options struct
@@ -1086,8 +1268,52 @@ func (svc *Service) genClientUpdateOptions(f *g.File, update string) {
 			g.Id("opts").Dot("opts").Op("=").Op("&").Id("options"),
 			g.Return(g.Id("opts")),
 		)
+
+	method := svc.methods[update]
+	hasInput := !isEmpty(method.Input)
+	f.Commentf("Build initializes a client.UpdateWorkflowWithOptionsRequest with defaults for a(n) %s update operation", svc.fqnForUpdate(update))
+	f.Func().
+		Params(g.Id("o").Op("*").Id(typeName)).
+		Id("Build").
+		ParamsFunc(func(args *g.Group) {
+			args.Id("workflowID").String()
+			args.Id("runID").String()
+			if hasInput {
+				args.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
+			}
+		}).
+		Params(
+			g.Op("*").Qual(clientPkg, "UpdateWorkflowWithOptionsRequest"),
+			g.Error(),
+		).
+		BlockFunc(func(fn *g.Group) {
+			svc.genClientUpdateWorkflowOptions(fn, update)
+			fn.Return(g.Id("options"), g.Nil())
+		})
 }
 
+// genClientUpdateOptionsBuild adds logic for initializing an UpdateWorkflowWithOptionsRequest from
+// variadic <Update>Options
+func (svc *Service) genClientUpdateOptionsBuild(fn *g.Group, update string) {
+	hasInput := !isEmpty(svc.methods[update].Input)
+	fn.Var().Id("o").Op("*").Add(svc.qual(toCamel("%sOptions", update)))
+	fn.If(g.Len(g.Id("opts")).Op(">").Lit(0)).Block(
+		g.Id("o").Op("=").Id("opts").Index(g.Lit(0)),
+	)
+	fn.List(g.Id("options"), g.Err()).Op(":=").Id("o").Dot("Build").CallFunc(func(args *g.Group) {
+		args.Id("workflowID")
+		args.Id("runID")
+		if hasInput {
+			args.Id("req")
+		}
+	})
+	fn.If(g.Err().Op("!=").Nil()).Block(
+		g.Return(g.Nil(), g.Err()),
+	)
+}
+
+// genClientUpdateWorkflowOptions adds logic for initializing an UpdateWorkflowWithOptionsRequest with
+// default values
 func (svc *Service) genClientUpdateWorkflowOptions(fn *g.Group, update string) {
 	updateOpts := svc.updates[update]
 	handler := svc.methods[update]
@@ -1095,8 +1321,8 @@ func (svc *Service) genClientUpdateWorkflowOptions(fn *g.Group, update string) {
 
 	// initialize update request options
 	fn.Id("options").Op(":=").Op("&").Qual(clientPkg, "UpdateWorkflowWithOptionsRequest").Values()
-	fn.If(g.Len(g.Id("opts")).Op(">").Lit(0).Op("&&").Id("opts").Index(g.Lit(0)).Dot("opts").Op("!=").Nil()).Block(
-		g.Id("options").Op("=").Id("opts").Index(g.Lit(0)).Dot("opts"),
+	fn.If(g.Id("o").Op("!=").Nil().Op("&&").Id("o").Dot("opts").Op("!=").Nil()).Block(
+		g.Id("options").Op("=").Id("o").Dot("opts"),
 	)
 
 	// add request args if update has inpute
@@ -1153,6 +1379,8 @@ func (svc *Service) genClientWorkflowOptions(f *g.File, workflow string) {
 	f.Commentf("%s provides configuration for a %s workflow operation", typeName, svc.fqnForWorkflow(workflow))
 	f.Type().Id(typeName).Struct(
 		g.Id("opts").Op("*").Qual(clientPkg, "StartWorkflowOptions"),
+		g.Id("cronSchedule").Op("*").String(),
+		g.Id("memo").Map(g.String()).Any(),
 	)
 
 	f.Commentf("%s initializes a new %s value", constructorName, typeName)
@@ -1170,6 +1398,66 @@ func (svc *Service) genClientWorkflowOptions(f *g.File, workflow string) {
 			g.Id("opts").Dot("opts").Op("=").Op("&").Id("options"),
 			g.Return(g.Id("opts")),
 		)
+
+	f.Comment("WithCronSchedule overrides the default cron schedule, an empty schedule starts a non-cron workflow")
+	f.Func().
+		Params(g.Id("opts").Op("*").Id(typeName)).
+		Id("WithCronSchedule").
+		Params(g.Id("schedule").String()).
+		Op("*").Id(typeName).
+		Block(
+			g.Id("opts").Dot("cronSchedule").Op("=").Op("&").Id("schedule"),
+			g.Return(g.Id("opts")),
+		)
+
+	f.Comment("WithMemo overrides the default workflow memo")
+	f.Func().
+		Params(g.Id("opts").Op("*").Id(typeName)).
+		Id("WithMemo").
+		Params(g.Id("memo").Map(g.String()).Any()).
+		Op("*").Id(typeName).
+		Block(
+			g.Id("opts").Dot("memo").Op("=").Id("memo"),
+			g.Return(g.Id("opts")),
+		)
+
+	method := svc.methods[workflow]
+	hasInput := !isEmpty(method.Input)
+	f.Commentf("Build initializes a client.StartWorkflowOptions with defaults for a(n) %s workflow operation", svc.fqnForWorkflow(workflow))
+	f.Func().
+		Params(g.Id("o").Op("*").Id(typeName)).
+		Id("Build").
+		ParamsFunc(func(args *g.Group) {
+			if hasInput {
+				args.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
+			}
+		}).
+		Params(
+			g.Op("*").Qual(clientPkg, "StartWorkflowOptions"),
+			g.Error(),
+		).
+		BlockFunc(func(fn *g.Group) {
+			svc.genClientStartWorkflowOptions(fn, workflow, false)
+			fn.Return(g.Id("opts"), g.Nil())
+		})
+}
+
+// genClientWorkflowOptionsBuild adds logic for initializing StartWorkflowOptions from variadic
+// <Workflow>Options
+func (svc *Service) genClientWorkflowOptionsBuild(fn *g.Group, workflow string) {
+	hasInput := !isEmpty(svc.methods[workflow].Input)
+	fn.Var().Id("o").Op("*").Add(svc.qual(toCamel("%sOptions", workflow)))
+	fn.If(g.Len(g.Id("options")).Op(">").Lit(0)).Block(
+		g.Id("o").Op("=").Id("options").Index(g.Lit(0)),
+	)
+	fn.List(g.Id("opts"), g.Err()).Op(":=").Id("o").Dot("Build").CallFunc(func(args *g.Group) {
+		if hasInput {
+			args.Id("req")
+		}
+	})
+	fn.If(g.Err().Op("!=").Nil()).Block(
+		g.Return(g.Nil(), g.Err()),
+	)
 }
 
 // genClientWorkflowRunImpl generates a <Workflow>Run struct
@@ -1201,13 +1489,14 @@ func (svc *Service) genClientWorkflowRunImplGetMethod(f *g.File, workflow string
 		Params(g.Id("ctx").Qual("context", "Context")).
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasOutput {
-				returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+				returnVals.Op("*").Add(goIdent(method.Output.GoIdent))
 			}
 			returnVals.Error()
 		}).
 		BlockFunc(func(fn *g.Group) {
+			after := g.Id("r").Dot("client").Dot("interceptors").Dot(toCamel("After%s", workflow))
 			if hasOutput {
-				fn.Var().Id("resp").Id(method.Output.GoIdent.GoName)
+				fn.Var().Id("resp").Add(goIdent(method.Output.GoIdent))
 				fn.If(
 					g.Err().Op(":=").Id("r").Dot("run").Dot("Get").Call(
 						g.Id("ctx"),
@@ -1215,20 +1504,22 @@ func (svc *Service) genClientWorkflowRunImplGetMethod(f *g.File, workflow string
 					),
 					g.Err().Op("!=").Nil(),
 				).Block(
+					g.Add(after).Call(g.Id("ctx"), g.Nil(), g.Err()),
 					g.Return(
 						g.Nil(), g.Err(),
 					),
 				)
+				fn.Add(after).Call(g.Id("ctx"), g.Op("&").Id("resp"), g.Nil())
 				fn.Return(
 					g.Op("&").Id("resp"), g.Nil(),
 				)
 			} else {
-				fn.Return(
-					g.Id("r").Dot("run").Dot("Get").Call(
-						g.Id("ctx"),
-						g.Nil(),
-					),
+				fn.Err().Op(":=").Id("r").Dot("run").Dot("Get").Call(
+					g.Id("ctx"),
+					g.Nil(),
 				)
+				fn.Add(after).Call(g.Id("ctx"), g.Err())
+				fn.Return(g.Err())
 			}
 		})
 }
@@ -1249,28 +1540,29 @@ func (svc *Service) genClientWorkflowRunImplIDMethod(f *g.File, workflow string)
 }
 
 // genClientWorkflowRunImplQueryMethod generates a <WOrkflow>Run's <Query> method
-func (svc *Service) genClientWorkflowRunImplQueryMethod(f *g.File, workflow string, query string) {
+func (svc *Service) genClientWorkflowRunImplQueryMethod(f *g.File, workflow string, ref string) {
 	typeName := toLowerCamel("%sRun", workflow)
-	handler := svc.methods[query]
+	owner, query := svc.lookupRef(ref)
+	handler := owner.methods[query]
 	hasInput := !isEmpty(handler.Input)
 
-	f.Commentf("%s executes a(n) %s query", query, svc.fqnForQuery(query))
+	f.Commentf("%s executes a(n) %s query", query, owner.fqnForQuery(query))
 	f.Func().
 		Params(g.Id("r").Op("*").Id(typeName)).
 		Id(query).
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual("context", "Context")
 			if hasInput {
-				args.Id("req").Op("*").Id(handler.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(goIdent(handler.Input.GoIdent))
 			}
 		}).
 		Params(
-			g.Op("*").Id(handler.Output.GoIdent.GoName),
+			g.Op("*").Add(goIdent(handler.Output.GoIdent)),
 			g.Error(),
 		).
 		Block(
 			g.Return(
-				g.Id("r").Dot("client").Dot(query).CallFunc(func(args *g.Group) {
+				svc.genClientWorkflowRunRefClient(owner).Dot(query).CallFunc(func(args *g.Group) {
 					args.Id("ctx")
 					args.Id("r").Dot("ID").Call()
 					args.Lit("")
@@ -1297,27 +1589,110 @@ func (svc *Service) genClientWorkflowRunImplRunIDMethod(f *g.File, workflow stri
 		)
 }
 
+// memoMethodComment documents the Memo method of <Workflow>Run implementations, which are only
+// generated for workflows that declare a memo mapping
+const memoMethodComment = "Memo describes the workflow execution and returns its decoded memo, which is the result of the workflow's memo mapping unless overridden via WithMemo"
+
+// memoField describes a field of a <Workflow>Memo struct
+type memoField struct {
+	// key is a memo key produced by the workflow's memo mapping
+	key string
+	// name is the Go name of the struct field
+	name string
+}
+
+// hasMemo reports whether any of the service's workflows declare a memo mapping
+func (svc *Service) hasMemo() bool {
+	for _, workflow := range svc.workflowsOrdered {
+		if svc.workflows[workflow].GetMemo() != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// memoFields returns the fields of a workflow's <Workflow>Memo struct, which are derived
+// from the keys produced by its memo mapping
+func (svc *Service) memoFields(workflow string) []memoField {
+	m, err := expression.ParseMapping(svc.workflows[workflow].GetMemo())
+	if err != nil {
+		return nil
+	}
+	var fields []memoField
+	for _, key := range expression.MappingKeys(m, svc.methods[workflow].Input.Desc) {
+		fields = append(fields, memoField{key: key, name: toCamel(key)})
+	}
+	return fields
+}
+
+// genClientWorkflowMemo generates a <Workflow>Memo struct
+func (svc *Service) genClientWorkflowMemo(f *g.File, workflow string) {
+	typeName := toCamel("%sMemo", workflow)
+
+	f.Commentf("%s describes the memo of a(n) %s workflow, with a field for each key produced by its memo mapping", typeName, svc.fqnForWorkflow(workflow))
+	f.Type().Id(typeName).StructFunc(func(fields *g.Group) {
+		for _, field := range svc.memoFields(workflow) {
+			fields.Commentf("%s is the decoded %q memo value", field.name, field.key)
+			fields.Id(field.name).Any()
+		}
+	})
+}
+
+// genClientWorkflowRunImplMemoMethod generates a <Workflow>Run's Memo method
+func (svc *Service) genClientWorkflowRunImplMemoMethod(f *g.File, workflow string) {
+	typeName := toLowerCamel("%sRun", workflow)
+
+	f.Comment(memoMethodComment)
+	f.Func().
+		Params(g.Id("r").Op("*").Id(typeName)).
+		Id("Memo").
+		Params(g.Id("ctx").Qual("context", "Context")).
+		Params(g.Op("*").Id(toCamel("%sMemo", workflow)), g.Error()).
+		BlockFunc(func(fn *g.Group) {
+			fn.List(g.Id("resp"), g.Err()).Op(":=").Id("r").Dot("client").Dot("client").Dot("DescribeWorkflowExecution").Call(
+				g.Id("ctx"), g.Id("r").Dot("ID").Call(), g.Id("r").Dot("RunID").Call(),
+			)
+			fn.If(g.Err().Op("!=").Nil()).Block(
+				g.Return(g.Nil(), g.Err()),
+			)
+			fn.Id("fields").Op(":=").Id("resp").Dot("GetWorkflowExecutionInfo").Call().Dot("GetMemo").Call().Dot("GetFields").Call()
+			fn.Id("memo").Op(":=").Op("&").Id(toCamel("%sMemo", workflow)).Values()
+			for _, field := range svc.memoFields(workflow) {
+				fn.If(g.List(g.Id("payload"), g.Id("ok")).Op(":=").Id("fields").Index(g.Lit(field.key)), g.Id("ok")).Block(
+					g.If(
+						g.Err().Op(":=").Id("r").Dot("client").Dot("dataConverter").Dot("FromPayload").Call(g.Id("payload"), g.Op("&").Id("memo").Dot(field.name)),
+						g.Err().Op("!=").Nil(),
+					).Block(
+						g.Return(g.Nil(), g.Qual("fmt", "Errorf").Call(g.Lit(fmt.Sprintf("error decoding %q memo: %%w", field.key)), g.Err())),
+					),
+				)
+			}
+			fn.Return(g.Id("memo"), g.Nil())
+		})
+}
+
 // genClientWorkflowRunImplSignalMethod generates a <Workflow>Run's <Signal> method
-func (svc *Service) genClientWorkflowRunImplSignalMethod(f *g.File, workflow string, signal string) {
+func (svc *Service) genClientWorkflowRunImplSignalMethod(f *g.File, workflow string, ref string) {
 	typeName := toLowerCamel("%sRun", workflow)
-	handler := svc.methods[signal]
+	owner, signal := svc.lookupRef(ref)
+	handler := owner.methods[signal]
 	hasInput := !isEmpty(handler.Input)
 
 	// generate get method
-	f.Commentf("%s sends a(n) %s signal", signal, svc.fqnForSignal(signal))
+	f.Commentf("%s sends a(n) %s signal", signal, owner.fqnForSignal(signal))
 	f.Func().
 		Params(g.Id("r").Op("*").Id(typeName)).
 		Id(signal).
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual("context", "Context")
 			if hasInput {
-				args.Id("req").Op("*").Id(handler.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(goIdent(handler.Input.GoIdent))
 			}
 		}).
 		Params(g.Error()).
 		Block(
 			g.Return(
-				g.Id("r").Dot("client").Dot(signal).CallFunc(func(args *g.Group) {
+				svc.genClientWorkflowRunRefClient(owner).Dot(signal).CallFunc(func(args *g.Group) {
 					args.Id("ctx")
 					args.Id("r").Dot("ID").Call()
 					args.Lit("")
@@ -1330,31 +1705,32 @@ func (svc *Service) genClientWorkflowRunImplSignalMethod(f *g.File, workflow str
 }
 
 // genClientWorkflowRunImplUpdateAsyncMethod generates a <Workflow>Run's <Update>Async method
-func (svc *Service) genClientWorkflowRunImplUpdateAsyncMethod(f *g.File, workflow string, update string) {
+func (svc *Service) genClientWorkflowRunImplUpdateAsyncMethod(f *g.File, workflow string, ref string) {
 	typeName := toLowerCamel("%sRun", workflow)
+	owner, update := svc.lookupRef(ref)
 	methodName := toCamel("%sAsync", update)
-	handler := svc.methods[update]
+	handler := owner.methods[update]
 	hasInput := !isEmpty(handler.Input)
 
 	// generate get method
-	f.Commentf("%s sends a(n) %s update to the workflow", methodName, svc.fqnForUpdate(update))
+	f.Commentf("%s sends a(n) %s update to the workflow", methodName, owner.fqnForUpdate(update))
 	f.Func().
 		Params(g.Id("r").Op("*").Id(typeName)).
 		Id(methodName).
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual("context", "Context")
 			if hasInput {
-				args.Id("req").Op("*").Id(handler.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(goIdent(handler.Input.GoIdent))
 			}
-			args.Id("opts").Op("...").Op("*").Id(toCamel("%sOptions", update))
+			args.Id("opts").Op("...").Op("*").Add(owner.qual(toCamel("%sOptions", update)))
 		}).
 		Params(
-			g.Id(toCamel("%sHandle", update)),
+			owner.qual(toCamel("%sHandle", update)),
 			g.Error(),
 		).
 		Block(
 			g.Return(
-				g.Id("r").Dot("client").Dot(toCamel("%sAsync", update)).CallFunc(func(args *g.Group) {
+				svc.genClientWorkflowRunRefClient(owner).Dot(toCamel("%sAsync", update)).CallFunc(func(args *g.Group) {
 					args.Id("ctx")
 					args.Id("r").Dot("ID").Call()
 					args.Id("r").Dot("RunID").Call()
@@ -1368,33 +1744,34 @@ func (svc *Service) genClientWorkflowRunImplUpdateAsyncMethod(f *g.File, workflo
 }
 
 // genClientWorkflowRunImplUpdateMethod generates a <Workflow>Run's <Update> method
-func (svc *Service) genClientWorkflowRunImplUpdateMethod(f *g.File, workflow string, update string) {
+func (svc *Service) genClientWorkflowRunImplUpdateMethod(f *g.File, workflow string, ref string) {
 	typeName := toLowerCamel("%sRun", workflow)
-	handler := svc.methods[update]
+	owner, update := svc.lookupRef(ref)
+	handler := owner.methods[update]
 	hasInput := !isEmpty(handler.Input)
 	hasOutput := !isEmpty(handler.Output)
 
 	// generate get method
-	f.Commentf("%s executes a(n) %s update", update, svc.fqnForUpdate(update))
+	f.Commentf("%s executes a(n) %s update", update, owner.fqnForUpdate(update))
 	f.Func().
 		Params(g.Id("r").Op("*").Id(typeName)).
 		Id(update).
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual("context", "Context")
 			if hasInput {
-				args.Id("req").Op("*").Id(handler.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(goIdent(handler.Input.GoIdent))
 			}
-			args.Id("opts").Op("...").Op("*").Id(toCamel("%sOptions", update))
+			args.Id("opts").Op("...").Op("*").Add(owner.qual(toCamel("%sOptions", update)))
 		}).
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasOutput {
-				returnVals.Op("*").Id(handler.Output.GoIdent.GoName)
+				returnVals.Op("*").Add(goIdent(handler.Output.GoIdent))
 			}
 			returnVals.Error()
 		}).
 		Block(
 			g.Return(
-				g.Id("r").Dot("client").Dot(update).CallFunc(func(args *g.Group) {
+				svc.genClientWorkflowRunRefClient(owner).Dot(update).CallFunc(func(args *g.Group) {
 					args.Id("ctx")
 					args.Id("r").Dot("ID").Call()
 					args.Id("r").Dot("RunID").Call()
@@ -1410,112 +1787,85 @@ func (svc *Service) genClientWorkflowRunImplUpdateMethod(f *g.File, workflow str
 // genClientWorkflowRunInterface generates a <Workflow>Run interface
 func (svc *Service) genClientWorkflowRunInterface(f *g.File, workflow string) {
 	typeName := toCamel("%sRun", workflow)
-	opts := svc.workflows[workflow]
-	method := svc.methods[workflow]
-	hasOutput := !isEmpty(method.Output)
-
 	f.Commentf("%s describes a(n) %s workflow run", typeName, svc.fqnForWorkflow(workflow))
-	f.Type().Id(typeName).InterfaceFunc(func(methods *g.Group) {
-		methods.Comment("ID returns the workflow ID")
-		methods.Id("ID").Params().String()
-
-		methods.Comment("RunID returns the workflow instance ID")
-		methods.Id("RunID").Params().String()
-
-		methods.Comment("Get blocks until the workflow is complete and returns the result")
-		methods.Id("Get").
-			Params(g.Id("ctx").Qual("context", "Context")).
-			ParamsFunc(func(returnVals *g.Group) {
-				if hasOutput {
-					returnVals.Op("*").Id(method.Output.GoIdent.GoName)
-				}
-				returnVals.Error()
-			})
+	genInterface(f, typeName, svc.clientWorkflowRunInterfaceMethods(workflow))
+}
 
-		for _, queryOpts := range opts.GetQuery() {
-			query := queryOpts.GetRef()
-			handler := svc.methods[query]
-			hasInput := !isEmpty(handler.Input)
+// clientWorkflowRunInterfaceMethods returns the methods of a <Workflow>Run interface
+func (svc *Service) clientWorkflowRunInterfaceMethods(workflow string) []*interfaceMethod {
+	opts := svc.workflows[workflow]
+	methods := []*interfaceMethod{
+		{name: "ID", comment: "ID returns the workflow ID", results: []interfaceMethodParam{{name: "id", typ: g.String()}}},
+		{name: "RunID", comment: "RunID returns the workflow instance ID", results: []interfaceMethodParam{{name: "runID", typ: g.String()}}},
+	}
+	if opts.GetMemo() != "" {
+		methods = append(methods, &interfaceMethod{
+			name:    "Memo",
+			comment: memoMethodComment,
+			params:  []interfaceMethodParam{ctxParam()},
+			results: []interfaceMethodParam{{name: "memo", typ: g.Op("*").Add(svc.qual(toCamel("%sMemo", workflow)))}, errResult()},
+		})
+	}
+	methods = append(methods, &interfaceMethod{
+		name:    "Get",
+		comment: "Get blocks until the workflow is complete and returns the result",
+		params:  []interfaceMethodParam{ctxParam()},
+		results: outputResults(svc.methods[workflow].Output),
+	})
 
-			if desc := handler.Comments.Leading.String(); desc != "" {
-				methods.Comment(strings.ReplaceAll(strings.TrimPrefix(desc, "//"), "\n//", ""))
-			} else {
-				methods.Commentf("%s executes a(n) %s query", query, svc.fqnForQuery(query))
-			}
-			methods.Id(query).
-				ParamsFunc(func(args *g.Group) {
-					args.Id("ctx").Qual("context", "Context")
-					if hasInput {
-						args.Id("req").Op("*").Id(handler.Input.GoIdent.GoName)
-					}
-				}).
-				Params(
-					g.Op("*").Id(handler.Output.GoIdent.GoName),
-					g.Error(),
-				)
-		}
+	for _, queryOpts := range opts.GetQuery() {
+		owner, query := svc.lookupRef(queryOpts.GetRef())
+		handler := owner.methods[query]
+		methods = append(methods, &interfaceMethod{
+			name:    query,
+			comment: handlerComment(handler, "%s executes a(n) %s query", query, owner.fqnForQuery(query)),
+			params:  append([]interfaceMethodParam{ctxParam()}, inputParam("req", handler.Input)...),
+			results: []interfaceMethodParam{{name: "resp", typ: g.Op("*").Add(goIdent(handler.Output.GoIdent))}, errResult()},
+		})
+	}
 
-		for _, signalOpts := range opts.GetSignal() {
-			signal := signalOpts.GetRef()
-			handler := svc.methods[signal]
-			hasInput := !isEmpty(handler.Input)
+	for _, signalOpts := range opts.GetSignal() {
+		owner, signal := svc.lookupRef(signalOpts.GetRef())
+		handler := owner.methods[signal]
+		methods = append(methods, &interfaceMethod{
+			name:    signal,
+			comment: handlerComment(handler, "%s sends a(n) %s signal", signal, owner.fqnForSignal(signal)),
+			params:  append([]interfaceMethodParam{ctxParam()}, inputParam("req", handler.Input)...),
+			results: []interfaceMethodParam{errResult()},
+		})
+	}
 
-			if desc := handler.Comments.Leading.String(); desc != "" {
-				methods.Comment(strings.ReplaceAll(strings.TrimPrefix(desc, "//"), "\n//", ""))
-			} else {
-				methods.Commentf("%s sends a(n) %s signal", signal, svc.fqnForSignal(signal))
-			}
-			methods.Id(signal).
-				ParamsFunc(func(args *g.Group) {
-					args.Id("ctx").Qual("context", "Context")
-					if hasInput {
-						args.Id("req").Op("*").Id(handler.Input.GoIdent.GoName)
-					}
-				}).
-				Params(g.Error())
-		}
+	for _, updateOpts := range opts.GetUpdate() {
+		owner, update := svc.lookupRef(updateOpts.GetRef())
+		handler := owner.methods[update]
+		params := append(append([]interfaceMethodParam{ctxParam()}, inputParam("req", handler.Input)...), interfaceMethodParam{
+			name: "opts", typ: g.Op("*").Add(owner.qual(toCamel("%sOptions", update))), variadic: true,
+		})
 
-		for _, updateOpts := range opts.GetUpdate() {
-			update := updateOpts.GetRef()
-			handler := svc.methods[update]
-			hasInput := !isEmpty(handler.Input)
-			hasOutput := !isEmpty(handler.Output)
+		// add synchronous flavor
+		methods = append(methods, &interfaceMethod{
+			name:    update,
+			comment: handlerComment(handler, "%s executes a(n) %s update", update, owner.fqnForUpdate(update)),
+			params:  params,
+			results: outputResults(handler.Output),
+		})
 
-			// add synchronous flavor
-			if desc := handler.Comments.Leading.String(); desc != "" {
-				methods.Comment(strings.ReplaceAll(strings.TrimPrefix(desc, "//"), "\n//", ""))
-			} else {
-				methods.Commentf("%s executes a(n) %s update", update, svc.fqnForUpdate(update))
-			}
-			methods.Id(update).
-				ParamsFunc(func(args *g.Group) {
-					args.Id("ctx").Qual("context", "Context")
-					if hasInput {
-						args.Id("req").Op("*").Id(handler.Input.GoIdent.GoName)
-					}
-					args.Id("opts").Op("...").Op("*").Id(toCamel("%sOptions", update))
-				}).
-				ParamsFunc(func(returnVals *g.Group) {
-					if hasOutput {
-						returnVals.Op("*").Id(handler.Output.GoIdent.GoName)
-					}
-					returnVals.Error()
-				})
+		// add async flavor
+		methods = append(methods, &interfaceMethod{
+			name:    toCamel("%sAsync", update),
+			comment: fmt.Sprintf("%sAsync sends a(n) %s update to the workflow", update, owner.fqnForUpdate(update)),
+			params:  params,
+			results: []interfaceMethodParam{{name: "handle", typ: owner.qual(toCamel("%sHandle", update))}, errResult()},
+		})
+	}
+	return methods
+}
 
-			// add async flavor
-			methods.Commentf("%sAsync sends a(n) %s update to the workflow", update, svc.fqnForUpdate(update))
-			methods.Id(toCamel("%sAsync", update)).
-				ParamsFunc(func(args *g.Group) {
-					args.Id("ctx").Qual("context", "Context")
-					if hasInput {
-						args.Id("req").Op("*").Id(handler.Input.GoIdent.GoName)
-					}
-					args.Id("opts").Op("...").Op("*").Id(toCamel("%sOptions", update))
-				}).
-				Params(
-					g.Id(toCamel("%sHandle", update)),
-					g.Error(),
-				)
-		}
-	})
+// genClientWorkflowRunRefClient returns the client used by a <Workflow>Run to execute queries,
+// signals, and updates defined by the given service, which may differ from the current service
+func (svc *Service) genClientWorkflowRunRefClient(owner *Service) *g.Statement {
+	if owner == svc {
+		return g.Id("r").Dot("client")
+	}
+	return owner.qual(toCamel("New%sClient", owner.Service.GoName)).Call(g.Id("r").Dot("client").Dot("client"))
 }
@@ -0,0 +1,194 @@
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// compatDefinition describes a Temporal definition compared by CheckCompatibility
+type compatDefinition struct {
+	manifestMethod
+	kind string
+	id   string
+	// refs contains the queries, signals, and updates supported by a workflow, keyed
+	// by kind and Temporal name
+	refs map[string]*manifestRef
+}
+
+// compatDefinitions indexes the Temporal definitions of a descriptor set by kind and
+// Temporal name
+type compatDefinitions map[string]map[string]*compatDefinition
+
+// add indexes the given definition
+func (defs compatDefinitions) add(def *compatDefinition) {
+	if defs[def.kind] == nil {
+		defs[def.kind] = make(map[string]*compatDefinition)
+	}
+	defs[def.kind][def.Name] = def
+}
+
+// byMethod returns the definition of the given kind implemented by the given rpc method
+func (defs compatDefinitions) byMethod(kind, method string) *compatDefinition {
+	for _, def := range defs[kind] {
+		if def.Method == method {
+			return def
+		}
+	}
+	return nil
+}
+
+// refKey returns the key used to index workflow query, signal, and update refs
+func refKey(kind, name string) string {
+	return kind + " " + name
+}
+
+// CheckCompatibility compares the Temporal definitions of two serialized FileDescriptorSets
+// and returns a description of each change that is incompatible with in-flight workflow
+// executions, such as renamed or removed definitions, changed input or output types, and
+// changed ID expressions
+func CheckCompatibility(oldPath, newPath string) ([]string, error) {
+	prev, err := loadCompatDefinitions(oldPath)
+	if err != nil {
+		return nil, err
+	}
+	next, err := loadCompatDefinitions(newPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []string
+	report := func(def *compatDefinition, format string, args ...any) {
+		changes = append(changes, fmt.Sprintf("%s %q: %s", def.kind, def.Name, fmt.Sprintf(format, args...)))
+	}
+
+	for _, kind := range []string{"workflow", "activity", "query", "signal", "update"} {
+		names := make([]string, 0, len(prev[kind]))
+		for name := range prev[kind] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			old := prev[kind][name]
+			def, ok := next[kind][name]
+			if !ok {
+				// query, signal, and update removals only affect the workflows that
+				// reference them, which are reported below
+				if kind != "workflow" && kind != "activity" {
+					continue
+				}
+				if renamed := next.byMethod(kind, old.Method); renamed != nil {
+					report(old, "renamed to %q", renamed.Name)
+				} else {
+					report(old, "removed")
+				}
+				continue
+			}
+
+			if old.Input != def.Input {
+				report(old, "input type changed from %s to %s", old.Input, def.Input)
+			}
+			if old.Output != def.Output {
+				report(old, "output type changed from %s to %s", old.Output, def.Output)
+			}
+			if old.id != def.id {
+				report(old, "id expression changed from %q to %q", old.id, def.id)
+			}
+
+			keys := make([]string, 0, len(old.refs))
+			for key := range old.refs {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				if _, ok := def.refs[key]; ok {
+					continue
+				}
+				refKind, refName, _ := strings.Cut(key, " ")
+				var renamed string
+				for newKey, ref := range def.refs {
+					if strings.HasPrefix(newKey, refKind+" ") && ref.Method == old.refs[key].Method {
+						renamed = ref.Name
+					}
+				}
+				if renamed != "" {
+					report(old, "%s %q renamed to %q", refKind, refName, renamed)
+				} else {
+					report(old, "no longer supports %s %q", refKind, refName)
+				}
+			}
+		}
+	}
+	return changes, nil
+}
+
+// loadCompatDefinitions reads a serialized FileDescriptorSet and indexes the Temporal
+// definitions of every service it defines
+func loadCompatDefinitions(filename string) (compatDefinitions, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error reading descriptor set: %w", err)
+	}
+	var set descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(b, &set); err != nil {
+		return nil, fmt.Errorf("error unmarshalling descriptor set %s: %w", filename, err)
+	}
+
+	// protogen requires a Go import path for every file, which is irrelevant when
+	// comparing definitions, so provide a placeholder for files without a go_package
+	req := &pluginpb.CodeGeneratorRequest{ProtoFile: set.GetFile()}
+	var params []string
+	for _, file := range set.GetFile() {
+		req.FileToGenerate = append(req.FileToGenerate, file.GetName())
+		if file.GetOptions().GetGoPackage() == "" {
+			params = append(params, fmt.Sprintf("M%s=%s", file.GetName(), path.Join("compat", path.Dir(file.GetName()))))
+		}
+	}
+	req.Parameter = proto.String(strings.Join(params, ","))
+
+	gen, err := protogen.Options{}.New(req)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing descriptor set %s: %w", filename, err)
+	}
+	p := Plugin{Plugin: gen}
+	p.parseServices()
+
+	defs := make(compatDefinitions)
+	for _, svc := range p.services {
+		m := svc.manifest()
+		for _, w := range m.Workflows {
+			def := &compatDefinition{manifestMethod: w.manifestMethod, kind: "workflow", id: w.ID, refs: make(map[string]*manifestRef)}
+			for _, ref := range w.Queries {
+				def.refs[refKey("query", ref.Name)] = ref
+			}
+			for _, ref := range w.Signals {
+				def.refs[refKey("signal", ref.Name)] = ref
+			}
+			for _, ref := range w.Updates {
+				def.refs[refKey("update", ref.Name)] = ref
+			}
+			defs.add(def)
+		}
+		for _, a := range m.Activities {
+			defs.add(&compatDefinition{manifestMethod: a.manifestMethod, kind: "activity"})
+		}
+		for _, q := range m.Queries {
+			defs.add(&compatDefinition{manifestMethod: q.manifestMethod, kind: "query"})
+		}
+		for _, s := range m.Signals {
+			defs.add(&compatDefinition{manifestMethod: s.manifestMethod, kind: "signal"})
+		}
+		for _, u := range m.Updates {
+			defs.add(&compatDefinition{manifestMethod: u.manifestMethod, kind: "update", id: u.ID})
+		}
+	}
+	return defs, nil
+}
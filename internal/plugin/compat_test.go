@@ -0,0 +1,220 @@
+package plugin
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	temporalv1 "github.com/cludden/protoc-gen-go-temporal/gen/temporal/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// compatMethod returns an rpc method with the given Temporal option
+func compatMethod(name, input, output string, ext protoreflect.ExtensionType, opts proto.Message) *descriptorpb.MethodDescriptorProto {
+	methodOpts := &descriptorpb.MethodOptions{}
+	proto.SetExtension(methodOpts, ext, opts)
+	return &descriptorpb.MethodDescriptorProto{
+		Name:       proto.String(name),
+		InputType:  proto.String(input),
+		OutputType: proto.String(output),
+		Options:    methodOpts,
+	}
+}
+
+// compatFile returns a file defining a compat.v1.Compat service with a workflow that
+// references a query, signal, and update, along with an activity
+func compatFile() *descriptorpb.FileDescriptorProto {
+	message := func(name string) *descriptorpb.DescriptorProto {
+		return &descriptorpb.DescriptorProto{
+			Name: proto.String(name),
+			Field: []*descriptorpb.FieldDescriptorProto{{
+				Name:     proto.String("id"),
+				JsonName: proto.String("id"),
+				Number:   proto.Int32(1),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+			}},
+		}
+	}
+	serviceOpts := &descriptorpb.ServiceOptions{}
+	proto.SetExtension(serviceOpts, temporalv1.E_Service, &temporalv1.ServiceOptions{
+		Features: &temporalv1.ServiceOptions_Features{
+			WorkflowUpdate: &temporalv1.ServiceOptions_Features_WorkflowUpdate{Enabled: true},
+		},
+	})
+	return &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("compat/v1/compat.proto"),
+		Package:    proto.String("compat.v1"),
+		Dependency: []string{"google/protobuf/empty.proto", "temporal/v1/temporal.proto"},
+		Syntax:     proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			message("Request"),
+			message("Response"),
+			message("OtherRequest"),
+		},
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name:    proto.String("Compat"),
+			Options: serviceOpts,
+			Method: []*descriptorpb.MethodDescriptorProto{
+				compatMethod("Foo", ".compat.v1.Request", ".compat.v1.Response", temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Id:     "foo/${! id }",
+					Query:  []*temporalv1.WorkflowOptions_Query{{Ref: "GetFoo"}},
+					Signal: []*temporalv1.WorkflowOptions_Signal{{Ref: "SetFoo"}},
+					Update: []*temporalv1.WorkflowOptions_Update{{Ref: "UpdateFoo"}},
+				}),
+				compatMethod("Bar", ".compat.v1.Request", ".compat.v1.Response", temporalv1.E_Activity, &temporalv1.ActivityOptions{}),
+				compatMethod("GetFoo", ".compat.v1.Request", ".compat.v1.Response", temporalv1.E_Query, &temporalv1.QueryOptions{}),
+				compatMethod("SetFoo", ".compat.v1.Request", ".google.protobuf.Empty", temporalv1.E_Signal, &temporalv1.SignalOptions{}),
+				compatMethod("UpdateFoo", ".compat.v1.Request", ".compat.v1.Response", temporalv1.E_Update, &temporalv1.UpdateOptions{
+					Id: "update-foo/${! id }",
+				}),
+			},
+		}},
+	}
+}
+
+// writeCompatDescriptorSet writes a serialized FileDescriptorSet containing the given file
+// and its dependencies to a temporary file and returns its path
+func writeCompatDescriptorSet(t *testing.T, file *descriptorpb.FileDescriptorProto) string {
+	t.Helper()
+	var set descriptorpb.FileDescriptorSet
+	seen := make(map[string]bool)
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		for i := 0; i < fd.Imports().Len(); i++ {
+			add(fd.Imports().Get(i).FileDescriptor)
+		}
+		set.File = append(set.File, protodesc.ToFileDescriptorProto(fd))
+	}
+	add(emptypb.File_google_protobuf_empty_proto)
+	add(temporalv1.File_temporal_v1_temporal_proto)
+	set.File = append(set.File, file)
+
+	b, err := proto.Marshal(&set)
+	require.NoError(t, err)
+	filename := filepath.Join(t.TempDir(), "set.binpb")
+	require.NoError(t, os.WriteFile(filename, b, 0644))
+	return filename
+}
+
+func TestCheckCompatibility(t *testing.T) {
+	// method returns the method of the compat.v1.Compat service with the given name
+	method := func(file *descriptorpb.FileDescriptorProto, name string) *descriptorpb.MethodDescriptorProto {
+		for _, m := range file.GetService()[0].GetMethod() {
+			if m.GetName() == name {
+				return m
+			}
+		}
+		t.Fatalf("method %q not found", name)
+		return nil
+	}
+	// options returns the Temporal options of the given method
+	options := func(m *descriptorpb.MethodDescriptorProto, ext protoreflect.ExtensionType) proto.Message {
+		return proto.GetExtension(m.GetOptions(), ext).(proto.Message)
+	}
+
+	cases := []struct {
+		desc     string
+		change   func(file *descriptorpb.FileDescriptorProto)
+		expected []string
+	}{
+		{
+			desc:   "no changes",
+			change: func(file *descriptorpb.FileDescriptorProto) {},
+		},
+		{
+			desc: "workflow name changed",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				options(method(file, "Foo"), temporalv1.E_Workflow).(*temporalv1.WorkflowOptions).Name = "compat.v1.FooV2"
+			},
+			expected: []string{`workflow "compat.v1.Compat.Foo": renamed to "compat.v1.FooV2"`},
+		},
+		{
+			desc: "activity method renamed without name",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				method(file, "Bar").Name = proto.String("Baz")
+			},
+			expected: []string{`activity "compat.v1.Compat.Bar": removed`},
+		},
+		{
+			desc: "activity method renamed with name",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				m := method(file, "Bar")
+				m.Name = proto.String("Baz")
+				options(m, temporalv1.E_Activity).(*temporalv1.ActivityOptions).Name = "compat.v1.Compat.Bar"
+			},
+		},
+		{
+			desc: "signal removed",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				svc := file.GetService()[0]
+				for i, m := range svc.GetMethod() {
+					if m.GetName() == "SetFoo" {
+						svc.Method = append(svc.Method[:i], svc.Method[i+1:]...)
+						break
+					}
+				}
+				options(method(file, "Foo"), temporalv1.E_Workflow).(*temporalv1.WorkflowOptions).Signal = nil
+			},
+			expected: []string{`workflow "compat.v1.Compat.Foo": no longer supports signal "compat.v1.Compat.SetFoo"`},
+		},
+		{
+			desc: "query and update renamed",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				options(method(file, "GetFoo"), temporalv1.E_Query).(*temporalv1.QueryOptions).Name = "getFoo"
+				options(method(file, "UpdateFoo"), temporalv1.E_Update).(*temporalv1.UpdateOptions).Name = "updateFoo"
+			},
+			expected: []string{
+				`workflow "compat.v1.Compat.Foo": query "compat.v1.Compat.GetFoo" renamed to "getFoo"`,
+				`workflow "compat.v1.Compat.Foo": update "compat.v1.Compat.UpdateFoo" renamed to "updateFoo"`,
+			},
+		},
+		{
+			desc: "input and output types changed",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				method(file, "Foo").InputType = proto.String(".compat.v1.OtherRequest")
+				method(file, "Bar").OutputType = proto.String(".google.protobuf.Empty")
+				method(file, "GetFoo").InputType = proto.String(".compat.v1.OtherRequest")
+			},
+			expected: []string{
+				`workflow "compat.v1.Compat.Foo": input type changed from compat.v1.Request to compat.v1.OtherRequest`,
+				`activity "compat.v1.Compat.Bar": output type changed from compat.v1.Response to google.protobuf.Empty`,
+				`query "compat.v1.Compat.GetFoo": input type changed from compat.v1.Request to compat.v1.OtherRequest`,
+			},
+		},
+		{
+			desc: "id expressions changed",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				options(method(file, "Foo"), temporalv1.E_Workflow).(*temporalv1.WorkflowOptions).Id = "foo/${! id.uppercase() }"
+				options(method(file, "UpdateFoo"), temporalv1.E_Update).(*temporalv1.UpdateOptions).Id = ""
+			},
+			expected: []string{
+				`workflow "compat.v1.Compat.Foo": id expression changed from "foo/${! id }" to "foo/${! id.uppercase() }"`,
+				`update "compat.v1.Compat.UpdateFoo": id expression changed from "update-foo/${! id }" to ""`,
+			},
+		},
+	}
+
+	oldPath := writeCompatDescriptorSet(t, compatFile())
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			file := compatFile()
+			c.change(file)
+			changes, err := CheckCompatibility(oldPath, writeCompatDescriptorSet(t, file))
+			require.NoError(t, err)
+			require.Equal(t, c.expected, changes)
+		})
+	}
+
+	_, err := CheckCompatibility(filepath.Join(t.TempDir(), "missing.binpb"), oldPath)
+	require.ErrorContains(t, err, "error reading descriptor set")
+}
@@ -0,0 +1,136 @@
+package plugin
+
+import (
+	"fmt"
+
+	g "github.com/dave/jennifer/jen"
+)
+
+// completionParams adds the result parameters of a Complete<Activity>[ByID] method
+func (svc *Service) completionParams(args *g.Group, activity string) {
+	method := svc.methods[activity]
+	if !isEmpty(method.Output) {
+		args.Id("resp").Op("*").Add(goIdent(method.Output.GoIdent))
+	}
+	args.Id("err").Error()
+}
+
+// completionResult returns the result argument of an asynchronous activity completion
+func (svc *Service) completionResult(activity string) *g.Statement {
+	if isEmpty(svc.methods[activity].Output) {
+		return g.Nil()
+	}
+	return g.Id("resp")
+}
+
+// clientActivityCompletionInterfaceMethods returns the Complete<Activity> and
+// Complete<Activity>ByID methods of a Client interface
+func (svc *Service) clientActivityCompletionInterfaceMethods(activity string) []*interfaceMethod {
+	result := append(inputParam("resp", svc.methods[activity].Output), interfaceMethodParam{name: "err", typ: g.Error()})
+	methodName := toCamel("Complete%s", activity)
+	byIDName := toCamel("Complete%sByID", activity)
+	return []*interfaceMethod{
+		{
+			name:    methodName,
+			comment: fmt.Sprintf("%s completes a(n) %s activity that returned activity.ErrResultPending, identified by its task token", methodName, svc.fqnForActivity(activity)),
+			params:  append([]interfaceMethodParam{ctxParam(), {name: "taskToken", typ: g.Index().Byte()}}, result...),
+			results: []interfaceMethodParam{errResult()},
+		},
+		{
+			name:    byIDName,
+			comment: fmt.Sprintf("%s completes a(n) %s activity that returned activity.ErrResultPending, identified by its activity ID", byIDName, svc.fqnForActivity(activity)),
+			params: append([]interfaceMethodParam{
+				ctxParam(),
+				{name: "namespace", typ: g.String()},
+				{name: "workflowID", typ: g.String()},
+				{name: "runID", typ: g.String()},
+				{name: "activityID", typ: g.String()},
+			}, result...),
+			results: []interfaceMethodParam{errResult()},
+		},
+	}
+}
+
+// genClientImplActivityCompletionMethods generates a Client's Complete<Activity> and
+// Complete<Activity>ByID methods
+func (svc *Service) genClientImplActivityCompletionMethods(f *g.File, activity string) {
+	clientType := toLowerCamel("%sClient", svc.Service.GoName)
+
+	methodName := toCamel("Complete%s", activity)
+	f.Commentf("%s completes a(n) %s activity that returned activity.ErrResultPending, identified by its task token", methodName, svc.fqnForActivity(activity))
+	f.Func().
+		Params(g.Id("c").Op("*").Id(clientType)).
+		Id(methodName).
+		ParamsFunc(func(args *g.Group) {
+			args.Id("ctx").Qual("context", "Context")
+			args.Id("taskToken").Index().Byte()
+			svc.completionParams(args, activity)
+		}).
+		Error().
+		Block(
+			g.Return(g.Id("c").Dot("client").Dot("CompleteActivity").Call(
+				g.Id("ctx"), g.Id("taskToken"), svc.completionResult(activity), g.Err(),
+			)),
+		)
+
+	methodName = toCamel("Complete%sByID", activity)
+	f.Commentf("%s completes a(n) %s activity that returned activity.ErrResultPending, identified by its activity ID", methodName, svc.fqnForActivity(activity))
+	f.Func().
+		Params(g.Id("c").Op("*").Id(clientType)).
+		Id(methodName).
+		ParamsFunc(func(args *g.Group) {
+			args.Id("ctx").Qual("context", "Context")
+			args.Id("namespace").String()
+			args.Id("workflowID").String()
+			args.Id("runID").String()
+			args.Id("activityID").String()
+			svc.completionParams(args, activity)
+		}).
+		Error().
+		Block(
+			g.Return(g.Id("c").Dot("client").Dot("CompleteActivityByID").Call(
+				g.Id("ctx"), g.Id("namespace"), g.Id("workflowID"), g.Id("runID"), g.Id("activityID"), svc.completionResult(activity), g.Err(),
+			)),
+		)
+}
+
+// genTestClientImplActivityCompletionMethods generates a TestClient's Complete<Activity>
+// and Complete<Activity>ByID methods
+func (svc *Service) genTestClientImplActivityCompletionMethods(f *g.File, activity string) {
+	clientType := toCamel("Test%sClient", svc.Service.GoName)
+
+	methodName := toCamel("Complete%s", activity)
+	f.Commentf("%s completes a(n) %s activity in the test environment", methodName, svc.fqnForActivity(activity))
+	f.Func().
+		Params(g.Id("c").Op("*").Id(clientType)).
+		Id(methodName).
+		ParamsFunc(func(args *g.Group) {
+			args.Id("ctx").Qual("context", "Context")
+			args.Id("taskToken").Index().Byte()
+			svc.completionParams(args, activity)
+		}).
+		Error().
+		Block(
+			g.Return(g.Id("c").Dot("env").Dot("CompleteActivity").Call(
+				g.Id("taskToken"), svc.completionResult(activity), g.Err(),
+			)),
+		)
+
+	methodName = toCamel("Complete%sByID", activity)
+	f.Commentf("%s is not supported by the test client, use %s instead", methodName, toCamel("Complete%s", activity))
+	f.Func().
+		Params(g.Id("c").Op("*").Id(clientType)).
+		Id(methodName).
+		ParamsFunc(func(args *g.Group) {
+			args.Id("ctx").Qual("context", "Context")
+			args.Id("namespace").String()
+			args.Id("workflowID").String()
+			args.Id("runID").String()
+			args.Id("activityID").String()
+			svc.completionParams(args, activity)
+		}).
+		Error().
+		Block(
+			g.Return(g.Qual("errors", "New").Call(g.Lit("activity completion by id is not supported by the test client"))),
+		)
+}
@@ -0,0 +1,143 @@
+package plugin
+
+import (
+	"sort"
+
+	temporalv1 "github.com/cludden/protoc-gen-go-temporal/gen/temporal/v1"
+	g "github.com/dave/jennifer/jen"
+	"google.golang.org/protobuf/proto"
+)
+
+// serviceErrors returns the typed application errors declared by the service's workflows
+// and activities, ordered by type. Errors declared by more than one method are included once.
+func (svc *Service) serviceErrors() []*temporalv1.ErrorOptions {
+	var declared []*temporalv1.ErrorOptions
+	for _, workflow := range svc.workflowsOrdered {
+		declared = append(declared, svc.workflows[workflow].GetErrors()...)
+	}
+	for _, activity := range svc.activitiesOrdered {
+		declared = append(declared, svc.activities[activity].GetErrors()...)
+	}
+
+	var errs []*temporalv1.ErrorOptions
+	seen := make(map[string]bool)
+	for _, e := range declared {
+		if e.GetType() == "" || seen[e.GetType()] || svc.lookupMessage(e.GetDetail()) == nil {
+			continue
+		}
+		seen[e.GetType()] = true
+		errs = append(errs, e)
+	}
+	sort.Slice(errs, func(i, j int) bool {
+		return errs[i].GetType() < errs[j].GetType()
+	})
+	return errs
+}
+
+// retryPolicyFor returns the retry policy generated for a workflow or activity, which adds
+// the types of its non-retryable errors to the configured policy, if any
+func retryPolicyFor(policy *temporalv1.RetryPolicy, errs []*temporalv1.ErrorOptions) *temporalv1.RetryPolicy {
+	var nonRetryable []string
+	for _, e := range errs {
+		if e.GetNonRetryable() && e.GetType() != "" {
+			nonRetryable = append(nonRetryable, e.GetType())
+		}
+	}
+	if len(nonRetryable) == 0 {
+		return policy
+	}
+
+	merged := &temporalv1.RetryPolicy{}
+	if policy != nil {
+		merged = proto.Clone(policy).(*temporalv1.RetryPolicy)
+	}
+	existing := make(map[string]bool)
+	for _, errType := range merged.NonRetryableErrorTypes {
+		existing[errType] = true
+	}
+	for _, errType := range nonRetryable {
+		if !existing[errType] {
+			existing[errType] = true
+			merged.NonRetryableErrorTypes = append(merged.NonRetryableErrorTypes, errType)
+		}
+	}
+	return merged
+}
+
+// genErrors generates an application error type constant, along with New<Type>Error and
+// As<Type>Error functions, for each typed application error declared by the service
+func (svc *Service) genErrors(f *g.File) {
+	errs := svc.serviceErrors()
+	if len(errs) == 0 {
+		return
+	}
+
+	// generate error type constants
+	f.Commentf("%s application error types", svc.Service.Desc.FullName())
+	f.Const().DefsFunc(func(defs *g.Group) {
+		for _, e := range errs {
+			defs.Id(toCamel("%sErrorType", e.GetType())).Op("=").Lit(e.GetType())
+		}
+	})
+
+	for _, e := range errs {
+		svc.genErrorConstructor(f, e)
+		svc.genErrorExtractor(f, e)
+	}
+}
+
+// genErrorConstructor generates a New<Type>Error function
+func (svc *Service) genErrorConstructor(f *g.File, e *temporalv1.ErrorOptions) {
+	detail := svc.lookupMessage(e.GetDetail())
+	functionName := toCamel("New%sError", e.GetType())
+	errType := toCamel("%sErrorType", e.GetType())
+
+	if e.GetNonRetryable() {
+		f.Commentf("%s initializes a new non-retryable %s application error with the given details", functionName, e.GetType())
+	} else {
+		f.Commentf("%s initializes a new %s application error with the given details", functionName, e.GetType())
+	}
+	f.Func().
+		Id(functionName).
+		Params(g.Id("detail").Op("*").Add(goIdent(detail.GoIdent))).
+		Error().
+		BlockFunc(func(fn *g.Group) {
+			if e.GetNonRetryable() {
+				fn.Return(g.Qual(temporalPkg, "NewNonRetryableApplicationError").Call(g.Id(errType), g.Id(errType), g.Nil(), g.Id("detail")))
+			} else {
+				fn.Return(g.Qual(temporalPkg, "NewApplicationError").Call(g.Id(errType), g.Id(errType), g.Id("detail")))
+			}
+		})
+}
+
+// genErrorExtractor generates an As<Type>Error function
+func (svc *Service) genErrorExtractor(f *g.File, e *temporalv1.ErrorOptions) {
+	detail := svc.lookupMessage(e.GetDetail())
+	functionName := toCamel("As%sError", e.GetType())
+
+	f.Commentf("%s returns the details of the first %s application error in the chain of err, which", functionName, e.GetType())
+	f.Comment("includes the causes of activity, child workflow, and workflow execution errors")
+	f.Func().
+		Id(functionName).
+		Params(g.Err().Error()).
+		Params(g.Op("*").Add(goIdent(detail.GoIdent)), g.Bool()).
+		Block(
+			g.Var().Id("appErr").Op("*").Qual(temporalPkg, "ApplicationError"),
+			g.For(g.Qual("errors", "As").Call(g.Err(), g.Op("&").Id("appErr"))).Block(
+				g.If(g.Id("appErr").Dot("Type").Call().Op("==").Id(toCamel("%sErrorType", e.GetType()))).Block(
+					g.Var().Id("detail").Op("*").Add(goIdent(detail.GoIdent)),
+					g.If(g.Id("appErr").Dot("HasDetails").Call()).Block(
+						g.If(g.Err().Op(":=").Id("appErr").Dot("Details").Call(g.Op("&").Id("detail")), g.Err().Op("!=").Nil()).Block(
+							g.Return(g.Nil(), g.False()),
+						),
+					),
+					g.If(g.Id("detail").Op("==").Nil()).Block(
+						g.Id("detail").Op("=").Op("&").Add(goIdent(detail.GoIdent)).Values(),
+					),
+					g.Return(g.Id("detail"), g.True()),
+				),
+				g.Err().Op("=").Id("appErr").Dot("Unwrap").Call(),
+			),
+			g.Return(g.Nil(), g.False()),
+		)
+}
@@ -0,0 +1,259 @@
+package plugin
+
+import (
+	"fmt"
+
+	g "github.com/dave/jennifer/jen"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// interceptorHook describes a typed hook method of a generated interceptor interface
+type interceptorHook struct {
+	name    string
+	comment string
+	params  []interceptorHookParam
+	// reverse indicates that the hook is invoked on chained interceptors in reverse order
+	reverse bool
+}
+
+// interceptorHookParam describes a parameter of an interceptor hook
+type interceptorHookParam struct {
+	name string
+	typ  g.Code
+}
+
+// newBeforeHook returns a hook invoked with the input of a method, if applicable
+func newBeforeHook(name, comment string, ctx g.Code, input *protogen.Message) interceptorHook {
+	params := []interceptorHookParam{{name: "ctx", typ: ctx}}
+	if !isEmpty(input) {
+		params = append(params, interceptorHookParam{name: "req", typ: g.Op("*").Add(goIdent(input.GoIdent))})
+	}
+	return interceptorHook{name: name, comment: comment, params: params}
+}
+
+// newAfterHook returns a hook invoked with the result of a method, if applicable, and error
+func newAfterHook(name, comment string, ctx g.Code, output *protogen.Message) interceptorHook {
+	params := []interceptorHookParam{{name: "ctx", typ: ctx}}
+	if !isEmpty(output) {
+		params = append(params, interceptorHookParam{name: "resp", typ: g.Op("*").Add(goIdent(output.GoIdent))})
+	}
+	params = append(params, interceptorHookParam{name: "err", typ: g.Error()})
+	return interceptorHook{name: name, comment: comment, params: params, reverse: true}
+}
+
+// activityHookName returns the name of an activity interceptor hook, which is suffixed
+// to avoid conflicting with workflow hooks when the method is also a workflow
+func (svc *Service) activityHookName(prefix, activity string) string {
+	if _, ok := svc.workflows[activity]; ok {
+		return toCamel("%s%sActivity", prefix, activity)
+	}
+	return toCamel("%s%s", prefix, activity)
+}
+
+// isIntercepted returns true if the given query, signal, or update is defined by the
+// service and registered by at least one of its workflows
+func (svc *Service) isIntercepted(handler string) bool {
+	for _, workflow := range svc.workflowsOrdered {
+		opts := svc.workflows[workflow]
+		var refs []string
+		for _, q := range opts.GetQuery() {
+			refs = append(refs, q.GetRef())
+		}
+		for _, s := range opts.GetSignal() {
+			refs = append(refs, s.GetRef())
+		}
+		for _, u := range opts.GetUpdate() {
+			refs = append(refs, u.GetRef())
+		}
+		for _, ref := range refs {
+			if owner, name := svc.lookupRef(ref); owner == svc && name == handler {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// interceptorHooks returns the hooks of the service's worker <Service>Interceptor
+func (svc *Service) interceptorHooks() (hooks []interceptorHook) {
+	ctx := g.Qual(workflowPkg, "Context")
+	for _, workflow := range svc.workflowsOrdered {
+		method := svc.methods[workflow]
+		fqn := svc.fqnForWorkflow(workflow)
+		hooks = append(hooks,
+			newBeforeHook(toCamel("Before%s", workflow), fmt.Sprintf("is invoked with the input of a(n) %s workflow before it executes", fqn), ctx, method.Input),
+			newAfterHook(toCamel("After%s", workflow), fmt.Sprintf("is invoked with the result of a(n) %s workflow after it executes", fqn), ctx, method.Output),
+		)
+	}
+	for _, query := range svc.queriesOrdered {
+		if !svc.isIntercepted(query) {
+			continue
+		}
+		method := svc.methods[query]
+		fqn := svc.fqnForQuery(query)
+		hooks = append(hooks,
+			newBeforeHook(toCamel("Before%s", query), fmt.Sprintf("is invoked with the input of a(n) %s query before it is handled", fqn), ctx, method.Input),
+			newAfterHook(toCamel("After%s", query), fmt.Sprintf("is invoked with the result of a(n) %s query after it is handled", fqn), ctx, method.Output),
+		)
+	}
+	for _, signal := range svc.signalsOrdered {
+		if !svc.isIntercepted(signal) {
+			continue
+		}
+		hooks = append(hooks,
+			newBeforeHook(toCamel("On%s", signal), fmt.Sprintf("is invoked with each %s signal received by a workflow", svc.fqnForSignal(signal)), ctx, svc.methods[signal].Input),
+		)
+	}
+	for _, update := range svc.updatesOrdered {
+		if !svc.isIntercepted(update) {
+			continue
+		}
+		method := svc.methods[update]
+		fqn := svc.fqnForUpdate(update)
+		hooks = append(hooks,
+			newBeforeHook(toCamel("Before%s", update), fmt.Sprintf("is invoked with the input of a(n) %s update before it is handled", fqn), ctx, method.Input),
+			newAfterHook(toCamel("After%s", update), fmt.Sprintf("is invoked with the result of a(n) %s update after it is handled", fqn), ctx, method.Output),
+		)
+	}
+	for _, activity := range svc.activitiesOrdered {
+		method := svc.methods[activity]
+		fqn := svc.fqnForActivity(activity)
+		hooks = append(hooks,
+			newBeforeHook(svc.activityHookName("Before", activity), fmt.Sprintf("is invoked with the input of a(n) %s activity before it executes", fqn), g.Qual("context", "Context"), method.Input),
+			newAfterHook(svc.activityHookName("After", activity), fmt.Sprintf("is invoked with the result of a(n) %s activity after it executes", fqn), g.Qual("context", "Context"), method.Output),
+		)
+	}
+	return hooks
+}
+
+// clientInterceptorHooks returns the hooks of the service's <Service>ClientInterceptor
+func (svc *Service) clientInterceptorHooks() (hooks []interceptorHook) {
+	ctx := g.Qual("context", "Context")
+	for _, workflow := range svc.workflowsOrdered {
+		method := svc.methods[workflow]
+		fqn := svc.fqnForWorkflow(workflow)
+		hooks = append(hooks,
+			newBeforeHook(toCamel("Before%s", workflow), fmt.Sprintf("is invoked with the input of a(n) %s workflow before it is started", fqn), ctx, method.Input),
+			newAfterHook(toCamel("After%s", workflow), fmt.Sprintf("is invoked with the result of a(n) %s workflow when it is retrieved", fqn), ctx, method.Output),
+		)
+	}
+	for _, query := range svc.queriesOrdered {
+		method := svc.methods[query]
+		fqn := svc.fqnForQuery(query)
+		hooks = append(hooks,
+			newBeforeHook(toCamel("Before%s", query), fmt.Sprintf("is invoked with the input of a(n) %s query before it is sent", fqn), ctx, method.Input),
+			newAfterHook(toCamel("After%s", query), fmt.Sprintf("is invoked with the result of a(n) %s query after it is received", fqn), ctx, method.Output),
+		)
+	}
+	for _, signal := range svc.signalsOrdered {
+		hooks = append(hooks,
+			newBeforeHook(toCamel("On%s", signal), fmt.Sprintf("is invoked with the input of a(n) %s signal before it is sent", svc.fqnForSignal(signal)), ctx, svc.methods[signal].Input),
+		)
+	}
+	for _, update := range svc.updatesOrdered {
+		method := svc.methods[update]
+		fqn := svc.fqnForUpdate(update)
+		hooks = append(hooks,
+			newBeforeHook(toCamel("Before%s", update), fmt.Sprintf("is invoked with the input of a(n) %s update before it is sent", fqn), ctx, method.Input),
+			newAfterHook(toCamel("After%s", update), fmt.Sprintf("is invoked with the result of a(n) %s update when it is retrieved", fqn), ctx, method.Output),
+		)
+	}
+	return hooks
+}
+
+// hasInterceptor returns true if the service generates a worker <Service>Interceptor
+func (svc *Service) hasInterceptor() bool {
+	return len(svc.workflowsOrdered) > 0 || len(svc.activitiesOrdered) > 0
+}
+
+// hasClientInterceptor returns true if the service generates a <Service>ClientInterceptor
+func (svc *Service) hasClientInterceptor() bool {
+	return len(svc.workflowsOrdered) > 0 || len(svc.queriesOrdered) > 0 || len(svc.signalsOrdered) > 0 || len(svc.updatesOrdered) > 0
+}
+
+// genInterceptor generates a <Service>Interceptor interface, along with a no-op
+// implementation, with typed hooks invoked by workers
+func (svc *Service) genInterceptor(f *g.File) {
+	if !svc.hasInterceptor() {
+		return
+	}
+	typeName := toCamel("%sInterceptor", svc.Service.GoName)
+	f.Commentf("%s describes typed hooks invoked around %s workflows, queries, signals, updates,", typeName, svc.Service.Desc.FullName())
+	f.Commentf("and activities executed by a worker, which can be installed via the Register%sWorkflows,", svc.Service.GoName)
+	f.Commentf("Register<Workflow>Workflow, Register%sActivities, and Register<Activity>Activity functions.", svc.Service.GoName)
+	f.Comment("Queries, signals, and updates defined by other services are not intercepted, and workflow,")
+	f.Comment("signal, and update hooks are not invoked while a workflow is replaying history.")
+	svc.genInterceptorTypes(f, typeName, toLowerCamel("%sInterceptors", svc.Service.GoName), svc.interceptorHooks())
+}
+
+// genClientInterceptor generates a <Service>ClientInterceptor interface, along with a
+// no-op implementation, with typed hooks invoked by the generated client
+func (svc *Service) genClientInterceptor(f *g.File) {
+	if !svc.hasClientInterceptor() {
+		return
+	}
+	typeName := toCamel("%sClientInterceptor", svc.Service.GoName)
+	f.Commentf("%s describes typed hooks invoked around %s workflows, queries, signals,", typeName, svc.Service.Desc.FullName())
+	f.Commentf("and updates sent by a client, which can be installed via the New%sClient functions", svc.Service.GoName)
+	svc.genInterceptorTypes(f, typeName, toLowerCamel("%sClientInterceptors", svc.Service.GoName), svc.clientInterceptorHooks())
+}
+
+// genInterceptorTypes generates an interceptor interface with the given hooks, along with
+// a Noop<Interface> implementation and an internal type that chains multiple interceptors
+func (svc *Service) genInterceptorTypes(f *g.File, typeName, chainName string, hooks []interceptorHook) {
+	params := func(hook interceptorHook, named bool) func(*g.Group) {
+		return func(args *g.Group) {
+			for _, p := range hook.params {
+				if named {
+					args.Id(p.name).Add(p.typ)
+				} else {
+					args.Add(p.typ)
+				}
+			}
+		}
+	}
+
+	// generate interface
+	f.Type().Id(typeName).InterfaceFunc(func(methods *g.Group) {
+		for _, hook := range hooks {
+			methods.Commentf("%s %s", hook.name, hook.comment)
+			methods.Id(hook.name).ParamsFunc(params(hook, true))
+		}
+	})
+
+	// generate no-op implementation
+	noopName := fmt.Sprintf("Noop%s", typeName)
+	f.Commentf("%s provides a no-op %s implementation that can be embedded", noopName, typeName)
+	f.Comment("to implement a subset of hooks")
+	f.Type().Id(noopName).Struct()
+	for _, hook := range hooks {
+		f.Commentf("%s implements %s", hook.name, typeName)
+		f.Func().Params(g.Id(noopName)).Id(hook.name).ParamsFunc(params(hook, false)).Block()
+	}
+
+	// generate chain implementation
+	f.Commentf("%s invokes the hooks of multiple %s values, in order for", chainName, typeName)
+	f.Comment("Before<Method> and On<Method> hooks and in reverse order for After<Method> hooks")
+	f.Type().Id(chainName).Index().Id(typeName)
+	for _, hook := range hooks {
+		call := func(interceptor *g.Statement) *g.Statement {
+			return interceptor.Dot(hook.name).CallFunc(func(args *g.Group) {
+				for _, p := range hook.params {
+					args.Id(p.name)
+				}
+			})
+		}
+		f.Commentf("%s implements %s", hook.name, typeName)
+		f.Func().Params(g.Id("c").Id(chainName)).Id(hook.name).ParamsFunc(params(hook, true)).BlockFunc(func(fn *g.Group) {
+			if hook.reverse {
+				fn.For(g.Id("i").Op(":=").Len(g.Id("c")).Op("-").Lit(1), g.Id("i").Op(">=").Lit(0), g.Id("i").Op("--")).Block(
+					call(g.Id("c").Index(g.Id("i"))),
+				)
+			} else {
+				fn.For(g.List(g.Id("_"), g.Id("interceptor")).Op(":=").Range().Id("c")).Block(
+					call(g.Id("interceptor")),
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,535 @@
+package plugin
+
+import (
+	"fmt"
+	"go/token"
+
+	temporalv1 "github.com/cludden/protoc-gen-go-temporal/gen/temporal/v1"
+	"github.com/cludden/protoc-gen-go-temporal/pkg/expression"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// supported lint rules
+const (
+	// ruleConflictingRef reports workflow refs that resolve to the same Go name
+	ruleConflictingRef = "CONFLICTING_REF"
+	// ruleDanglingRef reports workflow refs to undefined queries, signals, or updates
+	ruleDanglingRef = "DANGLING_REF"
+	// ruleDeprecatedCLIFeature reports fields that use the deprecated CLIFeature enum, which
+	// is not read by the plugin
+	ruleDeprecatedCLIFeature = "DEPRECATED_CLI_FEATURE"
+	// ruleDuplicateName reports Temporal names used by more than one method
+	ruleDuplicateName = "DUPLICATE_NAME"
+	// ruleInvalidCompensation reports activity compensations that reference undefined
+	// activities, or whose input can't be derived from the activity input or output
+	ruleInvalidCompensation = "INVALID_COMPENSATION"
+	// ruleInvalidError reports typed application errors without a type, with an undefined
+	// detail message, or that conflict with another declaration of the same type
+	ruleInvalidError = "INVALID_ERROR"
+	// ruleInvalidHeartbeat reports activity heartbeat refs to undefined messages
+	ruleInvalidHeartbeat = "INVALID_HEARTBEAT"
+	// ruleInvalidIDExpression reports workflow, activity, or update ID expressions that can't
+	// be parsed or that reference fields not defined by the input message
+	ruleInvalidIDExpression = "INVALID_ID_EXPRESSION"
+	// ruleInvalidMemo reports memo mappings that can't be parsed or that reference fields
+	// not defined by the input message
+	ruleInvalidMemo = "INVALID_MEMO"
+	// ruleInvalidMethodOptions reports methods with an unsupported combination of options
+	ruleInvalidMethodOptions = "INVALID_METHOD_OPTIONS"
+	// ruleInvalidSearchAttributes reports search attribute mappings that can't be parsed
+	// or that reference fields not defined by the input message
+	ruleInvalidSearchAttributes = "INVALID_SEARCH_ATTRIBUTES"
+	// ruleInvalidSpanAttributes reports span attribute mappings that can't be parsed or
+	// that reference fields not defined by the input message
+	ruleInvalidSpanAttributes = "INVALID_SPAN_ATTRIBUTES"
+	// ruleSignalOutput reports signals that return a value
+	ruleSignalOutput = "SIGNAL_OUTPUT_NOT_EMPTY"
+	// ruleTracingDisabled reports span attribute mappings that are ignored because the
+	// tracing feature is disabled
+	ruleTracingDisabled = "TRACING_FEATURE_DISABLED"
+	// ruleUpdateDisabled reports update options that are ignored because the
+	// workflow update feature is disabled
+	ruleUpdateDisabled = "UPDATE_FEATURE_DISABLED"
+)
+
+// methodOptionsFieldNumber is the field number of google.protobuf.MethodDescriptorProto.options
+const methodOptionsFieldNumber = 4
+
+// severity describes how a diagnostic affects code generation
+type severity int
+
+const (
+	// severityError diagnostics fail code generation
+	severityError severity = iota
+	// severityWarning diagnostics are reported but do not fail code generation
+	severityWarning
+)
+
+// diagnostic describes a problem found in a temporal service definition
+type diagnostic struct {
+	file     string
+	line     int
+	col      int
+	rule     string
+	severity severity
+	message  string
+}
+
+// Error implements the error interface, formatting the diagnostic as
+// file:line:col RULE_ID message
+func (d *diagnostic) Error() string {
+	return fmt.Sprintf("%s:%d:%d %s %s", d.file, d.line, d.col, d.rule, d.message)
+}
+
+// linter collects diagnostics
+type linter struct {
+	diagnostics []*diagnostic
+	// names maps Temporal names to the method that first used them, by kind
+	names map[string]map[string]namedMethod
+	// errors maps the Go names of typed application error helpers, qualified by Go import
+	// path, to the service that first declared them
+	errors map[string]*Service
+}
+
+// namedMethod describes the method that first used a Temporal name, and the service that
+// defines it
+type namedMethod struct {
+	svc    *Service
+	method *protogen.Method
+}
+
+// report records a diagnostic located at the given source path of the given file. If the
+// file has no source info for the path, the closest enclosing element is used instead.
+func (l *linter) report(file *protogen.File, path protoreflect.SourcePath, sev severity, rule string, format string, args ...any) {
+	loc := file.Desc.SourceLocations().ByPath(path)
+	for loc.Path == nil && len(path) > 0 {
+		path = path[:len(path)-1]
+		loc = file.Desc.SourceLocations().ByPath(path)
+	}
+	l.diagnostics = append(l.diagnostics, &diagnostic{
+		file:     file.Desc.Path(),
+		line:     loc.StartLine + 1,
+		col:      loc.StartColumn + 1,
+		rule:     rule,
+		severity: sev,
+		message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// diagnose lints the services defined by the files being generated
+func (p *Plugin) diagnose() []*diagnostic {
+	l := linter{names: make(map[string]map[string]namedMethod), errors: make(map[string]*Service)}
+	for _, file := range p.Files {
+		if !file.Generate {
+			continue
+		}
+		lintDeprecated(&l, file)
+		for _, service := range file.Services {
+			p.services[service.Desc.FullName()].lint(&l)
+		}
+	}
+	return l.diagnostics
+}
+
+// lintDeprecated records diagnostics for fields and extensions defined by the given file that
+// use deprecated Temporal option types
+func lintDeprecated(l *linter, file *protogen.File) {
+	cliFeature := temporalv1.CLIFeature(0).Descriptor().FullName()
+	check := func(field *protogen.Field) {
+		if field.Enum != nil && field.Enum.Desc.FullName() == cliFeature {
+			l.report(file, field.Location.Path, severityWarning, ruleDeprecatedCLIFeature, "field %q uses deprecated enum %s, which is not read by the plugin, use temporal.v1.ServiceOptions.Features.CLI instead", field.Desc.FullName(), cliFeature)
+		}
+	}
+	var checkMessages func(messages []*protogen.Message)
+	checkMessages = func(messages []*protogen.Message) {
+		for _, message := range messages {
+			for _, field := range message.Fields {
+				check(field)
+			}
+			for _, ext := range message.Extensions {
+				check(ext)
+			}
+			checkMessages(message.Messages)
+		}
+	}
+	for _, ext := range file.Extensions {
+		check(ext)
+	}
+	checkMessages(file.Messages)
+}
+
+// optionPath returns the source path of the given method option
+func optionPath(method *protogen.Method, xt protoreflect.ExtensionType) protoreflect.SourcePath {
+	path := make(protoreflect.SourcePath, 0, len(method.Location.Path)+2)
+	path = append(path, method.Location.Path...)
+	return append(path, methodOptionsFieldNumber, int32(xt.TypeDescriptor().Number()))
+}
+
+// lint records diagnostics for the service definition
+func (svc *Service) lint(l *linter) {
+	svc.lintMethodOptions(l)
+	svc.lintRefs(l)
+	svc.lintSignals(l)
+	svc.lintHeartbeats(l)
+	svc.lintCompensations(l)
+	svc.lintErrors(l)
+	svc.lintExpressions(l)
+	svc.lintNames(l)
+}
+
+// lintMethodOptions ensures that each method defines a supported combination of options
+func (svc *Service) lintMethodOptions(l *linter) {
+	for _, method := range svc.Service.Methods {
+		name := toCamel(method.GoName)
+		var mode int
+		if _, ok := svc.workflows[name]; ok {
+			mode |= modeWorkflow
+		}
+		if _, ok := svc.activities[name]; ok {
+			mode |= modeActivity
+		}
+		if _, ok := svc.queries[name]; ok {
+			mode |= modeQuery
+		}
+		if _, ok := svc.signals[name]; ok {
+			mode |= modeSignal
+		}
+		if _, ok := svc.updates[name]; ok {
+			mode |= modeUpdate
+		}
+
+		if !svc.opts.GetFeatures().GetWorkflowUpdate().GetEnabled() && proto.HasExtension(method.Desc.Options(), temporalv1.E_Update) {
+			l.report(svc.File, optionPath(method, temporalv1.E_Update), severityWarning, ruleUpdateDisabled, "update options for method %q are ignored because features.workflow_update is not enabled for service %q", method.Desc.FullName(), svc.Service.Desc.FullName())
+		}
+
+		switch mode {
+		case modeWorkflow, modeActivity, modeWorkflow | modeActivity, modeQuery, modeSignal, modeUpdate:
+		default:
+			l.report(svc.File, method.Location.Path, severityError, ruleInvalidMethodOptions, "invalid method options for method %q", method.Desc.FullName())
+		}
+	}
+}
+
+// lintRefs ensures that workflow query, signal, and update refs are defined and do not conflict
+func (svc *Service) lintRefs(l *linter) {
+	for _, workflow := range svc.workflowsOrdered {
+		opts := svc.workflows[workflow]
+		path := optionPath(svc.methods[workflow], temporalv1.E_Workflow)
+		refs := make(map[string]string)
+
+		check := func(kind, ref string, defined func(owner *Service, name string) bool) {
+			owner, name := svc.lookupRef(ref)
+			if owner == nil || !defined(owner, name) {
+				l.report(svc.File, path, severityError, ruleDanglingRef, "workflow %q references undefined %s: %q", workflow, kind, ref)
+				return
+			}
+			if prev, ok := refs[name]; ok {
+				l.report(svc.File, path, severityError, ruleConflictingRef, "workflow %q references conflicting queries, signals, or updates named %q: %q and %q", workflow, name, prev, ref)
+			}
+			refs[name] = ref
+		}
+
+		for _, queryOpts := range opts.GetQuery() {
+			check("query", queryOpts.GetRef(), func(owner *Service, name string) bool {
+				return owner.queries[name] != nil
+			})
+		}
+		for _, signalOpts := range opts.GetSignal() {
+			check("signal", signalOpts.GetRef(), func(owner *Service, name string) bool {
+				return owner.signals[name] != nil
+			})
+		}
+		for _, updateOpts := range opts.GetUpdate() {
+			check("update", updateOpts.GetRef(), func(owner *Service, name string) bool {
+				return owner.updates[name] != nil
+			})
+		}
+	}
+}
+
+// lintHeartbeats ensures that activity heartbeat refs resolve to a message
+func (svc *Service) lintHeartbeats(l *linter) {
+	for _, activity := range svc.activitiesOrdered {
+		ref := svc.activities[activity].GetHeartbeat()
+		if ref == "" || svc.lookupMessage(ref) != nil {
+			continue
+		}
+		method := svc.methods[activity]
+		l.report(svc.File, optionPath(method, temporalv1.E_Activity), severityError, ruleInvalidHeartbeat, "activity %q references undefined heartbeat message: %q", method.Desc.FullName(), ref)
+	}
+}
+
+// lintCompensations ensures that activity compensation refs resolve to an activity defined
+// by the service, and that the compensating activity input can be derived
+func (svc *Service) lintCompensations(l *linter) {
+	for _, activity := range svc.activitiesOrdered {
+		opts := svc.activities[activity].GetCompensate()
+		if opts == nil {
+			continue
+		}
+		method := svc.methods[activity]
+		path := optionPath(method, temporalv1.E_Activity)
+		compensation, input := svc.lookupCompensation(activity)
+		switch {
+		case compensation == "":
+			l.report(svc.File, path, severityError, ruleInvalidCompensation, "activity %q references undefined compensating activity: %q", method.Desc.FullName(), opts.GetRef())
+		case input == "":
+			l.report(svc.File, path, severityError, ruleInvalidCompensation, "activity %q requires a compensation input mapping, as the %q input does not match the activity input or output", method.Desc.FullName(), compensation)
+		case input == compensationInputMapping:
+			if err := lintCompensationMapping(method, opts.GetInput()); err != nil {
+				l.report(svc.File, path, severityError, ruleInvalidCompensation, "invalid compensation input mapping %q for activity %q: %v", opts.GetInput(), method.Desc.FullName(), err)
+			}
+		}
+	}
+}
+
+// lintCompensationMapping parses a compensation input mapping and validates the field
+// paths it references against the activity's input and output messages
+func lintCompensationMapping(method *protogen.Method, mapping string) error {
+	m, err := expression.ParseMapping(mapping)
+	if err != nil {
+		return err
+	}
+	for _, path := range expression.FieldPaths(m) {
+		var msg *protogen.Message
+		switch path[0] {
+		case "input":
+			msg = method.Input
+		case "output":
+			msg = method.Output
+		default:
+			return fmt.Errorf("field %q not found, expected input or output", path[0])
+		}
+		if err := expression.ValidateFieldPath(msg.Desc, path[1:]); err != nil {
+			return fmt.Errorf("%s: %w", path[0], err)
+		}
+	}
+	return nil
+}
+
+// lintErrors ensures that typed application errors define a type and resolvable detail
+// message, and that each type is declared consistently within a Go package
+func (svc *Service) lintErrors(l *linter) {
+	declared := make(map[string]*temporalv1.ErrorOptions)
+	check := func(method *protogen.Method, xt protoreflect.ExtensionType, kind string, errs []*temporalv1.ErrorOptions) {
+		path := optionPath(method, xt)
+		for _, e := range errs {
+			switch {
+			case e.GetType() == "":
+				l.report(svc.File, path, severityError, ruleInvalidError, "%s %q declares an error without a type", kind, method.Desc.FullName())
+				continue
+			case svc.lookupMessage(e.GetDetail()) == nil:
+				l.report(svc.File, path, severityError, ruleInvalidError, "%s %q error %q references undefined detail message: %q", kind, method.Desc.FullName(), e.GetType(), e.GetDetail())
+				continue
+			}
+
+			if prev, ok := declared[e.GetType()]; ok {
+				if svc.lookupMessage(prev.GetDetail()) != svc.lookupMessage(e.GetDetail()) || prev.GetNonRetryable() != e.GetNonRetryable() {
+					l.report(svc.File, path, severityError, ruleInvalidError, "%s %q error %q conflicts with another declaration of the same type", kind, method.Desc.FullName(), e.GetType())
+				}
+				continue
+			}
+			declared[e.GetType()] = e
+
+			key := fmt.Sprintf("%s.%s", svc.File.GoImportPath, toCamel("%sErrorType", e.GetType()))
+			if owner, ok := l.errors[key]; ok && owner != svc {
+				l.report(svc.File, path, severityError, ruleInvalidError, "%s %q error %q conflicts with an error declared by service %q", kind, method.Desc.FullName(), e.GetType(), owner.Service.Desc.FullName())
+				continue
+			}
+			l.errors[key] = svc
+		}
+	}
+
+	for _, workflow := range svc.workflowsOrdered {
+		check(svc.methods[workflow], temporalv1.E_Workflow, "workflow", svc.workflows[workflow].GetErrors())
+	}
+	for _, activity := range svc.activitiesOrdered {
+		check(svc.methods[activity], temporalv1.E_Activity, "activity", svc.activities[activity].GetErrors())
+	}
+}
+
+// lintSignals ensures that signals return no value, unless signal method is also an
+// activity, query, update, and/or workflow
+func (svc *Service) lintSignals(l *linter) {
+	for _, signal := range svc.signalsOrdered {
+		handler := svc.methods[signal]
+		_, isActivity := svc.activities[signal]
+		_, isQuery := svc.queries[signal]
+		_, isUpdate := svc.updates[signal]
+		_, isWorkflow := svc.workflows[signal]
+		if !isActivity && !isQuery && !isUpdate && !isWorkflow && !isEmpty(handler.Output) {
+			l.report(svc.File, handler.Location.Path, severityError, ruleSignalOutput, "expected signal %q output to be google.protobuf.Empty, got: %s", signal, handler.Output.Desc.FullName())
+		}
+	}
+}
+
+// lintExpressions ensures that ID expressions, search attribute mappings, and memo mappings
+// can be parsed, and that the field paths they reference exist on the method's input message
+func (svc *Service) lintExpressions(l *linter) {
+	for _, workflow := range svc.workflowsOrdered {
+		opts := svc.workflows[workflow]
+		method := svc.methods[workflow]
+		path := optionPath(method, temporalv1.E_Workflow)
+		if expr := opts.GetId(); expr != "" {
+			if err := lintIDExpression(method, expr); err != nil {
+				l.report(svc.File, path, severityError, ruleInvalidIDExpression, "invalid id expression %q for workflow %q: %v", expr, method.Desc.FullName(), err)
+			}
+		}
+		if mapping := opts.GetSearchAttributes(); mapping != "" {
+			if err := lintMapping(method, mapping); err != nil {
+				l.report(svc.File, path, severityError, ruleInvalidSearchAttributes, "invalid search attributes mapping %q for workflow %q: %v", mapping, method.Desc.FullName(), err)
+			}
+		}
+		if mapping := opts.GetMemo(); mapping != "" {
+			if err := lintMapping(method, mapping); err != nil {
+				l.report(svc.File, path, severityError, ruleInvalidMemo, "invalid memo mapping %q for workflow %q: %v", mapping, method.Desc.FullName(), err)
+			} else if err := svc.lintMemoFields(workflow); err != nil {
+				l.report(svc.File, path, severityError, ruleInvalidMemo, "invalid memo mapping %q for workflow %q: %v", mapping, method.Desc.FullName(), err)
+			}
+		}
+	}
+
+	for _, activity := range svc.activitiesOrdered {
+		method := svc.methods[activity]
+		if expr := svc.activities[activity].GetId(); expr != "" {
+			if err := lintIDExpression(method, expr); err != nil {
+				l.report(svc.File, optionPath(method, temporalv1.E_Activity), severityError, ruleInvalidIDExpression, "invalid id expression %q for activity %q: %v", expr, method.Desc.FullName(), err)
+			}
+		}
+	}
+
+	for _, update := range svc.updatesOrdered {
+		method := svc.methods[update]
+		if expr := svc.updates[update].GetId(); expr != "" {
+			if err := lintIDExpression(method, expr); err != nil {
+				l.report(svc.File, optionPath(method, temporalv1.E_Update), severityError, ruleInvalidIDExpression, "invalid id expression %q for update %q: %v", expr, method.Desc.FullName(), err)
+			}
+		}
+	}
+
+	svc.lintSpanAttributes(l)
+}
+
+// lintSpanAttributes validates the span attribute mappings of workflows, activities, queries,
+// signals, and updates, which are ignored unless the service enables tracing
+func (svc *Service) lintSpanAttributes(l *linter) {
+	check := func(name string, xt protoreflect.ExtensionType, kind, mapping string) {
+		if mapping == "" {
+			return
+		}
+		method := svc.methods[name]
+		path := optionPath(method, xt)
+		if !svc.tracingEnabled() {
+			l.report(svc.File, path, severityWarning, ruleTracingDisabled, "span attributes mapping for %s %q is ignored because features.tracing is not enabled for service %q", kind, method.Desc.FullName(), svc.Service.Desc.FullName())
+			return
+		}
+		if err := lintMapping(method, mapping); err != nil {
+			l.report(svc.File, path, severityError, ruleInvalidSpanAttributes, "invalid span attributes mapping %q for %s %q: %v", mapping, kind, method.Desc.FullName(), err)
+		}
+	}
+	for _, workflow := range svc.workflowsOrdered {
+		check(workflow, temporalv1.E_Workflow, "workflow", svc.workflows[workflow].GetSpanAttributes())
+	}
+	for _, activity := range svc.activitiesOrdered {
+		check(activity, temporalv1.E_Activity, "activity", svc.activities[activity].GetSpanAttributes())
+	}
+	for _, query := range svc.queriesOrdered {
+		check(query, temporalv1.E_Query, "query", svc.queries[query].GetSpanAttributes())
+	}
+	for _, signal := range svc.signalsOrdered {
+		check(signal, temporalv1.E_Signal, "signal", svc.signals[signal].GetSpanAttributes())
+	}
+	for _, update := range svc.updatesOrdered {
+		check(update, temporalv1.E_Update, "update", svc.updates[update].GetSpanAttributes())
+	}
+}
+
+// lintIDExpression parses an ID expression and validates the field paths it references
+// against the method's input message
+func lintIDExpression(method *protogen.Method, input string) error {
+	expr, err := expression.ParseExpression(input)
+	if err != nil {
+		return err
+	}
+	for _, path := range expr.FieldPaths() {
+		if err := expression.ValidateFieldPath(method.Input.Desc, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lintMapping parses a bloblang mapping and validates the field paths it references
+// against the method's input message
+func lintMapping(method *protogen.Method, mapping string) error {
+	m, err := expression.ParseMapping(mapping)
+	if err != nil {
+		return err
+	}
+	for _, path := range expression.FieldPaths(m) {
+		if err := expression.ValidateFieldPath(method.Input.Desc, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lintMemoFields ensures that the keys produced by a workflow's memo mapping can be
+// determined, and that each key converts to a unique Go field name
+func (svc *Service) lintMemoFields(workflow string) error {
+	fields := svc.memoFields(workflow)
+	if len(fields) == 0 {
+		return fmt.Errorf("unable to determine memo keys, expected root.<key> assignments or an object with static keys")
+	}
+	names := make(map[string]string, len(fields))
+	for _, field := range fields {
+		if !token.IsIdentifier(field.name) {
+			return fmt.Errorf("memo key %q can't be converted to a Go field name", field.key)
+		}
+		if prev, ok := names[field.name]; ok {
+			return fmt.Errorf("memo keys %q and %q convert to the same Go field name: %s", prev, field.key, field.name)
+		}
+		names[field.name] = field.key
+	}
+	return nil
+}
+
+// lintNames ensures that Temporal names are not used by more than one method. Duplicate
+// workflow and activity names are errors when defined by the same service or by services
+// that share a default task queue, as they conflict when registered with the same worker.
+// Other duplicate names are reported as warnings.
+func (svc *Service) lintNames(l *linter) {
+	check := func(kind, name, method string, xt protoreflect.ExtensionType, registered bool) {
+		if l.names[kind] == nil {
+			l.names[kind] = make(map[string]namedMethod)
+		}
+		m := svc.methods[method]
+		if prev, ok := l.names[kind][name]; ok {
+			sev := severityWarning
+			if tq := svc.opts.GetTaskQueue(); registered && (prev.svc == svc || tq != "" && tq == prev.svc.opts.GetTaskQueue()) {
+				sev = severityError
+			}
+			l.report(svc.File, optionPath(m, xt), sev, ruleDuplicateName, "%s name %q of method %q is already used by method %q", kind, name, m.Desc.FullName(), prev.method.Desc.FullName())
+			return
+		}
+		l.names[kind][name] = namedMethod{svc: svc, method: m}
+	}
+
+	for _, workflow := range svc.workflowsOrdered {
+		check("workflow", svc.fqnForWorkflow(workflow), workflow, temporalv1.E_Workflow, true)
+	}
+	for _, activity := range svc.activitiesOrdered {
+		check("activity", svc.fqnForActivity(activity), activity, temporalv1.E_Activity, true)
+	}
+	for _, query := range svc.queriesOrdered {
+		check("query", svc.fqnForQuery(query), query, temporalv1.E_Query, false)
+	}
+	for _, signal := range svc.signalsOrdered {
+		check("signal", svc.fqnForSignal(signal), signal, temporalv1.E_Signal, false)
+	}
+	for _, update := range svc.updatesOrdered {
+		check("update", svc.fqnForUpdate(update), update, temporalv1.E_Update, false)
+	}
+}
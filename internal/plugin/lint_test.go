@@ -0,0 +1,425 @@
+package plugin
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoparse"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// lintTestFile is the name of the proto file compiled by newLintTestPlugin
+const lintTestFile = "test/v1/test.proto"
+
+// newLintTestPlugin compiles the given proto source, which can import
+// temporal/v1/temporal.proto, and returns a Plugin that generates it
+func newLintTestPlugin(t *testing.T, source string) *Plugin {
+	t.Helper()
+	parser := protoparse.Parser{
+		IncludeSourceCodeInfo: true,
+		Accessor: func(filename string) (io.ReadCloser, error) {
+			if filename == lintTestFile {
+				return io.NopCloser(strings.NewReader(source)), nil
+			}
+			return os.Open(filepath.Join("..", "..", "proto", filename))
+		},
+	}
+	fds, err := parser.ParseFiles(lintTestFile)
+	require.NoError(t, err)
+
+	req := &pluginpb.CodeGeneratorRequest{FileToGenerate: []string{lintTestFile}}
+	seen := make(map[string]bool)
+	var add func(fd *desc.FileDescriptor)
+	add = func(fd *desc.FileDescriptor) {
+		if seen[fd.GetName()] {
+			return
+		}
+		seen[fd.GetName()] = true
+		for _, dep := range fd.GetDependencies() {
+			add(dep)
+		}
+		req.ProtoFile = append(req.ProtoFile, fd.AsFileDescriptorProto())
+	}
+	add(fds[0])
+	req.Parameter = proto.String("Mtemporal/v1/temporal.proto=github.com/cludden/protoc-gen-go-temporal/gen/temporal/v1,M" + lintTestFile + "=example.com/test/v1")
+
+	gen, err := protogen.Options{}.New(req)
+	require.NoError(t, err)
+	p := &Plugin{Plugin: gen}
+	p.parseServices()
+	return p
+}
+
+// lintTestSource returns proto source defining a test.v1.Test service with the given
+// methods, which can use the Request and Response messages
+func lintTestSource(methods string) string {
+	return `syntax = "proto3";
+
+package test.v1;
+
+import "google/protobuf/empty.proto";
+import "temporal/v1/temporal.proto";
+
+service Test {
+  option (temporal.v1.service) = {
+    task_queue: "test"
+  };
+` + methods + `}
+
+message Request {
+  string id = 1;
+  Nested nested = 2;
+}
+
+message Nested {
+  string name = 1;
+}
+
+message Response {
+  string value = 1;
+}
+`
+}
+
+func TestLintExpressionSeverity(t *testing.T) {
+	cases := []struct {
+		desc     string
+		options  string
+		severity severity
+		rule     string
+		message  string
+	}{
+		{
+			desc:    "valid id expression",
+			options: `id: 'foo/${! nested.name.or(id) }'`,
+		},
+		{
+			desc:    "valid mapping with triple quoted string",
+			options: "memo: 'root.note = \"\"\"a \"quoted\" note about this.missing\"\"\" + id'",
+		},
+		{
+			desc:    "valid mapping with named map",
+			options: "search_attributes: 'map upper {\\n  root = this.uppercase()\\n}\\nroot.Name = nested.name.apply(\"upper\")'",
+		},
+		{
+			desc:    "valid id expression with method result field",
+			options: `id: 'foo/${! id.parse_json().name }'`,
+		},
+		{
+			desc:    "valid mapping with match context",
+			options: `memo: 'root.name = match nested { this.name != "" => this.name, _ => "none" }'`,
+		},
+		{
+			desc:     "unresolved id expression field",
+			options:  `id: 'foo/${! missing }'`,
+			severity: severityError,
+			rule:     ruleInvalidIDExpression,
+			message:  `field "missing" not found in message test.v1.Request`,
+		},
+		{
+			desc:     "unresolved memo field",
+			options:  `memo: 'root.foo = nested.missing'`,
+			severity: severityError,
+			rule:     ruleInvalidMemo,
+			message:  `field "nested.missing" not found in message test.v1.Nested`,
+		},
+		{
+			desc:     "memo mapping without static keys",
+			options:  `memo: 'root = this'`,
+			severity: severityError,
+			rule:     ruleInvalidMemo,
+			message:  "unable to determine memo keys",
+		},
+		{
+			desc:     "memo keys with conflicting field names",
+			options:  `memo: 'root = { "foo_bar": id, "fooBar": id }'`,
+			severity: severityError,
+			rule:     ruleInvalidMemo,
+			message:  "convert to the same Go field name: FooBar",
+		},
+		{
+			desc:     "invalid id expression",
+			options:  `id: 'foo/${! id.uppercase( }'`,
+			severity: severityError,
+			rule:     ruleInvalidIDExpression,
+			message:  "invalid id expression",
+		},
+		{
+			desc:     "invalid search attributes mapping",
+			options:  `search_attributes: 'root.Foo = '`,
+			severity: severityError,
+			rule:     ruleInvalidSearchAttributes,
+			message:  "invalid search attributes mapping",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			p := newLintTestPlugin(t, lintTestSource(`
+  rpc Foo(Request) returns (Response) {
+    option (temporal.v1.workflow) = {
+      `+c.options+`
+    };
+  }
+`))
+			diagnostics := p.diagnose()
+			if c.rule == "" {
+				require.Empty(t, diagnostics)
+				return
+			}
+			require.Len(t, diagnostics, 1)
+			require.Equal(t, c.severity, diagnostics[0].severity)
+			require.Equal(t, c.rule, diagnostics[0].rule)
+			require.Contains(t, diagnostics[0].message, c.message)
+		})
+	}
+}
+
+func TestDiagnose(t *testing.T) {
+	cases := []struct {
+		desc     string
+		source   string
+		expected []string
+	}{
+		{
+			desc: "valid service",
+			source: lintTestSource(`
+  rpc Foo(Request) returns (Response) {
+    option (temporal.v1.workflow) = {
+      id: 'foo/${! id }'
+      query: { ref: 'GetFoo' }
+      signal: { ref: 'SetFoo' }
+    };
+  }
+
+  rpc GetFoo(google.protobuf.Empty) returns (Response) {
+    option (temporal.v1.query) = {};
+  }
+
+  rpc SetFoo(Request) returns (google.protobuf.Empty) {
+    option (temporal.v1.signal) = {};
+  }
+`),
+		},
+		{
+			desc: "method without options",
+			source: lintTestSource(`
+  rpc Foo(Request) returns (Response) {}
+`),
+			expected: []string{
+				`test/v1/test.proto:13:3 INVALID_METHOD_OPTIONS invalid method options for method "test.v1.Test.Foo"`,
+			},
+		},
+		{
+			desc: "dangling ref",
+			source: lintTestSource(`
+  rpc Foo(Request) returns (Response) {
+    option (temporal.v1.workflow) = {
+      query: { ref: 'GetFoo' }
+    };
+  }
+`),
+			expected: []string{
+				`test/v1/test.proto:14:5 DANGLING_REF workflow "Foo" references undefined query: "GetFoo"`,
+			},
+		},
+		{
+			desc: "signal output",
+			source: lintTestSource(`
+  rpc SetFoo(Request) returns (Response) {
+    option (temporal.v1.signal) = {};
+  }
+`),
+			expected: []string{
+				`test/v1/test.proto:13:3 SIGNAL_OUTPUT_NOT_EMPTY expected signal "SetFoo" output to be google.protobuf.Empty, got: test.v1.Response`,
+			},
+		},
+		{
+			desc: "update feature disabled",
+			source: lintTestSource(`
+  rpc UpdateFoo(Request) returns (Response) {
+    option (temporal.v1.update) = {};
+  }
+`),
+			expected: []string{
+				`test/v1/test.proto:14:5 UPDATE_FEATURE_DISABLED update options for method "test.v1.Test.UpdateFoo" are ignored because features.workflow_update is not enabled for service "test.v1.Test"`,
+				`test/v1/test.proto:13:3 INVALID_METHOD_OPTIONS invalid method options for method "test.v1.Test.UpdateFoo"`,
+			},
+		},
+		{
+			desc: "duplicate workflow name",
+			source: lintTestSource(`
+  rpc Foo(Request) returns (Response) {
+    option (temporal.v1.workflow) = {};
+  }
+
+  rpc Bar(Request) returns (Response) {
+    option (temporal.v1.workflow) = {
+      name: 'test.v1.Test.Foo'
+    };
+  }
+`),
+			expected: []string{
+				`test/v1/test.proto:14:5 DUPLICATE_NAME workflow name "test.v1.Test.Foo" of method "test.v1.Test.Foo" is already used by method "test.v1.Test.Bar"`,
+			},
+		},
+		{
+			desc: "invalid heartbeat and compensation",
+			source: lintTestSource(`
+  rpc Foo(Request) returns (Response) {
+    option (temporal.v1.activity) = {
+      heartbeat: 'Missing'
+      compensate: { ref: 'Bar' }
+    };
+  }
+`),
+			expected: []string{
+				`test/v1/test.proto:14:5 INVALID_HEARTBEAT activity "test.v1.Test.Foo" references undefined heartbeat message: "Missing"`,
+				`test/v1/test.proto:14:5 INVALID_COMPENSATION activity "test.v1.Test.Foo" references undefined compensating activity: "Bar"`,
+			},
+		},
+		{
+			desc: "deprecated cli feature",
+			source: lintTestSource("") + `
+message Settings {
+  temporal.v1.CLIFeature cli = 1;
+}
+`,
+			expected: []string{
+				`test/v1/test.proto:28:3 DEPRECATED_CLI_FEATURE field "test.v1.Settings.cli" uses deprecated enum temporal.v1.CLIFeature, which is not read by the plugin, use temporal.v1.ServiceOptions.Features.CLI instead`,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			var actual []string
+			for _, d := range newLintTestPlugin(t, c.source).diagnose() {
+				actual = append(actual, d.Error())
+			}
+			require.Equal(t, c.expected, actual)
+		})
+	}
+}
+
+func TestLintDuplicateNames(t *testing.T) {
+	source := func(taskQueue string) string {
+		return `syntax = "proto3";
+
+package test.v1;
+
+import "temporal/v1/temporal.proto";
+
+service Foo {
+  option (temporal.v1.service) = {
+    task_queue: "foo"
+  };
+
+  rpc Run(Request) returns (Response) {
+    option (temporal.v1.workflow) = {
+      name: 'run'
+    };
+    option (temporal.v1.activity) = {
+      name: 'run'
+    };
+  }
+}
+
+service Bar {
+  option (temporal.v1.service) = {
+    task_queue: "` + taskQueue + `"
+  };
+
+  rpc Run(Request) returns (Response) {
+    option (temporal.v1.workflow) = {
+      name: 'run'
+    };
+    option (temporal.v1.activity) = {
+      name: 'run'
+    };
+  }
+}
+
+message Request {}
+
+message Response {}
+`
+	}
+
+	cases := []struct {
+		desc      string
+		taskQueue string
+		severity  severity
+	}{
+		{desc: "shared task queue", taskQueue: "foo", severity: severityError},
+		{desc: "different task queues", taskQueue: "bar", severity: severityWarning},
+		{desc: "no default task queue", taskQueue: "", severity: severityWarning},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			diagnostics := newLintTestPlugin(t, source(c.taskQueue)).diagnose()
+			require.Len(t, diagnostics, 2)
+			for _, d := range diagnostics {
+				require.Equal(t, ruleDuplicateName, d.rule)
+				require.Equal(t, c.severity, d.severity)
+			}
+		})
+	}
+}
+
+func TestRunDiagnostics(t *testing.T) {
+	warning := lintTestSource(`
+  rpc Foo(Request) returns (Response) {
+    option (temporal.v1.workflow) = {};
+  }
+`) + `
+message Settings {
+  temporal.v1.CLIFeature cli = 1;
+}
+`
+	warningOutput := `test/v1/test.proto:32:3 DEPRECATED_CLI_FEATURE field "test.v1.Settings.cli" uses deprecated enum temporal.v1.CLIFeature, which is not read by the plugin, use temporal.v1.ServiceOptions.Features.CLI instead` + "\n"
+	invalid := lintTestSource(`
+  rpc Foo(Request) returns (Response) {}
+`)
+	invalidOutput := `test/v1/test.proto:13:3 INVALID_METHOD_OPTIONS invalid method options for method "test.v1.Test.Foo"`
+
+	cases := []struct {
+		desc      string
+		source    string
+		lint      bool
+		err       string
+		stderr    string
+		generated bool
+	}{
+		{desc: "generate with warnings", source: warning, stderr: warningOutput, generated: true},
+		{desc: "generate with errors", source: invalid, err: invalidOutput},
+		{desc: "lint with warnings", source: warning, lint: true, stderr: warningOutput},
+		{desc: "lint with errors", source: invalid, lint: true, err: "found 1 lint error(s)", stderr: invalidOutput + "\n"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			var stderr bytes.Buffer
+			p := newLintTestPlugin(t, c.source)
+			p.lint, p.stderr = c.lint, &stderr
+
+			err := p.Run(p.Plugin)
+			if c.err != "" {
+				require.EqualError(t, err, c.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, c.stderr, stderr.String())
+			require.Equal(t, c.generated, len(p.Response().GetFile()) > 0)
+		})
+	}
+}
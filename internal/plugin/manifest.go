@@ -0,0 +1,343 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+
+	temporalv1 "github.com/cludden/protoc-gen-go-temporal/gen/temporal/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// manifest describes the Temporal definitions generated for a single protobuf file
+type manifest struct {
+	// File is the path of the protobuf source file
+	File string `json:"file"`
+	// Services describes the temporal services defined by the file
+	Services []*manifestService `json:"services"`
+}
+
+// manifestService describes a temporal protobuf service definition
+type manifestService struct {
+	Name       string              `json:"name"`
+	Namespace  string              `json:"namespace,omitempty"`
+	TaskQueue  string              `json:"task_queue,omitempty"`
+	Workflows  []*manifestWorkflow `json:"workflows,omitempty"`
+	Activities []*manifestActivity `json:"activities,omitempty"`
+	Queries    []*manifestHandler  `json:"queries,omitempty"`
+	Signals    []*manifestHandler  `json:"signals,omitempty"`
+	Updates    []*manifestUpdate   `json:"updates,omitempty"`
+}
+
+// manifestMethod describes the properties common to all Temporal definitions
+type manifestMethod struct {
+	// Method is the fully-qualified name of the protobuf rpc method
+	Method string `json:"method"`
+	// Name is the Temporal name used to register and invoke the definition
+	Name string `json:"name"`
+	// Input is the fully-qualified name of the input message
+	Input string `json:"input"`
+	// Output is the fully-qualified name of the output message
+	Output string `json:"output"`
+}
+
+// manifestWorkflow describes a workflow definition
+type manifestWorkflow struct {
+	manifestMethod
+	TaskQueue           string               `json:"task_queue,omitempty"`
+	ID                  string               `json:"id,omitempty"`
+	CronSchedule        string               `json:"cron_schedule,omitempty"`
+	Schedule            *manifestSchedule    `json:"schedule,omitempty"`
+	IDReusePolicy       string               `json:"id_reuse_policy,omitempty"`
+	SearchAttributes    string               `json:"search_attributes,omitempty"`
+	Memo                string               `json:"memo,omitempty"`
+	ExecutionTimeout    string               `json:"execution_timeout,omitempty"`
+	RunTimeout          string               `json:"run_timeout,omitempty"`
+	TaskTimeout         string               `json:"task_timeout,omitempty"`
+	RetryPolicy         *manifestRetryPolicy `json:"retry_policy,omitempty"`
+	Errors              []*manifestError     `json:"errors,omitempty"`
+	Namespace           string               `json:"namespace,omitempty"`
+	ParentClosePolicy   string               `json:"parent_close_policy,omitempty"`
+	WaitForCancellation bool                 `json:"wait_for_cancellation,omitempty"`
+	Queries             []*manifestRef       `json:"queries,omitempty"`
+	Signals             []*manifestRef       `json:"signals,omitempty"`
+	Updates             []*manifestRef       `json:"updates,omitempty"`
+}
+
+// manifestSchedule describes default workflow schedule options
+type manifestSchedule struct {
+	CronExpressions []string `json:"cron_expressions,omitempty"`
+	Intervals       []string `json:"intervals,omitempty"`
+	Jitter          string   `json:"jitter,omitempty"`
+	TimeZoneName    string   `json:"time_zone_name,omitempty"`
+	OverlapPolicy   string   `json:"overlap_policy,omitempty"`
+	CatchupWindow   string   `json:"catchup_window,omitempty"`
+	PauseOnFailure  bool     `json:"pause_on_failure,omitempty"`
+}
+
+// manifestRef describes a query, signal, or update supported by a workflow
+type manifestRef struct {
+	// Ref is the ref as defined in the workflow options
+	Ref string `json:"ref"`
+	// Method is the fully-qualified name of the referenced protobuf rpc method
+	Method string `json:"method"`
+	// Name is the Temporal name of the referenced query, signal, or update
+	Name string `json:"name"`
+	// Start indicates whether a signal-with-start helper is generated
+	Start bool `json:"start,omitempty"`
+}
+
+// manifestActivity describes an activity definition
+type manifestActivity struct {
+	manifestMethod
+	TaskQueue              string                `json:"task_queue,omitempty"`
+	ID                     string                `json:"id,omitempty"`
+	ScheduleToCloseTimeout string                `json:"schedule_to_close_timeout,omitempty"`
+	ScheduleToStartTimeout string                `json:"schedule_to_start_timeout,omitempty"`
+	StartToCloseTimeout    string                `json:"start_to_close_timeout,omitempty"`
+	HeartbeatTimeout       string                `json:"heartbeat_timeout,omitempty"`
+	Heartbeat              string                `json:"heartbeat,omitempty"`
+	AutoHeartbeat          bool                  `json:"auto_heartbeat,omitempty"`
+	AsyncCompletion        bool                  `json:"async_completion,omitempty"`
+	Session                bool                  `json:"session,omitempty"`
+	Compensate             *manifestCompensation `json:"compensate,omitempty"`
+	RetryPolicy            *manifestRetryPolicy  `json:"retry_policy,omitempty"`
+	Errors                 []*manifestError      `json:"errors,omitempty"`
+}
+
+// manifestHandler describes a query or signal definition
+type manifestHandler struct {
+	manifestMethod
+}
+
+// manifestUpdate describes an update definition
+type manifestUpdate struct {
+	manifestMethod
+	ID         string `json:"id,omitempty"`
+	Validate   bool   `json:"validate,omitempty"`
+	WaitPolicy string `json:"wait_policy,omitempty"`
+}
+
+// manifestCompensation describes the activity that compensates an activity
+type manifestCompensation struct {
+	Activity string `json:"activity"`
+	Input    string `json:"input,omitempty"`
+}
+
+// manifestError describes a typed application error returned by a workflow or activity
+type manifestError struct {
+	Type         string `json:"type"`
+	Detail       string `json:"detail"`
+	NonRetryable bool   `json:"non_retryable,omitempty"`
+}
+
+// manifestRetryPolicy describes an activity or workflow retry policy
+type manifestRetryPolicy struct {
+	InitialInterval        string   `json:"initial_interval,omitempty"`
+	BackoffCoefficient     float64  `json:"backoff_coefficient,omitempty"`
+	MaxInterval            string   `json:"max_interval,omitempty"`
+	MaxAttempts            int32    `json:"max_attempts,omitempty"`
+	NonRetryableErrorTypes []string `json:"non_retryable_error_types,omitempty"`
+}
+
+// render marshals the manifest as indented JSON
+func (m *manifest) render() ([]byte, error) {
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling manifest: %w", err)
+	}
+	return append(b, '\n'), nil
+}
+
+// manifest returns a manifest describing the service's Temporal definitions
+func (svc *Service) manifest() *manifestService {
+	m := &manifestService{
+		Name:      string(svc.Service.Desc.FullName()),
+		Namespace: svc.opts.GetNamespace(),
+		TaskQueue: svc.opts.GetTaskQueue(),
+	}
+
+	for _, workflow := range svc.workflowsOrdered {
+		opts := svc.workflows[workflow]
+		w := &manifestWorkflow{
+			manifestMethod:      svc.manifestMethod(workflow, svc.fqnForWorkflow(workflow)),
+			TaskQueue:           svc.taskQueueFor(opts.GetTaskQueue()),
+			ID:                  opts.GetId(),
+			CronSchedule:        opts.GetCronSchedule(),
+			SearchAttributes:    opts.GetSearchAttributes(),
+			Memo:                opts.GetMemo(),
+			ExecutionTimeout:    formatDuration(opts.GetExecutionTimeout()),
+			RunTimeout:          formatDuration(opts.GetRunTimeout()),
+			TaskTimeout:         formatDuration(opts.GetTaskTimeout()),
+			RetryPolicy:         manifestRetryPolicyFor(retryPolicyFor(opts.GetRetryPolicy(), opts.GetErrors())),
+			Errors:              svc.manifestErrors(opts.GetErrors()),
+			Namespace:           opts.GetNamespace(),
+			WaitForCancellation: opts.GetWaitForCancellation(),
+		}
+		if policy := opts.GetIdReusePolicy(); policy != temporalv1.IDReusePolicy_WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
+			w.IDReusePolicy = policy.String()
+		}
+		if schedule := opts.GetSchedule(); schedule != nil {
+			w.Schedule = &manifestSchedule{
+				CronExpressions: schedule.GetCronExpressions(),
+				Jitter:          formatDuration(schedule.GetJitter()),
+				TimeZoneName:    schedule.GetTimeZoneName(),
+				CatchupWindow:   formatDuration(schedule.GetCatchupWindow()),
+				PauseOnFailure:  schedule.GetPauseOnFailure(),
+			}
+			for _, interval := range schedule.GetIntervals() {
+				w.Schedule.Intervals = append(w.Schedule.Intervals, formatDuration(interval))
+			}
+			if policy := schedule.GetOverlapPolicy(); policy != temporalv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_UNSPECIFIED {
+				w.Schedule.OverlapPolicy = policy.String()
+			}
+		}
+		if policy := opts.GetParentClosePolicy(); policy != temporalv1.ParentClosePolicy_PARENT_CLOSE_POLICY_UNSPECIFIED {
+			w.ParentClosePolicy = policy.String()
+		}
+		for _, query := range opts.GetQuery() {
+			if owner, name := svc.lookupRef(query.GetRef()); owner != nil {
+				w.Queries = append(w.Queries, &manifestRef{
+					Ref:    query.GetRef(),
+					Method: string(owner.methods[name].Desc.FullName()),
+					Name:   owner.fqnForQuery(name),
+				})
+			}
+		}
+		for _, signal := range opts.GetSignal() {
+			if owner, name := svc.lookupRef(signal.GetRef()); owner != nil {
+				w.Signals = append(w.Signals, &manifestRef{
+					Ref:    signal.GetRef(),
+					Method: string(owner.methods[name].Desc.FullName()),
+					Name:   owner.fqnForSignal(name),
+					Start:  signal.GetStart(),
+				})
+			}
+		}
+		for _, update := range opts.GetUpdate() {
+			if owner, name := svc.lookupRef(update.GetRef()); owner != nil {
+				w.Updates = append(w.Updates, &manifestRef{
+					Ref:    update.GetRef(),
+					Method: string(owner.methods[name].Desc.FullName()),
+					Name:   owner.fqnForUpdate(name),
+				})
+			}
+		}
+		m.Workflows = append(m.Workflows, w)
+	}
+
+	for _, activity := range svc.activitiesOrdered {
+		opts := svc.activities[activity]
+		var heartbeat string
+		if message := svc.lookupMessage(opts.GetHeartbeat()); message != nil {
+			heartbeat = string(message.Desc.FullName())
+		}
+		var compensate *manifestCompensation
+		if compensation, _ := svc.lookupCompensation(activity); compensation != "" {
+			compensate = &manifestCompensation{
+				Activity: svc.fqnForActivity(compensation),
+				Input:    opts.GetCompensate().GetInput(),
+			}
+		}
+		m.Activities = append(m.Activities, &manifestActivity{
+			manifestMethod:         svc.manifestMethod(activity, svc.fqnForActivity(activity)),
+			TaskQueue:              svc.taskQueueFor(opts.GetTaskQueue()),
+			ID:                     opts.GetId(),
+			ScheduleToCloseTimeout: formatDuration(opts.GetScheduleToCloseTimeout()),
+			ScheduleToStartTimeout: formatDuration(opts.GetScheduleToStartTimeout()),
+			StartToCloseTimeout:    formatDuration(opts.GetStartToCloseTimeout()),
+			HeartbeatTimeout:       formatDuration(opts.GetHeartbeatTimeout()),
+			Heartbeat:              heartbeat,
+			AutoHeartbeat:          opts.GetAutoHeartbeat(),
+			AsyncCompletion:        opts.GetAsyncCompletion(),
+			Session:                opts.GetSession(),
+			Compensate:             compensate,
+			RetryPolicy:            manifestRetryPolicyFor(retryPolicyFor(opts.GetRetryPolicy(), opts.GetErrors())),
+			Errors:                 svc.manifestErrors(opts.GetErrors()),
+		})
+	}
+
+	for _, query := range svc.queriesOrdered {
+		m.Queries = append(m.Queries, &manifestHandler{svc.manifestMethod(query, svc.fqnForQuery(query))})
+	}
+
+	for _, signal := range svc.signalsOrdered {
+		m.Signals = append(m.Signals, &manifestHandler{svc.manifestMethod(signal, svc.fqnForSignal(signal))})
+	}
+
+	// updates are only generated when the workflow update feature is enabled
+	if svc.opts.GetFeatures().GetWorkflowUpdate().GetEnabled() {
+		for _, update := range svc.updatesOrdered {
+			opts := svc.updates[update]
+			u := &manifestUpdate{
+				manifestMethod: svc.manifestMethod(update, svc.fqnForUpdate(update)),
+				ID:             opts.GetId(),
+				Validate:       opts.GetValidate(),
+			}
+			if policy := opts.GetWaitPolicy(); policy != temporalv1.WaitPolicy_WAIT_POLICY_UNSPECIFIED {
+				u.WaitPolicy = policy.String()
+			}
+			m.Updates = append(m.Updates, u)
+		}
+	}
+	return m
+}
+
+// manifestMethod returns the common manifest properties of the given method
+func (svc *Service) manifestMethod(method, name string) manifestMethod {
+	m := svc.methods[method]
+	return manifestMethod{
+		Method: string(m.Desc.FullName()),
+		Name:   name,
+		Input:  string(m.Input.Desc.FullName()),
+		Output: string(m.Output.Desc.FullName()),
+	}
+}
+
+// taskQueueFor returns the given task queue override, falling back to the service
+// default task queue
+func (svc *Service) taskQueueFor(taskQueue string) string {
+	if taskQueue != "" {
+		return taskQueue
+	}
+	return svc.opts.GetTaskQueue()
+}
+
+// manifestErrors converts typed application error options to their manifest representation
+func (svc *Service) manifestErrors(errs []*temporalv1.ErrorOptions) []*manifestError {
+	var result []*manifestError
+	for _, e := range errs {
+		detail := e.GetDetail()
+		if message := svc.lookupMessage(detail); message != nil {
+			detail = string(message.Desc.FullName())
+		}
+		result = append(result, &manifestError{
+			Type:         e.GetType(),
+			Detail:       detail,
+			NonRetryable: e.GetNonRetryable(),
+		})
+	}
+	return result
+}
+
+// manifestRetryPolicyFor converts a retry policy option to its manifest representation
+func manifestRetryPolicyFor(policy *temporalv1.RetryPolicy) *manifestRetryPolicy {
+	if policy == nil {
+		return nil
+	}
+	return &manifestRetryPolicy{
+		InitialInterval:        formatDuration(policy.GetInitialInterval()),
+		BackoffCoefficient:     policy.GetBackoffCoefficient(),
+		MaxInterval:            formatDuration(policy.GetMaxInterval()),
+		MaxAttempts:            policy.GetMaxAttempts(),
+		NonRetryableErrorTypes: policy.GetNonRetryableErrorTypes(),
+	}
+}
+
+// formatDuration formats a duration option as a Go duration string (e.g. 1h30m0s),
+// returning an empty string if the duration is not set
+func formatDuration(d *durationpb.Duration) string {
+	if d == nil {
+		return ""
+	}
+	return d.AsDuration().String()
+}
@@ -0,0 +1,120 @@
+package plugin
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var updateGolden = flag.Bool("update", false, "update golden files")
+
+func TestManifest(t *testing.T) {
+	require := require.New(t)
+	p := newLintTestPlugin(t, `syntax = "proto3";
+
+package test.v1;
+
+import "google/protobuf/empty.proto";
+import "temporal/v1/temporal.proto";
+
+service Test {
+  option (temporal.v1.service) = {
+    namespace: "default"
+    task_queue: "test"
+    features: {
+      workflow_update: { enabled: true }
+    }
+  };
+
+  rpc Foo(Request) returns (Response) {
+    option (temporal.v1.workflow) = {
+      id: 'foo/${! id }'
+      id_reuse_policy: WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE
+      execution_timeout: { seconds: 3600 }
+      retry_policy: {
+        initial_interval: { seconds: 1 }
+        backoff_coefficient: 2
+        max_attempts: 3
+      }
+      errors: [
+        { type: 'NotFound', detail: 'Detail', non_retryable: true }
+      ]
+      query: { ref: 'test.v1.Other.GetFoo' }
+      signal: { ref: 'test.v1.Other.SetFoo', start: true }
+      update: { ref: 'UpdateFoo' }
+    };
+  }
+
+  rpc Bar(Request) returns (Response) {
+    option (temporal.v1.activity) = {
+      task_queue: "bar"
+      start_to_close_timeout: { seconds: 10 }
+      retry_policy: {
+        max_interval: { seconds: 30 }
+        non_retryable_error_types: ['Fatal']
+      }
+      errors: [
+        { type: 'Conflict', detail: 'test.v1.Detail' }
+      ]
+    };
+  }
+
+  rpc UpdateFoo(Request) returns (Response) {
+    option (temporal.v1.update) = {
+      id: 'update/${! id }'
+      wait_policy: WAIT_POLICY_COMPLETED
+    };
+  }
+}
+
+service Other {
+  option (temporal.v1.service) = {
+    task_queue: "other"
+  };
+
+  rpc GetFoo(Request) returns (Response) {
+    option (temporal.v1.query) = {
+      name: 'getFoo'
+    };
+  }
+
+  rpc SetFoo(Request) returns (google.protobuf.Empty) {
+    option (temporal.v1.signal) = {};
+  }
+}
+
+message Request {
+  string id = 1;
+}
+
+message Response {
+  string value = 1;
+}
+
+message Detail {
+  string reason = 1;
+}
+`)
+	require.NoError(p.Param("manifest", "true"))
+	require.NoError(p.Run(p.Plugin))
+
+	var actual string
+	for _, f := range p.Response().GetFile() {
+		if strings.HasSuffix(f.GetName(), "_temporal.manifest.json") {
+			actual = f.GetContent()
+		}
+	}
+	require.NotEmpty(actual)
+
+	golden := filepath.Join("testdata", "manifest.golden.json")
+	if *updateGolden {
+		require.NoError(os.WriteFile(golden, []byte(actual), 0o644))
+	}
+	expected, err := os.ReadFile(golden)
+	require.NoError(err)
+	require.Equal(string(expected), actual)
+}
@@ -0,0 +1,145 @@
+package plugin
+
+import (
+	"fmt"
+
+	g "github.com/dave/jennifer/jen"
+)
+
+const mockPkg = "github.com/stretchr/testify/mock"
+
+// renderMock writes testify mocks of the service's client, workflow run, and update
+// handle interfaces to the given File
+func (svc *Service) renderMock(f *g.File) {
+	svc.genMock(f, toCamel("%sClient", svc.Service.GoName), svc.clientInterfaceMethods())
+	for _, workflow := range svc.workflowsOrdered {
+		svc.genMock(f, toCamel("%sRun", workflow), svc.clientWorkflowRunInterfaceMethods(workflow))
+	}
+	for _, update := range svc.updatesOrdered {
+		svc.genMock(f, toCamel("%sHandle", update), svc.clientUpdateHandleInterfaceMethods(update))
+	}
+}
+
+// genMock generates a Mock<Interface> type that implements the given interface using
+// testify's mock package, along with a typed On<Method> expectation helper for each method
+func (svc *Service) genMock(f *g.File, iface string, methods []*interfaceMethod) {
+	typeName := fmt.Sprintf("Mock%s", iface)
+
+	// generate mock type
+	f.Commentf("%s is a testify mock implementation of %s", typeName, iface)
+	f.Type().Id(typeName).Struct(
+		g.Qual(mockPkg, "Mock"),
+	)
+	f.Var().Id("_").Add(svc.qual(iface)).Op("=").Parens(g.Op("*").Id(typeName)).Parens(g.Nil())
+
+	// generate constructor
+	f.Commentf("New%s initializes a new %s that asserts its expectations when the test completes", typeName, typeName)
+	f.Func().
+		Id(fmt.Sprintf("New%s", typeName)).
+		Params(g.Id("t").Interface(
+			g.Qual(mockPkg, "TestingT"),
+			g.Id("Cleanup").Params(g.Func().Params()),
+		)).
+		Op("*").Id(typeName).
+		Block(
+			g.Id("m").Op(":=").Op("&").Id(typeName).Values(),
+			g.Id("m").Dot("Mock").Dot("Test").Call(g.Id("t")),
+			g.Id("t").Dot("Cleanup").Call(g.Func().Params().Block(
+				g.Id("m").Dot("AssertExpectations").Call(g.Id("t")),
+			)),
+			g.Return(g.Id("m")),
+		)
+
+	for _, m := range methods {
+		svc.genMockMethod(f, typeName, iface, m)
+		svc.genMockExpectation(f, typeName, m)
+	}
+}
+
+// genMockMethod generates a mock implementation of an interface method that returns
+// the values specified by the matching expectation. Variadic arguments are recorded
+// as a single slice argument.
+func (svc *Service) genMockMethod(f *g.File, typeName, iface string, m *interfaceMethod) {
+	f.Commentf("%s implements %s", m.name, iface)
+	f.Func().
+		Params(g.Id("m").Op("*").Id(typeName)).
+		Id(m.name).
+		ParamsFunc(m.genParams).
+		ParamsFunc(func(results *g.Group) {
+			for _, r := range m.results {
+				results.Add(r.typ)
+			}
+		}).
+		BlockFunc(func(fn *g.Group) {
+			fn.Id("args").Op(":=").Id("m").Dot("Called").CallFunc(func(args *g.Group) {
+				for _, p := range m.params {
+					args.Id(p.name)
+				}
+			})
+			for i, r := range m.results {
+				result := fmt.Sprintf("r%d", i)
+				fn.Var().Id(result).Add(r.typ)
+				fn.If(g.Id("v").Op(":=").Id("args").Dot("Get").Call(g.Lit(i)), g.Id("v").Op("!=").Nil()).Block(
+					g.Id(result).Op("=").Id("v").Assert(r.typ),
+				)
+			}
+			fn.ReturnFunc(func(returnVals *g.Group) {
+				for i := range m.results {
+					returnVals.Id(fmt.Sprintf("r%d", i))
+				}
+			})
+		})
+}
+
+// genMockExpectation generates an On<Method> method that registers an expectation for
+// calls to an interface method, and a Mock<Interface><Method>Call type with a typed
+// Return method
+func (svc *Service) genMockExpectation(f *g.File, typeName string, m *interfaceMethod) {
+	callType := fmt.Sprintf("%s%sCall", typeName, m.name)
+
+	f.Commentf("On%s registers an expectation for a call to %s with the given arguments, which may", m.name, m.name)
+	f.Comment("be exact values, mock.Anything, or other testify argument matchers")
+	f.Func().
+		Params(g.Id("m").Op("*").Id(typeName)).
+		Id(fmt.Sprintf("On%s", m.name)).
+		ParamsFunc(func(args *g.Group) {
+			for _, p := range m.params {
+				args.Id(p.name).Any()
+			}
+		}).
+		Op("*").Id(callType).
+		Block(
+			g.Return(g.Op("&").Id(callType).Values(
+				g.Id("Call").Op(":").Id("m").Dot("On").CallFunc(func(args *g.Group) {
+					args.Lit(m.name)
+					for _, p := range m.params {
+						args.Id(p.name)
+					}
+				}),
+			)),
+		)
+
+	f.Commentf("%s describes an expected call to %s.%s", callType, typeName, m.name)
+	f.Type().Id(callType).Struct(
+		g.Op("*").Qual(mockPkg, "Call"),
+	)
+
+	f.Comment("Return specifies the values returned by the call")
+	f.Func().
+		Params(g.Id("c").Op("*").Id(callType)).
+		Id("Return").
+		ParamsFunc(func(args *g.Group) {
+			for _, r := range m.results {
+				args.Id(r.name).Add(r.typ)
+			}
+		}).
+		Op("*").Id(callType).
+		Block(
+			g.Id("c").Dot("Call").Dot("Return").CallFunc(func(args *g.Group) {
+				for _, r := range m.results {
+					args.Id(r.name)
+				}
+			}),
+			g.Return(g.Id("c")),
+		)
+}
@@ -1,11 +1,47 @@
 package plugin
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path"
 	"runtime"
+	"strconv"
+	"strings"
 
 	g "github.com/dave/jennifer/jen"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// supported component plugin parameters
+const (
+	componentCLI        = "cli"
+	componentClient     = "client"
+	componentMock       = "mock"
+	componentTestClient = "testclient"
+	componentWorker     = "worker"
+)
+
+// componentDependencies describes the components whose generated code is referenced by
+// each component
+var componentDependencies = map[string][]string{
+	componentCLI:        {componentClient},
+	componentMock:       {componentClient},
+	componentTestClient: {componentClient, componentWorker},
+	componentWorker:     {componentClient},
+}
+
+// supported layout plugin parameter values
+const (
+	// layoutSingle renders all components to a single <prefix>_temporal.pb.go file
+	layoutSingle = "single"
+	// layoutFiles renders each component to a separate file in the same Go package
+	layoutFiles = "files"
+	// layoutPackages renders each component to a separate file, with the cli, mocks, and
+	// test client rendered to sibling Go packages
+	layoutPackages = "packages"
 )
 
 // Plugin provides a protoc plugin for generating temporal workers and clients in go
@@ -13,55 +49,287 @@ type Plugin struct {
 	*protogen.Plugin
 	Commit  string
 	Version string
+
+	// disabled contains the set of components that were explicitly disabled
+	disabled map[string]bool
+	// layout describes how components are organized into files and packages
+	layout string
+	// lint disables code generation and reports all diagnostics
+	lint bool
+	// manifest enables rendering of a <prefix>_temporal.manifest.json file describing the
+	// Temporal definitions of each file
+	manifest bool
+	// only identifies the component that was set to "only", if any
+	only string
+	// services contains all services defined by the input files and their imports
+	services map[protoreflect.FullName]*Service
+	// stderr receives diagnostics reported during code generation, defaults to os.Stderr
+	stderr io.Writer
 }
 
 // Param provides a protogen ParamFunc handler
 func (p *Plugin) Param(key, value string) error {
+	switch key {
+	case componentCLI, componentClient, componentMock, componentTestClient, componentWorker:
+		if value == "only" {
+			if p.only != "" && p.only != key {
+				return fmt.Errorf("invalid plugin parameter %s=%s: %s=only already specified", key, value, p.only)
+			}
+			p.only = key
+			return nil
+		}
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid plugin parameter %s=%s: expected true, false, or only", key, value)
+		}
+		if p.disabled == nil {
+			p.disabled = make(map[string]bool)
+		}
+		p.disabled[key] = !enabled
+		return nil
+	case "lint":
+		lint, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid plugin parameter %s=%s: expected true or false", key, value)
+		}
+		p.lint = lint
+		return nil
+	case "manifest":
+		manifest, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid plugin parameter %s=%s: expected true or false", key, value)
+		}
+		p.manifest = manifest
+		return nil
+	case "layout":
+		switch value {
+		case layoutSingle, layoutFiles, layoutPackages:
+			p.layout = value
+			return nil
+		default:
+			return fmt.Errorf("invalid plugin parameter %s=%s: expected %s, %s, or %s", key, value, layoutSingle, layoutFiles, layoutPackages)
+		}
+	default:
+		return fmt.Errorf("unsupported plugin parameter %q", key)
+	}
+}
+
+// enabled returns true if the given component should be rendered. Mocks are only
+// rendered when explicitly enabled.
+func (p *Plugin) enabled(component string) bool {
+	if p.only != "" {
+		return component == p.only
+	}
+	if disabled, ok := p.disabled[component]; ok {
+		return !disabled
+	}
+	return component != componentMock
+}
+
+// validate ensures that no enabled component depends on a component that was explicitly
+// disabled, as the generated code would not compile. Components generated exclusively via
+// the "only" parameter are assumed to be combined with separately generated dependencies,
+// which requires each component to be rendered to its own file.
+func (p *Plugin) validate() error {
+	if p.only != "" {
+		if p.layout != layoutFiles && p.layout != layoutPackages {
+			return fmt.Errorf("invalid plugin parameters: %s=only requires layout=%s or layout=%s", p.only, layoutFiles, layoutPackages)
+		}
+		return nil
+	}
+	for _, component := range []string{componentClient, componentWorker, componentTestClient, componentMock, componentCLI} {
+		if !p.enabled(component) {
+			continue
+		}
+		for _, dependency := range componentDependencies[component] {
+			if !p.enabled(dependency) {
+				return fmt.Errorf("invalid plugin parameters: %s requires %s, set %s=false to disable it", component, strings.Join(componentDependencies[component], " and "), component)
+			}
+		}
+	}
 	return nil
 }
 
 // Run defines the plugin entrypoint
 func (p *Plugin) Run(plugin *protogen.Plugin) error {
 	p.Plugin = plugin
+	if err := p.validate(); err != nil {
+		return err
+	}
+
+	p.parseServices()
+
+	// report diagnostics, failing if any errors are found
+	stderr := p.stderr
+	if stderr == nil {
+		stderr = os.Stderr
+	}
+	var errs error
+	var errCount int
+	for _, d := range p.diagnose() {
+		if d.severity == severityError {
+			errCount++
+		}
+		if p.lint || d.severity == severityWarning {
+			fmt.Fprintln(stderr, d)
+			continue
+		}
+		errs = errors.Join(errs, d)
+	}
+	if p.lint {
+		if errCount > 0 {
+			return fmt.Errorf("found %d lint error(s)", errCount)
+		}
+		return nil
+	}
+	if errs != nil {
+		return errs
+	}
 
 	for _, file := range p.Files {
 		if !file.Generate {
 			continue
 		}
 
-		pkgName := string(file.GoPackageName)
-		f := g.NewFile(string(pkgName))
-		genCodeGenerationHeader(p, f, file)
-
-		var hasContent bool
+		targets := p.targetsFor(file)
+		m := manifest{File: file.Desc.Path()}
 		for _, service := range file.Services {
-			svc, err := parseService(plugin, file, service)
-			if err != nil {
-				return fmt.Errorf("error parsing service %s: %w", service.GoName, err)
-			}
-			if len(svc.activities) == 0 && len(svc.workflows) == 0 && len(svc.signals) == 0 && len(svc.queries) == 0 {
+			svc := p.services[service.Desc.FullName()]
+			if len(svc.activities) == 0 && len(svc.workflows) == 0 && len(svc.signals) == 0 && len(svc.queries) == 0 && len(svc.updates) == 0 {
 				continue
 			}
+			m.Services = append(m.Services, svc.manifest())
 
-			svc.render(f)
-			svc.renderTestClient(f)
-			if svc.opts.GetFeatures().GetCli().GetEnabled() {
-				svc.renderCLI(f)
+			if p.enabled(componentClient) {
+				svc.renderClient(targets[componentClient].use())
+			}
+			if p.enabled(componentWorker) {
+				svc.renderWorker(targets[componentWorker].use())
+			}
+			if p.enabled(componentTestClient) {
+				svc.renderTestClient(targets[componentTestClient].use())
+			}
+			if p.enabled(componentMock) {
+				svc.renderMock(targets[componentMock].use())
+			}
+			if p.enabled(componentCLI) && svc.opts.GetFeatures().GetCli().GetEnabled() {
+				svc.renderCLI(targets[componentCLI].use())
 			}
-			hasContent = true
 		}
 
-		if !hasContent {
-			continue
+		rendered := make(map[*target]bool)
+		for _, component := range []string{componentClient, componentWorker, componentTestClient, componentMock, componentCLI} {
+			t := targets[component]
+			if !t.hasContent || rendered[t] {
+				continue
+			}
+			rendered[t] = true
+			if err := t.Render(p.NewGeneratedFile(t.filename, t.importPath)); err != nil {
+				return fmt.Errorf("error rendering file: %w", err)
+			}
 		}
 
-		if err := f.Render(p.NewGeneratedFile(fmt.Sprintf("%s_temporal.pb.go", file.GeneratedFilenamePrefix), file.GoImportPath)); err != nil {
-			return fmt.Errorf("error rendering file: %w", err)
+		if p.manifest && len(m.Services) > 0 {
+			b, err := m.render()
+			if err != nil {
+				return err
+			}
+			if _, err := p.NewGeneratedFile(file.GeneratedFilenamePrefix+"_temporal.manifest.json", "").Write(b); err != nil {
+				return fmt.Errorf("error rendering manifest: %w", err)
+			}
 		}
 	}
 	return nil
 }
 
+// parseServices parses all services, including those defined by imported files, so that
+// workflows can reference queries, signals, and updates defined by other services
+func (p *Plugin) parseServices() {
+	p.services = make(map[protoreflect.FullName]*Service)
+	for _, file := range p.Files {
+		for _, service := range file.Services {
+			p.services[service.Desc.FullName()] = parseService(p, file, service)
+		}
+	}
+}
+
+// target describes a generated file that one or more components are rendered to
+type target struct {
+	*g.File
+	filename   string
+	importPath protogen.GoImportPath
+	hasContent bool
+}
+
+// use marks the target as having content and returns the underlying File
+func (t *target) use() *g.File {
+	t.hasContent = true
+	return t.File
+}
+
+// componentPackage returns the Go package name and import path that the given component of
+// the given file is rendered to, based on the configured layout
+func (p *Plugin) componentPackage(file *protogen.File, component string) (string, protogen.GoImportPath) {
+	var subpackage string
+	if p.layout == layoutPackages {
+		switch component {
+		case componentCLI:
+			subpackage = "cli"
+		case componentMock:
+			subpackage = "mock"
+		case componentTestClient:
+			subpackage = "test"
+		}
+	}
+	if subpackage == "" {
+		return string(file.GoPackageName), file.GoImportPath
+	}
+	pkgName := fmt.Sprintf("%stemporal%s", file.GoPackageName, subpackage)
+	return pkgName, protogen.GoImportPath(path.Join(string(file.GoImportPath), pkgName))
+}
+
+// targetsFor returns the generated file targets for each component of the given
+// file, based on the configured layout
+func (p *Plugin) targetsFor(file *protogen.File) map[string]*target {
+	newTarget := func(suffix string, component string) *target {
+		pkgName, importPath := p.componentPackage(file, component)
+		dir, base := path.Split(file.GeneratedFilenamePrefix)
+		if importPath != file.GoImportPath {
+			dir = path.Join(dir, pkgName)
+		}
+		f := g.NewFilePathName(string(importPath), pkgName)
+		for _, dep := range p.Files {
+			f.ImportName(string(dep.GoImportPath), string(dep.GoPackageName))
+		}
+		genCodeGenerationHeader(p, f, file)
+		return &target{
+			File:       f,
+			filename:   path.Join(dir, fmt.Sprintf("%s_temporal%s.pb.go", base, suffix)),
+			importPath: importPath,
+		}
+	}
+
+	switch p.layout {
+	case layoutFiles, layoutPackages:
+		return map[string]*target{
+			componentCLI:        newTarget("_cli", componentCLI),
+			componentClient:     newTarget("_client", componentClient),
+			componentMock:       newTarget("_mock", componentMock),
+			componentTestClient: newTarget("_test_client", componentTestClient),
+			componentWorker:     newTarget("_worker", componentWorker),
+		}
+	default:
+		t := newTarget("", componentClient)
+		return map[string]*target{
+			componentCLI:        t,
+			componentClient:     t,
+			componentMock:       t,
+			componentTestClient: t,
+			componentWorker:     t,
+		}
+	}
+}
+
 func genCodeGenerationHeader(p *Plugin, f *g.File, target *protogen.File) {
 	f.PackageComment("Code generated by protoc-gen-go_temporal. DO NOT EDIT.")
 	f.PackageComment("versions: ")
@@ -0,0 +1,70 @@
+package plugin
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPluginComponents(t *testing.T) {
+	require := require.New(t)
+
+	all := []string{componentClient, componentWorker, componentTestClient, componentMock, componentCLI}
+	cases := []struct {
+		params   string
+		enabled  []string
+		err      string
+		paramErr string
+	}{
+		{params: "", enabled: []string{componentClient, componentWorker, componentTestClient, componentCLI}},
+		{params: "mock=true", enabled: all},
+		{params: "testclient=false,cli=false", enabled: []string{componentClient, componentWorker}},
+		{params: "worker=false,testclient=false", enabled: []string{componentClient, componentCLI}},
+		{params: "worker=false,testclient=false,cli=false,mock=true", enabled: []string{componentClient, componentMock}},
+		{params: "cli=only,layout=files", enabled: []string{componentCLI}},
+		{params: "testclient=only,layout=packages", enabled: []string{componentTestClient}},
+		{params: "cli=only", err: "cli=only requires layout=files or layout=packages"},
+		{params: "client=only,layout=single", err: "client=only requires layout=files or layout=packages"},
+		{params: "client=false", err: "worker requires client"},
+		{params: "worker=false", err: "testclient requires client and worker, set testclient=false to disable it"},
+		{params: "client=false,worker=false,testclient=false", err: "cli requires client"},
+		{params: "client=false,worker=false,testclient=false,cli=false,mock=true", err: "mock requires client"},
+		{params: "worker=false,testclient=true", err: "testclient requires client and worker"},
+		{params: "cli=only,client=only", paramErr: "cli=only already specified"},
+		{params: "client=maybe", paramErr: "expected true, false, or only"},
+		{params: "server=true", paramErr: `unsupported plugin parameter "server"`},
+	}
+
+	for _, c := range cases {
+		p := &Plugin{}
+		var paramErr error
+		if c.params != "" {
+			for _, param := range strings.Split(c.params, ",") {
+				key, value, _ := strings.Cut(param, "=")
+				if paramErr = p.Param(key, value); paramErr != nil {
+					break
+				}
+			}
+		}
+		if c.paramErr != "" {
+			require.ErrorContains(paramErr, c.paramErr, c.params)
+			continue
+		}
+		require.NoError(paramErr, c.params)
+
+		err := p.validate()
+		if c.err != "" {
+			require.ErrorContains(err, c.err, c.params)
+			continue
+		}
+		require.NoError(err, c.params)
+		var enabled []string
+		for _, component := range all {
+			if p.enabled(component) {
+				enabled = append(enabled, component)
+			}
+		}
+		require.Equal(c.enabled, enabled, c.params)
+	}
+}
@@ -0,0 +1,35 @@
+package plugin
+
+import (
+	g "github.com/dave/jennifer/jen"
+)
+
+// genTestReplayFunction generates a Replay<Service>Workflows function that replays workflow
+// histories against the service's workflow implementations
+func (svc *Service) genTestReplayFunction(f *g.File) {
+	functionName := toCamel("Replay%sWorkflows", svc.Service.GoName)
+
+	f.Commentf("%s replays the given JSON workflow history files, exported from Temporal, against", functionName)
+	f.Commentf("the given %s workflow implementations, registered via %s.", svc.Service.Desc.FullName(), toCamel("Register%sWorkflows", svc.Service.GoName))
+	f.Comment("Each history file that cannot be replayed, such as due to a nondeterminism error, is")
+	f.Comment("reported as a test error.")
+	f.Func().Id(functionName).
+		Params(
+			g.Id("t").Qual(testutilPkg, "TestingT"),
+			g.Id("workflows").Add(svc.qual(toCamel("%sWorkflows", svc.Service.GoName))),
+			g.Id("historyFiles").Op("...").String(),
+		).
+		Block(
+			g.Id("t").Dot("Helper").Call(),
+			g.Id("replayer").Op(":=").Qual(workerPkg, "NewWorkflowReplayer").Call(),
+			svc.qual(toCamel("Register%sWorkflows", svc.Service.GoName)).Call(g.Id("replayer"), g.Id("workflows")),
+			g.For(g.List(g.Id("_"), g.Id("historyFile")).Op(":=").Range().Id("historyFiles")).Block(
+				g.If(
+					g.Err().Op(":=").Id("replayer").Dot("ReplayWorkflowHistoryFromJSONFile").Call(g.Nil(), g.Id("historyFile")),
+					g.Err().Op("!=").Nil(),
+				).Block(
+					g.Id("t").Dot("Errorf").Call(g.Lit("error replaying workflow history %s: %v"), g.Id("historyFile"), g.Err()),
+				),
+			),
+		)
+}
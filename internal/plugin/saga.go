@@ -0,0 +1,298 @@
+package plugin
+
+import (
+	"fmt"
+
+	g "github.com/dave/jennifer/jen"
+)
+
+// compensation input sources
+const (
+	compensationInputNone    = "none"
+	compensationInputMapping = "mapping"
+	compensationInputReq     = "req"
+	compensationInputResp    = "resp"
+)
+
+// lookupCompensation resolves the compensating activity of the given activity, along with
+// the source of the compensating activity input. An empty activity name is returned if the
+// activity does not declare a compensation, or if it can't be resolved.
+func (svc *Service) lookupCompensation(activity string) (compensation string, input string) {
+	opts := svc.activities[activity].GetCompensate()
+	if opts.GetRef() == "" {
+		return "", ""
+	}
+	owner, name := svc.lookupRef(opts.GetRef())
+	if owner != svc {
+		return "", ""
+	}
+	if _, ok := svc.activities[name]; !ok {
+		return "", ""
+	}
+
+	method, compensator := svc.methods[activity], svc.methods[name]
+	switch {
+	case opts.GetInput() != "":
+		input = compensationInputMapping
+	case isEmpty(compensator.Input):
+		input = compensationInputNone
+	case !isEmpty(method.Input) && compensator.Input.Desc.FullName() == method.Input.Desc.FullName():
+		input = compensationInputReq
+	case !isEmpty(method.Output) && compensator.Input.Desc.FullName() == method.Output.Desc.FullName():
+		input = compensationInputResp
+	}
+	return name, input
+}
+
+// compensatedActivities returns the service activities with a resolvable compensation
+func (svc *Service) compensatedActivities() (activities []string) {
+	for _, activity := range svc.activitiesOrdered {
+		if compensation, input := svc.lookupCompensation(activity); compensation != "" && input != "" {
+			activities = append(activities, activity)
+		}
+	}
+	return activities
+}
+
+// genSaga generates <Service>Saga and <Service>SagaOptions structs that record and execute
+// activity compensations
+func (svc *Service) genSaga(f *g.File) {
+	activities := svc.compensatedActivities()
+	if len(activities) == 0 {
+		return
+	}
+	typeName := toCamel("%sSaga", svc.Service.GoName)
+	optionsName := toCamel("%sSagaOptions", svc.Service.GoName)
+
+	// generate options type definition
+	f.Commentf("%s describes how a %s runs compensations", optionsName, typeName)
+	f.Type().Id(optionsName).Struct(
+		g.Id("continueOnError").Bool(),
+		g.Id("parallel").Bool(),
+	)
+
+	// generate New<Service>SagaOptions constructor
+	f.Commentf("New%s initializes a new %s value", optionsName, optionsName)
+	f.Func().Id(toCamel("New%s", optionsName)).Params().Op("*").Id(optionsName).Block(
+		g.Return(g.Op("&").Id(optionsName).Values()),
+	)
+
+	// generate WithContinueOnError method
+	f.Comment("WithContinueOnError configures whether sequential compensation continues running the remaining")
+	f.Comment("compensations after a compensation fails, returning the combined errors")
+	f.Func().
+		Params(g.Id("opts").Op("*").Id(optionsName)).
+		Id("WithContinueOnError").
+		Params(g.Id("continueOnError").Bool()).
+		Op("*").Id(optionsName).
+		Block(
+			g.Id("opts").Dot("continueOnError").Op("=").Id("continueOnError"),
+			g.Return(g.Id("opts")),
+		)
+
+	// generate WithParallel method
+	f.Comment("WithParallel configures whether compensations run concurrently rather than sequentially in reverse")
+	f.Comment("order, in which case every compensation runs regardless of failures")
+	f.Func().
+		Params(g.Id("opts").Op("*").Id(optionsName)).
+		Id("WithParallel").
+		Params(g.Id("parallel").Bool()).
+		Op("*").Id(optionsName).
+		Block(
+			g.Id("opts").Dot("parallel").Op("=").Id("parallel"),
+			g.Return(g.Id("opts")),
+		)
+
+	// generate type definition
+	f.Commentf("%s executes %s activities and records the compensation of each successful", typeName, svc.Service.Desc.FullName())
+	f.Comment("activity execution, which can be run via Compensate to roll back the saga")
+	f.Type().Id(typeName).Struct(
+		g.Id("compensations").Index().Func().Params(g.Qual(workflowPkg, "Context")).Error(),
+		g.Id("opts").Op("*").Id(optionsName),
+	)
+
+	// generate New<Service>Saga constructor
+	functionName := toCamel("New%s", typeName)
+	f.Commentf("%s initializes a new %s value", functionName, typeName)
+	f.Func().
+		Id(functionName).
+		Params(g.Id("options").Op("...").Op("*").Id(optionsName)).
+		Op("*").Id(typeName).
+		Block(
+			g.Var().Id("opts").Op("*").Id(optionsName),
+			g.If(g.Len(g.Id("options")).Op(">").Lit(0).Op("&&").Id("options").Index(g.Lit(0)).Op("!=").Nil()).Block(
+				g.Id("opts").Op("=").Id("options").Index(g.Lit(0)),
+			).Else().Block(
+				g.Id("opts").Op("=").Id(toCamel("New%s", optionsName)).Call(),
+			),
+			g.Return(g.Op("&").Id(typeName).Values(g.Id("opts").Op(":").Id("opts"))),
+		)
+
+	// generate AddCompensation method
+	f.Comment("AddCompensation records a custom compensation to be run by Compensate")
+	f.Func().
+		Params(g.Id("s").Op("*").Id(typeName)).
+		Id("AddCompensation").
+		Params(g.Id("fn").Func().Params(g.Qual(workflowPkg, "Context")).Error()).
+		Block(
+			g.Id("s").Dot("compensations").Op("=").Append(g.Id("s").Dot("compensations"), g.Id("fn")),
+		)
+
+	// generate Compensate method
+	f.Comment("Compensate runs the recorded compensations in reverse order, or concurrently if configured. If a")
+	f.Comment("sequential compensation fails and ContinueOnError is not configured, it returns immediately and")
+	f.Comment("retains the failed and remaining compensations, allowing Compensate to be retried. Use a")
+	f.Comment("disconnected context to compensate after the workflow is canceled.")
+	f.Func().
+		Params(g.Id("s").Op("*").Id(typeName)).
+		Id("Compensate").
+		Params(g.Id("ctx").Qual(workflowPkg, "Context")).
+		Error().
+		Block(
+			g.Id("compensations").Op(":=").Id("s").Dot("compensations"),
+			g.Id("s").Dot("compensations").Op("=").Nil(),
+			g.Var().Id("errs").Index().Error(),
+			g.If(g.Id("s").Dot("opts").Dot("parallel")).Block(
+				g.Id("futures").Op(":=").Make(g.Index().Qual(workflowPkg, "Future"), g.Lit(0), g.Len(g.Id("compensations"))),
+				g.For(g.Id("i").Op(":=").Len(g.Id("compensations")).Op("-").Lit(1), g.Id("i").Op(">=").Lit(0), g.Id("i").Op("--")).Block(
+					g.Id("compensate").Op(":=").Id("compensations").Index(g.Id("i")),
+					g.List(g.Id("future"), g.Id("settable")).Op(":=").Qual(workflowPkg, "NewFuture").Call(g.Id("ctx")),
+					g.Qual(workflowPkg, "Go").Call(g.Id("ctx"), g.Func().Params(g.Id("ctx").Qual(workflowPkg, "Context")).Block(
+						g.Id("settable").Dot("Set").Call(g.Nil(), g.Id("compensate").Call(g.Id("ctx"))),
+					)),
+					g.Id("futures").Op("=").Append(g.Id("futures"), g.Id("future")),
+				),
+				g.For(g.List(g.Id("_"), g.Id("future")).Op(":=").Range().Id("futures")).Block(
+					g.If(g.Err().Op(":=").Id("future").Dot("Get").Call(g.Id("ctx"), g.Nil()), g.Err().Op("!=").Nil()).Block(
+						g.Id("errs").Op("=").Append(g.Id("errs"), g.Err()),
+					),
+				),
+				g.Return(g.Qual("errors", "Join").Call(g.Id("errs").Op("..."))),
+			),
+			g.For(g.Id("i").Op(":=").Len(g.Id("compensations")).Op("-").Lit(1), g.Id("i").Op(">=").Lit(0), g.Id("i").Op("--")).Block(
+				g.If(g.Err().Op(":=").Id("compensations").Index(g.Id("i")).Call(g.Id("ctx")), g.Err().Op("!=").Nil()).Block(
+					g.If(g.Op("!").Id("s").Dot("opts").Dot("continueOnError")).Block(
+						g.Id("s").Dot("compensations").Op("=").Id("compensations").Index(g.Empty(), g.Id("i").Op("+").Lit(1)),
+						g.Return(g.Err()),
+					),
+					g.Id("errs").Op("=").Append(g.Id("errs"), g.Err()),
+				),
+			),
+			g.Return(g.Qual("errors", "Join").Call(g.Id("errs").Op("..."))),
+		)
+
+	// generate <Activity> methods
+	for _, activity := range activities {
+		svc.genSagaActivityMethod(f, activity)
+	}
+}
+
+// genSagaActivityMethod generates a <Service>Saga's <Activity> method
+func (svc *Service) genSagaActivityMethod(f *g.File, activity string) {
+	method := svc.methods[activity]
+	hasInput := !isEmpty(method.Input)
+	hasOutput := !isEmpty(method.Output)
+	typeName := toCamel("%sSaga", svc.Service.GoName)
+	compensation, input := svc.lookupCompensation(activity)
+	compensator := svc.methods[compensation]
+
+	// returnErr returns the given error, along with the activity output if applicable
+	returnErr := func(err g.Code) *g.Statement {
+		if hasOutput {
+			return g.Return(g.Id("resp"), err)
+		}
+		return g.Return(err)
+	}
+
+	f.Commentf("%s executes a(n) %s activity, recording a(n) %s compensation if successful", activity, svc.fqnForActivity(activity), svc.fqnForActivity(compensation))
+	f.Func().
+		Params(g.Id("s").Op("*").Id(typeName)).
+		Id(activity).
+		ParamsFunc(func(args *g.Group) {
+			args.Id("ctx").Qual(workflowPkg, "Context")
+			if hasInput {
+				args.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
+			}
+			args.Id("options").Op("...").Op("*").Id(toCamel("%sActivityOptions", activity))
+		}).
+		ParamsFunc(func(returnVals *g.Group) {
+			if hasOutput {
+				returnVals.Op("*").Add(goIdent(method.Output.GoIdent))
+			}
+			returnVals.Error()
+		}).
+		BlockFunc(func(fn *g.Group) {
+			// execute activity
+			fn.ListFunc(func(vals *g.Group) {
+				if hasOutput {
+					vals.Id("resp")
+				}
+				vals.Err()
+			}).Op(":=").Id(activity).CallFunc(func(args *g.Group) {
+				args.Id("ctx")
+				if hasInput {
+					args.Id("req")
+				}
+				args.Id("options").Op("...")
+			})
+			fn.If(g.Err().Op("!=").Nil()).Block(returnErr(g.Err()))
+
+			// derive compensation input
+			switch input {
+			case compensationInputMapping:
+				sources := []struct {
+					ok  bool
+					key string
+					arg string
+				}{{hasInput, "input", "req"}, {hasOutput, "output", "resp"}}
+				for _, source := range sources {
+					if !source.ok {
+						continue
+					}
+					fn.List(g.Id(source.key), g.Err()).Op(":=").Qual(expressionPkg, "ToStructured").Call(g.Id(source.arg).Dot("ProtoReflect").Call())
+					fn.If(g.Err().Op("!=").Nil()).Block(
+						returnErr(g.Qual("fmt", "Errorf").Call(g.Lit(fmt.Sprintf("error serializing %s for %q compensation input mapping: %%w", source.key, activity)), g.Err())),
+					)
+				}
+				fn.Id("structured").Op(":=").Map(g.String()).Any().CustomFunc(multiLineValues, func(vals *g.Group) {
+					for _, source := range sources {
+						if source.ok {
+							vals.Lit(source.key).Op(":").Id(source.key)
+						}
+					}
+				})
+				fn.List(g.Id("result"), g.Err()).Op(":=").Id(toCamel("%sCompensationMapping", activity)).Dot("Query").Call(g.Id("structured"))
+				fn.If(g.Err().Op("!=").Nil()).Block(
+					returnErr(g.Qual("fmt", "Errorf").Call(g.Lit(fmt.Sprintf("error executing %q compensation input mapping: %%w", activity)), g.Err())),
+				)
+				fn.Id("compensation").Op(":=").Op("&").Add(goIdent(compensator.Input.GoIdent)).Values()
+				fn.If(g.Err().Op(":=").Qual(expressionPkg, "FromStructured").Call(g.Id("result"), g.Id("compensation")), g.Err().Op("!=").Nil()).Block(
+					returnErr(g.Qual("fmt", "Errorf").Call(g.Lit(fmt.Sprintf("error converting %q compensation input: %%w", activity)), g.Err())),
+				)
+			case compensationInputReq:
+				fn.Id("compensation").Op(":=").Id("req")
+			case compensationInputResp:
+				fn.Id("compensation").Op(":=").Id("resp")
+			}
+
+			// record compensation
+			fn.Id("s").Dot("AddCompensation").Call(g.Func().Params(g.Id("ctx").Qual(workflowPkg, "Context")).Error().Block(
+				g.ListFunc(func(vals *g.Group) {
+					if !isEmpty(compensator.Output) {
+						vals.Id("_")
+					}
+					vals.Err()
+				}).Op(":=").Id(compensation).CallFunc(func(args *g.Group) {
+					args.Id("ctx")
+					if input != compensationInputNone {
+						args.Id("compensation")
+					}
+				}),
+				g.If(g.Err().Op("!=").Nil()).Block(
+					g.Return(g.Qual("fmt", "Errorf").Call(g.Lit(fmt.Sprintf("error compensating %q activity: %%w", activity)), g.Err())),
+				),
+				g.Return(g.Nil()),
+			))
+			fn.Add(returnErr(g.Nil()))
+		})
+}
@@ -0,0 +1,616 @@
+package plugin
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	temporalv1 "github.com/cludden/protoc-gen-go-temporal/gen/temporal/v1"
+	g "github.com/dave/jennifer/jen"
+	"github.com/iancoleman/strcase"
+)
+
+// scheduleVerifiedNote describes the verification performed by schedule methods that act on an
+// existing schedule
+const scheduleVerifiedNote = ", failing if the schedule starts a different workflow"
+
+// clientScheduleInterfaceMethods returns the <Action><Workflow>Schedule methods of a Client interface
+func (svc *Service) clientScheduleInterfaceMethods(workflow string) []*interfaceMethod {
+	method := svc.methods[workflow]
+	idParam := interfaceMethodParam{name: "id", typ: g.String()}
+
+	// add Create<Workflow>Schedule and Get<Workflow>Schedule methods
+	createName, getName := toCamel("Create%sSchedule", workflow), toCamel("Get%sSchedule", workflow)
+	methods := []*interfaceMethod{
+		{
+			name:    createName,
+			comment: fmt.Sprintf("%s creates a Temporal Schedule that periodically starts a(n) %s workflow", createName, svc.fqnForWorkflow(workflow)),
+			params: append(append([]interfaceMethodParam{
+				ctxParam(),
+				idParam,
+				{name: "spec", typ: g.Qual(clientPkg, "ScheduleSpec")},
+			}, inputParam("req", method.Input)...), interfaceMethodParam{
+				name: "opts", typ: g.Op("*").Add(svc.qual(toCamel("%sScheduleOptions", workflow))), variadic: true,
+			}),
+			results: []interfaceMethodParam{{name: "handle", typ: g.Qual(clientPkg, "ScheduleHandle")}, errResult()},
+		},
+		{
+			name:    getName,
+			comment: fmt.Sprintf("%s describes an existing %s workflow schedule", getName, svc.fqnForWorkflow(workflow)),
+			params:  []interfaceMethodParam{ctxParam(), idParam},
+			results: []interfaceMethodParam{{name: "desc", typ: g.Op("*").Qual(clientPkg, "ScheduleDescription")}, errResult()},
+		},
+	}
+
+	// add Pause<Workflow>Schedule and Unpause<Workflow>Schedule methods
+	for _, action := range []string{"Pause", "Unpause"} {
+		methodName := toCamel("%s%sSchedule", action, workflow)
+		methods = append(methods, &interfaceMethod{
+			name:    methodName,
+			comment: fmt.Sprintf("%s %ss an existing %s workflow schedule with an optional note", methodName, strings.ToLower(action), svc.fqnForWorkflow(workflow)) + scheduleVerifiedNote,
+			params:  []interfaceMethodParam{ctxParam(), idParam, {name: "note", typ: g.String()}},
+			results: []interfaceMethodParam{errResult()},
+		})
+	}
+
+	// add Trigger<Workflow>Schedule and Delete<Workflow>Schedule methods
+	triggerName, deleteName := toCamel("Trigger%sSchedule", workflow), toCamel("Delete%sSchedule", workflow)
+	return append(methods,
+		&interfaceMethod{
+			name:    triggerName,
+			comment: fmt.Sprintf("%s immediately starts a(n) %s workflow using an existing schedule", triggerName, svc.fqnForWorkflow(workflow)) + scheduleVerifiedNote,
+			params:  []interfaceMethodParam{ctxParam(), idParam},
+			results: []interfaceMethodParam{errResult()},
+		},
+		&interfaceMethod{
+			name:    deleteName,
+			comment: fmt.Sprintf("%s deletes an existing %s workflow schedule", deleteName, svc.fqnForWorkflow(workflow)) + scheduleVerifiedNote,
+			params:  []interfaceMethodParam{ctxParam(), idParam},
+			results: []interfaceMethodParam{errResult()},
+		},
+	)
+}
+
+// genClientImplScheduleMethods generates <Action><Workflow>Schedule client methods
+func (svc *Service) genClientImplScheduleMethods(f *g.File, workflow string) {
+	clientType := toLowerCamel("%sClient", svc.Service.GoName)
+	method := svc.methods[workflow]
+	hasInput := !isEmpty(method.Input)
+
+	// generate Create<Workflow>Schedule method
+	methodName := toCamel("Create%sSchedule", workflow)
+	f.Commentf("%s creates a Temporal Schedule that periodically starts a(n) %s workflow", methodName, svc.fqnForWorkflow(workflow))
+	f.Func().
+		Params(g.Id("c").Op("*").Id(clientType)).
+		Id(methodName).
+		ParamsFunc(func(args *g.Group) {
+			args.Id("ctx").Qual("context", "Context")
+			args.Id("id").String()
+			args.Id("spec").Qual(clientPkg, "ScheduleSpec")
+			if hasInput {
+				args.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
+			}
+			args.Id("options").Op("...").Op("*").Id(toCamel("%sScheduleOptions", workflow))
+		}).
+		Params(
+			g.Qual(clientPkg, "ScheduleHandle"),
+			g.Error(),
+		).
+		BlockFunc(func(fn *g.Group) {
+			fn.Var().Id("o").Op("*").Id(toCamel("%sScheduleOptions", workflow))
+			fn.If(g.Len(g.Id("options")).Op(">").Lit(0)).Block(
+				g.Id("o").Op("=").Id("options").Index(g.Lit(0)),
+			)
+			fn.List(g.Id("opts"), g.Err()).Op(":=").Id("o").Dot("Build").CallFunc(func(args *g.Group) {
+				args.Id("id")
+				args.Id("spec")
+				if hasInput {
+					args.Id("req")
+				}
+			})
+			fn.If(g.Err().Op("!=").Nil()).Block(
+				g.Return(g.Nil(), g.Err()),
+			)
+			fn.Return(g.Id("c").Dot("client").Dot("ScheduleClient").Call().Dot("Create").Call(g.Id("ctx"), g.Op("*").Id("opts")))
+		})
+
+	// generate Get<Workflow>Schedule method
+	methodName = toCamel("Get%sSchedule", workflow)
+	f.Commentf("%s describes an existing %s workflow schedule", methodName, svc.fqnForWorkflow(workflow))
+	f.Func().
+		Params(g.Id("c").Op("*").Id(clientType)).
+		Id(methodName).
+		Params(
+			g.Id("ctx").Qual("context", "Context"),
+			g.Id("id").String(),
+		).
+		Params(
+			g.Op("*").Qual(clientPkg, "ScheduleDescription"),
+			g.Error(),
+		).
+		Block(
+			g.List(g.Id("desc"), g.Err()).Op(":=").Id("c").Dot("client").Dot("ScheduleClient").Call().Dot("GetHandle").Call(g.Id("ctx"), g.Id("id")).Dot("Describe").Call(g.Id("ctx")),
+			g.If(g.Err().Op("!=").Nil()).Block(
+				g.Return(g.Nil(), g.Err()),
+			),
+			g.If(
+				g.List(g.Id("action"), g.Id("ok")).Op(":=").Id("desc").Dot("Schedule").Dot("Action").Assert(g.Op("*").Qual(clientPkg, "ScheduleWorkflowAction")),
+				g.Op("!").Id("ok").Op("||").Id("action").Dot("Workflow").Op("!=").Id(toCamel("%sWorkflowName", workflow)),
+			).Block(
+				g.Return(g.Nil(), g.Qual("fmt", "Errorf").Call(g.Lit("schedule %q does not start a(n) %s workflow"), g.Id("id"), g.Id(toCamel("%sWorkflowName", workflow)))),
+			),
+			g.Return(g.Id("desc"), g.Nil()),
+		)
+
+	// verifySchedule returns a statement that describes the schedule via Get<Workflow>Schedule,
+	// returning an error if its action does not start a(n) <Workflow> workflow
+	verifySchedule := func() *g.Statement {
+		return g.If(
+			g.List(g.Id("_"), g.Err()).Op(":=").Id("c").Dot(toCamel("Get%sSchedule", workflow)).Call(g.Id("ctx"), g.Id("id")),
+			g.Err().Op("!=").Nil(),
+		).Block(
+			g.Return(g.Err()),
+		)
+	}
+
+	// generate Pause<Workflow>Schedule and Unpause<Workflow>Schedule methods
+	for _, action := range []string{"Pause", "Unpause"} {
+		methodName = toCamel("%s%sSchedule", action, workflow)
+		f.Commentf("%s %ss an existing %s workflow schedule with an optional note%s", methodName, strings.ToLower(action), svc.fqnForWorkflow(workflow), scheduleVerifiedNote)
+		f.Func().
+			Params(g.Id("c").Op("*").Id(clientType)).
+			Id(methodName).
+			Params(
+				g.Id("ctx").Qual("context", "Context"),
+				g.Id("id").String(),
+				g.Id("note").String(),
+			).
+			Error().
+			Block(
+				verifySchedule(),
+				g.Return(g.Id("c").Dot("client").Dot("ScheduleClient").Call().Dot("GetHandle").Call(g.Id("ctx"), g.Id("id")).Dot(action).Call(
+					g.Id("ctx"),
+					g.Qual(clientPkg, fmt.Sprintf("Schedule%sOptions", action)).Values(g.Id("Note").Op(":").Id("note")),
+				)),
+			)
+	}
+
+	// generate Trigger<Workflow>Schedule method
+	methodName = toCamel("Trigger%sSchedule", workflow)
+	f.Commentf("%s immediately starts a(n) %s workflow using an existing schedule%s", methodName, svc.fqnForWorkflow(workflow), scheduleVerifiedNote)
+	f.Func().
+		Params(g.Id("c").Op("*").Id(clientType)).
+		Id(methodName).
+		Params(
+			g.Id("ctx").Qual("context", "Context"),
+			g.Id("id").String(),
+		).
+		Error().
+		Block(
+			verifySchedule(),
+			g.Return(g.Id("c").Dot("client").Dot("ScheduleClient").Call().Dot("GetHandle").Call(g.Id("ctx"), g.Id("id")).Dot("Trigger").Call(
+				g.Id("ctx"),
+				g.Qual(clientPkg, "ScheduleTriggerOptions").Values(),
+			)),
+		)
+
+	// generate Delete<Workflow>Schedule method
+	methodName = toCamel("Delete%sSchedule", workflow)
+	f.Commentf("%s deletes an existing %s workflow schedule%s", methodName, svc.fqnForWorkflow(workflow), scheduleVerifiedNote)
+	f.Func().
+		Params(g.Id("c").Op("*").Id(clientType)).
+		Id(methodName).
+		Params(
+			g.Id("ctx").Qual("context", "Context"),
+			g.Id("id").String(),
+		).
+		Error().
+		Block(
+			verifySchedule(),
+			g.Return(g.Id("c").Dot("client").Dot("ScheduleClient").Call().Dot("GetHandle").Call(g.Id("ctx"), g.Id("id")).Dot("Delete").Call(g.Id("ctx"))),
+		)
+}
+
+// genClientScheduleOptions generates a <Workflow>ScheduleOptions struct
+func (svc *Service) genClientScheduleOptions(f *g.File, workflow string) {
+	typeName := toCamel("%sScheduleOptions", workflow)
+	constructorName := "New" + typeName
+	method := svc.methods[workflow]
+	hasInput := !isEmpty(method.Input)
+	opts := svc.workflows[workflow].GetSchedule()
+
+	f.Commentf("%s provides configuration for a %s workflow schedule", typeName, svc.fqnForWorkflow(workflow))
+	f.Type().Id(typeName).Struct(
+		g.Id("opts").Op("*").Qual(clientPkg, "ScheduleOptions"),
+		g.Id("workflowOpts").Op("*").Id(toCamel("%sOptions", workflow)),
+	)
+
+	f.Commentf("%s initializes a new %s value", constructorName, typeName)
+	f.Func().Id(constructorName).Params().Op("*").Id(typeName).Block(
+		g.Return(g.Op("&").Id(typeName).Values()),
+	)
+
+	f.Comment("WithScheduleOptions sets the initial client.ScheduleOptions")
+	f.Func().
+		Params(g.Id("opts").Op("*").Id(typeName)).
+		Id("WithScheduleOptions").
+		Params(g.Id("options").Qual(clientPkg, "ScheduleOptions")).
+		Op("*").Id(typeName).
+		Block(
+			g.Id("opts").Dot("opts").Op("=").Op("&").Id("options"),
+			g.Return(g.Id("opts")),
+		)
+
+	f.Commentf("WithWorkflowOptions sets the options used to initialize the scheduled workflow action")
+	f.Func().
+		Params(g.Id("opts").Op("*").Id(typeName)).
+		Id("WithWorkflowOptions").
+		Params(g.Id("options").Op("*").Id(toCamel("%sOptions", workflow))).
+		Op("*").Id(typeName).
+		Block(
+			g.Id("opts").Dot("workflowOpts").Op("=").Id("options"),
+			g.Return(g.Id("opts")),
+		)
+
+	f.Commentf("Build initializes a client.ScheduleOptions with defaults for a(n) %s workflow schedule", svc.fqnForWorkflow(workflow))
+	f.Func().
+		Params(g.Id("o").Op("*").Id(typeName)).
+		Id("Build").
+		ParamsFunc(func(args *g.Group) {
+			args.Id("id").String()
+			args.Id("spec").Qual(clientPkg, "ScheduleSpec")
+			if hasInput {
+				args.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
+			}
+		}).
+		Params(
+			g.Op("*").Qual(clientPkg, "ScheduleOptions"),
+			g.Error(),
+		).
+		BlockFunc(func(fn *g.Group) {
+			fn.Id("opts").Op(":=").Op("&").Qual(clientPkg, "ScheduleOptions").Values()
+			// copy the provided options so that they can be reused
+			fn.If(g.Id("o").Op("!=").Nil().Op("&&").Id("o").Dot("opts").Op("!=").Nil()).Block(
+				g.Id("v").Op(":=").Op("*").Id("o").Dot("opts"),
+				g.Id("opts").Op("=").Op("&").Id("v"),
+			)
+			fn.Id("opts").Dot("ID").Op("=").Id("id")
+			fn.Id("opts").Dot("Spec").Op("=").Id("spec")
+
+			// set default spec if no times specified
+			if exprs, intervals := opts.GetCronExpressions(), opts.GetIntervals(); len(exprs) > 0 || len(intervals) > 0 {
+				fn.If(
+					g.Len(g.Id("opts").Dot("Spec").Dot("Calendars")).Op("==").Lit(0).Op("&&").
+						Len(g.Id("opts").Dot("Spec").Dot("CronExpressions")).Op("==").Lit(0).Op("&&").
+						Len(g.Id("opts").Dot("Spec").Dot("Intervals")).Op("==").Lit(0),
+				).BlockFunc(func(b *g.Group) {
+					if len(exprs) > 0 {
+						b.Id("opts").Dot("Spec").Dot("CronExpressions").Op("=").Index().String().ValuesFunc(func(vals *g.Group) {
+							for _, expr := range exprs {
+								vals.Lit(expr)
+							}
+						})
+					}
+					if len(intervals) > 0 {
+						// list one interval per line, followed by its duration
+						b.Id("opts").Dot("Spec").Dot("Intervals").Op("=").Index().Qual(clientPkg, "ScheduleIntervalSpec").CustomFunc(g.Options{Open: "{", Close: "}", Multi: true}, func(vals *g.Group) {
+							for _, interval := range intervals {
+								vals.Values(g.Id("Every").Op(":").Id(strconv.FormatInt(interval.AsDuration().Nanoseconds(), 10))).Op(",").Comment(interval.AsDuration().String())
+							}
+						})
+					}
+				})
+			}
+
+			if jitter := opts.GetJitter(); jitter.IsValid() {
+				fn.If(g.Id("opts").Dot("Spec").Dot("Jitter").Op("==").Lit(0)).Block(
+					g.Id("opts").Dot("Spec").Dot("Jitter").Op("=").Id(strconv.FormatInt(jitter.AsDuration().Nanoseconds(), 10)).Comment(jitter.AsDuration().String()),
+				)
+			}
+
+			if tz := opts.GetTimeZoneName(); tz != "" {
+				fn.If(g.Id("opts").Dot("Spec").Dot("TimeZoneName").Op("==").Lit("")).Block(
+					g.Id("opts").Dot("Spec").Dot("TimeZoneName").Op("=").Lit(tz),
+				)
+			}
+
+			if policy := opts.GetOverlapPolicy(); policy != temporalv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_UNSPECIFIED {
+				fn.If(g.Id("opts").Dot("Overlap").Op("==").Qual(enumsPkg, "SCHEDULE_OVERLAP_POLICY_UNSPECIFIED")).Block(
+					g.Id("opts").Dot("Overlap").Op("=").Qual(enumsPkg, policy.String()),
+				)
+			}
+
+			if window := opts.GetCatchupWindow(); window.IsValid() {
+				fn.If(g.Id("opts").Dot("CatchupWindow").Op("==").Lit(0)).Block(
+					g.Id("opts").Dot("CatchupWindow").Op("=").Id(strconv.FormatInt(window.AsDuration().Nanoseconds(), 10)).Comment(window.AsDuration().String()),
+				)
+			}
+
+			if opts.GetPauseOnFailure() {
+				fn.Id("opts").Dot("PauseOnFailure").Op("=").True()
+			}
+
+			// initialize scheduled workflow action using workflow defaults
+			fn.Var().Id("workflowOpts").Op("*").Id(toCamel("%sOptions", workflow))
+			fn.If(g.Id("o").Op("!=").Nil()).Block(
+				g.Id("workflowOpts").Op("=").Id("o").Dot("workflowOpts"),
+			)
+			fn.List(g.Id("wopts"), g.Err()).Op(":=").Id("workflowOpts").Dot("Build").CallFunc(func(args *g.Group) {
+				if hasInput {
+					args.Id("req")
+				}
+			})
+			fn.If(g.Err().Op("!=").Nil()).Block(
+				g.Return(g.Nil(), g.Err()),
+			)
+			fn.Id("opts").Dot("Action").Op("=").Op("&").Qual(clientPkg, "ScheduleWorkflowAction").CustomFunc(multiLineValues, func(fields *g.Group) {
+				fields.Id("ID").Op(":").Id("wopts").Dot("ID")
+				fields.Id("Workflow").Op(":").Id(toCamel("%sWorkflowName", workflow))
+				if hasInput {
+					fields.Id("Args").Op(":").Index().Any().Values(g.Id("req"))
+				}
+				for _, field := range []string{"TaskQueue", "WorkflowExecutionTimeout", "WorkflowRunTimeout", "WorkflowTaskTimeout", "RetryPolicy", "Memo", "SearchAttributes"} {
+					fields.Id(field).Op(":").Id("wopts").Dot(field)
+				}
+			})
+			fn.Return(g.Id("opts"), g.Nil())
+		})
+}
+
+// genCliScheduleCommand generates a <Workflow>-schedule command with subcommands for managing
+// workflow schedules
+func (svc *Service) genCliScheduleCommand(cmds *g.Group, workflow string) {
+	method := svc.methods[workflow]
+	hasInput := !isEmpty(method.Input)
+
+	// scheduleIDFlag adds the required schedule-id flag
+	scheduleIDFlag := func(flags *g.Group) {
+		flags.Op("&").Qual(cliPkg, "StringFlag").CustomFunc(multiLineValues, func(fields *g.Group) {
+			fields.Id("Name").Op(":").Lit("schedule-id")
+			fields.Id("Usage").Op(":").Lit("schedule id")
+			fields.Id("Required").Op(":").True()
+			fields.Id("Aliases").Op(":").Index().String().Values(g.Lit("s"))
+		})
+	}
+	// noteFlag adds an optional note flag
+	noteFlag := func(flags *g.Group) {
+		flags.Op("&").Qual(cliPkg, "StringFlag").CustomFunc(multiLineValues, func(fields *g.Group) {
+			fields.Id("Name").Op(":").Lit("note")
+			fields.Id("Usage").Op(":").Lit("informative note describing the change")
+		})
+	}
+	// action generates a subcommand Action that invokes pre, initializes a client, and then
+	// invokes fn
+	action := func(pre, fn func(fn *g.Group)) *g.Statement {
+		return g.Func().Params(g.Id("cmd").Op("*").Qual(cliPkg, "Context")).Error().BlockFunc(func(b *g.Group) {
+			if pre != nil {
+				pre(b)
+			}
+			b.List(g.Id("c"), g.Err()).Op(":=").Id("opts").Dot("clientForCommand").Call(g.Id("cmd"))
+			b.If(g.Err().Op("!=").Nil()).Block(
+				g.Return(g.Qual("fmt", "Errorf").Call(g.Lit("error initializing client for command: %w"), g.Err())),
+			)
+			b.Defer().Id("c").Dot("Close").Call()
+			b.Id("client").Op(":=").Add(svc.qual(toCamel("New%sClient", svc.Service.GoName))).Call(g.Id("c"))
+			fn(b)
+		})
+	}
+	// simple generates a subcommand that invokes a schedule client method and prints success
+	simple := func(subcmds *g.Group, name, usage, methodName string, hasNote bool) {
+		subcmds.CustomFunc(multiLineValues, func(cmd *g.Group) {
+			cmd.Id("Name").Op(":").Lit(name)
+			cmd.Id("Usage").Op(":").Lit(usage)
+			cmd.Id("Flags").Op(":").Index().Qual(cliPkg, "Flag").CustomFunc(multiLineValues, func(flags *g.Group) {
+				scheduleIDFlag(flags)
+				if hasNote {
+					noteFlag(flags)
+				}
+			})
+			cmd.Id("Action").Op(":").Add(action(nil, func(fn *g.Group) {
+				fn.If(
+					g.Err().Op(":=").Id("client").Dot(methodName).CallFunc(func(args *g.Group) {
+						args.Id("cmd").Dot("Context")
+						args.Id("cmd").Dot("String").Call(g.Lit("schedule-id"))
+						if hasNote {
+							args.Id("cmd").Dot("String").Call(g.Lit("note"))
+						}
+					}),
+					g.Err().Op("!=").Nil(),
+				).Block(
+					g.Return(g.Qual("fmt", "Errorf").Call(g.Lit(fmt.Sprintf("error executing %s schedule command: %%w", name)), g.Err())),
+				)
+				fn.Qual("fmt", "Println").Call(g.Lit("success"))
+				fn.Return(g.Nil())
+			}))
+		})
+	}
+
+	desc := fmt.Sprintf("manages %s workflow schedules", svc.fqnForWorkflow(workflow))
+	cmds.Comment(desc)
+	cmds.CustomFunc(multiLineValues, func(cmd *g.Group) {
+		cmd.Id("Name").Op(":").Lit(strcase.ToKebab(workflow) + "-schedule")
+		cmd.Id("Usage").Op(":").Lit(desc)
+		if svc.opts.GetFeatures().GetCli().GetCategories() {
+			cmd.Id("Category").Op(":").Lit("SCHEDULES")
+		}
+		cmd.Id("UseShortOptionHandling").Op(":").True()
+		cmd.Id("Before").Op(":").Id("opts").Dot("before")
+		cmd.Id("After").Op(":").Id("opts").Dot("after")
+		cmd.Id("Subcommands").Op(":").Index().Op("*").Qual(cliPkg, "Command").CustomFunc(multiLineValues, func(subcmds *g.Group) {
+			// generate create subcommand
+			subcmds.CustomFunc(multiLineValues, func(cmd *g.Group) {
+				cmd.Id("Name").Op(":").Lit("create")
+				cmd.Id("Usage").Op(":").Lit(fmt.Sprintf("creates a schedule that periodically starts a(n) %s workflow", svc.fqnForWorkflow(workflow)))
+				cmd.Id("Flags").Op(":").Index().Qual(cliPkg, "Flag").CustomFunc(multiLineValues, func(flags *g.Group) {
+					scheduleIDFlag(flags)
+					flags.Op("&").Qual(cliPkg, "StringSliceFlag").CustomFunc(multiLineValues, func(fields *g.Group) {
+						fields.Id("Name").Op(":").Lit("cron")
+						fields.Id("Usage").Op(":").Lit("cron expression describing when to start the workflow, overriding the default spec")
+					})
+					flags.Op("&").Qual(cliPkg, "StringSliceFlag").CustomFunc(multiLineValues, func(fields *g.Group) {
+						fields.Id("Name").Op(":").Lit("interval")
+						fields.Id("Usage").Op(":").Lit("interval (e.g. 1h) describing how often to start the workflow, overriding the default spec")
+					})
+					if hasInput {
+						for _, field := range method.Input.Fields {
+							svc.genCliFlagForField(flags, field, "INPUT")
+						}
+					}
+				})
+				cmd.Id("Action").Op(":").Add(action(func(fn *g.Group) {
+					// unmarshal input
+					if hasInput {
+						unmarshaller := fmt.Sprintf("unmarshalCliFlagsTo%s", method.Input.GoIdent.GoName)
+						fn.List(g.Id("req"), g.Err()).Op(":=").Id(unmarshaller).Call(g.Id("cmd"))
+						fn.If(g.Err().Op("!=").Nil()).Block(
+							g.Return(g.Qual("fmt", "Errorf").Call(g.Lit("error unmarshalling request: %w"), g.Err())),
+						)
+					}
+
+					// initialize schedule spec
+					fn.Id("spec").Op(":=").Qual(clientPkg, "ScheduleSpec").Values(
+						g.Id("CronExpressions").Op(":").Id("cmd").Dot("StringSlice").Call(g.Lit("cron")),
+					)
+					fn.For(g.List(g.Id("_"), g.Id("interval")).Op(":=").Range().Id("cmd").Dot("StringSlice").Call(g.Lit("interval"))).Block(
+						g.List(g.Id("every"), g.Err()).Op(":=").Qual("time", "ParseDuration").Call(g.Id("interval")),
+						g.If(g.Err().Op("!=").Nil()).Block(
+							g.Return(g.Qual("fmt", "Errorf").Call(g.Lit("error parsing interval %q: %w"), g.Id("interval"), g.Err())),
+						),
+						g.Id("spec").Dot("Intervals").Op("=").Append(g.Id("spec").Dot("Intervals"), g.Qual(clientPkg, "ScheduleIntervalSpec").Values(g.Id("Every").Op(":").Id("every"))),
+					)
+
+				}, func(fn *g.Group) {
+					fn.List(g.Id("handle"), g.Err()).Op(":=").Id("client").Dot(toCamel("Create%sSchedule", workflow)).CallFunc(func(args *g.Group) {
+						args.Id("cmd").Dot("Context")
+						args.Id("cmd").Dot("String").Call(g.Lit("schedule-id"))
+						args.Id("spec")
+						if hasInput {
+							args.Id("req")
+						}
+					})
+					fn.If(g.Err().Op("!=").Nil()).Block(
+						g.Return(g.Qual("fmt", "Errorf").Call(g.Lit("error creating schedule: %w"), g.Err())),
+					)
+					fn.Qual("fmt", "Println").Call(g.Lit("success"))
+					fn.Qual("fmt", "Printf").Call(g.Lit("schedule id: %s\n"), g.Id("handle").Dot("GetID").Call())
+					fn.Return(g.Nil())
+				}))
+			})
+
+			// generate get subcommand
+			subcmds.CustomFunc(multiLineValues, func(cmd *g.Group) {
+				cmd.Id("Name").Op(":").Lit("get")
+				cmd.Id("Usage").Op(":").Lit("describes an existing schedule")
+				cmd.Id("Flags").Op(":").Index().Qual(cliPkg, "Flag").CustomFunc(multiLineValues, func(flags *g.Group) {
+					scheduleIDFlag(flags)
+				})
+				cmd.Id("Action").Op(":").Add(action(nil, func(fn *g.Group) {
+					fn.List(g.Id("desc"), g.Err()).Op(":=").Id("client").Dot(toCamel("Get%sSchedule", workflow)).Call(
+						g.Id("cmd").Dot("Context"),
+						g.Id("cmd").Dot("String").Call(g.Lit("schedule-id")),
+					)
+					fn.If(g.Err().Op("!=").Nil()).Block(
+						g.Return(g.Qual("fmt", "Errorf").Call(g.Lit("error describing schedule: %w"), g.Err())),
+					)
+					fn.If(g.Id("state").Op(":=").Id("desc").Dot("Schedule").Dot("State"), g.Id("state").Op("!=").Nil()).Block(
+						g.Qual("fmt", "Printf").Call(g.Lit("paused: %t\n"), g.Id("state").Dot("Paused")),
+						g.Qual("fmt", "Printf").Call(g.Lit("note: %s\n"), g.Id("state").Dot("Note")),
+					)
+					fn.For(g.List(g.Id("_"), g.Id("t")).Op(":=").Range().Id("desc").Dot("Info").Dot("NextActionTimes")).Block(
+						g.Qual("fmt", "Printf").Call(g.Lit("next action: %s\n"), g.Id("t").Dot("Format").Call(g.Qual("time", "RFC3339"))),
+					)
+					fn.For(g.List(g.Id("_"), g.Id("a")).Op(":=").Range().Id("desc").Dot("Info").Dot("RecentActions")).Block(
+						g.Qual("fmt", "Printf").Call(g.Lit("recent action: %s\n"), g.Id("a").Dot("ActualTime").Dot("Format").Call(g.Qual("time", "RFC3339"))),
+					)
+					fn.Return(g.Nil())
+				}))
+			})
+
+			simple(subcmds, "pause", "pauses an existing schedule", toCamel("Pause%sSchedule", workflow), true)
+			simple(subcmds, "unpause", "unpauses an existing schedule", toCamel("Unpause%sSchedule", workflow), true)
+			simple(subcmds, "trigger", "immediately starts a workflow using an existing schedule", toCamel("Trigger%sSchedule", workflow), false)
+			simple(subcmds, "delete", "deletes an existing schedule", toCamel("Delete%sSchedule", workflow), false)
+		})
+	})
+}
+
+// genTestClientImplScheduleMethods generates a TestClient's <Action><Workflow>Schedule methods,
+// which return an error as schedules are not supported by the test environment
+func (svc *Service) genTestClientImplScheduleMethods(f *g.File, workflow string) {
+	clientType := toCamel("Test%sClient", svc.Service.GoName)
+	method := svc.methods[workflow]
+	hasInput := !isEmpty(method.Input)
+	errNotSupported := g.Qual("errors", "New").Call(g.Lit("schedules are not supported by the test client"))
+
+	methodName := toCamel("Create%sSchedule", workflow)
+	f.Commentf("%s is not supported by the test client", methodName)
+	f.Func().
+		Params(g.Id("c").Op("*").Id(clientType)).
+		Id(methodName).
+		ParamsFunc(func(args *g.Group) {
+			args.Id("ctx").Qual("context", "Context")
+			args.Id("id").String()
+			args.Id("spec").Qual(clientPkg, "ScheduleSpec")
+			if hasInput {
+				args.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
+			}
+			args.Id("options").Op("...").Op("*").Add(svc.qual(toCamel("%sScheduleOptions", workflow)))
+		}).
+		Params(
+			g.Qual(clientPkg, "ScheduleHandle"),
+			g.Error(),
+		).
+		Block(
+			g.Return(g.Nil(), errNotSupported.Clone()),
+		)
+
+	methodName = toCamel("Get%sSchedule", workflow)
+	f.Commentf("%s is not supported by the test client", methodName)
+	f.Func().
+		Params(g.Id("c").Op("*").Id(clientType)).
+		Id(methodName).
+		Params(
+			g.Id("ctx").Qual("context", "Context"),
+			g.Id("id").String(),
+		).
+		Params(
+			g.Op("*").Qual(clientPkg, "ScheduleDescription"),
+			g.Error(),
+		).
+		Block(
+			g.Return(g.Nil(), errNotSupported.Clone()),
+		)
+
+	for _, action := range []string{"Pause", "Unpause"} {
+		methodName = toCamel("%s%sSchedule", action, workflow)
+		f.Commentf("%s is not supported by the test client", methodName)
+		f.Func().
+			Params(g.Id("c").Op("*").Id(clientType)).
+			Id(methodName).
+			Params(
+				g.Id("ctx").Qual("context", "Context"),
+				g.Id("id").String(),
+				g.Id("note").String(),
+			).
+			Error().
+			Block(
+				g.Return(errNotSupported.Clone()),
+			)
+	}
+
+	for _, action := range []string{"Trigger", "Delete"} {
+		methodName = toCamel("%s%sSchedule", action, workflow)
+		f.Commentf("%s is not supported by the test client", methodName)
+		f.Func().
+			Params(g.Id("c").Op("*").Id(clientType)).
+			Id(methodName).
+			Params(
+				g.Id("ctx").Qual("context", "Context"),
+				g.Id("id").String(),
+			).
+			Error().
+			Block(
+				g.Return(errNotSupported.Clone()),
+			)
+	}
+}
@@ -1,23 +1,26 @@
 package plugin
 
 import (
-	"errors"
-	"fmt"
 	"sort"
+	"strings"
 
 	temporalv1 "github.com/cludden/protoc-gen-go-temporal/gen/temporal/v1"
 	g "github.com/dave/jennifer/jen"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // imported packages
 const (
 	activityPkg   = "go.temporal.io/sdk/activity"
 	clientPkg     = "go.temporal.io/sdk/client"
+	converterPkg  = "go.temporal.io/sdk/converter"
 	enumsPkg      = "go.temporal.io/api/enums/v1"
 	expressionPkg = "github.com/cludden/protoc-gen-go-temporal/pkg/expression"
+	heartbeatPkg  = "github.com/cludden/protoc-gen-go-temporal/pkg/heartbeat"
 	temporalPkg   = "go.temporal.io/sdk/temporal"
+	tracingPkg    = "github.com/cludden/protoc-gen-go-temporal/pkg/tracing"
 	updatePkg     = "go.temporal.io/api/update/v1"
 	uuidPkg       = "github.com/google/uuid"
 	workflowPkg   = "go.temporal.io/sdk/workflow"
@@ -34,7 +37,7 @@ const (
 
 // Service describes a temporal protobuf service definition
 type Service struct {
-	*protogen.Plugin
+	*Plugin
 	*protogen.Service
 	*protogen.File
 	opts              *temporalv1.ServiceOptions
@@ -52,7 +55,7 @@ type Service struct {
 }
 
 // parseService extracts a Service from a protogen.Service value
-func parseService(p *protogen.Plugin, file *protogen.File, service *protogen.Service) (*Service, error) {
+func parseService(p *Plugin, file *protogen.File, service *protogen.Service) *Service {
 	svc := Service{
 		Plugin:     p,
 		Service:    service,
@@ -73,44 +76,32 @@ func parseService(p *protogen.Plugin, file *protogen.File, service *protogen.Ser
 		name := toCamel(method.GoName)
 		svc.methods[name] = method
 
-		var mode int
 		if opts, ok := proto.GetExtension(method.Desc.Options(), temporalv1.E_Workflow).(*temporalv1.WorkflowOptions); ok && opts != nil {
 			svc.workflows[name] = opts
 			svc.workflowsOrdered = append(svc.workflowsOrdered, name)
-			mode |= modeWorkflow
 		}
 
 		if opts, ok := proto.GetExtension(method.Desc.Options(), temporalv1.E_Activity).(*temporalv1.ActivityOptions); ok && opts != nil {
 			svc.activities[name] = opts
 			svc.activitiesOrdered = append(svc.activitiesOrdered, name)
-			mode |= modeActivity
 		}
 
 		if opts, ok := proto.GetExtension(method.Desc.Options(), temporalv1.E_Query).(*temporalv1.QueryOptions); ok && opts != nil {
 			svc.queries[name] = opts
 			svc.queriesOrdered = append(svc.queriesOrdered, name)
-			mode |= modeQuery
 		}
 
 		if opts, ok := proto.GetExtension(method.Desc.Options(), temporalv1.E_Signal).(*temporalv1.SignalOptions); ok && opts != nil {
 			svc.signals[name] = opts
 			svc.signalsOrdered = append(svc.signalsOrdered, name)
-			mode |= modeSignal
 		}
 
 		if svc.opts.GetFeatures().GetWorkflowUpdate().GetEnabled() {
 			if opts, ok := proto.GetExtension(method.Desc.Options(), temporalv1.E_Update).(*temporalv1.UpdateOptions); ok && opts != nil {
 				svc.updates[name] = opts
 				svc.updatesOrdered = append(svc.updatesOrdered, name)
-				mode |= modeUpdate
 			}
 		}
-
-		switch mode {
-		case modeWorkflow, modeActivity, modeWorkflow | modeActivity, modeQuery, modeSignal, modeUpdate:
-		default:
-			p.Error(fmt.Errorf("invalid method options for method %q", method.Desc.FullName()))
-		}
 	}
 
 	sort.Strings(svc.activitiesOrdered)
@@ -118,48 +109,68 @@ func parseService(p *protogen.Plugin, file *protogen.File, service *protogen.Ser
 	sort.Strings(svc.signalsOrdered)
 	sort.Strings(svc.updatesOrdered)
 	sort.Strings(svc.workflowsOrdered)
+	return &svc
+}
 
-	var errs error
-	for _, workflow := range svc.workflowsOrdered {
-		opts := svc.workflows[workflow]
-
-		// ensure workflow queries are defined
-		for _, queryOpts := range opts.GetQuery() {
-			query := queryOpts.GetRef()
-			if _, ok := svc.queries[query]; !ok {
-				errs = errors.Join(errs, fmt.Errorf("workflow  %q references undefined query: %q", workflow, query))
-			}
+// lookupRef resolves a workflow query, signal, or update ref to the service that defines
+// it and the method's Go name. A ref is either the name of a method defined by the current
+// service, or the fully-qualified name of a method defined by any service in the current
+// file or its imports (e.g. acme.common.v1.Control.Pause). A nil service is returned if the
+// ref cannot be resolved.
+func (svc *Service) lookupRef(ref string) (*Service, string) {
+	i := strings.LastIndex(ref, ".")
+	if i < 0 {
+		return svc, ref
+	}
+	owner, ok := svc.services[protoreflect.FullName(ref[:i])]
+	if !ok {
+		return nil, ""
+	}
+	for _, method := range owner.Service.Methods {
+		if string(method.Desc.Name()) == ref[i+1:] {
+			return owner, toCamel(method.GoName)
 		}
+	}
+	return nil, ""
+}
 
-		// ensure workflow signals are defined
-		for _, signalOpts := range opts.GetSignal() {
-			signal := signalOpts.GetRef()
-			if _, ok := svc.signals[signal]; !ok {
-				errs = errors.Join(errs, fmt.Errorf("workflow  %q references undefined signal: %q", workflow, signal))
+// lookupMessage resolves a message ref to the corresponding message. A ref is either the
+// name of a message defined in the current package, or the fully-qualified name of a message
+// defined in the current file or its imports. A nil message is returned if the ref cannot
+// be resolved.
+func (svc *Service) lookupMessage(ref string) *protogen.Message {
+	name := protoreflect.FullName(ref)
+	if !strings.Contains(ref, ".") {
+		name = svc.File.Desc.Package().Append(protoreflect.Name(ref))
+	}
+	var find func(messages []*protogen.Message) *protogen.Message
+	find = func(messages []*protogen.Message) *protogen.Message {
+		for _, message := range messages {
+			if message.Desc.FullName() == name {
+				return message
 			}
-		}
-
-		// ensure workflow updates are defined
-		for _, updateOpts := range opts.GetUpdate() {
-			update := updateOpts.GetRef()
-			if _, ok := svc.updates[update]; !ok {
-				errs = errors.Join(errs, fmt.Errorf("workflow  %q references undefined update: %q", workflow, update))
+			if nested := find(message.Messages); nested != nil {
+				return nested
 			}
 		}
+		return nil
 	}
-
-	// ensure that signals return no value, unless signal method is also an activity, query, and/or workflow
-	for _, signal := range svc.signalsOrdered {
-		handler := svc.methods[signal]
-		_, isActivity := svc.activities[signal]
-		_, isQuery := svc.queries[signal]
-		_, isUpdate := svc.updates[signal]
-		_, isWorkflow := svc.workflows[signal]
-		if !isActivity && !isQuery && !isUpdate && !isWorkflow && !isEmpty(handler.Output) {
-			errs = errors.Join(errs, fmt.Errorf("expected signal %q output to be google.protobuf.Empty, got: %s", signal, handler.Output.GoIdent.GoName))
+	for _, file := range svc.Plugin.Files {
+		if message := find(file.Messages); message != nil {
+			return message
 		}
 	}
-	return &svc, errs
+	return nil
+}
+
+// goIdent returns a qualified reference to the given Go identifier
+func goIdent(ident protogen.GoIdent) *g.Statement {
+	return g.Qual(string(ident.GoImportPath), ident.GoName)
+}
+
+// qual returns a qualified reference to a declaration generated in the service's Go package
+func (svc *Service) qual(name string) *g.Statement {
+	return g.Qual(string(svc.File.GoImportPath), name)
 }
 
 func (svc *Service) fqnForActivity(activity string) string {
@@ -170,28 +181,28 @@ func (svc *Service) fqnForActivity(activity string) string {
 }
 
 func (svc *Service) fqnForQuery(query string) string {
-	if fqn := svc.activities[query].GetName(); fqn != "" {
+	if fqn := svc.queries[query].GetName(); fqn != "" {
 		return fqn
 	}
 	return string(svc.methods[query].Desc.FullName())
 }
 
 func (svc *Service) fqnForSignal(signal string) string {
-	if fqn := svc.activities[signal].GetName(); fqn != "" {
+	if fqn := svc.signals[signal].GetName(); fqn != "" {
 		return fqn
 	}
 	return string(svc.methods[signal].Desc.FullName())
 }
 
 func (svc *Service) fqnForUpdate(update string) string {
-	if fqn := svc.activities[update].GetName(); fqn != "" {
+	if fqn := svc.updates[update].GetName(); fqn != "" {
 		return fqn
 	}
 	return string(svc.methods[update].Desc.FullName())
 }
 
 func (svc *Service) fqnForWorkflow(workflow string) string {
-	if fqn := svc.activities[workflow].GetName(); fqn != "" {
+	if fqn := svc.workflows[workflow].GetName(); fqn != "" {
 		return fqn
 	}
 	return string(svc.methods[workflow].Desc.FullName())
@@ -256,6 +267,23 @@ func (svc *Service) genConstants(f *g.File) {
 		})
 	}
 
+	// add workflow memo mappings
+	workflowMemos := [][]string{}
+	for _, workflow := range svc.workflowsOrdered {
+		opts := svc.workflows[workflow]
+		if mapping := opts.GetMemo(); mapping != "" {
+			workflowMemos = append(workflowMemos, []string{workflow, mapping})
+		}
+	}
+	if len(workflowMemos) > 0 {
+		f.Commentf("%s workflow memo mappings", svc.Service.Desc.FullName())
+		f.Var().DefsFunc(func(defs *g.Group) {
+			for _, pair := range workflowMemos {
+				defs.Id(toCamel("%sMemoMapping", pair[0])).Op("=").Qual(expressionPkg, "MustParseMapping").Call(g.Lit(pair[1]))
+			}
+		})
+	}
+
 	// add activity names
 	if len(svc.activities) > 0 {
 		f.Commentf("%s activity names", svc.Service.Desc.FullName())
@@ -272,6 +300,38 @@ func (svc *Service) genConstants(f *g.File) {
 		})
 	}
 
+	// add activity id expressions
+	activityIdExpressions := [][]string{}
+	for _, activity := range svc.activitiesOrdered {
+		if expr := svc.activities[activity].GetId(); expr != "" {
+			activityIdExpressions = append(activityIdExpressions, []string{activity, expr})
+		}
+	}
+	if len(activityIdExpressions) > 0 {
+		f.Commentf("%s activity id expressions", svc.Service.Desc.FullName())
+		f.Var().DefsFunc(func(defs *g.Group) {
+			for _, pair := range activityIdExpressions {
+				defs.Id(toCamel("%sActivityIDExpression", pair[0])).Op("=").Qual(expressionPkg, "MustParseExpression").Call(g.Lit(pair[1]))
+			}
+		})
+	}
+
+	// add activity compensation input mappings
+	activityCompensations := [][]string{}
+	for _, activity := range svc.activitiesOrdered {
+		if mapping := svc.activities[activity].GetCompensate().GetInput(); mapping != "" {
+			activityCompensations = append(activityCompensations, []string{activity, mapping})
+		}
+	}
+	if len(activityCompensations) > 0 {
+		f.Commentf("%s activity compensation input mappings", svc.Service.Desc.FullName())
+		f.Var().DefsFunc(func(defs *g.Group) {
+			for _, pair := range activityCompensations {
+				defs.Id(toCamel("%sCompensationMapping", pair[0])).Op("=").Qual(expressionPkg, "MustParseMapping").Call(g.Lit(pair[1]))
+			}
+		})
+	}
+
 	// add query names
 	if len(svc.queries) > 0 {
 		f.Commentf("%s query names", svc.Service.Desc.FullName())
@@ -336,16 +396,28 @@ func (svc *Service) genConstants(f *g.File) {
 			}
 		})
 	}
+
+	// add span attribute mappings
+	if spanAttributes := svc.spanAttributesMappings(); svc.tracingEnabled() && len(spanAttributes) > 0 {
+		f.Commentf("%s span attribute mappings", svc.Service.Desc.FullName())
+		f.Var().DefsFunc(func(defs *g.Group) {
+			for _, pair := range spanAttributes {
+				defs.Id(pair[0]).Op("=").Qual(expressionPkg, "MustParseMapping").Call(g.Lit(pair[1]))
+			}
+		})
+	}
 }
 
-// render writes the temporal service to the given File
-func (svc *Service) render(f *g.File) {
+// renderClient writes the temporal service constants and client to the given File
+func (svc *Service) renderClient(f *g.File) {
 	svc.genConstants(f)
+	svc.genErrors(f)
 
 	// generate client interface and implementation
 	svc.genClientInterface(f)
 	svc.genClientImpl(f)
 	svc.genClientImplConstructor(f)
+	svc.genClientInterceptor(f)
 
 	// generate client workflow methods
 	for _, workflow := range svc.workflowsOrdered {
@@ -353,6 +425,7 @@ func (svc *Service) render(f *g.File) {
 		svc.genClientImplWorkflowMethod(f, workflow)
 		svc.genClientImplWorkflowAsyncMethod(f, workflow)
 		svc.genClientImplWorkflowGetMethod(f, workflow)
+		svc.genClientImplScheduleMethods(f, workflow)
 		for _, signal := range opts.GetSignal() {
 			if signal.GetStart() {
 				svc.genClientImplSignalWithStartMethod(f, workflow, signal.GetRef())
@@ -377,14 +450,28 @@ func (svc *Service) render(f *g.File) {
 		svc.genClientImplUpdateMethodAsync(f, update)
 	}
 
+	// generate client asynchronous activity completion methods
+	for _, activity := range svc.activitiesOrdered {
+		if svc.activities[activity].GetAsyncCompletion() {
+			svc.genClientImplActivityCompletionMethods(f, activity)
+		}
+	}
+
 	// generate <Workflow>Options, <Workflow>Run interfaces and implementations used by client
 	for _, workflow := range svc.workflowsOrdered {
 		opts := svc.workflows[workflow]
 		svc.genClientWorkflowOptions(f, workflow)
+		svc.genClientScheduleOptions(f, workflow)
+		if opts.GetMemo() != "" {
+			svc.genClientWorkflowMemo(f, workflow)
+		}
 		svc.genClientWorkflowRunInterface(f, workflow)
 		svc.genClientWorkflowRunImpl(f, workflow)
 		svc.genClientWorkflowRunImplIDMethod(f, workflow)
 		svc.genClientWorkflowRunImplRunIDMethod(f, workflow)
+		if opts.GetMemo() != "" {
+			svc.genClientWorkflowRunImplMemoMethod(f, workflow)
+		}
 		svc.genClientWorkflowRunImplGetMethod(f, workflow)
 
 		// generate query methods
@@ -414,11 +501,15 @@ func (svc *Service) render(f *g.File) {
 		svc.genClientUpdateHandleImplGetMethod(f, update)
 		svc.genClientUpdateOptions(f, update)
 	}
+}
 
+// renderWorker writes the temporal service worker helpers to the given File
+func (svc *Service) renderWorker(f *g.File) {
 	// generate workflows interface and registration helper
 	svc.genWorkerWorkflowFunctionVars(f)
 	svc.genWorkerWorkflowsInterface(f)
 	svc.genWorkerRegisterWorkflows(f)
+	svc.genInterceptor(f)
 
 	// generate workflow types, methods, functions
 	for _, workflow := range svc.workflowsOrdered {
@@ -451,14 +542,22 @@ func (svc *Service) render(f *g.File) {
 	svc.genActivityRegisterAllFunction(f)
 	for _, activity := range svc.activitiesOrdered {
 		svc.genActivityRegisterOneFunction(f, activity)
+		svc.genActivityHeartbeatFunctions(f, activity)
 		svc.genActivityFuture(f, activity)
 		svc.genActivityFutureGetMethod(f, activity)
+		svc.genActivityFutureLastHeartbeatDetailsMethod(f, activity)
 		svc.genActivityFutureSelectMethod(f, activity)
 		svc.genActivityFunction(f, activity, false, false)
 		svc.genActivityFunction(f, activity, false, true)
-		svc.genActivityFunction(f, activity, true, false)
-		svc.genActivityFunction(f, activity, true, true)
-		svc.genActivityLocalOptions(f, activity)
+		// local activities don't support activity ids, so activities with an id expression
+		// can't be executed locally without ignoring it
+		if svc.activities[activity].GetId() == "" {
+			svc.genActivityFunction(f, activity, true, false)
+			svc.genActivityFunction(f, activity, true, true)
+			svc.genActivityLocalOptions(f, activity)
+		}
 		svc.genActivityOptions(f, activity)
 	}
+	svc.genSession(f)
+	svc.genSaga(f)
 }
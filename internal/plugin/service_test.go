@@ -0,0 +1,78 @@
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceFQN(t *testing.T) {
+	require := require.New(t)
+	p := newLintTestPlugin(t, `syntax = "proto3";
+
+package test.v1;
+
+import "google/protobuf/empty.proto";
+import "temporal/v1/temporal.proto";
+
+service Test {
+  option (temporal.v1.service) = {
+    task_queue: "test"
+    features: {
+      workflow_update: { enabled: true }
+    }
+  };
+
+  rpc Foo(Request) returns (Response) {
+    option (temporal.v1.workflow) = {
+      name: 'foo-workflow'
+      query: { ref: 'GetFoo' }
+      signal: { ref: 'SetFoo' }
+      update: { ref: 'UpdateFoo' }
+    };
+    option (temporal.v1.activity) = {
+      name: 'foo-activity'
+    };
+  }
+
+  rpc Bar(Request) returns (Response) {
+    option (temporal.v1.workflow) = {};
+  }
+
+  rpc GetFoo(Request) returns (Response) {
+    option (temporal.v1.query) = {
+      name: 'getFoo'
+    };
+  }
+
+  rpc SetFoo(Request) returns (google.protobuf.Empty) {
+    option (temporal.v1.signal) = {
+      name: 'setFoo'
+    };
+  }
+
+  rpc UpdateFoo(Request) returns (Response) {
+    option (temporal.v1.update) = {
+      name: 'updateFoo'
+    };
+  }
+}
+
+message Request {
+  string id = 1;
+}
+
+message Response {
+  string value = 1;
+}
+`)
+	svc := p.services["test.v1.Test"]
+	require.NotNil(svc)
+
+	require.Equal("foo-workflow", svc.fqnForWorkflow("Foo"))
+	require.Equal("foo-activity", svc.fqnForActivity("Foo"))
+	require.Equal("test.v1.Test.Bar", svc.fqnForWorkflow("Bar"))
+	require.Equal("getFoo", svc.fqnForQuery("GetFoo"))
+	require.Equal("setFoo", svc.fqnForSignal("SetFoo"))
+	require.Equal("updateFoo", svc.fqnForUpdate("UpdateFoo"))
+}
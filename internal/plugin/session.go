@@ -0,0 +1,166 @@
+package plugin
+
+import (
+	"fmt"
+
+	g "github.com/dave/jennifer/jen"
+)
+
+// sessionActivities returns the service activities that opt in to the generated
+// <Service>Session helper
+func (svc *Service) sessionActivities() (activities []string) {
+	for _, activity := range svc.activitiesOrdered {
+		if svc.activities[activity].GetSession() {
+			activities = append(activities, activity)
+		}
+	}
+	return activities
+}
+
+// genSession generates a <Service>Session struct, along with New<Service>Session and
+// Recreate<Service>Session constructors, that executes activities within a Temporal
+// worker session
+func (svc *Service) genSession(f *g.File) {
+	activities := svc.sessionActivities()
+	if len(activities) == 0 {
+		return
+	}
+	typeName := toCamel("%sSession", svc.Service.GoName)
+
+	// generate type definition
+	f.Commentf("%s executes %s activities within a Temporal worker session, which schedules them on the same worker host", typeName, svc.Service.Desc.FullName())
+	f.Type().Id(typeName).Struct(
+		g.Id("ctx").Qual(workflowPkg, "Context"),
+	)
+
+	// generate New<Service>Session constructor
+	functionName := toCamel("New%s", typeName)
+	f.Commentf("%s creates a new worker session via workflow.CreateSession", functionName)
+	f.Func().
+		Id(functionName).
+		Params(
+			g.Id("ctx").Qual(workflowPkg, "Context"),
+			g.Id("opts").Op("*").Qual(workflowPkg, "SessionOptions"),
+		).
+		Params(g.Op("*").Id(typeName), g.Error()).
+		Block(
+			g.List(g.Id("sessionCtx"), g.Err()).Op(":=").Qual(workflowPkg, "CreateSession").Call(g.Id("ctx"), g.Id("opts")),
+			g.If(g.Err().Op("!=").Nil()).Block(
+				g.Return(g.Nil(), g.Qual("fmt", "Errorf").Call(g.Lit("error creating session: %w"), g.Err())),
+			),
+			g.Return(g.Op("&").Id(typeName).Values(g.Id("ctx").Op(":").Id("sessionCtx")), g.Nil()),
+		)
+
+	// generate Recreate<Service>Session constructor
+	functionName = toCamel("Recreate%s", typeName)
+	f.Commentf("%s recreates a worker session on the worker host identified by the given recreate token via workflow.RecreateSession", functionName)
+	f.Func().
+		Id(functionName).
+		Params(
+			g.Id("ctx").Qual(workflowPkg, "Context"),
+			g.Id("recreateToken").Index().Byte(),
+			g.Id("opts").Op("*").Qual(workflowPkg, "SessionOptions"),
+		).
+		Params(g.Op("*").Id(typeName), g.Error()).
+		Block(
+			g.List(g.Id("sessionCtx"), g.Err()).Op(":=").Qual(workflowPkg, "RecreateSession").Call(g.Id("ctx"), g.Id("recreateToken"), g.Id("opts")),
+			g.If(g.Err().Op("!=").Nil()).Block(
+				g.Return(g.Nil(), g.Qual("fmt", "Errorf").Call(g.Lit("error recreating session: %w"), g.Err())),
+			),
+			g.Return(g.Op("&").Id(typeName).Values(g.Id("ctx").Op(":").Id("sessionCtx")), g.Nil()),
+		)
+
+	// generate Complete method
+	f.Comment("Complete completes the session via workflow.CompleteSession, releasing its resources on the worker host")
+	f.Func().
+		Params(g.Id("s").Op("*").Id(typeName)).
+		Id("Complete").
+		Params().
+		Block(
+			g.Qual(workflowPkg, "CompleteSession").Call(g.Id("s").Dot("ctx")),
+		)
+
+	// generate Context method
+	f.Comment("Context returns the session context, which can be used to execute other activities within the session")
+	f.Func().
+		Params(g.Id("s").Op("*").Id(typeName)).
+		Id("Context").
+		Params().
+		Qual(workflowPkg, "Context").
+		Block(
+			g.Return(g.Id("s").Dot("ctx")),
+		)
+
+	// generate Info method
+	f.Comment("Info returns information about the session")
+	f.Func().
+		Params(g.Id("s").Op("*").Id(typeName)).
+		Id("Info").
+		Params().
+		Op("*").Qual(workflowPkg, "SessionInfo").
+		Block(
+			g.Return(g.Qual(workflowPkg, "GetSessionInfo").Call(g.Id("s").Dot("ctx"))),
+		)
+
+	// generate RecreateToken method
+	f.Commentf("RecreateToken returns a token that can be passed to %s to recreate the session on the same worker host", functionName)
+	f.Func().
+		Params(g.Id("s").Op("*").Id(typeName)).
+		Id("RecreateToken").
+		Params().
+		Index().Byte().
+		Block(
+			g.Return(g.Id("s").Dot("Info").Call().Dot("GetRecreateToken").Call()),
+		)
+
+	// generate <Activity>[Async] methods
+	for _, activity := range activities {
+		svc.genSessionActivityMethod(f, activity, false)
+		svc.genSessionActivityMethod(f, activity, true)
+	}
+}
+
+// genSessionActivityMethod generates a <Service>Session's <Activity>[Async] method
+func (svc *Service) genSessionActivityMethod(f *g.File, activity string, async bool) {
+	method := svc.methods[activity]
+	hasInput := !isEmpty(method.Input)
+	hasOutput := !isEmpty(method.Output)
+	typeName := toCamel("%sSession", svc.Service.GoName)
+
+	methodName := activity
+	desc := fmt.Sprintf("%s executes a(n) %s activity within the session", methodName, svc.fqnForActivity(activity))
+	if async {
+		methodName = toCamel("%sAsync", methodName)
+		desc = fmt.Sprintf("%s executes a(n) %s activity within the session (asynchronously)", methodName, svc.fqnForActivity(activity))
+	}
+
+	f.Comment(desc)
+	f.Func().
+		Params(g.Id("s").Op("*").Id(typeName)).
+		Id(methodName).
+		ParamsFunc(func(args *g.Group) {
+			if hasInput {
+				args.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
+			}
+			args.Id("options").Op("...").Op("*").Id(toCamel("%sActivityOptions", activity))
+		}).
+		ParamsFunc(func(returnVals *g.Group) {
+			if async {
+				returnVals.Op("*").Id(fmt.Sprintf("%sFuture", method.GoName))
+			} else {
+				if hasOutput {
+					returnVals.Op("*").Add(goIdent(method.Output.GoIdent))
+				}
+				returnVals.Error()
+			}
+		}).
+		Block(
+			g.Return(g.Id(methodName).CallFunc(func(args *g.Group) {
+				args.Id("s").Dot("ctx")
+				if hasInput {
+					args.Id("req")
+				}
+				args.Id("options").Op("...")
+			})),
+		)
+}
@@ -0,0 +1,144 @@
+package plugin
+
+import (
+	g "github.com/dave/jennifer/jen"
+)
+
+// genTestActivitiesImpl generates a Test<Service>Activities struct and constructor
+func (svc *Service) genTestActivitiesImpl(f *g.File) {
+	interfaceName := toCamel("%sActivities", svc.Service.GoName)
+	typeName := toCamel("Test%sActivities", svc.Service.GoName)
+	functionName := "New" + typeName
+
+	f.Commentf("%s executes %s activities in a test activity environment", typeName, svc.Service.Desc.FullName())
+	f.Type().Id(typeName).Struct(
+		g.Id("env").Op("*").Qual(testsuitePkg, "TestActivityEnvironment"),
+	)
+
+	f.Var().Id("_").Add(svc.qual(interfaceName)).Op("=").Op("&").Id(typeName).Values()
+	f.Commentf("%s registers the given activities with the test activity environment", functionName)
+	f.Commentf("and initializes a new %s value", typeName)
+	f.Func().Id(functionName).
+		Params(
+			g.Id("env").Op("*").Qual(testsuitePkg, "TestActivityEnvironment"),
+			g.Id("activities").Add(svc.qual(interfaceName)),
+		).
+		Op("*").Id(typeName).
+		Block(
+			svc.qual(toCamel("Register%sActivities", svc.Service.GoName)).Call(g.Id("env"), g.Id("activities")),
+			g.Return(g.Op("&").Id(typeName).Values(g.Id("env"))),
+		)
+}
+
+// genTestActivitiesImplActivityMethod generates a Test<Service>Activities <Activity> method
+func (svc *Service) genTestActivitiesImplActivityMethod(f *g.File, activity string) {
+	method := svc.methods[activity]
+	hasInput := !isEmpty(method.Input)
+	hasOutput := !isEmpty(method.Output)
+
+	f.Commentf("%s executes a(n) %s activity in the test activity environment.", activity, svc.fqnForActivity(activity))
+	f.Comment("The context is ignored, use the environment's SetWorkerOptions (BackgroundActivityContext)")
+	f.Comment("and SetTestTimeout methods to configure the activity context and timeout.")
+	f.Func().
+		Params(g.Id("a").Op("*").Id(toCamel("Test%sActivities", svc.Service.GoName))).
+		Id(activity).
+		ParamsFunc(func(args *g.Group) {
+			args.Id("_").Qual("context", "Context")
+			if hasInput {
+				args.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
+			}
+		}).
+		ParamsFunc(func(returnVals *g.Group) {
+			if hasOutput {
+				returnVals.Op("*").Add(goIdent(method.Output.GoIdent))
+			}
+			returnVals.Error()
+		}).
+		BlockFunc(func(fn *g.Group) {
+			execute := g.Id("a").Dot("env").Dot("ExecuteActivity").CallFunc(func(args *g.Group) {
+				args.Add(svc.qual(toCamel("%sActivityName", activity)))
+				if hasInput {
+					args.Id("req")
+				}
+			})
+			if !hasOutput {
+				fn.List(g.Id("_"), g.Err()).Op(":=").Add(execute)
+				fn.Return(g.Err())
+				return
+			}
+			fn.List(g.Id("val"), g.Err()).Op(":=").Add(execute)
+			fn.If(g.Err().Op("!=").Nil()).Block(
+				g.Return(g.Nil(), g.Err()),
+			)
+			fn.Var().Id("resp").Add(goIdent(method.Output.GoIdent))
+			fn.If(g.Err().Op(":=").Id("val").Dot("Get").Call(g.Op("&").Id("resp")), g.Err().Op("!=").Nil()).Block(
+				g.Return(g.Nil(), g.Err()),
+			)
+			fn.Return(g.Op("&").Id("resp"), g.Nil())
+		})
+}
+
+// genTestActivitiesImplHeartbeatMethods generates Test<Service>Activities Set<Activity>HeartbeatDetails
+// and On<Activity>Heartbeat methods for activities that declare a heartbeat details message
+func (svc *Service) genTestActivitiesImplHeartbeatMethods(f *g.File, activity string) {
+	message := svc.lookupMessage(svc.activities[activity].GetHeartbeat())
+	if message == nil {
+		return
+	}
+	typeName := toCamel("Test%sActivities", svc.Service.GoName)
+
+	methodName := toCamel("Set%sHeartbeatDetails", activity)
+	f.Commentf("%s sets the typed details of a previous %s activity attempt's", methodName, svc.fqnForActivity(activity))
+	f.Commentf("last heartbeat, returned by %s in subsequent executions", toCamel("Get%sHeartbeatDetails", activity))
+	f.Func().
+		Params(g.Id("a").Op("*").Id(typeName)).
+		Id(methodName).
+		Params(g.Id("details").Op("*").Add(goIdent(message.GoIdent))).
+		Block(
+			g.Id("a").Dot("env").Dot("SetHeartbeatDetails").Call(g.Id("details")),
+		)
+
+	methodName = toCamel("On%sHeartbeat", activity)
+	f.Commentf("%s registers a listener that is invoked with the typed details of heartbeats", methodName)
+	f.Commentf("recorded by %s activity executions. The listener replaces any heartbeat", svc.fqnForActivity(activity))
+	f.Comment("listener previously registered with the test activity environment, and may not be invoked")
+	f.Comment("for every heartbeat due to heartbeat throttling.")
+	f.Func().
+		Params(g.Id("a").Op("*").Id(typeName)).
+		Id(methodName).
+		Params(g.Id("fn").Func().Params(g.Op("*").Add(goIdent(message.GoIdent)))).
+		Block(
+			g.Id("a").Dot("env").Dot("SetOnActivityHeartbeatListener").Call(
+				g.Func().
+					Params(
+						g.Id("info").Op("*").Qual(activityPkg, "Info"),
+						g.Id("encoded").Qual(converterPkg, "EncodedValues"),
+					).
+					Block(
+						g.If(g.Id("info").Dot("ActivityType").Dot("Name").Op("!=").Add(svc.qual(toCamel("%sActivityName", activity)))).Block(
+							g.Return(),
+						),
+						g.Var().Id("details").Add(goIdent(message.GoIdent)),
+						g.If(g.Id("encoded").Dot("HasValues").Call()).Block(
+							g.If(g.Err().Op(":=").Id("encoded").Dot("Get").Call(g.Op("&").Id("details")), g.Err().Op("!=").Nil()).Block(
+								g.Return(),
+							),
+						),
+						g.Id("fn").Call(g.Op("&").Id("details")),
+					),
+			),
+		)
+}
+
+// renderTestActivities generates a Test<Service>Activities type that executes the service's
+// activities in a test activity environment
+func (svc *Service) renderTestActivities(f *g.File) {
+	if len(svc.activitiesOrdered) == 0 {
+		return
+	}
+	svc.genTestActivitiesImpl(f)
+	for _, activity := range svc.activitiesOrdered {
+		svc.genTestActivitiesImplActivityMethod(f, activity)
+		svc.genTestActivitiesImplHeartbeatMethods(f, activity)
+	}
+}
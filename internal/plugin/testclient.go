@@ -16,7 +16,7 @@ func (svc *Service) genTestClientImpl(f *g.File) {
 	f.Comment("TestClient provides a testsuite-compatible Client")
 	f.Type().Id(toCamel("Test%sClient", svc.Service.GoName)).Struct(
 		g.Id("env").Op("*").Qual(testsuitePkg, "TestWorkflowEnvironment"),
-		g.Id("workflows").Id(toCamel("%sWorkflows", svc.Service.GoName)),
+		g.Id("workflows").Add(svc.qual(toCamel("%sWorkflows", svc.Service.GoName))),
 	)
 }
 
@@ -26,116 +26,205 @@ func (svc *Service) genTestClientImplNewMethod(f *g.File) {
 	typeName := toCamel("Test%sClient", svc.Service.GoName)
 	functionName := "New" + typeName
 
-	f.Var().Id("_").Id(interfaceName).Op("=").Op("&").Id(typeName).Values()
+	f.Var().Id("_").Add(svc.qual(interfaceName)).Op("=").Op("&").Id(typeName).Values()
 	f.Commentf("%s initializes a new %s value", functionName, typeName)
+	if len(svc.activitiesOrdered) > 0 {
+		f.Comment("If workflows are provided without activities, placeholder activities are registered that")
+		f.Comment("fail unless mocked using the client's On<Activity> methods")
+	}
 	f.Func().Id(functionName).
 		Params(
 			g.Id("env").Op("*").Qual(testsuitePkg, "TestWorkflowEnvironment"),
-			g.Id("workflows").Id(toCamel("%sWorkflows", svc.Service.GoName)),
-			g.Id("activities").Id(toCamel("%sActivities", svc.Service.GoName)),
+			g.Id("workflows").Add(svc.qual(toCamel("%sWorkflows", svc.Service.GoName))),
+			g.Id("activities").Add(svc.qual(toCamel("%sActivities", svc.Service.GoName))),
 		).
 		Params(
 			g.Op("*").Id(typeName),
 		).
-		Block(
-			g.Id(toCamel("Register%sWorkflows", svc.Service.GoName)).Call(g.Id("env"), g.Id("workflows")),
-			g.If(g.Id("activities").Op("!=").Nil()).Block(
-				g.Id(toCamel("Register%sActivities", svc.Service.GoName)).Call(g.Id("env"), g.Id("activities")),
-			),
-			g.Return(g.Op("&").Id(typeName).Values(g.Id("env"), g.Id("workflows"))),
-		)
+		BlockFunc(func(fn *g.Group) {
+			fn.If(g.Id("workflows").Op("!=").Nil()).Block(
+				svc.qual(toCamel("Register%sWorkflows", svc.Service.GoName)).Call(g.Id("env"), g.Id("workflows")),
+			)
+			registerActivities := fn.If(g.Id("activities").Op("!=").Nil()).Block(
+				svc.qual(toCamel("Register%sActivities", svc.Service.GoName)).Call(g.Id("env"), g.Id("activities")),
+			)
+			// register placeholder activities that can be mocked when testing workflows without
+			// activity implementations
+			if len(svc.activitiesOrdered) > 0 {
+				registerActivities.Else().If(g.Id("workflows").Op("!=").Nil()).BlockFunc(func(bl *g.Group) {
+					for _, activity := range svc.activitiesOrdered {
+						bl.Add(svc.genTestClientUnmockedActivity(activity))
+					}
+				})
+			}
+			fn.Return(g.Op("&").Id(typeName).Values(g.Id("env"), g.Id("workflows")))
+		})
 }
 
-// genTestClientImplQueryMethod genereates a TestClient <Query> method
-func (svc *Service) genTestClientImplQueryMethod(f *g.File, query string) {
-	handler := svc.methods[query]
-	hasInput := !isEmpty(handler.Input)
-	hasOutput := !isEmpty(handler.Output)
+// genTestClientUnmockedActivity returns a statement that registers a placeholder <Activity>
+// implementation that fails with a non-retryable error unless mocked
+func (svc *Service) genTestClientUnmockedActivity(activity string) *g.Statement {
+	return svc.qual(toCamel("Register%sActivity", activity)).Call(
+		g.Id("env"),
+		svc.genTestUnmockedFunc(activity, g.Qual("context", "Context"), "KindActivity", svc.qual(toCamel("%sActivityName", activity))),
+	)
+}
 
-	f.Commentf("%s executes a %s query", query, query)
-	f.Func().
-		Params(g.Id("c").Op("*").Id(toCamel("Test%sClient", svc.Service.GoName))).
-		Id(query).
+// genTestUnmockedFunc returns a function literal with the signature of the given method that
+// returns a non-retryable testutil not mocked error of the given kind
+func (svc *Service) genTestUnmockedFunc(name string, ctx *g.Statement, kind string, temporalName *g.Statement) *g.Statement {
+	method := svc.methods[name]
+	hasInput := !isEmpty(method.Input)
+	hasOutput := !isEmpty(method.Output)
+
+	return g.Func().
 		ParamsFunc(func(args *g.Group) {
-			args.Id("ctx").Qual("context", "Context")
-			args.Id("workflowID").String()
-			args.Id("runID").String()
+			args.Add(ctx)
 			if hasInput {
-				args.Id("req").Op("*").Id(handler.Input.GoIdent.GoName)
+				args.Op("*").Add(goIdent(method.Input.GoIdent))
 			}
 		}).
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasOutput {
-				returnVals.Op("*").Id(handler.Output.GoIdent.GoName)
+				returnVals.Op("*").Add(goIdent(method.Output.GoIdent))
 			}
 			returnVals.Error()
 		}).
-		BlockFunc(func(fn *g.Group) {
-			fn.List(g.Id("val"), g.Err()).Op(":=").Id("c").Dot("env").Dot("QueryWorkflow").CallFunc(func(args *g.Group) {
-				args.Id(fmt.Sprintf("%sQueryName", query))
-				if hasInput {
-					args.Id("req")
+		Block(
+			g.ReturnFunc(func(returnVals *g.Group) {
+				if hasOutput {
+					returnVals.Nil()
 				}
-			})
-			fn.If(g.Err().Op("!=").Nil()).Block(
-				g.ReturnFunc(func(returnVals *g.Group) {
-					if hasOutput {
-						returnVals.Nil()
-					}
-					returnVals.Err()
-				}),
-			).Else().If(g.Op("!").Id("val").Dot("HasValue").Call()).Block(
-				g.ReturnFunc(func(returnVals *g.Group) {
-					if hasOutput {
-						returnVals.Nil()
+				returnVals.Qual(testutilPkg, "NewNotMockedError").Call(g.Qual(testutilPkg, kind), temporalName)
+			}),
+		)
+}
+
+// genTestClientImplActivityMockMethod generates a TestClient On<Activity> method
+func (svc *Service) genTestClientImplActivityMockMethod(f *g.File, activity string) {
+	methodName := toCamel("On%s", activity)
+
+	if !isEmpty(svc.methods[activity].Input) {
+		f.Commentf("%s mocks executions of the %s activity whose input satisfies the given", methodName, svc.fqnForActivity(activity))
+		f.Comment("matcher, which may be nil to match any input")
+	} else {
+		f.Commentf("%s mocks executions of the %s activity", methodName, svc.fqnForActivity(activity))
+	}
+	svc.genTestMockMethod(f, toCamel("Test%sClient", svc.Service.GoName), methodName, toCamel("Test%sActivityCall", activity), activity, "OnActivity", svc.qual(toCamel("%sActivityName", activity)))
+}
+
+// genTestMockMethod generates a mock method on the given type that registers a mock with the
+// test environment's given On<Kind> method, matching inputs that satisfy an optional typed
+// matcher, and returns the typed mock call wrapper
+func (svc *Service) genTestMockMethod(f *g.File, typeName, methodName, callName, name, onMethod string, temporalName *g.Statement) {
+	method := svc.methods[name]
+	hasInput := !isEmpty(method.Input)
+
+	f.Func().
+		Params(g.Id("c").Op("*").Id(typeName)).
+		Id(methodName).
+		ParamsFunc(func(args *g.Group) {
+			if hasInput {
+				args.Id("matcher").Func().Params(g.Op("*").Add(goIdent(method.Input.GoIdent))).Bool()
+			}
+		}).
+		Op("*").Id(callName).
+		BlockFunc(func(fn *g.Group) {
+			if hasInput {
+				fn.Var().Id("req").Any().Op("=").Qual(mockPkg, "Anything")
+				fn.If(g.Id("matcher").Op("!=").Nil()).Block(
+					g.Id("req").Op("=").Qual(mockPkg, "MatchedBy").Call(g.Id("matcher")),
+				)
+			}
+			fn.Return(g.Op("&").Id(callName).Values(
+				g.Id("c").Dot("env").Dot(onMethod).CallFunc(func(args *g.Group) {
+					args.Add(temporalName)
+					args.Qual(mockPkg, "Anything")
+					if hasInput {
+						args.Id("req")
 					}
-					returnVals.Nil()
 				}),
-			).Else().BlockFunc(func(bl *g.Group) {
-				if !hasOutput {
-					bl.Return(g.Nil())
-				} else {
-					bl.Var().Id("result").Id(handler.Output.GoIdent.GoName)
-					bl.If(g.Err().Op(":=").Id("val").Dot("Get").Call(g.Op("&").Id("result")), g.Err().Op("!=").Nil()).Block(
-						g.Return(
-							g.Nil(),
-							g.Err(),
-						),
-					)
-					bl.Return(g.Op("&").Id("result"), g.Nil())
-				}
-			})
+			))
 		})
 }
 
-// genTestClientImplQueryDelayedMethod genereates a TestClient <Query>Delayed method
-func (svc *Service) genTestClientImplQueryDelayedMethod(f *g.File, query string) {
+// genTestClientActivityCall generates a Test<Activity>ActivityCall struct and methods
+func (svc *Service) genTestClientActivityCall(f *g.File, activity string) {
+	typeName := toCamel("Test%sActivityCall", activity)
+	f.Commentf("%s describes a mocked %s activity execution", typeName, svc.fqnForActivity(activity))
+	svc.genTestMockCall(f, typeName, activity, "activity")
+}
+
+// genTestMockCall generates a typed wrapper around a testsuite.MockCallWrapper for mocked
+// executions of the given method, where kind describes the mocked executions in doc comments
+func (svc *Service) genTestMockCall(f *g.File, typeName, name, kind string) {
+	method := svc.methods[name]
+	hasOutput := !isEmpty(method.Output)
+
+	f.Type().Id(typeName).Struct(
+		g.Id("call").Op("*").Qual(testsuitePkg, "MockCallWrapper"),
+	)
+
+	f.Commentf("Return specifies the result of matching %s executions", kind)
+	f.Func().
+		Params(g.Id("c").Op("*").Id(typeName)).
+		Id("Return").
+		ParamsFunc(func(args *g.Group) {
+			if hasOutput {
+				args.Id("resp").Op("*").Add(goIdent(method.Output.GoIdent))
+			}
+			args.Err().Error()
+		}).
+		Op("*").Id(typeName).
+		Block(
+			g.Id("c").Dot("call").Dot("Return").CallFunc(func(args *g.Group) {
+				if hasOutput {
+					args.Id("resp")
+				}
+				args.Err()
+			}),
+			g.Return(g.Id("c")),
+		)
+
+	f.Commentf("Times specifies the number of %s executions the mock should match", kind)
+	f.Func().
+		Params(g.Id("c").Op("*").Id(typeName)).
+		Id("Times").
+		Params(g.Id("n").Int()).
+		Op("*").Id(typeName).
+		Block(
+			g.Id("c").Dot("call").Dot("Times").Call(g.Id("n")),
+			g.Return(g.Id("c")),
+		)
+}
+
+// genTestClientImplQueryMethod genereates a TestClient <Query> method
+func (svc *Service) genTestClientImplQueryMethod(f *g.File, query string) {
 	handler := svc.methods[query]
 	hasInput := !isEmpty(handler.Input)
 	hasOutput := !isEmpty(handler.Output)
-	methodName := toCamel("%sDelayed", query)
 
-	f.Commentf("%s executes a %s query", methodName, svc.fqnForQuery(query))
+	f.Commentf("%s executes a %s query", query, query)
 	f.Func().
 		Params(g.Id("c").Op("*").Id(toCamel("Test%sClient", svc.Service.GoName))).
-		Id(methodName).
+		Id(query).
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual("context", "Context")
 			args.Id("workflowID").String()
 			args.Id("runID").String()
 			if hasInput {
-				args.Id("req").Op("*").Id(handler.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(goIdent(handler.Input.GoIdent))
 			}
 		}).
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasOutput {
-				returnVals.Op("*").Id(handler.Output.GoIdent.GoName)
+				returnVals.Op("*").Add(goIdent(handler.Output.GoIdent))
 			}
 			returnVals.Error()
 		}).
 		BlockFunc(func(fn *g.Group) {
 			fn.List(g.Id("val"), g.Err()).Op(":=").Id("c").Dot("env").Dot("QueryWorkflow").CallFunc(func(args *g.Group) {
-				args.Id(fmt.Sprintf("%sQueryName", query))
+				args.Add(svc.qual(fmt.Sprintf("%sQueryName", query)))
 				if hasInput {
 					args.Id("req")
 				}
@@ -158,7 +247,7 @@ func (svc *Service) genTestClientImplQueryDelayedMethod(f *g.File, query string)
 				if !hasOutput {
 					bl.Return(g.Nil())
 				} else {
-					bl.Var().Id("result").Id(handler.Output.GoIdent.GoName)
+					bl.Var().Id("result").Add(goIdent(handler.Output.GoIdent))
 					bl.If(g.Err().Op(":=").Id("val").Dot("Get").Call(g.Op("&").Id("result")), g.Err().Op("!=").Nil()).Block(
 						g.Return(
 							g.Nil(),
@@ -185,7 +274,7 @@ func (svc *Service) genTestClientImplSignalMethod(f *g.File, signal string) {
 			args.Id("workflowID").String()
 			args.Id("runID").String()
 			if hasInput {
-				args.Id("req").Op("*").Id(handler.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(goIdent(handler.Input.GoIdent))
 			}
 		}).
 		Params(
@@ -193,7 +282,7 @@ func (svc *Service) genTestClientImplSignalMethod(f *g.File, signal string) {
 		).
 		Block(
 			g.Id("c").Dot("env").Dot("SignalWorkflow").CallFunc(func(args *g.Group) {
-				args.Id(fmt.Sprintf("%sSignalName", signal))
+				args.Add(svc.qual(fmt.Sprintf("%sSignalName", signal)))
 				if hasInput {
 					args.Id("req")
 				} else {
@@ -220,27 +309,17 @@ func (svc *Service) genTestClientImplUpdateMethod(f *g.File, update string) {
 			args.Id("workflowID").String()
 			args.Id("runID").String()
 			if hasInput {
-				args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
 			}
-			args.Id("opts").Op("...").Op("*").Id(toCamel("%sOptions", update))
+			args.Id("opts").Op("...").Op("*").Add(svc.qual(toCamel("%sOptions", update)))
 		}).
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasOutput {
-				returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+				returnVals.Op("*").Add(goIdent(method.Output.GoIdent))
 			}
 			returnVals.Error()
 		}).
 		Block(
-			// initialize update request options
-			g.Id("options").Op(":=").Id(toCamel("New%sOptions", update)).Call(),
-			g.If(g.Len(g.Id("opts")).Op(">").Lit(0).Op("&&").Id("opts").Index(g.Lit(0)).Dot("opts").Op("!=").Nil()).Block(
-				g.Id("options").Op("=").Id("opts").Index(g.Lit(0)),
-			),
-
-			// override wait policy
-			g.Id("options").Dot("opts").Dot("WaitPolicy").Op("=").Op("&").Qual(updatePkg, "WaitPolicy").Values(
-				g.Id("LifecycleStage").Op(":").Qual(enumsPkg, "UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED"),
-			),
 			g.List(g.Id("handle"), g.Err()).Op(":=").Id("c").Dot(asyncName).CallFunc(func(args *g.Group) {
 				args.Id("ctx")
 				args.Id("workflowID")
@@ -248,7 +327,7 @@ func (svc *Service) genTestClientImplUpdateMethod(f *g.File, update string) {
 				if hasInput {
 					args.Id("req")
 				}
-				args.Id("options")
+				args.Id("opts").Op("...")
 			}),
 			g.If(g.Err().Op("!=").Nil()).Block(
 				g.ReturnFunc(func(returnVals *g.Group) {
@@ -277,22 +356,22 @@ func (svc *Service) genTestClientImplUpdateAsyncMethod(f *g.File, update string)
 			args.Id("workflowID").String()
 			args.Id("runID").String()
 			if hasInput {
-				args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
 			}
-			args.Id("opts").Op("...").Op("*").Id(toCamel("%sOptions", update))
+			args.Id("opts").Op("...").Op("*").Add(svc.qual(toCamel("%sOptions", update)))
 		}).
 		Params(
-			g.Id(toCamel("%sHandle", update)),
+			svc.qual(toCamel("%sHandle", update)),
 			g.Error(),
 		).
 		BlockFunc(func(fn *g.Group) {
 			// generate UpdateWorkflowWithOptionsRequest with defaults
-			svc.genClientUpdateWorkflowOptions(fn, update)
+			svc.genClientUpdateOptionsBuild(fn, update)
 
 			// update workflow
 			fn.Id("uc").Op(":=").Qual(testutilPkg, "NewUpdateCallbacks").Call()
 			fn.Id("c").Dot("env").Dot("UpdateWorkflow").CallFunc(func(args *g.Group) {
-				args.Id(toCamel("%sUpdateName", update))
+				args.Add(svc.qual(toCamel("%sUpdateName", update)))
 				args.Id("uc")
 				if hasInput {
 					args.Id("req")
@@ -328,13 +407,13 @@ func (svc *Service) genTestClientImplWorkflowMethod(f *g.File, workflow string)
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual("context", "Context")
 			if hasInput {
-				args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
 			}
-			args.Id("opts").Op("...").Op("*").Id(toCamel("%sOptions", workflow))
+			args.Id("opts").Op("...").Op("*").Add(svc.qual(toCamel("%sOptions", workflow)))
 		}).
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasOutput {
-				returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+				returnVals.Op("*").Add(goIdent(method.Output.GoIdent))
 			}
 			returnVals.Error()
 		}).
@@ -370,16 +449,16 @@ func (svc *Service) genTestClientImplWorkflowAsyncMethod(f *g.File, workflow str
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual("context", "Context")
 			if hasInput {
-				args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
 			}
-			args.Id("options").Op("...").Op("*").Id(toCamel("%sOptions", workflow))
+			args.Id("options").Op("...").Op("*").Add(svc.qual(toCamel("%sOptions", workflow)))
 		}).
 		Params(
-			g.Id(fmt.Sprintf("%sRun", workflow)),
+			svc.qual(fmt.Sprintf("%sRun", workflow)),
 			g.Error(),
 		).
 		BlockFunc(func(fn *g.Group) {
-			svc.genClientStartWorkflowOptions(fn, workflow, false)
+			svc.genClientWorkflowOptionsBuild(fn, workflow)
 			fn.Return(
 				g.Op("&").Id(fmt.Sprintf("test%sRun", workflow)).ValuesFunc(func(fields *g.Group) {
 					fields.Id("client").Op(":").Id("c")
@@ -407,7 +486,7 @@ func (svc *Service) genTestClientImplWorkflowGetMethod(f *g.File, workflow strin
 			g.Id("runID").String(),
 		).
 		Params(
-			g.Id(fmt.Sprintf("%sRun", workflow)),
+			svc.qual(fmt.Sprintf("%sRun", workflow)),
 		).
 		Block(
 			g.Return(
@@ -420,11 +499,12 @@ func (svc *Service) genTestClientImplWorkflowGetMethod(f *g.File, workflow strin
 }
 
 // genTestClientImplWorkflowWithSignalMethod generates a TestClient's <workflow>With<signal> method
-func (svc *Service) genTestClientImplWorkflowWithSignalMethod(f *g.File, workflow, signal string) {
+func (svc *Service) genTestClientImplWorkflowWithSignalMethod(f *g.File, workflow, ref string) {
 	method := svc.methods[workflow]
 	hasInput := !isEmpty(method.Input)
 	hasOutput := !isEmpty(method.Output)
-	handler := svc.methods[signal]
+	owner, signal := svc.lookupRef(ref)
+	handler := owner.methods[signal]
 	hasSignalInput := !isEmpty(handler.Input)
 
 	f.Commentf("%sWith%s sends a(n) %s signal to a(n) %s workflow, starting it if necessary", workflow, signal, signal, workflow)
@@ -434,16 +514,16 @@ func (svc *Service) genTestClientImplWorkflowWithSignalMethod(f *g.File, workflo
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual("context", "Context")
 			if hasInput {
-				args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
 			}
 			if hasSignalInput {
-				args.Id("signal").Op("*").Id(handler.Input.GoIdent.GoName)
+				args.Id("signal").Op("*").Add(goIdent(handler.Input.GoIdent))
 			}
-			args.Id("opts").Op("...").Op("*").Id(toCamel("%sOptions", workflow))
+			args.Id("opts").Op("...").Op("*").Add(svc.qual(toCamel("%sOptions", workflow)))
 		}).
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasOutput {
-				returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+				returnVals.Op("*").Add(goIdent(method.Output.GoIdent))
 			}
 			returnVals.Error()
 		}).
@@ -451,7 +531,7 @@ func (svc *Service) genTestClientImplWorkflowWithSignalMethod(f *g.File, workflo
 			g.Id("c").Dot("env").Dot("RegisterDelayedCallback").Call(
 				g.Func().Params().Block(
 					g.Id("c").Dot("env").Dot("SignalWorkflow").CallFunc(func(args *g.Group) {
-						args.Id(fmt.Sprintf("%sSignalName", signal))
+						args.Add(owner.qual(fmt.Sprintf("%sSignalName", signal)))
 						if hasSignalInput {
 							args.Id("signal")
 						} else {
@@ -474,10 +554,11 @@ func (svc *Service) genTestClientImplWorkflowWithSignalMethod(f *g.File, workflo
 }
 
 // genTestClientImplWorkflowWithSignalAsyncMethod generates a TestClient's <workflow>With<signal>Async method
-func (svc *Service) genTestClientImplWorkflowWithSignalAsyncMethod(f *g.File, workflow, signal string) {
+func (svc *Service) genTestClientImplWorkflowWithSignalAsyncMethod(f *g.File, workflow, ref string) {
 	method := svc.methods[workflow]
 	hasInput := !isEmpty(method.Input)
-	handler := svc.methods[signal]
+	owner, signal := svc.lookupRef(ref)
+	handler := owner.methods[signal]
 	hasSignalInput := !isEmpty(handler.Input)
 
 	f.Commentf("%sWith%sAsync sends a(n) %s signal to a(n) %s workflow, starting it if necessary", workflow, signal, signal, workflow)
@@ -487,25 +568,25 @@ func (svc *Service) genTestClientImplWorkflowWithSignalAsyncMethod(f *g.File, wo
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual("context", "Context")
 			if hasInput {
-				args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
 			}
 			if hasSignalInput {
-				args.Id("signal").Op("*").Id(handler.Input.GoIdent.GoName)
+				args.Id("signal").Op("*").Add(goIdent(handler.Input.GoIdent))
 			}
-			args.Id("opts").Op("...").Op("*").Id(toCamel("%sOptions", workflow))
+			args.Id("opts").Op("...").Op("*").Add(svc.qual(toCamel("%sOptions", workflow)))
 		}).
 		Params(
-			g.Id(toCamel("%sRun", workflow)),
+			svc.qual(toCamel("%sRun", workflow)),
 			g.Error(),
 		).
 		Block(
 			g.Id("c").Dot("env").Dot("RegisterDelayedCallback").Call(
 				g.Func().Params().Block(
-					g.Id("_").Op("=").Id("c").Dot(signal).CallFunc(func(args *g.Group) {
+					g.Id("_").Op("=").Add(svc.genTestClientRefClient(owner, g.Id("c"), g.Id("c").Dot("env"))).Dot(signal).CallFunc(func(args *g.Group) {
 						args.Id("ctx")
 						args.Lit("")
 						args.Lit("")
-						if hasInput {
+						if hasSignalInput {
 							args.Id("signal")
 						}
 					}),
@@ -531,7 +612,7 @@ func (svc *Service) genTestClientUpdateHandleImpl(f *g.File, update string) {
 	hasInput := !isEmpty(handler.Input)
 
 	// generate struct
-	f.Var().Id("_").Id(interfaceName).Op("=").Op("&").Id(typeName).Values()
+	f.Var().Id("_").Add(svc.qual(interfaceName)).Op("=").Op("&").Id(typeName).Values()
 	f.Commentf("%s provides an internal implementation of a(n) %s", typeName, interfaceName)
 	f.Type().
 		Id(typeName).
@@ -540,7 +621,7 @@ func (svc *Service) genTestClientUpdateHandleImpl(f *g.File, update string) {
 			fields.Id("env").Op("*").Qual(testsuitePkg, "TestWorkflowEnvironment")
 			fields.Id("opts").Op("*").Qual(clientPkg, "UpdateWorkflowWithOptionsRequest")
 			if hasInput {
-				fields.Id("req").Op("*").Id(handler.Input.GoIdent.GoName)
+				fields.Id("req").Op("*").Add(goIdent(handler.Input.GoIdent))
 			}
 			fields.Id("runID").String()
 			fields.Id("workflowID").String()
@@ -558,7 +639,7 @@ func (svc *Service) genTestClientUpdateHandleImplGetMethod(f *g.File, update str
 		Params(g.Id("ctx").Qual("context", "Context")).
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasOutput {
-				returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+				returnVals.Op("*").Add(goIdent(method.Output.GoIdent))
 			}
 			returnVals.Error()
 		}).
@@ -576,7 +657,7 @@ func (svc *Service) genTestClientUpdateHandleImplGetMethod(f *g.File, update str
 				Block(
 					g.ReturnFunc(func(returnVals *g.Group) {
 						if hasOutput {
-							returnVals.Id("resp").Op(".").Parens(g.Op("*").Id(method.Output.GoIdent.GoName))
+							returnVals.Id("resp").Op(".").Parens(g.Op("*").Add(goIdent(method.Output.GoIdent)))
 						}
 						returnVals.Nil()
 					}),
@@ -634,7 +715,7 @@ func (svc *Service) genTestClientWorkflowGetMethod(f *g.File, workflow string) {
 			g.String(),
 		).
 		Params(
-			g.Id(fmt.Sprintf("%sRun", workflow)),
+			svc.qual(fmt.Sprintf("%sRun", workflow)),
 			g.Error(),
 		).
 		Block(
@@ -647,16 +728,16 @@ func (svc *Service) genTestClientWorkflowRunImpl(f *g.File, workflow string) {
 	method := svc.methods[workflow]
 	hasInput := !isEmpty(method.Input)
 	// generate test<Workflow>Run struct
-	f.Var().Id("_").Id(fmt.Sprintf("%sRun", workflow)).Op("=").Op("&").Id(fmt.Sprintf("test%sRun", workflow)).Values()
+	f.Var().Id("_").Add(svc.qual(fmt.Sprintf("%sRun", workflow))).Op("=").Op("&").Id(fmt.Sprintf("test%sRun", workflow)).Values()
 	f.Commentf("test%sRun provides convenience methods for interacting with a(n) %s workflow in the test environment", workflow, workflow)
 	f.Type().Id(fmt.Sprintf("test%sRun", workflow)).StructFunc(func(fields *g.Group) {
 		fields.Id("client").Op("*").Id(toCamel("Test%sClient", svc.Service.GoName))
 		fields.Id("env").Op("*").Qual(testsuitePkg, "TestWorkflowEnvironment")
 		fields.Id("opts").Op("*").Qual(clientPkg, "StartWorkflowOptions")
 		if hasInput {
-			fields.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+			fields.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
 		}
-		fields.Id("workflows").Id(toCamel("%sWorkflows", svc.Service.GoName))
+		fields.Id("workflows").Add(svc.qual(toCamel("%sWorkflows", svc.Service.GoName)))
 	})
 }
 
@@ -673,14 +754,14 @@ func (svc *Service) genTestClientWorkflowRunImplGetMethod(f *g.File, workflow st
 		Params(g.Qual("context", "Context")).
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasOutput {
-				returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+				returnVals.Op("*").Add(goIdent(method.Output.GoIdent))
 			}
 			returnVals.Error()
 		}).
 		BlockFunc(func(fn *g.Group) {
 			// execute workflow
 			fn.Id("r").Dot("env").Dot("ExecuteWorkflow").CallFunc(func(args *g.Group) {
-				args.Id(toCamel("%sWorkflowName", workflow))
+				args.Add(svc.qual(toCamel("%sWorkflowName", workflow)))
 				if hasInput {
 					args.Id("r").Dot("req")
 				}
@@ -705,7 +786,7 @@ func (svc *Service) genTestClientWorkflowRunImplGetMethod(f *g.File, workflow st
 			)
 			// return workflow result
 			if hasOutput {
-				fn.Var().Id("result").Id(method.Output.GoIdent.GoName)
+				fn.Var().Id("result").Add(goIdent(method.Output.GoIdent))
 				fn.If(g.Err().Op(":=").Id("r").Dot("env").Dot("GetWorkflowResult").Call(g.Op("&").Id("result")), g.Err().Op("!=").Nil()).Block(
 					g.Return(g.Nil(), g.Err()),
 				)
@@ -733,8 +814,9 @@ func (svc *Service) genTestClientWorkflowRunImplIDMethod(f *g.File, workflow str
 }
 
 // genTestClientWorkflowRunImplQueryMethod generates a test<Workflow>Run's <Query> method
-func (svc *Service) genTestClientWorkflowRunImplQueryMethod(f *g.File, workflow, query string) {
-	handler := svc.methods[query]
+func (svc *Service) genTestClientWorkflowRunImplQueryMethod(f *g.File, workflow, ref string) {
+	owner, query := svc.lookupRef(ref)
+	handler := owner.methods[query]
 	hasInput := !isEmpty(handler.Input)
 	hasOutput := !isEmpty(handler.Output)
 
@@ -745,17 +827,17 @@ func (svc *Service) genTestClientWorkflowRunImplQueryMethod(f *g.File, workflow,
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual("context", "Context")
 			if hasInput {
-				args.Id("req").Op("*").Id(handler.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(goIdent(handler.Input.GoIdent))
 			}
 		}).
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasOutput {
-				returnVals.Op("*").Id(handler.Output.GoIdent.GoName)
+				returnVals.Op("*").Add(goIdent(handler.Output.GoIdent))
 			}
 			returnVals.Error()
 		}).Block(
 		g.Return(
-			g.Id("r").Dot("client").Dot(query).CallFunc(func(args *g.Group) {
+			svc.genTestClientRefClient(owner, g.Id("r").Dot("client"), g.Id("r").Dot("env")).Dot(query).CallFunc(func(args *g.Group) {
 				args.Id("ctx")
 				args.Id("r").Dot("ID").Call()
 				args.Id("r").Dot("RunID").Call()
@@ -780,9 +862,29 @@ func (svc *Service) genTestClientWorkflowRunImplRunIDMethod(f *g.File, workflow
 		)
 }
 
+// genTestClientWorkflowRunImplMemoMethod generates a test<Workflow>Run's Memo method
+func (svc *Service) genTestClientWorkflowRunImplMemoMethod(f *g.File, workflow string) {
+	f.Commentf("Memo returns a test %s workflow run's memo, populated from the memo of its start options", workflow)
+	f.Func().
+		Params(g.Id("r").Op("*").Id(fmt.Sprintf("test%sRun", workflow))).
+		Id("Memo").
+		Params(g.Qual("context", "Context")).
+		Params(g.Op("*").Add(svc.qual(toCamel("%sMemo", workflow))), g.Error()).
+		BlockFunc(func(fn *g.Group) {
+			fn.Id("memo").Op(":=").Op("&").Add(svc.qual(toCamel("%sMemo", workflow))).Values()
+			fn.If(g.Id("r").Dot("opts").Op("!=").Nil()).BlockFunc(func(bl *g.Group) {
+				for _, field := range svc.memoFields(workflow) {
+					bl.Id("memo").Dot(field.name).Op("=").Id("r").Dot("opts").Dot("Memo").Index(g.Lit(field.key))
+				}
+			})
+			fn.Return(g.Id("memo"), g.Nil())
+		})
+}
+
 // genTestClientWorkflowRunImplQueryMethod generates a test<Workflow>Run's <Signal> method
-func (svc *Service) genTestClientWorkflowRunImplSignalMethod(f *g.File, workflow, signal string) {
-	handler := svc.methods[signal]
+func (svc *Service) genTestClientWorkflowRunImplSignalMethod(f *g.File, workflow, ref string) {
+	owner, signal := svc.lookupRef(ref)
+	handler := owner.methods[signal]
 	hasInput := !isEmpty(handler.Input)
 
 	f.Commentf("%s executes a %s signal against a test %s workflow", signal, signal, workflow)
@@ -792,7 +894,7 @@ func (svc *Service) genTestClientWorkflowRunImplSignalMethod(f *g.File, workflow
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual("context", "Context")
 			if hasInput {
-				args.Id("req").Op("*").Id(handler.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(goIdent(handler.Input.GoIdent))
 			}
 		}).
 		Params(
@@ -800,7 +902,7 @@ func (svc *Service) genTestClientWorkflowRunImplSignalMethod(f *g.File, workflow
 		).
 		Block(
 			g.Return(
-				g.Id("r").Dot("client").Dot(signal).CallFunc(func(args *g.Group) {
+				svc.genTestClientRefClient(owner, g.Id("r").Dot("client"), g.Id("r").Dot("env")).Dot(signal).CallFunc(func(args *g.Group) {
 					args.Id("ctx")
 					args.Id("r").Dot("ID").Call()
 					args.Id("r").Dot("RunID").Call()
@@ -813,31 +915,32 @@ func (svc *Service) genTestClientWorkflowRunImplSignalMethod(f *g.File, workflow
 }
 
 // genTestClientWorkflowRunImplQueryMethod generates a test<Workflow>Run's <Update> method
-func (svc *Service) genTestClientWorkflowRunImplUpdateMethod(f *g.File, workflow, update string) {
-	handler := svc.methods[update]
+func (svc *Service) genTestClientWorkflowRunImplUpdateMethod(f *g.File, workflow, ref string) {
+	owner, update := svc.lookupRef(ref)
+	handler := owner.methods[update]
 	hasInput := !isEmpty(handler.Input)
 	hasOutput := !isEmpty(handler.Output)
 
-	f.Commentf("%s executes a(n) %s update against a test %s workflow", update, svc.fqnForUpdate(update), svc.fqnForWorkflow(workflow))
+	f.Commentf("%s executes a(n) %s update against a test %s workflow", update, owner.fqnForUpdate(update), svc.fqnForWorkflow(workflow))
 	f.Func().
 		Params(g.Id("r").Op("*").Id(fmt.Sprintf("test%sRun", workflow))).
 		Id(update).
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual("context", "Context")
 			if hasInput {
-				args.Id("req").Op("*").Id(handler.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(goIdent(handler.Input.GoIdent))
 			}
-			args.Id("opts").Op("...").Op("*").Id(toCamel("%sOptions", update))
+			args.Id("opts").Op("...").Op("*").Add(owner.qual(toCamel("%sOptions", update)))
 		}).
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasOutput {
-				returnVals.Op("*").Id(handler.Output.GoIdent.GoName)
+				returnVals.Op("*").Add(goIdent(handler.Output.GoIdent))
 			}
 			returnVals.Error()
 		}).
 		Block(
 			g.Return(
-				g.Id("r").Dot("client").Dot(update).CallFunc(func(args *g.Group) {
+				svc.genTestClientRefClient(owner, g.Id("r").Dot("client"), g.Id("r").Dot("env")).Dot(update).CallFunc(func(args *g.Group) {
 					args.Id("ctx")
 					args.Id("r").Dot("ID").Call()
 					args.Id("r").Dot("RunID").Call()
@@ -851,29 +954,30 @@ func (svc *Service) genTestClientWorkflowRunImplUpdateMethod(f *g.File, workflow
 }
 
 // genTestClientWorkflowRunImplQueryMethod generates a test<Workflow>Run's <Update>Async method
-func (svc *Service) genTestClientWorkflowRunImplUpdateAsyncMethod(f *g.File, workflow, update string) {
-	handler := svc.methods[update]
+func (svc *Service) genTestClientWorkflowRunImplUpdateAsyncMethod(f *g.File, workflow, ref string) {
+	owner, update := svc.lookupRef(ref)
+	handler := owner.methods[update]
 	hasInput := !isEmpty(handler.Input)
 	methodName := toCamel("%sAsync", update)
 
-	f.Commentf("%s executes a(n) %s update against a test %s workflow", methodName, svc.fqnForUpdate(update), svc.fqnForWorkflow(workflow))
+	f.Commentf("%s executes a(n) %s update against a test %s workflow", methodName, owner.fqnForUpdate(update), svc.fqnForWorkflow(workflow))
 	f.Func().
 		Params(g.Id("r").Op("*").Id(fmt.Sprintf("test%sRun", workflow))).
 		Id(methodName).
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual("context", "Context")
 			if hasInput {
-				args.Id("req").Op("*").Id(handler.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(goIdent(handler.Input.GoIdent))
 			}
-			args.Id("opts").Op("...").Op("*").Id(toCamel("%sOptions", update))
+			args.Id("opts").Op("...").Op("*").Add(owner.qual(toCamel("%sOptions", update)))
 		}).
 		Params(
-			g.Id(toCamel("%sHandle", update)),
+			owner.qual(toCamel("%sHandle", update)),
 			g.Error(),
 		).
 		Block(
 			g.Return(
-				g.Id("r").Dot("client").Dot(methodName).CallFunc(func(args *g.Group) {
+				svc.genTestClientRefClient(owner, g.Id("r").Dot("client"), g.Id("r").Dot("env")).Dot(methodName).CallFunc(func(args *g.Group) {
 					args.Id("ctx")
 					args.Id("r").Dot("ID").Call()
 					args.Id("r").Dot("RunID").Call()
@@ -886,6 +990,286 @@ func (svc *Service) genTestClientWorkflowRunImplUpdateAsyncMethod(f *g.File, wor
 		)
 }
 
+// genTestClientImplAfterMethod generates a TestClient After method and the Test<Service>Delayed
+// struct it returns
+func (svc *Service) genTestClientImplAfterMethod(f *g.File) {
+	clientName := toCamel("Test%sClient", svc.Service.GoName)
+	typeName := toCamel("Test%sDelayed", svc.Service.GoName)
+
+	f.Commentf("After returns a %s value that schedules queries, signals, and updates", typeName)
+	f.Comment("to be sent to the test environment's workflow after the given duration of virtual time")
+	f.Func().
+		Params(g.Id("c").Op("*").Id(clientName)).
+		Id("After").
+		Params(g.Id("d").Qual("time", "Duration")).
+		Op("*").Id(typeName).
+		Block(
+			g.Return(g.Op("&").Id(typeName).Values(g.Id("c"), g.Id("d"))),
+		)
+
+	f.Commentf("%s schedules %s queries, signals, and updates using the test", typeName, svc.Service.Desc.FullName())
+	f.Comment("environment's RegisterDelayedCallback method")
+	f.Type().Id(typeName).Struct(
+		g.Id("client").Op("*").Id(clientName),
+		g.Id("delay").Qual("time", "Duration"),
+	)
+}
+
+// genTestClientDelayedQueryMethod generates a Test<Service>Delayed <Query> method
+func (svc *Service) genTestClientDelayedQueryMethod(f *g.File, query string) {
+	handler := svc.methods[query]
+	hasInput := !isEmpty(handler.Input)
+	hasOutput := !isEmpty(handler.Output)
+
+	f.Commentf("%s schedules a(n) %s query, invoking fn with the result", query, svc.fqnForQuery(query))
+	f.Func().
+		Params(g.Id("d").Op("*").Id(toCamel("Test%sDelayed", svc.Service.GoName))).
+		Id(query).
+		ParamsFunc(func(args *g.Group) {
+			if hasInput {
+				args.Id("req").Op("*").Add(goIdent(handler.Input.GoIdent))
+			}
+			args.Id("fn").Func().ParamsFunc(func(fnArgs *g.Group) {
+				if hasOutput {
+					fnArgs.Op("*").Add(goIdent(handler.Output.GoIdent))
+				}
+				fnArgs.Error()
+			})
+		}).
+		Block(
+			g.Id("d").Dot("client").Dot("env").Dot("RegisterDelayedCallback").Call(
+				g.Func().Params().Block(
+					g.Id("fn").Call(
+						g.Id("d").Dot("client").Dot(query).CallFunc(func(args *g.Group) {
+							args.Qual("context", "Background").Call()
+							args.Lit("")
+							args.Lit("")
+							if hasInput {
+								args.Id("req")
+							}
+						}),
+					),
+				),
+				g.Id("d").Dot("delay"),
+			),
+		)
+}
+
+// genTestClientDelayedSignalMethod generates a Test<Service>Delayed <Signal> method
+func (svc *Service) genTestClientDelayedSignalMethod(f *g.File, signal string) {
+	handler := svc.methods[signal]
+	hasInput := !isEmpty(handler.Input)
+
+	f.Commentf("%s schedules a(n) %s signal", signal, svc.fqnForSignal(signal))
+	f.Func().
+		Params(g.Id("d").Op("*").Id(toCamel("Test%sDelayed", svc.Service.GoName))).
+		Id(signal).
+		ParamsFunc(func(args *g.Group) {
+			if hasInput {
+				args.Id("req").Op("*").Add(goIdent(handler.Input.GoIdent))
+			}
+		}).
+		Block(
+			g.Id("d").Dot("client").Dot("env").Dot("RegisterDelayedCallback").Call(
+				g.Func().Params().Block(
+					g.Id("d").Dot("client").Dot("env").Dot("SignalWorkflow").CallFunc(func(args *g.Group) {
+						args.Add(svc.qual(fmt.Sprintf("%sSignalName", signal)))
+						if hasInput {
+							args.Id("req")
+						} else {
+							args.Nil()
+						}
+					}),
+				),
+				g.Id("d").Dot("delay"),
+			),
+		)
+}
+
+// genTestClientDelayedUpdateMethod generates a Test<Service>Delayed <Update> method
+func (svc *Service) genTestClientDelayedUpdateMethod(f *g.File, update string) {
+	handler := svc.methods[update]
+	hasInput := !isEmpty(handler.Input)
+
+	f.Commentf("%s schedules a(n) %s update, invoking the given callbacks, which may be nil", update, svc.fqnForUpdate(update))
+	f.Func().
+		Params(g.Id("d").Op("*").Id(toCamel("Test%sDelayed", svc.Service.GoName))).
+		Id(update).
+		ParamsFunc(func(args *g.Group) {
+			if hasInput {
+				args.Id("req").Op("*").Add(goIdent(handler.Input.GoIdent))
+			}
+			args.Id("callbacks").Op("*").Id(toCamel("Test%sCallbacks", update))
+		}).
+		Block(
+			g.If(g.Id("callbacks").Op("==").Nil()).Block(
+				g.Id("callbacks").Op("=").Op("&").Id(toCamel("Test%sCallbacks", update)).Values(),
+			),
+			g.Id("d").Dot("client").Dot("env").Dot("RegisterDelayedCallback").Call(
+				g.Func().Params().Block(
+					g.Id("d").Dot("client").Dot("env").Dot("UpdateWorkflow").CallFunc(func(args *g.Group) {
+						args.Add(svc.qual(toCamel("%sUpdateName", update)))
+						args.Id("callbacks")
+						if hasInput {
+							args.Id("req")
+						}
+					}),
+				),
+				g.Id("d").Dot("delay"),
+			),
+		)
+}
+
+// genTestClientUpdateCallbacks generates a Test<Update>Callbacks struct and methods
+func (svc *Service) genTestClientUpdateCallbacks(f *g.File, update string) {
+	handler := svc.methods[update]
+	hasOutput := !isEmpty(handler.Output)
+	typeName := toCamel("Test%sCallbacks", update)
+
+	f.Commentf("%s describes optional callbacks invoked in response to a(n) %s update", typeName, svc.fqnForUpdate(update))
+	f.Comment("scheduled in a test environment")
+	f.Type().Id(typeName).Struct(
+		g.Id("OnAccept").Func().Params(),
+		g.Id("OnReject").Func().Params(g.Error()),
+		g.Id("OnComplete").Func().ParamsFunc(func(args *g.Group) {
+			if hasOutput {
+				args.Op("*").Add(goIdent(handler.Output.GoIdent))
+			}
+			args.Error()
+		}),
+	)
+
+	f.Comment("Accept invokes the OnAccept callback, if set")
+	f.Func().
+		Params(g.Id("c").Op("*").Id(typeName)).
+		Id("Accept").
+		Params().
+		Block(
+			g.If(g.Id("c").Dot("OnAccept").Op("!=").Nil()).Block(
+				g.Id("c").Dot("OnAccept").Call(),
+			),
+		)
+
+	f.Comment("Reject invokes the OnReject callback, if set")
+	f.Func().
+		Params(g.Id("c").Op("*").Id(typeName)).
+		Id("Reject").
+		Params(g.Err().Error()).
+		Block(
+			g.If(g.Id("c").Dot("OnReject").Op("!=").Nil()).Block(
+				g.Id("c").Dot("OnReject").Call(g.Err()),
+			),
+		)
+
+	f.Comment("Complete invokes the OnComplete callback, if set")
+	f.Func().
+		Params(g.Id("c").Op("*").Id(typeName)).
+		Id("Complete").
+		Params(g.Id("success").Any(), g.Err().Error()).
+		BlockFunc(func(fn *g.Group) {
+			if !hasOutput {
+				fn.If(g.Id("c").Dot("OnComplete").Op("!=").Nil()).Block(
+					g.Id("c").Dot("OnComplete").Call(g.Err()),
+				)
+				return
+			}
+			fn.If(g.Id("c").Dot("OnComplete").Op("!=").Nil()).Block(
+				g.List(g.Id("resp"), g.Id("_")).Op(":=").Id("success").Assert(g.Op("*").Add(goIdent(handler.Output.GoIdent))),
+				g.Id("c").Dot("OnComplete").Call(g.Id("resp"), g.Err()),
+			)
+		})
+}
+
+// genTestChildWorkflowsImpl generates a Test<Service>ChildWorkflows struct and constructor
+func (svc *Service) genTestChildWorkflowsImpl(f *g.File) {
+	typeName := toCamel("Test%sChildWorkflows", svc.Service.GoName)
+	functionName := "New" + typeName
+
+	f.Commentf("%s provides typed mocks for %s child workflow executions in a", typeName, svc.Service.Desc.FullName())
+	f.Comment("test environment, including child workflows started by workflows defined in other services")
+	f.Type().Id(typeName).Struct(
+		g.Id("env").Op("*").Qual(testsuitePkg, "TestWorkflowEnvironment"),
+	)
+
+	f.Commentf("%s initializes a new %s value, registering placeholder workflows", functionName, typeName)
+	f.Comment("that fail unless mocked. It must be called before any mocks are registered with the test")
+	f.Comment("environment, and must not be used when the service's workflows are registered with the test")
+	f.Comment("environment, in which case the test client's On<Workflow>Child methods can be used instead.")
+	f.Func().Id(functionName).
+		Params(g.Id("env").Op("*").Qual(testsuitePkg, "TestWorkflowEnvironment")).
+		Op("*").Id(typeName).
+		BlockFunc(func(fn *g.Group) {
+			for _, workflow := range svc.workflowsOrdered {
+				fn.Add(svc.genTestChildWorkflowsUnmockedWorkflow(workflow))
+			}
+			fn.Return(g.Op("&").Id(typeName).Values(g.Id("env")))
+		})
+}
+
+// genTestChildWorkflowsUnmockedWorkflow returns a statement that registers a placeholder <Workflow>
+// implementation that fails with a non-retryable error unless mocked
+func (svc *Service) genTestChildWorkflowsUnmockedWorkflow(workflow string) *g.Statement {
+	return g.Id("env").Dot("RegisterWorkflowWithOptions").Call(
+		svc.genTestUnmockedFunc(workflow, g.Qual(workflowPkg, "Context"), "KindWorkflow", svc.qual(toCamel("%sWorkflowName", workflow))),
+		g.Qual(workflowPkg, "RegisterOptions").Values(
+			g.Id("Name").Op(":").Add(svc.qual(toCamel("%sWorkflowName", workflow))),
+		),
+	)
+}
+
+// genTestChildWorkflowsImplMockMethod generates a Test<Service>ChildWorkflows On<Workflow>Child method
+func (svc *Service) genTestChildWorkflowsImplMockMethod(f *g.File, workflow string) {
+	methodName := toCamel("On%sChild", workflow)
+
+	if !isEmpty(svc.methods[workflow].Input) {
+		f.Commentf("%s mocks child %s workflow executions whose input satisfies the given", methodName, svc.fqnForWorkflow(workflow))
+		f.Comment("matcher, which may be nil to match any input")
+	} else {
+		f.Commentf("%s mocks child %s workflow executions", methodName, svc.fqnForWorkflow(workflow))
+	}
+	svc.genTestMockMethod(f, toCamel("Test%sChildWorkflows", svc.Service.GoName), methodName, toCamel("Test%sChildCall", workflow), workflow, "OnWorkflow", svc.qual(toCamel("%sWorkflowName", workflow)))
+}
+
+// genTestClientImplChildWorkflowMockMethod generates a TestClient On<Workflow>Child method
+func (svc *Service) genTestClientImplChildWorkflowMockMethod(f *g.File, workflow string) {
+	method := svc.methods[workflow]
+	hasInput := !isEmpty(method.Input)
+	clientName := toCamel("Test%sClient", svc.Service.GoName)
+	methodName := toCamel("On%sChild", workflow)
+
+	if hasInput {
+		f.Commentf("%s mocks child %s workflow executions whose input satisfies the given", methodName, svc.fqnForWorkflow(workflow))
+		f.Comment("matcher, which may be nil to match any input. The workflow must be registered with the test environment.")
+	} else {
+		f.Commentf("%s mocks child %s workflow executions, which must be registered with the test environment", methodName, svc.fqnForWorkflow(workflow))
+	}
+	f.Func().
+		Params(g.Id("c").Op("*").Id(clientName)).
+		Id(methodName).
+		ParamsFunc(func(args *g.Group) {
+			if hasInput {
+				args.Id("matcher").Func().Params(g.Op("*").Add(goIdent(method.Input.GoIdent))).Bool()
+			}
+		}).
+		Op("*").Id(toCamel("Test%sChildCall", workflow)).
+		Block(
+			g.Return(
+				g.Parens(g.Op("&").Id(toCamel("Test%sChildWorkflows", svc.Service.GoName)).Values(g.Id("c").Dot("env"))).Dot(methodName).CallFunc(func(args *g.Group) {
+					if hasInput {
+						args.Id("matcher")
+					}
+				}),
+			),
+		)
+}
+
+// genTestClientChildWorkflowCall generates a Test<Workflow>ChildCall struct and methods
+func (svc *Service) genTestClientChildWorkflowCall(f *g.File, workflow string) {
+	typeName := toCamel("Test%sChildCall", workflow)
+	f.Commentf("%s describes a mocked child %s workflow execution", typeName, svc.fqnForWorkflow(workflow))
+	svc.genTestMockCall(f, typeName, workflow, "child workflow")
+}
+
 func (svc *Service) renderTestClient(f *g.File) {
 	// generate test client
 	svc.genTestClientImpl(f)
@@ -894,6 +1278,7 @@ func (svc *Service) renderTestClient(f *g.File) {
 		svc.genTestClientImplWorkflowMethod(f, workflow)
 		svc.genTestClientImplWorkflowAsyncMethod(f, workflow)
 		svc.genTestClientImplWorkflowGetMethod(f, workflow)
+		svc.genTestClientImplScheduleMethods(f, workflow)
 		for _, signal := range svc.workflows[workflow].GetSignal() {
 			if !signal.GetStart() {
 				continue
@@ -925,6 +1310,42 @@ func (svc *Service) renderTestClient(f *g.File) {
 		svc.genTestClientUpdateHandleImplWorkflowIDMethod(f, update)
 	}
 
+	// generate test client activity mocks
+	for _, activity := range svc.activitiesOrdered {
+		svc.genTestClientImplActivityMockMethod(f, activity)
+		svc.genTestClientActivityCall(f, activity)
+	}
+
+	// generate delayed query, signal, and update methods
+	if len(svc.queriesOrdered) > 0 || len(svc.signalsOrdered) > 0 || len(svc.updatesOrdered) > 0 {
+		svc.genTestClientImplAfterMethod(f)
+		for _, query := range svc.queriesOrdered {
+			svc.genTestClientDelayedQueryMethod(f, query)
+		}
+		for _, signal := range svc.signalsOrdered {
+			svc.genTestClientDelayedSignalMethod(f, signal)
+		}
+		for _, update := range svc.updatesOrdered {
+			svc.genTestClientDelayedUpdateMethod(f, update)
+			svc.genTestClientUpdateCallbacks(f, update)
+		}
+	}
+
+	// generate child workflow mocks
+	svc.genTestChildWorkflowsImpl(f)
+	for _, workflow := range svc.workflowsOrdered {
+		svc.genTestClientImplChildWorkflowMockMethod(f, workflow)
+		svc.genTestChildWorkflowsImplMockMethod(f, workflow)
+		svc.genTestClientChildWorkflowCall(f, workflow)
+	}
+
+	// generate test client asynchronous activity completion methods
+	for _, activity := range svc.activitiesOrdered {
+		if svc.activities[activity].GetAsyncCompletion() {
+			svc.genTestClientImplActivityCompletionMethods(f, activity)
+		}
+	}
+
 	// generate workflow test runs
 	for _, workflow := range svc.workflowsOrdered {
 		opts := svc.workflows[workflow]
@@ -932,6 +1353,9 @@ func (svc *Service) renderTestClient(f *g.File) {
 		svc.genTestClientWorkflowRunImplGetMethod(f, workflow)
 		svc.genTestClientWorkflowRunImplIDMethod(f, workflow)
 		svc.genTestClientWorkflowRunImplRunIDMethod(f, workflow)
+		if opts.GetMemo() != "" {
+			svc.genTestClientWorkflowRunImplMemoMethod(f, workflow)
+		}
 
 		// generate query methods
 		for _, queryOpts := range opts.GetQuery() {
@@ -949,4 +1373,22 @@ func (svc *Service) renderTestClient(f *g.File) {
 			svc.genTestClientWorkflowRunImplUpdateAsyncMethod(f, workflow, updateOpts.GetRef())
 		}
 	}
+
+	// generate activity test environment wrapper
+	svc.renderTestActivities(f)
+
+	// generate workflow history replay function
+	if len(svc.workflowsOrdered) > 0 {
+		svc.genTestReplayFunction(f)
+	}
+}
+
+// genTestClientRefClient returns the test client used to execute queries, signals, and updates
+// defined by the given service, which may differ from the current service
+func (svc *Service) genTestClientRefClient(owner *Service, client, env *g.Statement) *g.Statement {
+	if owner == svc {
+		return client
+	}
+	_, importPath := svc.componentPackage(owner.File, componentTestClient)
+	return g.Qual(string(importPath), toCamel("NewTest%sClient", owner.Service.GoName)).Call(env, g.Nil(), g.Nil())
 }
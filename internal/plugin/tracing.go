@@ -0,0 +1,96 @@
+package plugin
+
+import (
+	g "github.com/dave/jennifer/jen"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// tracingEnabled returns true if the service generates OpenTelemetry spans
+func (svc *Service) tracingEnabled() bool {
+	return svc.opts.GetFeatures().GetTracing().GetEnabled()
+}
+
+// spanAttributesMappings returns the names and definitions of the service's span attribute
+// mappings, in workflow, activity, query, signal, update order
+func (svc *Service) spanAttributesMappings() (mappings [][]string) {
+	for _, workflow := range svc.workflowsOrdered {
+		if mapping := svc.workflows[workflow].GetSpanAttributes(); mapping != "" {
+			mappings = append(mappings, []string{svc.spanAttributesMapping(modeWorkflow, workflow), mapping})
+		}
+	}
+	for _, activity := range svc.activitiesOrdered {
+		if mapping := svc.activities[activity].GetSpanAttributes(); mapping != "" {
+			mappings = append(mappings, []string{svc.spanAttributesMapping(modeActivity, activity), mapping})
+		}
+	}
+	for _, query := range svc.queriesOrdered {
+		if mapping := svc.queries[query].GetSpanAttributes(); mapping != "" {
+			mappings = append(mappings, []string{svc.spanAttributesMapping(modeQuery, query), mapping})
+		}
+	}
+	for _, signal := range svc.signalsOrdered {
+		if mapping := svc.signals[signal].GetSpanAttributes(); mapping != "" {
+			mappings = append(mappings, []string{svc.spanAttributesMapping(modeSignal, signal), mapping})
+		}
+	}
+	for _, update := range svc.updatesOrdered {
+		if mapping := svc.updates[update].GetSpanAttributes(); mapping != "" {
+			mappings = append(mappings, []string{svc.spanAttributesMapping(modeUpdate, update), mapping})
+		}
+	}
+	return mappings
+}
+
+// spanAttributesMapping returns the name of the span attribute mapping variable generated
+// for a workflow, activity, query, signal, or update, or an empty string if the method
+// does not define a mapping
+func (svc *Service) spanAttributesMapping(mode int, name string) string {
+	var mapping string
+	switch mode {
+	case modeWorkflow:
+		mapping = svc.workflows[name].GetSpanAttributes()
+	case modeActivity:
+		if mapping = svc.activities[name].GetSpanAttributes(); mapping != "" {
+			return toCamel("%sActivitySpanAttributesMapping", name)
+		}
+	case modeQuery:
+		mapping = svc.queries[name].GetSpanAttributes()
+	case modeSignal:
+		mapping = svc.signals[name].GetSpanAttributes()
+	case modeUpdate:
+		mapping = svc.updates[name].GetSpanAttributes()
+	}
+	if mapping == "" {
+		return ""
+	}
+	return toCamel("%sSpanAttributesMapping", name)
+}
+
+// genSpanAttributes returns the attributes of a generated span, extended with the attributes
+// produced by the method's span attribute mapping when the method defines one and has input
+func (svc *Service) genSpanAttributes(mode int, name string, input *protogen.Message, req g.Code, attrs ...g.Code) []g.Code {
+	mapping := svc.spanAttributesMapping(mode, name)
+	if mapping == "" || isEmpty(input) {
+		return attrs
+	}
+	return []g.Code{
+		g.Qual(tracingPkg, "Attributes").Call(append([]g.Code{g.Id(mapping), req}, attrs...)...).Op("..."),
+	}
+}
+
+// genSpanStart adds a statement that starts a span with the given name, prefixed with the
+// operation, and attributes using the given tracing package function
+func (svc *Service) genSpanStart(fn *g.Group, start, operation string, name g.Code, attrs []g.Code) {
+	fn.List(g.Id("ctx"), g.Id("span")).Op(":=").Qual(tracingPkg, start).Call(
+		append([]g.Code{
+			g.Id("ctx"),
+			g.Lit(string(svc.Service.Desc.FullName())),
+			g.Lit(operation + ":").Op("+").Add(name),
+		}, attrs...)...,
+	)
+}
+
+// genSpanEnd returns a statement that ends a span started by genSpanStart
+func genSpanEnd(err g.Code) *g.Statement {
+	return g.Qual(tracingPkg, "End").Call(g.Id("span"), err)
+}
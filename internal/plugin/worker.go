@@ -15,9 +15,11 @@ func (svc *Service) genWorkerBuilderFunction(f *g.File, workflow string) {
 	hasInput := !isEmpty(method.Input)
 	hasOutput := !isEmpty(method.Output)
 	builderName := fmt.Sprintf("build%s", method.GoName)
+	interceptors := toCamel("%sInterceptor", svc.Service.GoName)
 
 	// generate Build<Workflow> function
-	f.Commentf("%s converts a %s workflow struct into a valid workflow function", builderName, method.GoName)
+	f.Commentf("%s converts a %s workflow struct into a valid workflow function that invokes", builderName, method.GoName)
+	f.Comment("the hooks of the given interceptors")
 	f.Func().
 		Id(builderName).
 		Params(
@@ -31,23 +33,25 @@ func (svc *Service) genWorkerBuilderFunction(f *g.File, workflow string) {
 					g.Id(toCamel("%sWorkflow", workflow)),
 					g.Error(),
 				),
+			g.Id("interceptors").Op("...").Id(interceptors),
 		).
 		Params(
 			g.Func().
 				ParamsFunc(func(args *g.Group) {
 					args.Qual(workflowPkg, "Context")
 					if hasInput {
-						args.Op("*").Id(method.Input.GoIdent.GoName)
+						args.Op("*").Add(goIdent(method.Input.GoIdent))
 					}
 				}).
 				ParamsFunc(func(returnVals *g.Group) {
 					if hasOutput {
-						returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+						returnVals.Op("*").Add(goIdent(method.Output.GoIdent))
 					}
 					returnVals.Error()
 				}),
 		).
 		Block(
+			g.Id("interceptor").Op(":=").Id(toLowerCamel("%sInterceptors", svc.Service.GoName)).Call(g.Id("interceptors")),
 			g.Return(
 				//g.Parens(g.Op("&").Id(workerName).Values(g.Id("wf"))).Dot(method.GoName),
 				// generate <Workflow> method for worker struct
@@ -55,28 +59,82 @@ func (svc *Service) genWorkerBuilderFunction(f *g.File, workflow string) {
 					ParamsFunc(func(args *g.Group) {
 						args.Id("ctx").Qual(workflowPkg, "Context")
 						if hasInput {
-							args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+							args.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
 						}
 					}).
 					ParamsFunc(func(returnVals *g.Group) {
 						if hasOutput {
-							returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+							returnVals.Id("resp").Op("*").Add(goIdent(method.Output.GoIdent))
 						}
-						returnVals.Error()
+						returnVals.Err().Error()
 					}).
 					BlockFunc(func(fn *g.Group) {
+						// start workflow span
+						if svc.tracingEnabled() {
+							svc.genSpanStart(fn, "StartWorkflow", "RunWorkflow", g.Id(toCamel("%sWorkflowName", workflow)), svc.genSpanAttributes(modeWorkflow, workflow, method.Input, g.Id("req"),
+								g.Qual(tracingPkg, "WorkflowNameKey").Dot("String").Call(g.Id(toCamel("%sWorkflowName", workflow))),
+							))
+							fn.Defer().Func().Params().Block(
+								g.Id("span").Dot("End").Call(g.Err()),
+							).Call()
+						}
+
+						// invoke interceptor hooks
+						fn.Add(genWorkerNotReplaying(g.Id("interceptor").Dot(toCamel("Before%s", workflow)).CallFunc(func(args *g.Group) {
+							args.Id("ctx")
+							if hasInput {
+								args.Id("req")
+							}
+						})))
+						fn.Defer().Func().Params().Block(
+							genWorkerNotReplaying(g.Id("interceptor").Dot(toCamel("After%s", workflow)).CallFunc(func(args *g.Group) {
+								args.Id("ctx")
+								if hasOutput {
+									args.Id("resp")
+								}
+								args.Err()
+							})),
+						).Call()
+
 						// build input struct
 						fn.Id("input").Op(":=").Op("&").Id(toCamel("%sInput", workflow)).BlockFunc(func(fields *g.Group) {
 							if hasInput {
 								fields.Id("Req").Op(":").Id("req").Op(",")
 							}
 							for _, s := range opts.GetSignal() {
-								signal := s.GetRef()
-								fields.Id(signal).Op(":").Op("&").Id(toCamel("%sSignal", signal)).Block(
-									g.Id("Channel").Op(":").Qual(workflowPkg, "GetSignalChannel").Call(
-										g.Id("ctx"), g.Id(toCamel("%sSignalName", signal)),
-									).Op(","),
-								).Op(",")
+								owner, signal := svc.lookupRef(s.GetRef())
+								fields.Id(signal).Op(":").Op("&").Add(owner.qual(toCamel("%sSignal", signal))).BlockFunc(func(signalFields *g.Group) {
+									signalFields.Id("Channel").Op(":").Qual(workflowPkg, "GetSignalChannel").Call(
+										g.Id("ctx"), owner.qual(toCamel("%sSignalName", signal)),
+									).Op(",")
+									if owner == svc {
+										hasSignalInput := !isEmpty(owner.methods[signal].Input)
+										signalFields.Id("onReceive").Op(":").Func().ParamsFunc(func(args *g.Group) {
+											if hasSignalInput {
+												args.Id("req").Op("*").Add(goIdent(owner.methods[signal].Input.GoIdent))
+											}
+										}).BlockFunc(func(onReceive *g.Group) {
+											if svc.tracingEnabled() {
+												onReceive.List(g.Id("_"), g.Id("span")).Op(":=").Qual(tracingPkg, "StartWorkflow").Call(
+													append([]g.Code{
+														g.Id("ctx"),
+														g.Lit(string(svc.Service.Desc.FullName())),
+														g.Lit("HandleSignal:").Op("+").Id(toCamel("%sSignalName", signal)),
+													}, svc.genSpanAttributes(modeSignal, signal, owner.methods[signal].Input, g.Id("req"),
+														g.Qual(tracingPkg, "SignalNameKey").Dot("String").Call(g.Id(toCamel("%sSignalName", signal))),
+													)...)...,
+												)
+												onReceive.Id("span").Dot("End").Call(g.Nil())
+											}
+											onReceive.Add(genWorkerNotReplaying(g.Id("interceptor").Dot(toCamel("On%s", signal)).CallFunc(func(args *g.Group) {
+												args.Id("ctx")
+												if hasSignalInput {
+													args.Id("req")
+												}
+											})))
+										}).Op(",")
+									}
+								}).Op(",")
 							}
 						})
 
@@ -95,10 +153,14 @@ func (svc *Service) genWorkerBuilderFunction(f *g.File, workflow string) {
 
 						// register query handlers
 						for _, q := range opts.GetQuery() {
-							query := q.GetRef()
+							owner, query := svc.lookupRef(q.GetRef())
+							handler := g.Id("wf").Dot(query)
+							if owner == svc {
+								handler = svc.genWorkerInterceptedQueryHandler(query)
+							}
 							fn.If(
 								g.Err().Op(":=").Qual(workflowPkg, "SetQueryHandler").Call(
-									g.Id("ctx"), g.Id(toCamel("%sQueryName", query)), g.Id("wf").Dot(query),
+									g.Id("ctx"), owner.qual(toCamel("%sQueryName", query)), handler,
 								),
 								g.Err().Op("!=").Nil(),
 							).Block(
@@ -113,8 +175,8 @@ func (svc *Service) genWorkerBuilderFunction(f *g.File, workflow string) {
 
 						// register update handlers
 						for _, u := range opts.GetUpdate() {
-							update := u.GetRef()
-							updateOpts := svc.updates[update]
+							owner, update := svc.lookupRef(u.GetRef())
+							updateOpts := owner.updates[update]
 
 							fn.BlockFunc(func(b *g.Group) {
 								// build UpdateHandlerOptions
@@ -124,9 +186,13 @@ func (svc *Service) genWorkerBuilderFunction(f *g.File, workflow string) {
 								}
 								b.Id("opts").Op(":=").Qual(workflowPkg, "UpdateHandlerOptions").Values(updateHandlerOptions...)
 
+								handler := g.Id("wf").Dot(update)
+								if owner == svc {
+									handler = svc.genWorkerInterceptedUpdateHandler(update)
+								}
 								b.If(
 									g.Err().Op(":=").Qual(workflowPkg, "SetUpdateHandlerWithOptions").Call(
-										g.Id("ctx"), g.Id(fmt.Sprintf("%sUpdateName", update)), g.Id("wf").Dot(update), g.Id("opts"),
+										g.Id("ctx"), owner.qual(fmt.Sprintf("%sUpdateName", update)), handler, g.Id("opts"),
 									),
 									g.Err().Op("!=").Nil(),
 								).Block(
@@ -149,6 +215,117 @@ func (svc *Service) genWorkerBuilderFunction(f *g.File, workflow string) {
 		)
 }
 
+// genWorkerNotReplaying wraps a workflow interceptor hook invocation so that it is skipped
+// while the workflow is replaying history, which would otherwise invoke the hook again for
+// events that were already intercepted
+func genWorkerNotReplaying(call g.Code) *g.Statement {
+	return g.If(g.Op("!").Qual(workflowPkg, "IsReplaying").Call(g.Id("ctx"))).Block(call)
+}
+
+// genWorkerInterceptedQueryHandler returns a query handler that invokes the workflow's
+// <Query> method along with the Before<Query> and After<Query> interceptor hooks
+func (svc *Service) genWorkerInterceptedQueryHandler(query string) *g.Statement {
+	handler := svc.methods[query]
+	hasInput := !isEmpty(handler.Input)
+
+	return g.Func().
+		ParamsFunc(func(args *g.Group) {
+			if hasInput {
+				args.Id("req").Op("*").Add(goIdent(handler.Input.GoIdent))
+			}
+		}).
+		Params(
+			g.Op("*").Add(goIdent(handler.Output.GoIdent)),
+			g.Error(),
+		).
+		BlockFunc(func(fn *g.Group) {
+			if svc.tracingEnabled() {
+				svc.genSpanStart(fn, "StartWorkflow", "HandleQuery", g.Id(toCamel("%sQueryName", query)), svc.genSpanAttributes(modeQuery, query, handler.Input, g.Id("req"),
+					g.Qual(tracingPkg, "QueryNameKey").Dot("String").Call(g.Id(toCamel("%sQueryName", query))),
+				))
+			}
+			fn.Id("interceptor").Dot(toCamel("Before%s", query)).CallFunc(func(args *g.Group) {
+				args.Id("ctx")
+				if hasInput {
+					args.Id("req")
+				}
+			})
+			fn.List(g.Id("resp"), g.Err()).Op(":=").Id("wf").Dot(query).CallFunc(func(args *g.Group) {
+				if hasInput {
+					args.Id("req")
+				}
+			})
+			fn.Id("interceptor").Dot(toCamel("After%s", query)).Call(g.Id("ctx"), g.Id("resp"), g.Err())
+			if svc.tracingEnabled() {
+				fn.Id("span").Dot("End").Call(g.Err())
+			}
+			fn.Return(g.Id("resp"), g.Err())
+		})
+}
+
+// genWorkerInterceptedUpdateHandler returns an update handler that invokes the workflow's
+// <Update> method along with the Before<Update> and After<Update> interceptor hooks
+func (svc *Service) genWorkerInterceptedUpdateHandler(update string) *g.Statement {
+	handler := svc.methods[update]
+	hasInput := !isEmpty(handler.Input)
+	hasOutput := !isEmpty(handler.Output)
+
+	return g.Func().
+		ParamsFunc(func(args *g.Group) {
+			args.Id("ctx").Qual(workflowPkg, "Context")
+			if hasInput {
+				args.Id("req").Op("*").Add(goIdent(handler.Input.GoIdent))
+			}
+		}).
+		ParamsFunc(func(returnVals *g.Group) {
+			if hasOutput {
+				returnVals.Op("*").Add(goIdent(handler.Output.GoIdent))
+			}
+			returnVals.Error()
+		}).
+		BlockFunc(func(fn *g.Group) {
+			if svc.tracingEnabled() {
+				fn.Id("ctx").Op("=").Qual(tracingPkg, "ContextWithSpan").Call(g.Id("ctx"), g.Id("span"))
+				svc.genSpanStart(fn, "StartWorkflow", "HandleUpdate", g.Id(toCamel("%sUpdateName", update)), svc.genSpanAttributes(modeUpdate, update, handler.Input, g.Id("req"),
+					g.Qual(tracingPkg, "UpdateNameKey").Dot("String").Call(g.Id(toCamel("%sUpdateName", update))),
+				))
+			}
+			fn.Add(genWorkerNotReplaying(g.Id("interceptor").Dot(toCamel("Before%s", update)).CallFunc(func(args *g.Group) {
+				args.Id("ctx")
+				if hasInput {
+					args.Id("req")
+				}
+			})))
+			fn.ListFunc(func(vals *g.Group) {
+				if hasOutput {
+					vals.Id("resp")
+				}
+				vals.Err()
+			}).Op(":=").Id("wf").Dot(update).CallFunc(func(args *g.Group) {
+				args.Id("ctx")
+				if hasInput {
+					args.Id("req")
+				}
+			})
+			fn.Add(genWorkerNotReplaying(g.Id("interceptor").Dot(toCamel("After%s", update)).CallFunc(func(args *g.Group) {
+				args.Id("ctx")
+				if hasOutput {
+					args.Id("resp")
+				}
+				args.Err()
+			})))
+			if svc.tracingEnabled() {
+				fn.Id("span").Dot("End").Call(g.Err())
+			}
+			fn.ReturnFunc(func(returnVals *g.Group) {
+				if hasOutput {
+					returnVals.Id("resp")
+				}
+				returnVals.Err()
+			})
+		})
+}
+
 // genWorkerRegisterWorkflow generates a Register<Workflow> public function
 func (svc *Service) genWorkerRegisterWorkflow(f *g.File, workflow string) {
 	method := svc.methods[workflow]
@@ -156,11 +333,12 @@ func (svc *Service) genWorkerRegisterWorkflow(f *g.File, workflow string) {
 	varName := toCamel("%sFunction", workflow)
 
 	// generate Register<Workflow> function
-	f.Commentf("Register%sWorkflow registers a %s workflow with the given worker", workflow, method.Desc.FullName())
+	f.Commentf("Register%sWorkflow registers a %s workflow with the given worker, invoking the hooks", workflow, method.Desc.FullName())
+	f.Comment("of the given interceptors")
 	f.Func().
 		Id(fmt.Sprintf("Register%sWorkflow", workflow)).
 		Params(
-			g.Id("r").Qual(workerPkg, "Registry"),
+			g.Id("r").Qual(workerPkg, "WorkflowRegistry"),
 			g.Id("wf").
 				Func().
 				Params(
@@ -171,9 +349,10 @@ func (svc *Service) genWorkerRegisterWorkflow(f *g.File, workflow string) {
 					g.Id(toCamel("%sWorkflow", workflow)),
 					g.Error(),
 				),
+			g.Id("interceptors").Op("...").Id(toCamel("%sInterceptor", svc.Service.GoName)),
 		).
 		Block(
-			g.Id(varName).Op("=").Id(builderName).Call(g.Id("wf")),
+			g.Id(varName).Op("=").Id(builderName).Call(g.Id("wf"), g.Id("interceptors").Op("...")),
 			g.Id("r").Dot("RegisterWorkflowWithOptions").Call(
 				g.Id(varName),
 				g.Qual(workflowPkg, "RegisterOptions").Values(
@@ -185,17 +364,25 @@ func (svc *Service) genWorkerRegisterWorkflow(f *g.File, workflow string) {
 
 // genWorkerRegisterWorkflows generates a public RegisterWorkflows method for a given service
 func (svc *Service) genWorkerRegisterWorkflows(f *g.File) {
-	f.Commentf("Register%sWorkflows registers %s workflows with the given worker", svc.Service.GoName, svc.Service.Desc.FullName())
+	if len(svc.workflowsOrdered) == 0 {
+		f.Commentf("Register%sWorkflows registers %s workflows with the given worker", svc.Service.GoName, svc.Service.Desc.FullName())
+	} else {
+		f.Commentf("Register%sWorkflows registers %s workflows with the given worker, invoking the hooks", svc.Service.GoName, svc.Service.Desc.FullName())
+		f.Comment("of the given interceptors")
+	}
 	f.Func().
 		Id(toCamel("Register%sWorkflows", svc.Service.GoName)).
-		Params(
-			g.Id("r").Qual(workerPkg, "Registry"),
-			g.Id("workflows").Id(toCamel("%sWorkflows", svc.Service.GoName)),
-		).
+		ParamsFunc(func(args *g.Group) {
+			args.Id("r").Qual(workerPkg, "WorkflowRegistry")
+			args.Id("workflows").Id(toCamel("%sWorkflows", svc.Service.GoName))
+			if len(svc.workflowsOrdered) > 0 {
+				args.Id("interceptors").Op("...").Id(toCamel("%sInterceptor", svc.Service.GoName))
+			}
+		}).
 		BlockFunc(func(fn *g.Group) {
 			for _, workflow := range svc.workflowsOrdered {
 				fn.Id(toCamel("Register%sWorkflow", workflow)).Call(
-					g.Id("r"), g.Id("workflows").Dot(workflow),
+					g.Id("r"), g.Id("workflows").Dot(workflow), g.Id("interceptors").Op("..."),
 				)
 			}
 		})
@@ -206,9 +393,16 @@ func (svc *Service) genWorkerSignal(f *g.File, signal string) {
 	typeName := toCamel("%sSignal", signal)
 
 	f.Commentf("%s describes a(n) %s signal", typeName, svc.methods[signal].Desc.FullName())
-	f.Type().Id(typeName).Struct(
-		g.Id("Channel").Qual(workflowPkg, "ReceiveChannel"),
-	)
+	f.Type().Id(typeName).StructFunc(func(fields *g.Group) {
+		fields.Id("Channel").Qual(workflowPkg, "ReceiveChannel")
+		if svc.isIntercepted(signal) {
+			fields.Id("onReceive").Func().ParamsFunc(func(args *g.Group) {
+				if input := svc.methods[signal].Input; !isEmpty(input) {
+					args.Op("*").Add(goIdent(input.GoIdent))
+				}
+			})
+		}
+	})
 }
 
 // genWorkerSignalExternal generates a <Signal>External public function
@@ -224,7 +418,7 @@ func (svc *Service) genWorkerSignalExternal(f *g.File, signal string) {
 			args.Id("workflowID").String()
 			args.Id("runID").String()
 			if hasInput {
-				args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
 			}
 		}).
 		Params(g.Error()).
@@ -257,13 +451,13 @@ func (svc *Service) genWorkerSignalReceive(f *g.File, signal string) {
 		Params(g.Id("ctx").Qual(workflowPkg, "Context")).
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasInput {
-				returnVals.Op("*").Id(method.Input.GoIdent.GoName)
+				returnVals.Op("*").Add(goIdent(method.Input.GoIdent))
 			}
 			returnVals.Bool()
 		}).
 		BlockFunc(func(b *g.Group) {
 			if hasInput {
-				b.Var().Id("resp").Id(method.Input.GoIdent.GoName)
+				b.Var().Id("resp").Add(goIdent(method.Input.GoIdent))
 			}
 			b.Id("more").Op(":=").Id("s").Dot("Channel").Dot("Receive").CallFunc(func(args *g.Group) {
 				args.Id("ctx")
@@ -273,6 +467,15 @@ func (svc *Service) genWorkerSignalReceive(f *g.File, signal string) {
 					args.Nil()
 				}
 			})
+			if svc.isIntercepted(signal) {
+				b.If(g.Id("more").Op("&&").Id("s").Dot("onReceive").Op("!=").Nil()).Block(
+					g.Id("s").Dot("onReceive").CallFunc(func(args *g.Group) {
+						if hasInput {
+							args.Op("&").Id("resp")
+						}
+					}),
+				)
+			}
 			b.ReturnFunc(func(returnVals *g.Group) {
 				if hasInput {
 					returnVals.Op("&").Id("resp")
@@ -294,14 +497,14 @@ func (svc *Service) genWorkerSignalReceiveAsync(f *g.File, signal string) {
 		Params().
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasInput {
-				returnVals.Op("*").Id(method.Input.GoIdent.GoName)
+				returnVals.Op("*").Add(goIdent(method.Input.GoIdent))
 			} else {
 				returnVals.Bool()
 			}
 		}).
 		BlockFunc(func(b *g.Group) {
 			if hasInput {
-				b.Var().Id("resp").Id(method.Input.GoIdent.GoName)
+				b.Var().Id("resp").Add(goIdent(method.Input.GoIdent))
 				b.If(
 					g.Id("ok").Op(":=").Id("s").Dot("Channel").Dot("ReceiveAsync").Call(
 						g.Op("&").Id("resp"),
@@ -310,7 +513,18 @@ func (svc *Service) genWorkerSignalReceiveAsync(f *g.File, signal string) {
 				).Block(
 					g.Return(g.Nil()),
 				)
+				if svc.isIntercepted(signal) {
+					b.If(g.Id("s").Dot("onReceive").Op("!=").Nil()).Block(
+						g.Id("s").Dot("onReceive").Call(g.Op("&").Id("resp")),
+					)
+				}
 				b.Return(g.Op("&").Id("resp"))
+			} else if svc.isIntercepted(signal) {
+				b.Id("ok").Op(":=").Id("s").Dot("Channel").Dot("ReceiveAsync").Call(g.Nil())
+				b.If(g.Id("ok").Op("&&").Id("s").Dot("onReceive").Op("!=").Nil()).Block(
+					g.Id("s").Dot("onReceive").Call(),
+				)
+				b.Return(g.Id("ok"))
 			} else {
 				b.Return(g.Id("s").Dot("Channel").Dot("ReceiveAsync").Call(g.Nil()))
 			}
@@ -330,7 +544,7 @@ func (svc *Service) genWorkerSignalSelect(f *g.File, signal string) {
 			g.Id("sel").Qual(workflowPkg, "Selector"),
 			g.Id("fn").Func().ParamsFunc(func(args *g.Group) {
 				if hasInput {
-					args.Op("*").Id(method.Input.GoIdent.GoName)
+					args.Op("*").Add(goIdent(method.Input.GoIdent))
 				}
 			}),
 		).
@@ -378,13 +592,13 @@ func (svc *Service) genWorkerWorkflowChild(f *g.File, workflow string) {
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual(workflowPkg, "Context")
 			if hasInput {
-				args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
 			}
 			args.Id("options").Op("...").Op("*").Id(toCamel("%sChildOptions", workflow))
 		}).
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasOutput {
-				returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+				returnVals.Op("*").Add(goIdent(method.Output.GoIdent))
 			}
 			returnVals.Error()
 		}).
@@ -422,7 +636,7 @@ func (svc *Service) genWorkerWorkflowChildAsync(f *g.File, workflow string) {
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual(workflowPkg, "Context")
 			if hasInput {
-				args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(goIdent(method.Input.GoIdent))
 			}
 			args.Id("options").Op("...").Op("*").Id(toCamel("%sChildOptions", workflow))
 		}).
@@ -506,13 +720,13 @@ func (svc *Service) genWorkerWorkflowChildRunGet(f *g.File, workflow string) {
 		).
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasOutput {
-				returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+				returnVals.Op("*").Add(goIdent(method.Output.GoIdent))
 			}
 			returnVals.Error()
 		}).
 		BlockFunc(func(fn *g.Group) {
 			if hasOutput {
-				fn.Var().Id("resp").Id(method.Output.GoIdent.GoName)
+				fn.Var().Id("resp").Add(goIdent(method.Output.GoIdent))
 			}
 			fn.If(
 				g.Err().Op(":=").Id("r").Dot("Future").Dot("Get").CallFunc(func(args *g.Group) {
@@ -607,19 +821,19 @@ func (svc *Service) genWorkerWorkflowChildRunSignals(f *g.File, workflow string)
 	opts := svc.workflows[workflow]
 
 	for _, signalOpts := range opts.GetSignal() {
-		signal := signalOpts.GetRef()
-		handler := svc.methods[signal]
+		owner, signal := svc.lookupRef(signalOpts.GetRef())
+		handler := owner.methods[signal]
 		hasInput := !isEmpty(handler.Input)
 		asyncName := toCamel("%sAsync", signal)
 
-		f.Commentf("%s sends a(n) %q signal request to the child workflow", signal, svc.fqnForSignal(signal))
+		f.Commentf("%s sends a(n) %q signal request to the child workflow", signal, owner.fqnForSignal(signal))
 		f.Func().
 			Params(g.Id("r").Op("*").Id(typeName)).
 			Id(signal).
 			ParamsFunc(func(params *g.Group) {
 				params.Id("ctx").Qual(workflowPkg, "Context")
 				if hasInput {
-					params.Id("input").Op("*").Id(handler.Input.GoIdent.GoName)
+					params.Id("input").Op("*").Add(goIdent(handler.Input.GoIdent))
 				}
 			}).
 			Params(g.Error()).
@@ -632,21 +846,21 @@ func (svc *Service) genWorkerWorkflowChildRunSignals(f *g.File, workflow string)
 				})).Dot("Get").Call(g.Id("ctx"), g.Nil()),
 			)
 
-		f.Commentf("%s sends a(n) %q signal request to the child workflow", asyncName, svc.fqnForSignal(signal))
+		f.Commentf("%s sends a(n) %q signal request to the child workflow", asyncName, owner.fqnForSignal(signal))
 		f.Func().
 			Params(g.Id("r").Op("*").Id(typeName)).
 			Id(asyncName).
 			ParamsFunc(func(params *g.Group) {
 				params.Id("ctx").Qual(workflowPkg, "Context")
 				if hasInput {
-					params.Id("input").Op("*").Id(handler.Input.GoIdent.GoName)
+					params.Id("input").Op("*").Add(goIdent(handler.Input.GoIdent))
 				}
 			}).
 			Params(g.Qual(workflowPkg, "Future")).
 			Block(
 				g.Return(g.Id("r").Dot("Future").Dot("SignalChildWorkflow").CallFunc(func(args *g.Group) {
 					args.Id("ctx")
-					args.Id(toCamel("%sSignalName", signal))
+					args.Add(owner.qual(toCamel("%sSignalName", signal)))
 					if hasInput {
 						args.Id("input")
 					} else {
@@ -710,12 +924,12 @@ func (svc *Service) genWorkerWorkflowFunctionVars(f *g.File) {
 				ParamsFunc(func(args *g.Group) {
 					args.Qual(workflowPkg, "Context")
 					if hasInput {
-						args.Op("*").Id(method.Input.GoIdent.GoName)
+						args.Op("*").Add(goIdent(method.Input.GoIdent))
 					}
 				}).
 				ParamsFunc(func(returnVals *g.Group) {
 					if hasOutput {
-						returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+						returnVals.Op("*").Add(goIdent(method.Output.GoIdent))
 					}
 					returnVals.Error()
 				})
@@ -733,13 +947,13 @@ func (svc *Service) genWorkerWorkflowInput(f *g.File, workflow string) {
 	f.Commentf("%s describes the input to a(n) %s workflow constructor", typeName, method.Desc.FullName())
 	f.Type().Id(typeName).StructFunc(func(fields *g.Group) {
 		if hasInput {
-			fields.Id("Req").Op("*").Id(method.Input.GoIdent.GoName)
+			fields.Id("Req").Op("*").Add(goIdent(method.Input.GoIdent))
 		}
 
 		// add workflow signals
 		for _, signalOpts := range opts.GetSignal() {
-			signal := signalOpts.GetRef()
-			fields.Id(signal).Op("*").Id(fmt.Sprintf("%sSignal", signal))
+			owner, signal := svc.lookupRef(signalOpts.GetRef())
+			fields.Id(signal).Op("*").Add(owner.qual(fmt.Sprintf("%sSignal", signal)))
 		}
 	})
 }
@@ -770,15 +984,15 @@ func (svc *Service) genWorkerWorkflowInterface(f *g.File, workflow string) {
 			).
 			ParamsFunc(func(returnVals *g.Group) {
 				if hasOutput {
-					returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+					returnVals.Op("*").Add(goIdent(method.Output.GoIdent))
 				}
 				returnVals.Error()
 			})
 
 		// add workflow query methods
 		for _, queryOpts := range opts.GetQuery() {
-			query := queryOpts.GetRef()
-			handler := svc.methods[query]
+			owner, query := svc.lookupRef(queryOpts.GetRef())
+			handler := owner.methods[query]
 			hasInput := !isEmpty(handler.Input)
 
 			if handler.Comments.Leading.String() != "" {
@@ -789,20 +1003,20 @@ func (svc *Service) genWorkerWorkflowInterface(f *g.File, workflow string) {
 			methods.Id(query).
 				ParamsFunc(func(args *g.Group) {
 					if hasInput {
-						args.Op("*").Id(handler.Input.GoIdent.GoName)
+						args.Op("*").Add(goIdent(handler.Input.GoIdent))
 					}
 				}).
 				Params(
-					g.Op("*").Id(handler.Output.GoIdent.GoName),
+					g.Op("*").Add(goIdent(handler.Output.GoIdent)),
 					g.Error(),
 				)
 		}
 
 		// add workflow update methods
 		for _, updateOpts := range opts.GetUpdate() {
-			update := updateOpts.GetRef()
-			handler := svc.methods[update]
-			handlerOpts := svc.updates[update]
+			owner, update := svc.lookupRef(updateOpts.GetRef())
+			handler := owner.methods[update]
+			handlerOpts := owner.updates[update]
 			hasInput := !isEmpty(handler.Input)
 			hasOutput := !isEmpty(handler.Output)
 
@@ -814,7 +1028,7 @@ func (svc *Service) genWorkerWorkflowInterface(f *g.File, workflow string) {
 					ParamsFunc(func(args *g.Group) {
 						args.Qual(workflowPkg, "Context")
 						if hasInput {
-							args.Op("*").Id(handler.Input.GoIdent.GoName)
+							args.Op("*").Add(goIdent(handler.Input.GoIdent))
 						}
 					}).
 					Params(g.Error())
@@ -830,12 +1044,12 @@ func (svc *Service) genWorkerWorkflowInterface(f *g.File, workflow string) {
 				ParamsFunc(func(args *g.Group) {
 					args.Qual(workflowPkg, "Context")
 					if hasInput {
-						args.Op("*").Id(handler.Input.GoIdent.GoName)
+						args.Op("*").Add(goIdent(handler.Input.GoIdent))
 					}
 				}).
 				ParamsFunc(func(returnVals *g.Group) {
 					if hasOutput {
-						returnVals.Op("*").Id(handler.Output.GoIdent.GoName)
+						returnVals.Op("*").Add(goIdent(handler.Output.GoIdent))
 					}
 					returnVals.Error()
 				})
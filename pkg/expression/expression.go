@@ -2,6 +2,7 @@ package expression
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -10,6 +11,8 @@ import (
 	"github.com/benthosdev/benthos/v4/public/bloblang"
 	_ "github.com/benthosdev/benthos/v4/public/components/pure"
 	_ "github.com/benthosdev/benthos/v4/public/components/pure/extended"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
@@ -90,7 +93,7 @@ func MustParseExpression(input string) *Expression {
 
 // MustParseMapping attempts to parse a bloblang mapping and panics on error
 func MustParseMapping(input string) *bloblang.Executor {
-	m, err := bloblang.Parse(input)
+	m, err := ParseMapping(input)
 	if err != nil {
 		panic(err)
 	}
@@ -115,6 +118,15 @@ func ParseExpression(input string) (*Expression, error) {
 	return expr, nil
 }
 
+// ParseMapping parses a bloblang mapping from the provided string
+func ParseMapping(input string) (*bloblang.Executor, error) {
+	m, err := bloblang.Parse(input)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing mapping %q: %w", input, err)
+	}
+	return m, nil
+}
+
 // ToStructured marshals a proto message into a map[string]any value
 func ToStructured(msg protoreflect.Message) (any, error) {
 	structured := make(map[string]any)
@@ -131,6 +143,19 @@ func ToStructured(msg protoreflect.Message) (any, error) {
 	return structured, err
 }
 
+// FromStructured unmarshals a json-compatible value, such as the result of a bloblang
+// mapping or ToStructured, into the given proto message
+func FromStructured(structured any, msg proto.Message) error {
+	b, err := json.Marshal(structured)
+	if err != nil {
+		return fmt.Errorf("error serializing structured value: %w", err)
+	}
+	if err := protojson.Unmarshal(b, msg); err != nil {
+		return fmt.Errorf("error unmarshalling structured value into %s: %w", msg.ProtoReflect().Descriptor().FullName(), err)
+	}
+	return nil
+}
+
 // marshalValue marshals a proto value into any
 func marshalValue(val protoreflect.Value, fd protoreflect.FieldDescriptor) (any, error) {
 	switch {
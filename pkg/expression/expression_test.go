@@ -118,3 +118,139 @@ func TestExpression(t *testing.T) {
 		}
 	}
 }
+
+func TestFieldPaths(t *testing.T) {
+	require := require.New(t)
+
+	cases := []struct {
+		input    string
+		expected [][]string
+	}{
+		{input: `id`, expected: [][]string{{"id"}}},
+		{input: `this.outerSingle.innerSingle.bar.uppercase()`, expected: [][]string{{"outerSingle", "innerSingle", "bar"}}},
+		{input: `requestVal.not_empty().catch("default.value").slug()`, expected: [][]string{{"requestVal"}}},
+		{input: `uuid_v4()`},
+		{input: `outerList.map_each(item -> item.foo).join(",")`, expected: [][]string{{"outerList"}}},
+		{input: `id.re_find_object("(?P<svc>.+):(?P<acc>.+)").svc`, expected: [][]string{{"id"}, {"id", "svc"}}},
+	}
+
+	for _, c := range cases {
+		expr, err := expression.ParseExpression(fmt.Sprintf("${! %s }", c.input))
+		require.NoError(err, c.input)
+		require.Equal(c.expected, expr.FieldPaths(), c.input)
+	}
+}
+
+func TestFieldPathsMapping(t *testing.T) {
+	require := require.New(t)
+
+	cases := []struct {
+		input    string
+		expected [][]string
+	}{
+		{input: `root.Foo = this.id`, expected: [][]string{{"id"}}},
+		{input: "let baz = intField.string()\nFoo = $baz\nBar = @meta_field # comment this.ignored\n", expected: [][]string{{"intField"}}},
+		{input: `root = if boolField == true { "a" } else { @meta_field }`, expected: [][]string{{"boolField"}}},
+		{input: "root = \"\"\"say \"hello\" to this.ignored\n\"\"\" + requestVal", expected: [][]string{{"requestVal"}}},
+		{input: "map tag {\n  root = this.uppercase()\n}\nroot.tag = id.apply(\"tag\")", expected: [][]string{{"id"}}},
+		{input: `root = match intField { 0 => "zero", _ => this.string() }`, expected: [][]string{{"intField"}}},
+		{input: `root = match { intField == 0 => "zero", _ => intField.string() }`, expected: [][]string{{"intField"}}},
+		{input: `root = outerSingle.innerSingle.(inner -> inner.bar | "none")`, expected: [][]string{{"outerSingle", "innerSingle"}, {"outerSingle", "innerSingle", "bar"}}},
+		{input: `root = meta("region").or("unknown") + "/" + random_int(seed: timestamp_unix_nano()).string()`},
+	}
+
+	for _, c := range cases {
+		m, err := expression.ParseMapping(c.input)
+		require.NoError(err, c.input)
+		require.Equal(c.expected, expression.FieldPaths(m), c.input)
+	}
+}
+
+func TestMappingKeys(t *testing.T) {
+	require := require.New(t)
+	desc := (&pb.Request{}).ProtoReflect().Descriptor()
+
+	cases := []struct {
+		input    string
+		expected []string
+	}{
+		{input: `root = { "requestVal": requestVal, "id": id }`, expected: []string{"id", "requestVal"}},
+		{input: "root.foo = requestVal.uppercase()\nbar = id", expected: []string{"bar", "foo"}},
+		{input: "root = { \"id\": id }\nroot.foo.bar = requestVal", expected: []string{"foo", "id"}},
+		{input: `root = this`, expected: []string{}},
+	}
+
+	for _, c := range cases {
+		m, err := expression.ParseMapping(c.input)
+		require.NoError(err, c.input)
+		require.Equal(c.expected, expression.MappingKeys(m, desc), c.input)
+	}
+}
+
+func TestIsDeterministic(t *testing.T) {
+	require := require.New(t)
+
+	cases := []struct {
+		input    string
+		expected bool
+	}{
+		{input: `static`, expected: true},
+		{input: `test/${! id }`, expected: true},
+		{input: `test/${! requestVal.not_empty().catch("uuid_v4()").slug() }`, expected: true},
+		{input: `test/${! id.hash("xxhash64").encode("hex") }`, expected: true},
+		{input: `test/${! uuid_v4() }`},
+		{input: `test/${! id }/${!now().ts_format("2006")}`},
+		{input: `test/${! random_int(seed: 5) }`},
+		{input: `test/${! count("ids") }`},
+		{input: `test/${! id.bloblang("root = uuid_v4()") }`},
+		{input: `test/${! "2024-01-01".ts_parse("2006-01-02").ts_unix() }`},
+	}
+
+	for _, c := range cases {
+		expr, err := expression.ParseExpression(c.input)
+		require.NoError(err, c.input)
+		require.Equal(c.expected, expr.IsDeterministic(), c.input)
+	}
+}
+
+func TestValidateFieldPath(t *testing.T) {
+	require := require.New(t)
+	desc := (&pb.Request{}).ProtoReflect().Descriptor()
+
+	require.NoError(expression.ValidateFieldPath(desc, []string{"requestVal"}))
+	require.NoError(expression.ValidateFieldPath(desc, []string{"outerSingle", "innerSingle", "bar"}))
+	require.NoError(expression.ValidateFieldPath(desc, []string{"outerList", "0", "foo"}))
+	require.ErrorContains(expression.ValidateFieldPath(desc, []string{"request_val"}), `field "request_val" not found`)
+	require.ErrorContains(expression.ValidateFieldPath(desc, []string{"outerSingle", "baz"}), `field "outerSingle.baz" not found`)
+	require.NoError(expression.ValidateFieldPath(desc, []string{"id", "foo"}))
+}
+
+func TestFromStructured(t *testing.T) {
+	require := require.New(t)
+	msg := &pb.Request{
+		RequestVal: "foo",
+		IntField:   3,
+		BytesField: []byte("bar"),
+		OuterSingle: &pb.Request_OuterNested{
+			InnerSingle: &pb.Request_OuterNested_InnerNested{Bar: "baz"},
+		},
+	}
+
+	structured, err := expression.ToStructured(msg.ProtoReflect())
+	require.NoError(err)
+	var actual pb.Request
+	require.NoError(expression.FromStructured(structured, &actual))
+	require.Equal(msg.GetRequestVal(), actual.GetRequestVal())
+	require.Equal(msg.GetIntField(), actual.GetIntField())
+	require.Equal(msg.GetBytesField(), actual.GetBytesField())
+	require.Equal("baz", actual.GetOuterSingle().GetInnerSingle().GetBar())
+
+	m := expression.MustParseMapping(`root.id = this.input.requestVal.uppercase()`)
+	result, err := m.Query(map[string]any{"input": structured})
+	require.NoError(err)
+	actual = pb.Request{}
+	require.NoError(expression.FromStructured(result, &actual))
+	require.Equal("FOO", actual.GetId())
+
+	require.ErrorContains(expression.FromStructured(map[string]any{"unknown": true}, &actual), "error unmarshalling structured value")
+}
@@ -0,0 +1,314 @@
+package expression
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// targetValue is the benthos target type of paths within a value
+const targetValue = 1
+
+// deterministic bloblang functions, which always return the same result when evaluated
+// against the same input
+var deterministicFunctions = map[string]bool{
+	"batch_index": true,
+	"batch_size":  true,
+	"content":     true,
+	"deleted":     true,
+	"error":       true,
+	"errored":     true,
+	"json":        true,
+	"meta":        true,
+	"metadata":    true,
+	"nothing":     true,
+	"range":       true,
+	"root_meta":   true,
+	"throw":       true,
+	"var":         true,
+}
+
+// deterministic bloblang methods, which always return the same result when evaluated
+// against the same input. Timestamp methods that depend on the local timezone and the
+// bloblang method, which executes dynamic mappings, are excluded.
+var deterministicMethods = map[string]bool{
+	"abs":                    true,
+	"all":                    true,
+	"any":                    true,
+	"append":                 true,
+	"apply":                  true,
+	"assign":                 true,
+	"bool":                   true,
+	"bytes":                  true,
+	"capitalize":             true,
+	"catch":                  true,
+	"ceil":                   true,
+	"collapse":               true,
+	"compress":               true,
+	"concat":                 true,
+	"contains":               true,
+	"decode":                 true,
+	"decompress":             true,
+	"decrypt_aes":            true,
+	"encode":                 true,
+	"encrypt_aes":            true,
+	"enumerated":             true,
+	"escape_html":            true,
+	"escape_url_query":       true,
+	"exists":                 true,
+	"explode":                true,
+	"filepath_join":          true,
+	"filepath_split":         true,
+	"filter":                 true,
+	"find":                   true,
+	"find_all":               true,
+	"find_all_by":            true,
+	"find_by":                true,
+	"flatten":                true,
+	"floor":                  true,
+	"fold":                   true,
+	"format":                 true,
+	"format_json":            true,
+	"format_msgpack":         true,
+	"format_xml":             true,
+	"format_yaml":            true,
+	"from":                   true,
+	"from_all":               true,
+	"get":                    true,
+	"has_prefix":             true,
+	"has_suffix":             true,
+	"hash":                   true,
+	"index":                  true,
+	"index_of":               true,
+	"int32":                  true,
+	"int64":                  true,
+	"join":                   true,
+	"json_path":              true,
+	"json_schema":            true,
+	"key_values":             true,
+	"keys":                   true,
+	"length":                 true,
+	"log":                    true,
+	"log10":                  true,
+	"lowercase":              true,
+	"map":                    true,
+	"map_each":               true,
+	"map_each_key":           true,
+	"max":                    true,
+	"merge":                  true,
+	"min":                    true,
+	"not":                    true,
+	"not_empty":              true,
+	"not_null":               true,
+	"number":                 true,
+	"or":                     true,
+	"parse_csv":              true,
+	"parse_duration":         true,
+	"parse_duration_iso8601": true,
+	"parse_form_url_encoded": true,
+	"parse_json":             true,
+	"parse_msgpack":          true,
+	"parse_parquet":          true,
+	"parse_url":              true,
+	"parse_xml":              true,
+	"parse_yaml":             true,
+	"quote":                  true,
+	"re_find_all":            true,
+	"re_find_all_object":     true,
+	"re_find_all_submatch":   true,
+	"re_find_object":         true,
+	"re_match":               true,
+	"re_replace":             true,
+	"re_replace_all":         true,
+	"replace":                true,
+	"replace_all":            true,
+	"replace_all_many":       true,
+	"replace_many":           true,
+	"reverse":                true,
+	"round":                  true,
+	"slice":                  true,
+	"slug":                   true,
+	"sort":                   true,
+	"sort_by":                true,
+	"split":                  true,
+	"squash":                 true,
+	"string":                 true,
+	"strip_html":             true,
+	"sum":                    true,
+	"trim":                   true,
+	"trim_prefix":            true,
+	"trim_suffix":            true,
+	"ts_add_iso8601":         true,
+	"ts_round":               true,
+	"ts_sub_iso8601":         true,
+	"ts_unix":                true,
+	"ts_unix_micro":          true,
+	"ts_unix_milli":          true,
+	"ts_unix_nano":           true,
+	"type":                   true,
+	"uint32":                 true,
+	"uint64":                 true,
+	"unescape_html":          true,
+	"unescape_url_query":     true,
+	"unique":                 true,
+	"unquote":                true,
+	"uppercase":              true,
+	"values":                 true,
+	"with":                   true,
+	"without":                true,
+}
+
+var (
+	deterministicEnvOnce sync.Once
+	deterministicEnv     *bloblang.Environment
+)
+
+// deterministicEnvironment returns a bloblang environment restricted to the allowed
+// deterministic functions and methods, with imports disabled
+func deterministicEnvironment() *bloblang.Environment {
+	deterministicEnvOnce.Do(func() {
+		env := bloblang.GlobalEnvironment()
+		var functions, methods []string
+		env.WalkFunctions(func(name string, _ *bloblang.FunctionView) {
+			if !deterministicFunctions[name] {
+				functions = append(functions, name)
+			}
+		})
+		env.WalkMethods(func(name string, _ *bloblang.MethodView) {
+			if !deterministicMethods[name] {
+				methods = append(methods, name)
+			}
+		})
+		deterministicEnv = env.WithoutFunctions(functions...).WithoutMethods(methods...).WithDisabledImports()
+	})
+	return deterministicEnv
+}
+
+// FieldPaths returns the input field paths (e.g. this.foo.bar or foo.bar) referenced by
+// a parsed bloblang mapping, as resolved by the bloblang parser. Assignment targets,
+// variables, metadata, and lambda parameters are excluded, and paths stop at method calls.
+func FieldPaths(m *bloblang.Executor) (paths [][]string) {
+	executor := unwrapExecutor(m)
+	if !executor.IsValid() {
+		return nil
+	}
+	queryTargets := executor.MethodByName("QueryTargets")
+	if !queryTargets.IsValid() {
+		return nil
+	}
+	seen := make(map[string]bool)
+	for _, path := range valuePaths(queryTargets.Call([]reflect.Value{reflect.New(queryTargets.Type().In(0)).Elem()})[1]) {
+		if key := strings.Join(path, "."); len(path) > 0 && !seen[key] {
+			seen[key] = true
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// MappingKeys returns the top-level keys of the object produced by a bloblang mapping,
+// which are determined by the mapping's root.<key> assignments, along with the keys of the
+// object returned when the mapping is evaluated against a zero-valued input message
+// (e.g. root = { "foo": foo }). Keys are returned in lexical order.
+func MappingKeys(m *bloblang.Executor, desc protoreflect.MessageDescriptor) []string {
+	seen := make(map[string]bool)
+	if executor := unwrapExecutor(m); executor.IsValid() {
+		if assignmentTargets := executor.MethodByName("AssignmentTargets"); assignmentTargets.IsValid() {
+			for _, path := range valuePaths(assignmentTargets.Call(nil)[0]) {
+				if len(path) > 0 {
+					seen[path[0]] = true
+				}
+			}
+		}
+	}
+	if structured, err := ToStructured(dynamicpb.NewMessage(desc)); err == nil {
+		if result, err := m.Query(structured); err == nil {
+			if values, ok := result.(map[string]any); ok {
+				for k := range values {
+					seen[k] = true
+				}
+			}
+		}
+	}
+	keys := make([]string, 0, len(seen))
+	for k := range seen {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// unwrapExecutor returns the internal benthos executor of a parsed mapping, whose query and
+// assignment targets are only exposed by internal benthos types and are therefore accessed
+// via reflection
+func unwrapExecutor(m *bloblang.Executor) reflect.Value {
+	unwrap := reflect.ValueOf(m.XUnwrapper()).MethodByName("Unwrap")
+	if !unwrap.IsValid() {
+		return reflect.Value{}
+	}
+	return unwrap.Call(nil)[0]
+}
+
+// valuePaths returns the paths of the value targets in a slice of benthos target paths
+func valuePaths(targets reflect.Value) (paths [][]string) {
+	for i := 0; i < targets.Len(); i++ {
+		target := targets.Index(i)
+		if target.FieldByName("Type").Int() != targetValue {
+			continue
+		}
+		path, _ := target.FieldByName("Path").Interface().([]string)
+		paths = append(paths, path)
+	}
+	return paths
+}
+
+// FieldPaths returns the input field paths referenced by an expression's queries
+func (e *Expression) FieldPaths() (paths [][]string) {
+	for _, fragment := range e.Fragments {
+		if fragment.Expr != nil && fragment.Expr.m != nil {
+			paths = append(paths, FieldPaths(fragment.Expr.m)...)
+		}
+	}
+	return paths
+}
+
+// IsDeterministic reports whether an expression's queries only call bloblang functions and
+// methods known to return the same result when evaluated against the same input. Functions
+// and methods are checked against allowlists, so those added by plugins or future bloblang
+// versions are treated as nondeterministic.
+func (e *Expression) IsDeterministic() bool {
+	env := deterministicEnvironment()
+	for _, fragment := range e.Fragments {
+		if fragment.Expr == nil {
+			continue
+		}
+		if _, err := env.Parse(fmt.Sprintf("root = %s", fragment.Expr.Mapping)); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateFieldPath ensures that a field path refers to a field of the given message
+// descriptor, using the JSON field names produced by ToStructured. Validation stops at
+// list, map, and scalar fields, as bloblang attributes fields accessed on the result of a
+// method call (e.g. id.parse_json().foo) to the method's receiver.
+func ValidateFieldPath(desc protoreflect.MessageDescriptor, path []string) error {
+	for i, segment := range path {
+		fd := desc.Fields().ByJSONName(segment)
+		if fd == nil {
+			return fmt.Errorf("field %q not found in message %s", strings.Join(path[:i+1], "."), desc.FullName())
+		}
+		if fd.IsList() || fd.IsMap() || fd.Message() == nil {
+			return nil
+		}
+		desc = fd.Message()
+	}
+	return nil
+}
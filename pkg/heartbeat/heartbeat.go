@@ -0,0 +1,83 @@
+package heartbeat
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.temporal.io/sdk/activity"
+)
+
+// stateKey identifies the automatic heartbeat state of an activity context
+type stateKey struct{}
+
+// state holds the details included in automatic heartbeats
+type state struct {
+	mu      sync.Mutex
+	details []any
+}
+
+// load returns the most recently recorded heartbeat details
+func (s *state) load() []any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.details
+}
+
+// store replaces the heartbeat details included in automatic heartbeats
+func (s *state) store(details []any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.details = details
+}
+
+// Auto records heartbeats for the activity associated with the given context at half of
+// the activity's heartbeat timeout until the returned stop function is called. Automatic
+// heartbeats include the given details until new details are recorded via Record using
+// the returned context. If the activity does not specify a heartbeat timeout, no
+// heartbeats are recorded.
+func Auto(ctx context.Context, details ...any) (context.Context, func()) {
+	timeout := activity.GetInfo(ctx).HeartbeatTimeout
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+
+	s := &state{details: details}
+	ctx = context.WithValue(ctx, stateKey{}, s)
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(timeout / 2)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				activity.RecordHeartbeat(ctx, s.load()...)
+			}
+		}
+	}()
+
+	var once sync.Once
+	return ctx, func() {
+		once.Do(func() {
+			close(done)
+			wg.Wait()
+		})
+	}
+}
+
+// Record records an activity heartbeat with the given details, which are included in
+// subsequent automatic heartbeats if the context was returned by Auto
+func Record(ctx context.Context, details ...any) {
+	if s, ok := ctx.Value(stateKey{}).(*state); ok {
+		s.store(details)
+	}
+	activity.RecordHeartbeat(ctx, details...)
+}
@@ -0,0 +1,39 @@
+package testutil
+
+import (
+	"fmt"
+
+	"go.temporal.io/sdk/temporal"
+)
+
+// Kind identifies the kind of Temporal definition registered as a placeholder by generated
+// test helpers
+type Kind string
+
+const (
+	// KindActivity identifies placeholder activities registered by generated test clients
+	KindActivity Kind = "activity"
+	// KindWorkflow identifies placeholder child workflows registered by generated test helpers
+	KindWorkflow Kind = "workflow"
+)
+
+const (
+	// ErrCodeActivityNotMocked is the application error type returned by placeholder activities
+	// that have not been mocked
+	ErrCodeActivityNotMocked = "ActivityNotMocked"
+	// ErrCodeWorkflowNotMocked is the application error type returned by placeholder child
+	// workflows that have not been mocked
+	ErrCodeWorkflowNotMocked = "WorkflowNotMocked"
+)
+
+// notMockedErrCodes maps each placeholder kind to the application error type it returns
+var notMockedErrCodes = map[Kind]string{
+	KindActivity: ErrCodeActivityNotMocked,
+	KindWorkflow: ErrCodeWorkflowNotMocked,
+}
+
+// NewNotMockedError returns the non-retryable error returned by placeholder activities and
+// workflows registered by generated test helpers for definitions that have not been mocked
+func NewNotMockedError(kind Kind, name string) error {
+	return temporal.NewNonRetryableApplicationError(fmt.Sprintf("%s %q is not mocked", kind, name), notMockedErrCodes[kind], nil)
+}
@@ -0,0 +1,8 @@
+package testutil
+
+// TestingT is the subset of *testing.T used by generated replay test helpers, which allows
+// them to be generated alongside production code without importing the testing package
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...any)
+}
@@ -7,8 +7,12 @@ import (
 )
 
 const (
+	// ErrCodeUpdateInvalidResponseType is the application error type returned when an update
+	// completes with a response of an unexpected type
 	ErrCodeUpdateInvalidResponseType = "UpdateInvalidResponseType"
-	ErrCodeUpdateValidationFailed    = "UpdateValidationFailed"
+	// ErrCodeUpdateValidationFailed is the application error type returned when an update is
+	// rejected by its validator
+	ErrCodeUpdateValidationFailed = "UpdateValidationFailed"
 )
 
 type UpdateCallbacks struct {
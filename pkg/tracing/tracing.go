@@ -0,0 +1,167 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/benthosdev/benthos/v4/public/bloblang"
+	"github.com/cludden/protoc-gen-go-temporal/pkg/expression"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/workflow"
+	"google.golang.org/protobuf/proto"
+)
+
+// span attribute keys describing Temporal names and identifiers
+const (
+	ActivityIDKey          = attribute.Key("temporal.activity.id")
+	ActivityNameKey        = attribute.Key("temporal.activity.name")
+	QueryNameKey           = attribute.Key("temporal.query.name")
+	SignalNameKey          = attribute.Key("temporal.signal.name")
+	SpanAttributesErrorKey = attribute.Key("temporal.span_attributes.error")
+	UpdateNameKey          = attribute.Key("temporal.update.name")
+	WorkflowIDKey          = attribute.Key("temporal.workflow.id")
+	WorkflowNameKey        = attribute.Key("temporal.workflow.name")
+	WorkflowRunIDKey       = attribute.Key("temporal.workflow.run_id")
+)
+
+// spanContextKey identifies the span context of the current workflow span
+type spanContextKey struct{}
+
+// Attributes returns the given attributes along with the attributes produced by evaluating
+// a span attributes mapping against a request message. The mapping must return an object,
+// whose values are converted to string, bool, int64, or float64 attributes. Errors are
+// recorded as a temporal.span_attributes.error attribute rather than failing the operation.
+func Attributes(mapping *bloblang.Executor, req proto.Message, attrs ...attribute.KeyValue) []attribute.KeyValue {
+	if mapping == nil || req == nil {
+		return attrs
+	}
+	structured, err := expression.ToStructured(req.ProtoReflect())
+	if err != nil {
+		return append(attrs, SpanAttributesErrorKey.String(fmt.Sprintf("error serializing input: %v", err)))
+	}
+	result, err := mapping.Query(structured)
+	if err != nil {
+		return append(attrs, SpanAttributesErrorKey.String(fmt.Sprintf("error executing mapping: %v", err)))
+	}
+	values, ok := result.(map[string]any)
+	if !ok {
+		return append(attrs, SpanAttributesErrorKey.String(fmt.Sprintf("expected mapping to return map[string]any, got: %T", result)))
+	}
+
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		switch v := values[k].(type) {
+		case string:
+			attrs = append(attrs, attribute.String(k, v))
+		case bool:
+			attrs = append(attrs, attribute.Bool(k, v))
+		case int:
+			attrs = append(attrs, attribute.Int(k, v))
+		case int32:
+			attrs = append(attrs, attribute.Int64(k, int64(v)))
+		case int64:
+			attrs = append(attrs, attribute.Int64(k, v))
+		case uint32:
+			attrs = append(attrs, attribute.Int64(k, int64(v)))
+		case float32:
+			attrs = append(attrs, attribute.Float64(k, float64(v)))
+		case float64:
+			attrs = append(attrs, attribute.Float64(k, v))
+		default:
+			attrs = append(attrs, attribute.String(k, fmt.Sprint(v)))
+		}
+	}
+	return attrs
+}
+
+// Start starts a span for a client operation using the tracer of the given service,
+// which is obtained from the global tracer provider
+func Start(ctx context.Context, service, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(service).Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+// StartActivity starts a span for an activity execution using the tracer of the given
+// service, including the activity and workflow identifiers of the activity
+func StartActivity(ctx context.Context, service, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	info := activity.GetInfo(ctx)
+	attrs = append(attrs,
+		ActivityIDKey.String(info.ActivityID),
+		WorkflowIDKey.String(info.WorkflowExecution.ID),
+		WorkflowRunIDKey.String(info.WorkflowExecution.RunID),
+	)
+	return otel.Tracer(service).Start(ctx, name, trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(attrs...))
+}
+
+// End records the given error, if not nil, and ends the span
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// WorkflowSpan describes a span started from workflow code
+type WorkflowSpan struct {
+	ctx  workflow.Context
+	span trace.Span
+}
+
+// StartWorkflow starts a span from workflow code using the tracer of the given service,
+// including the workflow identifiers. The span is a child of the workflow span stored in
+// the given context, if any, and is timestamped using workflow.Now. To avoid recording
+// duplicate spans, no span is started while the workflow is replaying history, so spans
+// are not recorded for workflow code that is replayed on another worker.
+func StartWorkflow(ctx workflow.Context, service, name string, attrs ...attribute.KeyValue) (workflow.Context, *WorkflowSpan) {
+	if workflow.IsReplaying(ctx) {
+		return ctx, &WorkflowSpan{}
+	}
+
+	info := workflow.GetInfo(ctx)
+	attrs = append(attrs,
+		WorkflowIDKey.String(info.WorkflowExecution.ID),
+		WorkflowRunIDKey.String(info.WorkflowExecution.RunID),
+	)
+
+	parent := context.Background()
+	if sc, ok := ctx.Value(spanContextKey{}).(trace.SpanContext); ok {
+		parent = trace.ContextWithSpanContext(parent, sc)
+	}
+	_, span := otel.Tracer(service).Start(parent, name,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithTimestamp(workflow.Now(ctx)),
+		trace.WithAttributes(attrs...),
+	)
+	return workflow.WithValue(ctx, spanContextKey{}, span.SpanContext()), &WorkflowSpan{ctx: ctx, span: span}
+}
+
+// ContextWithSpan returns a copy of ctx in which spans started via StartWorkflow are
+// children of the given workflow span, if started, which allows handlers invoked with
+// a context derived from the workflow root context to continue the workflow's trace
+func ContextWithSpan(ctx workflow.Context, s *WorkflowSpan) workflow.Context {
+	if s == nil || s.span == nil {
+		return ctx
+	}
+	return workflow.WithValue(ctx, spanContextKey{}, s.span.SpanContext())
+}
+
+// End records the given error, if not nil, and ends the span, if started
+func (s *WorkflowSpan) End(err error) {
+	if s == nil || s.span == nil {
+		return
+	}
+	if err != nil {
+		s.span.RecordError(err)
+		s.span.SetStatus(codes.Error, err.Error())
+	}
+	s.span.End(trace.WithTimestamp(workflow.Now(s.ctx)))
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	simplepb "github.com/cludden/protoc-gen-go-temporal/gen/simple"
+	commonpb "github.com/cludden/protoc-gen-go-temporal/gen/simple/common"
 	"github.com/urfave/cli/v2"
 	logger "go.temporal.io/sdk/log"
 	"go.temporal.io/sdk/worker"
@@ -142,6 +143,35 @@ func (wf *someWorkflow3) Execute(ctx workflow.Context) error {
 
 // ============================================================================
 
+type someWorkflow4 struct {
+	*simplepb.SomeWorkflow4Input
+	value string
+}
+
+func (w *Workflows) SomeWorkflow4(ctx workflow.Context, input *simplepb.SomeWorkflow4Input) (simplepb.SomeWorkflow4Workflow, error) {
+	return &someWorkflow4{SomeWorkflow4Input: input}, nil
+}
+
+func (wf *someWorkflow4) Execute(ctx workflow.Context) error {
+	for wf.value != "done" {
+		signal, _ := wf.SetValue.Receive(ctx)
+		wf.value = signal.GetValue()
+	}
+	return nil
+}
+
+func (wf *someWorkflow4) GetValue() (*commonpb.GetValueResponse, error) {
+	return &commonpb.GetValueResponse{Value: wf.value}, nil
+}
+
+func (wf *someWorkflow4) UpdateValue(ctx workflow.Context, req *commonpb.UpdateValueRequest) (*commonpb.UpdateValueResponse, error) {
+	previous := wf.value
+	wf.value = req.GetValue()
+	return &commonpb.UpdateValueResponse{Previous: previous}, nil
+}
+
+// ============================================================================
+
 type Activities struct{}
 
 var ActivityEvents []string
@@ -3,15 +3,40 @@ package main
 import (
 	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	simplepb "github.com/cludden/protoc-gen-go-temporal/gen/simple"
+	commonpb "github.com/cludden/protoc-gen-go-temporal/gen/simple/common"
+	"github.com/cludden/protoc-gen-go-temporal/pkg/testutil"
+	"github.com/cludden/protoc-gen-go-temporal/pkg/tracing"
 	"github.com/ory/dockertest/v3"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	temporalcommon "go.temporal.io/api/common/v1"
+	enums "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/mocks"
+	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/testsuite"
 	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
 )
 
 func TestSomeWorkflow1(t *testing.T) {
@@ -60,6 +85,9 @@ func TestSomeWorkflow1(t *testing.T) {
 	}, simplepb.NewSomeWorkflow1Options().WithStartWorkflowOptions(client.StartWorkflowOptions{}))
 	require.NoError(err)
 	require.Regexp("^some-workflow-1/foo/.{32}", run.ID())
+	memo, err := run.Memo(ctx)
+	require.NoError(err)
+	require.Equal(&simplepb.SomeWorkflow1Memo{RequestVal: "some request"}, memo)
 
 	// send signals
 	require.NoError(run.SomeSignal1(ctx))
@@ -123,6 +151,34 @@ func TestCli(t *testing.T) {
 	}
 }
 
+func TestCliWorkerOptions(t *testing.T) {
+	require := require.New(t)
+
+	for _, c := range []struct {
+		args     []string
+		expected bool
+	}{
+		{args: []string{"test", "worker"}, expected: true},
+		{args: []string{"test", "worker", "--enable-session-worker=false"}, expected: false},
+	} {
+		var opts worker.Options
+		app, err := simplepb.NewSimpleCli(simplepb.NewSimpleCliOptions().
+			WithClient(func(*cli.Context) (client.Client, error) {
+				c := &mocks.Client{}
+				c.On("Close").Return()
+				return c, nil
+			}).
+			WithWorker(func(cmd *cli.Context, c client.Client) (worker.Worker, error) {
+				opts = simplepb.NewSimpleCliWorkerOptions(cmd)
+				return nil, errors.New("worker not started")
+			}),
+		)
+		require.NoError(err)
+		require.ErrorContains(app.Run(c.args), "worker not started")
+		require.Equal(c.expected, opts.EnableSessionWorker, c.args)
+	}
+}
+
 func TestSomeWorkflow1WithTestClient(t *testing.T) {
 	ActivityEvents = nil
 	require := require.New(t)
@@ -178,6 +234,172 @@ func TestSomeWorkflow1WithTestClient(t *testing.T) {
 	}, ActivityEvents)
 }
 
+func TestSomeWorkflow1WithMockedActivities(t *testing.T) {
+	require, ctx := require.New(t), context.Background()
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	client := simplepb.NewTestSimpleClient(env, &Workflows{}, nil)
+
+	client.OnSomeActivity3(func(req *simplepb.SomeActivity3Request) bool {
+		return req.GetRequestVal() == "some activity param"
+	}).Return(&simplepb.SomeActivity3Response{ResponseVal: "mocked"}, nil).Times(1)
+	client.OnSomeActivity3(nil).Return(&simplepb.SomeActivity3Response{ResponseVal: "mocked local"}, nil)
+
+	run, err := client.SomeWorkflow1Async(ctx, &simplepb.SomeWorkflow1Request{Id: "foo", RequestVal: "some request"})
+	require.NoError(err)
+	env.RegisterDelayedCallback(func() {
+		require.NoError(run.SomeSignal1(ctx))
+		require.NoError(run.SomeSignal2(ctx, &simplepb.SomeSignal2Request{RequestVal: "foo"}))
+		require.NoError(run.SomeSignal2(ctx, &simplepb.SomeSignal2Request{RequestVal: "bar"}))
+	}, time.Minute)
+
+	resp, err := run.Get(ctx)
+	require.NoError(err)
+	require.Contains(resp.GetResponseVal(), "some activity 3 with response mocked\n")
+	require.Contains(resp.GetResponseVal(), "some local activity 3 with response mocked local")
+	env.AssertExpectations(t)
+}
+
+func TestSomeWorkflow1WithUnmockedActivity(t *testing.T) {
+	require, ctx := require.New(t), context.Background()
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	client := simplepb.NewTestSimpleClient(env, &Workflows{}, nil)
+
+	_, err := client.SomeWorkflow1(ctx, &simplepb.SomeWorkflow1Request{Id: "foo"})
+	var appErr *temporal.ApplicationError
+	require.ErrorAs(err, &appErr)
+	require.Equal(testutil.ErrCodeActivityNotMocked, appErr.Type())
+}
+
+func TestSimpleChildWorkflowMocks(t *testing.T) {
+	require := require.New(t)
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	client := simplepb.NewTestSimpleClient(env, &Workflows{}, &Activities{})
+	other := simplepb.NewTestOtherChildWorkflows(env)
+	env.RegisterWorkflowWithOptions(func(ctx workflow.Context) error {
+		if err := simplepb.SomeWorkflow2Child(ctx); err != nil {
+			return err
+		}
+		if _, err := simplepb.OtherWorkflowChild(ctx, &simplepb.OtherWorkflowRequest{SomeVal: "bar"}); err != nil {
+			return err
+		}
+		return simplepb.SomeWorkflow3Child(ctx, &simplepb.SomeWorkflow3Request{Id: "baz", RequestVal: "foo"})
+	}, workflow.RegisterOptions{Name: "parent"})
+
+	other.OnOtherWorkflowChild(nil).Return(&simplepb.OtherWorkflowResponse{}, nil)
+	client.OnSomeWorkflow2Child().Return(nil).Times(1)
+	client.OnSomeWorkflow3Child(func(req *simplepb.SomeWorkflow3Request) bool {
+		return req.GetRequestVal() == "foo"
+	}).Return(errors.New("boom"))
+
+	env.ExecuteWorkflow("parent")
+	require.ErrorContains(env.GetWorkflowError(), "boom")
+	env.AssertExpectations(t)
+}
+
+func TestSimpleUnmockedChildWorkflow(t *testing.T) {
+	require := require.New(t)
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	simplepb.NewTestOtherChildWorkflows(env)
+	env.RegisterWorkflowWithOptions(func(ctx workflow.Context) error {
+		_, err := simplepb.OtherWorkflowChild(ctx, &simplepb.OtherWorkflowRequest{SomeVal: "bar"})
+		return err
+	}, workflow.RegisterOptions{Name: "parent"})
+
+	env.ExecuteWorkflow("parent")
+	var appErr *temporal.ApplicationError
+	require.ErrorAs(env.GetWorkflowError(), &appErr)
+	require.Equal(testutil.ErrCodeWorkflowNotMocked, appErr.Type())
+	require.ErrorContains(appErr, `workflow "mycompany.simple.Other.OtherWorkflow" is not mocked`)
+}
+
+func TestSimpleDelayed(t *testing.T) {
+	require, ctx := require.New(t), context.Background()
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	client := simplepb.NewTestSimpleClient(env, &Workflows{}, &Activities{})
+
+	var queried string
+	client.After(time.Minute).SomeSignal1()
+	client.After(time.Minute * 2).SomeSignal2(&simplepb.SomeSignal2Request{RequestVal: "foo"})
+	client.After(time.Minute*3).SomeQuery2(&simplepb.SomeQuery2Request{RequestVal: "bar"}, func(resp *simplepb.SomeQuery2Response, err error) {
+		require.NoError(err)
+		queried = resp.GetResponseVal()
+	})
+	client.After(time.Minute * 4).SomeSignal2(&simplepb.SomeSignal2Request{RequestVal: "baz"})
+
+	resp, err := client.SomeWorkflow1(ctx, &simplepb.SomeWorkflow1Request{Id: "foo", RequestVal: "some request"})
+	require.NoError(err)
+	require.Contains(queried, "some signal 2 with param foo\nsome query 2 with param bar")
+	require.Contains(resp.GetResponseVal(), "some signal 2 with param baz")
+
+	env = suite.NewTestWorkflowEnvironment()
+	client = simplepb.NewTestSimpleClient(env, &Workflows{}, &Activities{})
+
+	var accepted bool
+	var updated *simplepb.SomeUpdate1Response
+	client.After(time.Second).SomeUpdate1(&simplepb.SomeUpdate1Request{RequestVal: "test"}, &simplepb.TestSomeUpdate1Callbacks{
+		OnAccept: func() { accepted = true },
+		OnComplete: func(resp *simplepb.SomeUpdate1Response, err error) {
+			require.NoError(err)
+			updated = resp
+		},
+	})
+	require.NoError(client.SomeWorkflow2(ctx))
+	require.True(accepted)
+	require.Equal("TEST", updated.GetResponseVal())
+}
+
+func TestSimpleReplay(t *testing.T) {
+	simplepb.ReplaySimpleWorkflows(t, &Workflows{}, "testdata/some_workflow_1.json")
+}
+
+func TestSimpleReplayNondeterministic(t *testing.T) {
+	var rt replayT
+	simplepb.ReplaySimpleWorkflows(&rt, &changedWorkflows{&Workflows{}}, "testdata/some_workflow_1.json")
+	require.Len(t, rt.errors, 1)
+	require.Contains(t, rt.errors[0], "testdata/some_workflow_1.json")
+	require.Contains(t, rt.errors[0], "nondeterministic")
+}
+
+type replayT struct {
+	errors []string
+}
+
+func (t *replayT) Helper() {}
+
+func (t *replayT) Errorf(format string, args ...any) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+// changedWorkflows replaces SomeWorkflow1 with a version that schedules a different activity
+// than the one recorded in testdata/some_workflow_1.json
+type changedWorkflows struct {
+	*Workflows
+}
+
+type changedSomeWorkflow1 struct {
+	*someWorkflow1
+}
+
+func (w *changedWorkflows) SomeWorkflow1(ctx workflow.Context, in *simplepb.SomeWorkflow1Input) (simplepb.SomeWorkflow1Workflow, error) {
+	wf, err := w.Workflows.SomeWorkflow1(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return &changedSomeWorkflow1{wf.(*someWorkflow1)}, nil
+}
+
+func (wf *changedSomeWorkflow1) Execute(ctx workflow.Context) (*simplepb.SomeWorkflow1Response, error) {
+	if err := simplepb.SomeActivity1(ctx); err != nil {
+		return nil, err
+	}
+	return wf.someWorkflow1.Execute(ctx)
+}
+
 func TestSomeWorkflow2(t *testing.T) {
 	require, ctx := require.New(t), context.Background()
 	var suite testsuite.WorkflowTestSuite
@@ -199,3 +421,738 @@ func TestSomeWorkflow2(t *testing.T) {
 	require.NoError(err)
 	require.Equal("TEST", update.GetResponseVal())
 }
+
+func TestSomeWorkflow3Options(t *testing.T) {
+	require := require.New(t)
+	req := &simplepb.SomeWorkflow3Request{Id: "foo", RequestVal: "bar"}
+
+	opts, err := simplepb.NewSomeWorkflow3Options().Build(req)
+	require.NoError(err)
+	require.Equal("0 3 * * *", opts.CronSchedule)
+
+	opts, err = simplepb.NewSomeWorkflow3Options().WithCronSchedule("@hourly").Build(req)
+	require.NoError(err)
+	require.Equal("@hourly", opts.CronSchedule)
+
+	opts, err = simplepb.NewSomeWorkflow3Options().WithCronSchedule("").Build(req)
+	require.NoError(err)
+	require.Empty(opts.CronSchedule)
+}
+
+func TestSomeActivity2Heartbeat(t *testing.T) {
+	require := require.New(t)
+	suite := &testsuite.WorkflowTestSuite{}
+	env := suite.NewTestActivityEnvironment()
+	env.SetHeartbeatDetails(&simplepb.SomeActivity2Progress{Offset: 42})
+
+	var resumed *simplepb.SomeActivity2Progress
+	env.RegisterActivityWithOptions(func(ctx context.Context, req *simplepb.SomeActivity2Request) error {
+		details, ok := simplepb.GetSomeActivity2HeartbeatDetails(ctx)
+		require.True(ok)
+		resumed = details
+		simplepb.RecordSomeActivity2Heartbeat(ctx, &simplepb.SomeActivity2Progress{Offset: details.GetOffset() + 1})
+		return nil
+	}, activity.RegisterOptions{Name: simplepb.SomeActivity2ActivityName})
+
+	_, err := env.ExecuteActivity(simplepb.SomeActivity2ActivityName, &simplepb.SomeActivity2Request{})
+	require.NoError(err)
+	require.Equal(int32(42), resumed.GetOffset())
+
+	details, ok := simplepb.GetSomeActivity2LastHeartbeatDetails(temporal.NewTimeoutError(enums.TIMEOUT_TYPE_HEARTBEAT, nil, &simplepb.SomeActivity2Progress{Offset: 43}))
+	require.True(ok)
+	require.Equal(int32(43), details.GetOffset())
+
+	_, ok = simplepb.GetSomeActivity2LastHeartbeatDetails(temporal.NewApplicationError("boom", "Error"))
+	require.False(ok)
+}
+
+type heartbeatActivities struct {
+	Activities
+}
+
+func (heartbeatActivities) SomeActivity2(ctx context.Context, req *simplepb.SomeActivity2Request) error {
+	details, _ := simplepb.GetSomeActivity2HeartbeatDetails(ctx)
+	simplepb.RecordSomeActivity2Heartbeat(ctx, &simplepb.SomeActivity2Progress{Offset: details.GetOffset() + 1})
+	return nil
+}
+
+func TestSimpleTestActivities(t *testing.T) {
+	require, ctx := require.New(t), context.Background()
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestActivityEnvironment()
+	activities := simplepb.NewTestSimpleActivities(env, &heartbeatActivities{})
+
+	resp, err := activities.SomeActivity3(ctx, &simplepb.SomeActivity3Request{RequestVal: "foo"})
+	require.NoError(err)
+	require.Equal("some response", resp.GetResponseVal())
+
+	var heartbeats []int32
+	activities.SetSomeActivity2HeartbeatDetails(&simplepb.SomeActivity2Progress{Offset: 41})
+	activities.OnSomeActivity2Heartbeat(func(details *simplepb.SomeActivity2Progress) {
+		heartbeats = append(heartbeats, details.GetOffset())
+	})
+	require.NoError(activities.SomeActivity2(ctx, &simplepb.SomeActivity2Request{RequestVal: "foo"}))
+	require.Equal([]int32{42}, heartbeats)
+}
+
+func TestSomeActivity2AutoHeartbeat(t *testing.T) {
+	require := require.New(t)
+	suite := &testsuite.WorkflowTestSuite{}
+	env := suite.NewTestWorkflowEnvironment()
+
+	var mu sync.Mutex
+	var heartbeats []int32
+	env.SetOnActivityHeartbeatListener(func(_ *activity.Info, details converter.EncodedValues) {
+		var progress *simplepb.SomeActivity2Progress
+		require.NoError(details.Get(&progress))
+		mu.Lock()
+		defer mu.Unlock()
+		heartbeats = append(heartbeats, progress.GetOffset())
+	})
+	simplepb.RegisterSomeActivity2Activity(env, func(ctx context.Context, req *simplepb.SomeActivity2Request) error {
+		simplepb.RecordSomeActivity2Heartbeat(ctx, &simplepb.SomeActivity2Progress{Offset: 1})
+		time.Sleep(500 * time.Millisecond)
+		return nil
+	})
+
+	env.ExecuteWorkflow(func(ctx workflow.Context) error {
+		return simplepb.SomeActivity2(ctx, &simplepb.SomeActivity2Request{RequestVal: "foo"}, simplepb.NewSomeActivity2ActivityOptions().WithActivityOptions(workflow.ActivityOptions{
+			StartToCloseTimeout: time.Minute,
+			HeartbeatTimeout:    200 * time.Millisecond,
+		}))
+	})
+	require.True(env.IsWorkflowCompleted())
+	require.NoError(env.GetWorkflowError())
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.GreaterOrEqual(len(heartbeats), 2)
+	for _, offset := range heartbeats {
+		require.Equal(int32(1), offset)
+	}
+}
+
+func TestSomeActivity2ID(t *testing.T) {
+	require := require.New(t)
+	suite := &testsuite.WorkflowTestSuite{}
+	env := suite.NewTestWorkflowEnvironment()
+
+	var ids []string
+	simplepb.RegisterSomeActivity2Activity(env, func(ctx context.Context, req *simplepb.SomeActivity2Request) error {
+		ids = append(ids, activity.GetInfo(ctx).ActivityID)
+		return nil
+	})
+
+	env.ExecuteWorkflow(func(ctx workflow.Context) error {
+		if err := simplepb.SomeActivity2(ctx, &simplepb.SomeActivity2Request{RequestVal: "foo"}); err != nil {
+			return err
+		}
+		return simplepb.SomeActivity2Async(ctx, &simplepb.SomeActivity2Request{RequestVal: "foo"}, simplepb.NewSomeActivity2ActivityOptions().WithActivityID("bar")).Get(ctx)
+	})
+	require.True(env.IsWorkflowCompleted())
+	require.NoError(env.GetWorkflowError())
+	require.Equal([]string{"some-activity-2/foo", "bar"}, ids)
+}
+
+func TestSomeActivity3AsyncCompletion(t *testing.T) {
+	require := require.New(t)
+	suite := &testsuite.WorkflowTestSuite{}
+	env := suite.NewTestWorkflowEnvironment()
+	client := simplepb.NewTestSimpleClient(env, &Workflows{}, &Activities{})
+
+	var taskToken []byte
+	simplepb.RegisterSomeActivity3Activity(env, func(ctx context.Context, req *simplepb.SomeActivity3Request) (*simplepb.SomeActivity3Response, error) {
+		taskToken = activity.GetInfo(ctx).TaskToken
+		return nil, activity.ErrResultPending
+	})
+	env.RegisterDelayedCallback(func() {
+		require.NoError(client.CompleteSomeActivity3(context.Background(), taskToken, &simplepb.SomeActivity3Response{ResponseVal: "done"}, nil))
+	}, time.Minute)
+
+	env.ExecuteWorkflow(func(ctx workflow.Context) (string, error) {
+		resp, err := simplepb.SomeActivity3(ctx, &simplepb.SomeActivity3Request{RequestVal: "foo"})
+		return resp.GetResponseVal(), err
+	})
+	require.True(env.IsWorkflowCompleted())
+	require.NoError(env.GetWorkflowError())
+	var result string
+	require.NoError(env.GetWorkflowResult(&result))
+	require.Equal("done", result)
+
+	require.Error(client.CompleteSomeActivity3ByID(context.Background(), "default", "foo", "", "1", nil, nil))
+}
+
+func TestSimpleSession(t *testing.T) {
+	require := require.New(t)
+	suite := &testsuite.WorkflowTestSuite{}
+	env := suite.NewTestWorkflowEnvironment()
+	env.SetWorkerOptions(worker.Options{EnableSessionWorker: true})
+
+	var executed []string
+	simplepb.RegisterSomeActivity1Activity(env, func(ctx context.Context) error {
+		executed = append(executed, "SomeActivity1")
+		return nil
+	})
+	simplepb.RegisterSomeActivity2Activity(env, func(ctx context.Context, req *simplepb.SomeActivity2Request) error {
+		executed = append(executed, req.GetRequestVal())
+		return nil
+	})
+
+	env.ExecuteWorkflow(func(ctx workflow.Context) error {
+		session, err := simplepb.NewSimpleSession(ctx, &workflow.SessionOptions{
+			CreationTimeout:  time.Minute,
+			ExecutionTimeout: time.Minute,
+		})
+		if err != nil {
+			return err
+		}
+		defer session.Complete()
+		if session.Info().SessionState != workflow.SessionStateOpen {
+			return fmt.Errorf("expected open session, got %v", session.Info().SessionState)
+		}
+		if len(session.RecreateToken()) == 0 {
+			return fmt.Errorf("expected recreate token")
+		}
+		if err := session.SomeActivity1(simplepb.NewSomeActivity1ActivityOptions().WithActivityOptions(workflow.ActivityOptions{
+			StartToCloseTimeout: time.Minute,
+		})); err != nil {
+			return err
+		}
+		return session.SomeActivity2Async(&simplepb.SomeActivity2Request{RequestVal: "foo"}).Get(ctx)
+	})
+	require.True(env.IsWorkflowCompleted())
+	require.NoError(env.GetWorkflowError())
+	require.Equal([]string{"SomeActivity1", "foo"}, executed)
+}
+
+func TestSimpleSaga(t *testing.T) {
+	for _, parallel := range []bool{false, true} {
+		require := require.New(t)
+		suite := &testsuite.WorkflowTestSuite{}
+		env := suite.NewTestWorkflowEnvironment()
+
+		var mu sync.Mutex
+		var executed []string
+		record := func(s string) {
+			mu.Lock()
+			defer mu.Unlock()
+			executed = append(executed, s)
+		}
+		simplepb.RegisterSomeActivity1Activity(env, func(ctx context.Context) error {
+			record("SomeActivity1")
+			return nil
+		})
+		simplepb.RegisterSomeActivity2Activity(env, func(ctx context.Context, req *simplepb.SomeActivity2Request) error {
+			record("SomeActivity2:" + req.GetRequestVal())
+			return nil
+		})
+		simplepb.RegisterSomeActivity3Activity(env, func(ctx context.Context, req *simplepb.SomeActivity3Request) (*simplepb.SomeActivity3Response, error) {
+			record("SomeActivity3:" + req.GetRequestVal())
+			return &simplepb.SomeActivity3Response{ResponseVal: "baz"}, nil
+		})
+
+		var compensateErr error
+		env.ExecuteWorkflow(func(ctx workflow.Context) error {
+			saga := simplepb.NewSimpleSaga(simplepb.NewSimpleSagaOptions().WithParallel(parallel))
+			if err := saga.SomeActivity2(ctx, &simplepb.SomeActivity2Request{RequestVal: "foo"}); err != nil {
+				return err
+			}
+			if _, err := saga.SomeActivity3(ctx, &simplepb.SomeActivity3Request{RequestVal: "bar"}); err != nil {
+				return err
+			}
+			failed := false
+			saga.AddCompensation(func(ctx workflow.Context) error {
+				if !failed {
+					failed = true
+					return fmt.Errorf("compensation failed")
+				}
+				return nil
+			})
+
+			ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{StartToCloseTimeout: time.Minute})
+			compensateErr = saga.Compensate(ctx)
+			return saga.Compensate(ctx)
+		})
+		require.True(env.IsWorkflowCompleted())
+		require.NoError(env.GetWorkflowError())
+		require.ErrorContains(compensateErr, "compensation failed")
+		if parallel {
+			require.Equal([]string{"SomeActivity2:foo", "SomeActivity3:bar"}, executed[:2])
+			require.ElementsMatch([]string{"SomeActivity2:baz", "SomeActivity1"}, executed[2:])
+		} else {
+			require.Equal([]string{"SomeActivity2:foo", "SomeActivity3:bar", "SomeActivity2:baz", "SomeActivity1"}, executed)
+		}
+	}
+}
+
+func TestSomeActivity3Errors(t *testing.T) {
+	require := require.New(t)
+	suite := &testsuite.WorkflowTestSuite{}
+	env := suite.NewTestWorkflowEnvironment()
+
+	var attempts int
+	simplepb.RegisterSomeActivity3Activity(env, func(ctx context.Context, req *simplepb.SomeActivity3Request) (*simplepb.SomeActivity3Response, error) {
+		attempts++
+		return nil, simplepb.NewSomeActivity3FailedError(&simplepb.SomeErrorDetail{Reason: req.GetRequestVal()})
+	})
+
+	env.ExecuteWorkflow(func(ctx workflow.Context) error {
+		_, err := simplepb.SomeActivity3(ctx, &simplepb.SomeActivity3Request{RequestVal: "foo"})
+		return err
+	})
+	require.True(env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	require.Error(err)
+	require.Equal(1, attempts)
+
+	detail, ok := simplepb.AsSomeActivity3FailedError(err)
+	require.True(ok)
+	require.Equal("foo", detail.GetReason())
+	_, ok = simplepb.AsSomeWorkflow1FailedError(err)
+	require.False(ok)
+
+	detail, ok = simplepb.AsSomeWorkflow1FailedError(fmt.Errorf("wrapped: %w", simplepb.NewSomeWorkflow1FailedError(&simplepb.SomeErrorDetail{Reason: "bar"})))
+	require.True(ok)
+	require.Equal("bar", detail.GetReason())
+}
+
+// recordingInterceptor records the hooks invoked on a simplepb.SimpleInterceptor
+type recordingInterceptor struct {
+	simplepb.NoopSimpleInterceptor
+	name   string
+	events *[]string
+}
+
+func (i *recordingInterceptor) BeforeSomeWorkflow1(ctx workflow.Context, req *simplepb.SomeWorkflow1Request) {
+	*i.events = append(*i.events, i.name+":BeforeSomeWorkflow1")
+}
+
+func (i *recordingInterceptor) AfterSomeWorkflow1(ctx workflow.Context, resp *simplepb.SomeWorkflow1Response, err error) {
+	*i.events = append(*i.events, i.name+":AfterSomeWorkflow1")
+}
+
+func (i *recordingInterceptor) OnSomeSignal1(ctx workflow.Context) {
+	*i.events = append(*i.events, i.name+":OnSomeSignal1")
+}
+
+func (i *recordingInterceptor) OnSomeSignal2(ctx workflow.Context, req *simplepb.SomeSignal2Request) {
+	*i.events = append(*i.events, i.name+":OnSomeSignal2:"+req.GetRequestVal())
+}
+
+func (i *recordingInterceptor) BeforeSomeWorkflow2(ctx workflow.Context) {
+	*i.events = append(*i.events, i.name+":BeforeSomeWorkflow2")
+}
+
+func (i *recordingInterceptor) AfterSomeWorkflow2(ctx workflow.Context, err error) {
+	*i.events = append(*i.events, i.name+":AfterSomeWorkflow2")
+}
+
+func (i *recordingInterceptor) BeforeSomeUpdate1(ctx workflow.Context, req *simplepb.SomeUpdate1Request) {
+	*i.events = append(*i.events, i.name+":BeforeSomeUpdate1:"+req.GetRequestVal())
+}
+
+func (i *recordingInterceptor) AfterSomeUpdate1(ctx workflow.Context, resp *simplepb.SomeUpdate1Response, err error) {
+	*i.events = append(*i.events, i.name+":AfterSomeUpdate1:"+resp.GetResponseVal())
+}
+
+func (i *recordingInterceptor) BeforeSomeActivity3(ctx context.Context, req *simplepb.SomeActivity3Request) {
+	*i.events = append(*i.events, i.name+":BeforeSomeActivity3:"+req.GetRequestVal())
+}
+
+func (i *recordingInterceptor) AfterSomeActivity3(ctx context.Context, resp *simplepb.SomeActivity3Response, err error) {
+	*i.events = append(*i.events, i.name+":AfterSomeActivity3:"+resp.GetResponseVal())
+}
+
+func TestSimpleInterceptor(t *testing.T) {
+	require := require.New(t)
+	suite := &testsuite.WorkflowTestSuite{}
+	env := suite.NewTestWorkflowEnvironment()
+
+	var events []string
+	interceptors := []simplepb.SimpleInterceptor{
+		&recordingInterceptor{name: "a", events: &events},
+		&recordingInterceptor{name: "b", events: &events},
+	}
+	simplepb.RegisterSomeWorkflow2Workflow(env, (&Workflows{}).SomeWorkflow2, interceptors...)
+
+	env.RegisterDelayedCallback(func() {
+		env.UpdateWorkflow(simplepb.SomeUpdate1UpdateName, testutil.NewUpdateCallbacks(), &simplepb.SomeUpdate1Request{RequestVal: "test"})
+	}, time.Second)
+	env.ExecuteWorkflow(simplepb.SomeWorkflow2WorkflowName)
+	require.True(env.IsWorkflowCompleted())
+	require.NoError(env.GetWorkflowError())
+	require.Equal([]string{
+		"a:BeforeSomeWorkflow2",
+		"b:BeforeSomeWorkflow2",
+		"a:BeforeSomeUpdate1:test",
+		"b:BeforeSomeUpdate1:test",
+		"b:AfterSomeUpdate1:TEST",
+		"a:AfterSomeUpdate1:TEST",
+		"b:AfterSomeWorkflow2",
+		"a:AfterSomeWorkflow2",
+	}, events)
+
+	events = nil
+	env = suite.NewTestWorkflowEnvironment()
+	simplepb.RegisterSomeActivity3Activity(env, func(ctx context.Context, req *simplepb.SomeActivity3Request) (*simplepb.SomeActivity3Response, error) {
+		return &simplepb.SomeActivity3Response{ResponseVal: strings.ToUpper(req.GetRequestVal())}, nil
+	}, interceptors[0])
+	env.ExecuteWorkflow(func(ctx workflow.Context) error {
+		_, err := simplepb.SomeActivity3(ctx, &simplepb.SomeActivity3Request{RequestVal: "foo"})
+		return err
+	})
+	require.True(env.IsWorkflowCompleted())
+	require.NoError(env.GetWorkflowError())
+	require.Equal([]string{"a:BeforeSomeActivity3:foo", "a:AfterSomeActivity3:FOO"}, events)
+}
+
+func TestSimpleInterceptorReplay(t *testing.T) {
+	require := require.New(t)
+
+	var events []string
+	replayer := worker.NewWorkflowReplayer()
+	simplepb.RegisterSimpleWorkflows(replayer, &Workflows{}, &recordingInterceptor{name: "a", events: &events})
+	require.NoError(replayer.ReplayWorkflowHistoryFromJSONFile(nil, "testdata/some_workflow_1.json"))
+	require.Empty(events)
+
+	suite := &testsuite.WorkflowTestSuite{}
+	env := suite.NewTestWorkflowEnvironment()
+	simplepb.RegisterSimpleWorkflows(env, &Workflows{}, &recordingInterceptor{name: "a", events: &events})
+	simplepb.RegisterSimpleActivities(env, &Activities{})
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(simplepb.SomeSignal1SignalName, nil)
+		env.SignalWorkflow(simplepb.SomeSignal2SignalName, &simplepb.SomeSignal2Request{RequestVal: "foo"})
+		env.SignalWorkflow(simplepb.SomeSignal2SignalName, &simplepb.SomeSignal2Request{RequestVal: "bar"})
+	}, time.Minute)
+	env.ExecuteWorkflow(simplepb.SomeWorkflow1WorkflowName, &simplepb.SomeWorkflow1Request{Id: "foo"})
+	require.True(env.IsWorkflowCompleted())
+	require.NoError(env.GetWorkflowError())
+	require.Equal([]string{
+		"a:BeforeSomeWorkflow1",
+		"a:OnSomeSignal1",
+		"a:OnSomeSignal2:foo",
+		"a:OnSomeSignal2:bar",
+		"a:AfterSomeWorkflow1",
+	}, events)
+}
+
+// recordingClientInterceptor records the hooks invoked on a simplepb.SimpleClientInterceptor
+type recordingClientInterceptor struct {
+	simplepb.NoopSimpleClientInterceptor
+	events []string
+}
+
+func (i *recordingClientInterceptor) BeforeSomeWorkflow2(ctx context.Context) {
+	i.events = append(i.events, "BeforeSomeWorkflow2")
+}
+
+func (i *recordingClientInterceptor) AfterSomeWorkflow2(ctx context.Context, err error) {
+	i.events = append(i.events, "AfterSomeWorkflow2")
+}
+
+func (i *recordingClientInterceptor) OnSomeSignal2(ctx context.Context, req *simplepb.SomeSignal2Request) {
+	i.events = append(i.events, "OnSomeSignal2:"+req.GetRequestVal())
+}
+
+func TestSimpleClientInterceptor(t *testing.T) {
+	require, ctx := require.New(t), context.Background()
+
+	run := &mocks.WorkflowRun{}
+	run.On("Get", mock.Anything, nil).Return(nil)
+	c := &mocks.Client{}
+	c.On("ExecuteWorkflow", mock.Anything, mock.Anything, simplepb.SomeWorkflow2WorkflowName).Return(run, nil)
+	c.On("SignalWorkflow", mock.Anything, "foo", "", simplepb.SomeSignal2SignalName, mock.Anything).Return(nil)
+
+	interceptor := &recordingClientInterceptor{}
+	simple := simplepb.NewSimpleClient(c, interceptor)
+	require.NoError(simple.SomeWorkflow2(ctx))
+	require.NoError(simple.SomeSignal2(ctx, "foo", "", &simplepb.SomeSignal2Request{RequestVal: "bar"}))
+	require.Equal([]string{"BeforeSomeWorkflow2", "AfterSomeWorkflow2", "OnSomeSignal2:bar"}, interceptor.events)
+}
+
+func TestSimpleMockClient(t *testing.T) {
+	require, ctx := require.New(t), context.Background()
+
+	handle := simplepb.NewMockSomeUpdate1Handle(t)
+	handle.OnGet(mock.Anything).Return(&simplepb.SomeUpdate1Response{ResponseVal: "BAR"}, nil)
+	run := simplepb.NewMockSomeWorkflow2Run(t)
+	run.OnID().Return("foo")
+	run.OnSomeUpdate1Async(mock.Anything, &simplepb.SomeUpdate1Request{RequestVal: "bar"}, mock.Anything).Return(handle, nil)
+	run.OnGet(mock.Anything).Return(nil)
+
+	var c simplepb.SimpleClient = simplepb.NewMockSimpleClient(t)
+	c.(*simplepb.MockSimpleClient).OnSomeWorkflow2Async(mock.Anything, mock.Anything).Return(run, nil)
+	c.(*simplepb.MockSimpleClient).OnSomeSignal2(mock.Anything, "foo", "", mock.Anything).Return(errors.New("boom"))
+
+	r, err := c.SomeWorkflow2Async(ctx)
+	require.NoError(err)
+	require.Equal("foo", r.ID())
+	h, err := r.SomeUpdate1Async(ctx, &simplepb.SomeUpdate1Request{RequestVal: "bar"})
+	require.NoError(err)
+	resp, err := h.Get(ctx)
+	require.NoError(err)
+	require.Equal("BAR", resp.GetResponseVal())
+	require.NoError(r.Get(ctx))
+	require.EqualError(c.SomeSignal2(ctx, "foo", "", &simplepb.SomeSignal2Request{}), "boom")
+}
+
+func TestSimpleTracing(t *testing.T) {
+	require, ctx := require.New(t), context.Background()
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	otel.SetTracerProvider(tp)
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+	span := func(name string) *tracetest.SpanStub {
+		for _, s := range exporter.GetSpans() {
+			if s.Name == name {
+				return &s
+			}
+		}
+		return nil
+	}
+	attrs := func(s *tracetest.SpanStub) map[attribute.Key]attribute.Value {
+		m := make(map[attribute.Key]attribute.Value, len(s.Attributes))
+		for _, kv := range s.Attributes {
+			m[kv.Key] = kv.Value
+		}
+		return m
+	}
+
+	// workflow and update handler spans
+	suite := &testsuite.WorkflowTestSuite{}
+	env := suite.NewTestWorkflowEnvironment()
+	simplepb.RegisterSomeWorkflow2Workflow(env, (&Workflows{}).SomeWorkflow2)
+	env.RegisterDelayedCallback(func() {
+		env.UpdateWorkflow(simplepb.SomeUpdate1UpdateName, testutil.NewUpdateCallbacks(), &simplepb.SomeUpdate1Request{RequestVal: "test"})
+	}, time.Second)
+	env.ExecuteWorkflow(simplepb.SomeWorkflow2WorkflowName)
+	require.True(env.IsWorkflowCompleted())
+	require.NoError(env.GetWorkflowError())
+
+	run := span("RunWorkflow:" + simplepb.SomeWorkflow2WorkflowName)
+	require.NotNil(run)
+	require.False(run.EndTime.IsZero())
+	require.Equal("mycompany.simple.Simple", run.InstrumentationLibrary.Name)
+	require.Equal(simplepb.SomeWorkflow2WorkflowName, attrs(run)[tracing.WorkflowNameKey].AsString())
+	require.NotEmpty(attrs(run)[tracing.WorkflowIDKey].AsString())
+
+	update := span("HandleUpdate:" + simplepb.SomeUpdate1UpdateName)
+	require.NotNil(update)
+	require.False(update.EndTime.IsZero())
+	require.Equal(run.SpanContext.TraceID(), update.Parent.TraceID())
+	require.Equal(run.SpanContext.SpanID(), update.Parent.SpanID())
+	require.Equal(simplepb.SomeUpdate1UpdateName, attrs(update)[tracing.UpdateNameKey].AsString())
+	require.Equal("test", attrs(update)["simple.request_val"].AsString())
+
+	// activity spans
+	env = suite.NewTestWorkflowEnvironment()
+	simplepb.RegisterSomeActivity3Activity(env, func(ctx context.Context, req *simplepb.SomeActivity3Request) (*simplepb.SomeActivity3Response, error) {
+		return nil, simplepb.NewSomeActivity3FailedError(&simplepb.SomeErrorDetail{Reason: "foo"})
+	})
+	env.ExecuteWorkflow(func(ctx workflow.Context) error {
+		_, err := simplepb.SomeActivity3(ctx, &simplepb.SomeActivity3Request{RequestVal: "foo"})
+		return err
+	})
+	require.True(env.IsWorkflowCompleted())
+	require.Error(env.GetWorkflowError())
+
+	activity := span("RunActivity:" + simplepb.SomeActivity3ActivityName)
+	require.NotNil(activity)
+	require.False(activity.EndTime.IsZero())
+	require.Equal(codes.Error, activity.Status.Code)
+	require.Equal("foo", attrs(activity)["simple.request_val"].AsString())
+	require.NotEmpty(attrs(activity)[tracing.ActivityIDKey].AsString())
+
+	// client spans
+	c := &mocks.Client{}
+	c.On("SignalWorkflow", mock.Anything, "foo", "", simplepb.SomeSignal2SignalName, mock.Anything).Return(nil)
+	require.NoError(simplepb.NewSimpleClient(c).SomeSignal2(ctx, "foo", "", &simplepb.SomeSignal2Request{RequestVal: "bar"}))
+
+	signal := span("SignalWorkflow:" + simplepb.SomeSignal2SignalName)
+	require.NotNil(signal)
+	require.False(signal.EndTime.IsZero())
+	require.Equal(codes.Unset, signal.Status.Code)
+	require.Equal("foo", attrs(signal)[tracing.WorkflowIDKey].AsString())
+	require.Equal("bar", attrs(signal)["simple.request_val"].AsString())
+}
+
+func TestSomeWorkflow1Options(t *testing.T) {
+	require := require.New(t)
+	req := &simplepb.SomeWorkflow1Request{Id: "foo", RequestVal: "bar"}
+
+	opts, err := simplepb.NewSomeWorkflow1Options().Build(req)
+	require.NoError(err)
+	require.Equal(map[string]any{"requestVal": "bar"}, opts.Memo)
+
+	opts, err = simplepb.NewSomeWorkflow1Options().WithMemo(map[string]any{"foo": "baz"}).Build(req)
+	require.NoError(err)
+	require.Equal(map[string]any{"foo": "baz"}, opts.Memo)
+
+	// Memo accessors are only generated for workflows that declare a memo mapping
+	_, ok := reflect.TypeOf((*simplepb.SomeWorkflow1Run)(nil)).Elem().MethodByName("Memo")
+	require.True(ok)
+	_, ok = reflect.TypeOf((*simplepb.SomeWorkflow2Run)(nil)).Elem().MethodByName("Memo")
+	require.False(ok)
+}
+
+func TestSomeWorkflow1Memo(t *testing.T) {
+	require, ctx := require.New(t), context.Background()
+
+	payload, err := converter.GetDefaultDataConverter().ToPayload("bar")
+	require.NoError(err)
+	run := &mocks.WorkflowRun{}
+	run.On("GetID").Return("foo")
+	run.On("GetRunID").Return("baz")
+	c := &mocks.Client{}
+	c.On("ExecuteWorkflow", mock.Anything, mock.Anything, simplepb.SomeWorkflow1WorkflowName, mock.Anything).Return(run, nil)
+	c.On("DescribeWorkflowExecution", mock.Anything, "foo", "baz").Return(&workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Memo: &temporalcommon.Memo{Fields: map[string]*temporalcommon.Payload{"requestVal": payload}},
+		},
+	}, nil)
+
+	simple := simplepb.NewSimpleClient(c)
+	r, err := simple.SomeWorkflow1Async(ctx, &simplepb.SomeWorkflow1Request{Id: "foo", RequestVal: "bar"})
+	require.NoError(err)
+	memo, err := r.Memo(ctx)
+	require.NoError(err)
+	require.Equal(&simplepb.SomeWorkflow1Memo{RequestVal: "bar"}, memo)
+}
+
+func TestSomeWorkflow3ScheduleOptions(t *testing.T) {
+	require := require.New(t)
+	req := &simplepb.SomeWorkflow3Request{Id: "foo", RequestVal: "bar"}
+
+	opts, err := simplepb.NewSomeWorkflow3ScheduleOptions().Build("some-schedule", client.ScheduleSpec{}, req)
+	require.NoError(err)
+	require.Equal("some-schedule", opts.ID)
+	require.Equal([]client.ScheduleIntervalSpec{{Every: time.Hour}}, opts.Spec.Intervals)
+	require.Equal(enums.SCHEDULE_OVERLAP_POLICY_BUFFER_ONE, opts.Overlap)
+	require.Equal(5*time.Minute, opts.CatchupWindow)
+	action, ok := opts.Action.(*client.ScheduleWorkflowAction)
+	require.True(ok)
+	require.Equal(simplepb.SomeWorkflow3WorkflowName, action.Workflow)
+	require.Equal("some-workflow-3/foo/bar", action.ID)
+	require.Equal("my-task-queue-2", action.TaskQueue)
+	require.Equal([]any{req}, action.Args)
+
+	opts, err = simplepb.NewSomeWorkflow3ScheduleOptions().Build("some-schedule", client.ScheduleSpec{CronExpressions: []string{"@daily"}}, req)
+	require.NoError(err)
+	require.Equal([]string{"@daily"}, opts.Spec.CronExpressions)
+	require.Empty(opts.Spec.Intervals)
+
+	// options provided via WithScheduleOptions are copied, so that they can be reused
+	scheduleOpts := simplepb.NewSomeWorkflow3ScheduleOptions().WithScheduleOptions(client.ScheduleOptions{Paused: true})
+	first, err := scheduleOpts.Build("first", client.ScheduleSpec{}, req)
+	require.NoError(err)
+	second, err := scheduleOpts.Build("second", client.ScheduleSpec{CronExpressions: []string{"@daily"}}, req)
+	require.NoError(err)
+	require.NotSame(first, second)
+	require.Equal("first", first.ID)
+	require.Equal([]client.ScheduleIntervalSpec{{Every: time.Hour}}, first.Spec.Intervals)
+	require.True(first.Paused)
+	require.Equal("second", second.ID)
+	require.Empty(second.Spec.Intervals)
+	require.True(second.Paused)
+}
+
+// fakeScheduleClient provides a client.ScheduleClient that returns a single fake schedule handle
+type fakeScheduleClient struct {
+	client.ScheduleClient
+	handle *fakeScheduleHandle
+}
+
+func (c *fakeScheduleClient) GetHandle(ctx context.Context, id string) client.ScheduleHandle {
+	return c.handle
+}
+
+// fakeScheduleHandle provides a client.ScheduleHandle that describes a schedule with the given
+// action and records the actions performed on it
+type fakeScheduleHandle struct {
+	client.ScheduleHandle
+	action  client.ScheduleAction
+	actions []string
+}
+
+func (h *fakeScheduleHandle) Describe(ctx context.Context) (*client.ScheduleDescription, error) {
+	return &client.ScheduleDescription{Schedule: client.Schedule{Action: h.action}}, nil
+}
+
+func (h *fakeScheduleHandle) Pause(ctx context.Context, options client.SchedulePauseOptions) error {
+	h.actions = append(h.actions, "pause")
+	return nil
+}
+
+func (h *fakeScheduleHandle) Unpause(ctx context.Context, options client.ScheduleUnpauseOptions) error {
+	h.actions = append(h.actions, "unpause")
+	return nil
+}
+
+func (h *fakeScheduleHandle) Trigger(ctx context.Context, options client.ScheduleTriggerOptions) error {
+	h.actions = append(h.actions, "trigger")
+	return nil
+}
+
+func (h *fakeScheduleHandle) Delete(ctx context.Context) error {
+	h.actions = append(h.actions, "delete")
+	return nil
+}
+
+func TestSomeWorkflow3ScheduleActions(t *testing.T) {
+	require, ctx := require.New(t), context.Background()
+	handle := &fakeScheduleHandle{}
+	c := &mocks.Client{}
+	c.On("ScheduleClient").Return(&fakeScheduleClient{handle: handle})
+	simple := simplepb.NewSimpleClient(c)
+	act := func() []error {
+		return []error{
+			simple.PauseSomeWorkflow3Schedule(ctx, "some-schedule", "note"),
+			simple.UnpauseSomeWorkflow3Schedule(ctx, "some-schedule", "note"),
+			simple.TriggerSomeWorkflow3Schedule(ctx, "some-schedule"),
+			simple.DeleteSomeWorkflow3Schedule(ctx, "some-schedule"),
+		}
+	}
+
+	handle.action = &client.ScheduleWorkflowAction{Workflow: simplepb.SomeWorkflow1WorkflowName}
+	for _, err := range act() {
+		require.EqualError(err, fmt.Sprintf("schedule \"some-schedule\" does not start a(n) %s workflow", simplepb.SomeWorkflow3WorkflowName))
+	}
+	require.Empty(handle.actions)
+
+	handle.action = &client.ScheduleWorkflowAction{Workflow: simplepb.SomeWorkflow3WorkflowName}
+	for _, err := range act() {
+		require.NoError(err)
+	}
+	require.Equal([]string{"pause", "unpause", "trigger", "delete"}, handle.actions)
+}
+
+func TestSomeWorkflow4(t *testing.T) {
+	require, ctx := require.New(t), context.Background()
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	client := simplepb.NewTestSimpleClient(env, &Workflows{}, &Activities{})
+
+	run, err := client.SomeWorkflow4WithSetValueAsync(ctx, &commonpb.SetValueRequest{Value: "foo"})
+	require.NoError(err)
+
+	env.RegisterDelayedCallback(func() {
+		resp, err := run.GetValue(ctx)
+		require.NoError(err)
+		require.Equal("foo", resp.GetValue())
+	}, time.Second)
+
+	var handle commonpb.UpdateValueHandle
+	env.RegisterDelayedCallback(func() {
+		handle, err = run.UpdateValueAsync(ctx, &commonpb.UpdateValueRequest{Value: "bar"})
+		require.NoError(err)
+	}, time.Second*2)
+
+	env.RegisterDelayedCallback(func() {
+		require.NoError(run.SetValue(ctx, &commonpb.SetValueRequest{Value: "done"}))
+	}, time.Second*3)
+
+	require.NoError(run.Get(ctx))
+	update, err := handle.Get(ctx)
+	require.NoError(err)
+	require.Equal("foo", update.GetPrevious())
+}